@@ -19,10 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_AccountQuery_FullMethodName  = "/cosmos.accounts.v1.Query/AccountQuery"
-	Query_Schema_FullMethodName        = "/cosmos.accounts.v1.Query/Schema"
-	Query_AccountType_FullMethodName   = "/cosmos.accounts.v1.Query/AccountType"
-	Query_AccountNumber_FullMethodName = "/cosmos.accounts.v1.Query/AccountNumber"
+	Query_AccountQuery_FullMethodName          = "/cosmos.accounts.v1.Query/AccountQuery"
+	Query_Schema_FullMethodName                = "/cosmos.accounts.v1.Query/Schema"
+	Query_AccountType_FullMethodName           = "/cosmos.accounts.v1.Query/AccountType"
+	Query_AccountNumber_FullMethodName         = "/cosmos.accounts.v1.Query/AccountNumber"
+	Query_AccountImplementation_FullMethodName = "/cosmos.accounts.v1.Query/AccountImplementation"
+	Query_Params_FullMethodName                = "/cosmos.accounts.v1.Query/Params"
 )
 
 // QueryClient is the client API for Query service.
@@ -37,6 +39,11 @@ type QueryClient interface {
 	AccountType(ctx context.Context, in *AccountTypeRequest, opts ...grpc.CallOption) (*AccountTypeResponse, error)
 	// AccountNumber returns the account number given the account address.
 	AccountNumber(ctx context.Context, in *AccountNumberRequest, opts ...grpc.CallOption) (*AccountNumberResponse, error)
+	// AccountImplementation returns the account type of an address, along with the
+	// history of its migrations to other account types.
+	AccountImplementation(ctx context.Context, in *AccountImplementationRequest, opts ...grpc.CallOption) (*AccountImplementationResponse, error)
+	// Params returns the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -83,6 +90,24 @@ func (c *queryClient) AccountNumber(ctx context.Context, in *AccountNumberReques
 	return out, nil
 }
 
+func (c *queryClient) AccountImplementation(ctx context.Context, in *AccountImplementationRequest, opts ...grpc.CallOption) (*AccountImplementationResponse, error) {
+	out := new(AccountImplementationResponse)
+	err := c.cc.Invoke(ctx, Query_AccountImplementation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -95,6 +120,11 @@ type QueryServer interface {
 	AccountType(context.Context, *AccountTypeRequest) (*AccountTypeResponse, error)
 	// AccountNumber returns the account number given the account address.
 	AccountNumber(context.Context, *AccountNumberRequest) (*AccountNumberResponse, error)
+	// AccountImplementation returns the account type of an address, along with the
+	// history of its migrations to other account types.
+	AccountImplementation(context.Context, *AccountImplementationRequest) (*AccountImplementationResponse, error)
+	// Params returns the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -114,6 +144,12 @@ func (UnimplementedQueryServer) AccountType(context.Context, *AccountTypeRequest
 func (UnimplementedQueryServer) AccountNumber(context.Context, *AccountNumberRequest) (*AccountNumberResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountNumber not implemented")
 }
+func (UnimplementedQueryServer) AccountImplementation(context.Context, *AccountImplementationRequest) (*AccountImplementationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountImplementation not implemented")
+}
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -199,6 +235,42 @@ func _Query_AccountNumber_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccountImplementation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountImplementationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountImplementation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountImplementation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountImplementation(ctx, req.(*AccountImplementationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -222,6 +294,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AccountNumber",
 			Handler:    _Query_AccountNumber_Handler,
 		},
+		{
+			MethodName: "AccountImplementation",
+			Handler:    _Query_AccountImplementation_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/accounts/v1/query.proto",
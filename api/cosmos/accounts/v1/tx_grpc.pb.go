@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Init_FullMethodName          = "/cosmos.accounts.v1.Msg/Init"
-	Msg_Execute_FullMethodName       = "/cosmos.accounts.v1.Msg/Execute"
-	Msg_ExecuteBundle_FullMethodName = "/cosmos.accounts.v1.Msg/ExecuteBundle"
+	Msg_Init_FullMethodName            = "/cosmos.accounts.v1.Msg/Init"
+	Msg_Execute_FullMethodName         = "/cosmos.accounts.v1.Msg/Execute"
+	Msg_MigrateAccount_FullMethodName  = "/cosmos.accounts.v1.Msg/MigrateAccount"
+	Msg_ScheduleAccount_FullMethodName = "/cosmos.accounts.v1.Msg/ScheduleAccount"
+	Msg_UpdateParams_FullMethodName    = "/cosmos.accounts.v1.Msg/UpdateParams"
+	Msg_ExecuteBundle_FullMethodName   = "/cosmos.accounts.v1.Msg/ExecuteBundle"
 )
 
 // MsgClient is the client API for Msg service.
@@ -32,6 +35,14 @@ type MsgClient interface {
 	Init(ctx context.Context, in *MsgInit, opts ...grpc.CallOption) (*MsgInitResponse, error)
 	// Execute executes a message to the target account.
 	Execute(ctx context.Context, in *MsgExecute, opts ...grpc.CallOption) (*MsgExecuteResponse, error)
+	// MigrateAccount migrates an account to another account type, running the
+	// migration handler of the new account type over the account state.
+	MigrateAccount(ctx context.Context, in *MsgMigrateAccount, opts ...grpc.CallOption) (*MsgMigrateAccountResponse, error)
+	// ScheduleAccount schedules the collection of the due intents of a scheduler
+	// account at the end of the blocks, until it has no pending intents.
+	ScheduleAccount(ctx context.Context, in *MsgScheduleAccount, opts ...grpc.CallOption) (*MsgScheduleAccountResponse, error)
+	// UpdateParams updates the parameters of the module.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// ExecuteBundle pertains account abstraction, it is used by the bundler
 	// to execute multiple UserOperations in a single transaction message.
 	ExecuteBundle(ctx context.Context, in *MsgExecuteBundle, opts ...grpc.CallOption) (*MsgExecuteBundleResponse, error)
@@ -63,6 +74,33 @@ func (c *msgClient) Execute(ctx context.Context, in *MsgExecute, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) MigrateAccount(ctx context.Context, in *MsgMigrateAccount, opts ...grpc.CallOption) (*MsgMigrateAccountResponse, error) {
+	out := new(MsgMigrateAccountResponse)
+	err := c.cc.Invoke(ctx, Msg_MigrateAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleAccount(ctx context.Context, in *MsgScheduleAccount, opts ...grpc.CallOption) (*MsgScheduleAccountResponse, error) {
+	out := new(MsgScheduleAccountResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) ExecuteBundle(ctx context.Context, in *MsgExecuteBundle, opts ...grpc.CallOption) (*MsgExecuteBundleResponse, error) {
 	out := new(MsgExecuteBundleResponse)
 	err := c.cc.Invoke(ctx, Msg_ExecuteBundle_FullMethodName, in, out, opts...)
@@ -80,6 +118,14 @@ type MsgServer interface {
 	Init(context.Context, *MsgInit) (*MsgInitResponse, error)
 	// Execute executes a message to the target account.
 	Execute(context.Context, *MsgExecute) (*MsgExecuteResponse, error)
+	// MigrateAccount migrates an account to another account type, running the
+	// migration handler of the new account type over the account state.
+	MigrateAccount(context.Context, *MsgMigrateAccount) (*MsgMigrateAccountResponse, error)
+	// ScheduleAccount schedules the collection of the due intents of a scheduler
+	// account at the end of the blocks, until it has no pending intents.
+	ScheduleAccount(context.Context, *MsgScheduleAccount) (*MsgScheduleAccountResponse, error)
+	// UpdateParams updates the parameters of the module.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// ExecuteBundle pertains account abstraction, it is used by the bundler
 	// to execute multiple UserOperations in a single transaction message.
 	ExecuteBundle(context.Context, *MsgExecuteBundle) (*MsgExecuteBundleResponse, error)
@@ -96,6 +142,15 @@ func (UnimplementedMsgServer) Init(context.Context, *MsgInit) (*MsgInitResponse,
 func (UnimplementedMsgServer) Execute(context.Context, *MsgExecute) (*MsgExecuteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Execute not implemented")
 }
+func (UnimplementedMsgServer) MigrateAccount(context.Context, *MsgMigrateAccount) (*MsgMigrateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateAccount not implemented")
+}
+func (UnimplementedMsgServer) ScheduleAccount(context.Context, *MsgScheduleAccount) (*MsgScheduleAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleAccount not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 func (UnimplementedMsgServer) ExecuteBundle(context.Context, *MsgExecuteBundle) (*MsgExecuteBundleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteBundle not implemented")
 }
@@ -148,6 +203,60 @@ func _Msg_Execute_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MigrateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_MigrateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateAccount(ctx, req.(*MsgMigrateAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleAccount(ctx, req.(*MsgScheduleAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_ExecuteBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgExecuteBundle)
 	if err := dec(in); err != nil {
@@ -181,6 +290,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Execute",
 			Handler:    _Msg_Execute_Handler,
 		},
+		{
+			MethodName: "MigrateAccount",
+			Handler:    _Msg_MigrateAccount_Handler,
+		},
+		{
+			MethodName: "ScheduleAccount",
+			Handler:    _Msg_ScheduleAccount_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 		{
 			MethodName: "ExecuteBundle",
 			Handler:    _Msg_ExecuteBundle_Handler,
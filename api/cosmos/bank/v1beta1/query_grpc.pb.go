@@ -32,6 +32,12 @@ const (
 	Query_DenomOwners_FullMethodName                = "/cosmos.bank.v1beta1.Query/DenomOwners"
 	Query_DenomOwnersByQuery_FullMethodName         = "/cosmos.bank.v1beta1.Query/DenomOwnersByQuery"
 	Query_SendEnabled_FullMethodName                = "/cosmos.bank.v1beta1.Query/SendEnabled"
+	Query_SendHooks_FullMethodName                  = "/cosmos.bank.v1beta1.Query/SendHooks"
+	Query_DenomAdmin_FullMethodName                 = "/cosmos.bank.v1beta1.Query/DenomAdmin"
+	Query_DenomsFromCreator_FullMethodName          = "/cosmos.bank.v1beta1.Query/DenomsFromCreator"
+	Query_BalanceHistory_FullMethodName             = "/cosmos.bank.v1beta1.Query/BalanceHistory"
+	Query_HistoricalBalance_FullMethodName          = "/cosmos.bank.v1beta1.Query/HistoricalBalance"
+	Query_AddressTransfers_FullMethodName           = "/cosmos.bank.v1beta1.Query/AddressTransfers"
 )
 
 // QueryClient is the client API for Query service.
@@ -90,6 +96,18 @@ type QueryClient interface {
 	// Any denomination that does not have a specific setting will use the default
 	// params.default_send_enabled, and will not be returned by this query.
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// SendHooks queries the send hooks which apply to a denom, in the order they run.
+	SendHooks(ctx context.Context, in *QuerySendHooksRequest, opts ...grpc.CallOption) (*QuerySendHooksResponse, error)
+	// DenomAdmin queries the admin of a token factory denom.
+	DenomAdmin(ctx context.Context, in *QueryDenomAdminRequest, opts ...grpc.CallOption) (*QueryDenomAdminResponse, error)
+	// DenomsFromCreator queries the token factory denoms created by an account.
+	DenomsFromCreator(ctx context.Context, in *QueryDenomsFromCreatorRequest, opts ...grpc.CallOption) (*QueryDenomsFromCreatorResponse, error)
+	// BalanceHistory queries the balance changes of an account, recorded by the balance change log.
+	BalanceHistory(ctx context.Context, in *QueryBalanceHistoryRequest, opts ...grpc.CallOption) (*QueryBalanceHistoryResponse, error)
+	// HistoricalBalance queries the balance of an account for a denom at a past height, from the balance change log.
+	HistoricalBalance(ctx context.Context, in *QueryHistoricalBalanceRequest, opts ...grpc.CallOption) (*QueryHistoricalBalanceResponse, error)
+	// AddressTransfers queries the transfers sent or received by an account, recorded by the transfer log.
+	AddressTransfers(ctx context.Context, in *QueryAddressTransfersRequest, opts ...grpc.CallOption) (*QueryAddressTransfersResponse, error)
 }
 
 type queryClient struct {
@@ -217,6 +235,60 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) SendHooks(ctx context.Context, in *QuerySendHooksRequest, opts ...grpc.CallOption) (*QuerySendHooksResponse, error) {
+	out := new(QuerySendHooksResponse)
+	err := c.cc.Invoke(ctx, Query_SendHooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomAdmin(ctx context.Context, in *QueryDenomAdminRequest, opts ...grpc.CallOption) (*QueryDenomAdminResponse, error) {
+	out := new(QueryDenomAdminResponse)
+	err := c.cc.Invoke(ctx, Query_DenomAdmin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomsFromCreator(ctx context.Context, in *QueryDenomsFromCreatorRequest, opts ...grpc.CallOption) (*QueryDenomsFromCreatorResponse, error) {
+	out := new(QueryDenomsFromCreatorResponse)
+	err := c.cc.Invoke(ctx, Query_DenomsFromCreator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BalanceHistory(ctx context.Context, in *QueryBalanceHistoryRequest, opts ...grpc.CallOption) (*QueryBalanceHistoryResponse, error) {
+	out := new(QueryBalanceHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_BalanceHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HistoricalBalance(ctx context.Context, in *QueryHistoricalBalanceRequest, opts ...grpc.CallOption) (*QueryHistoricalBalanceResponse, error) {
+	out := new(QueryHistoricalBalanceResponse)
+	err := c.cc.Invoke(ctx, Query_HistoricalBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressTransfers(ctx context.Context, in *QueryAddressTransfersRequest, opts ...grpc.CallOption) (*QueryAddressTransfersResponse, error) {
+	out := new(QueryAddressTransfersResponse)
+	err := c.cc.Invoke(ctx, Query_AddressTransfers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -273,6 +345,18 @@ type QueryServer interface {
 	// Any denomination that does not have a specific setting will use the default
 	// params.default_send_enabled, and will not be returned by this query.
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// SendHooks queries the send hooks which apply to a denom, in the order they run.
+	SendHooks(context.Context, *QuerySendHooksRequest) (*QuerySendHooksResponse, error)
+	// DenomAdmin queries the admin of a token factory denom.
+	DenomAdmin(context.Context, *QueryDenomAdminRequest) (*QueryDenomAdminResponse, error)
+	// DenomsFromCreator queries the token factory denoms created by an account.
+	DenomsFromCreator(context.Context, *QueryDenomsFromCreatorRequest) (*QueryDenomsFromCreatorResponse, error)
+	// BalanceHistory queries the balance changes of an account, recorded by the balance change log.
+	BalanceHistory(context.Context, *QueryBalanceHistoryRequest) (*QueryBalanceHistoryResponse, error)
+	// HistoricalBalance queries the balance of an account for a denom at a past height, from the balance change log.
+	HistoricalBalance(context.Context, *QueryHistoricalBalanceRequest) (*QueryHistoricalBalanceResponse, error)
+	// AddressTransfers queries the transfers sent or received by an account, recorded by the transfer log.
+	AddressTransfers(context.Context, *QueryAddressTransfersRequest) (*QueryAddressTransfersResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -319,6 +403,24 @@ func (UnimplementedQueryServer) DenomOwnersByQuery(context.Context, *QueryDenomO
 func (UnimplementedQueryServer) SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (UnimplementedQueryServer) SendHooks(context.Context, *QuerySendHooksRequest) (*QuerySendHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendHooks not implemented")
+}
+func (UnimplementedQueryServer) DenomAdmin(context.Context, *QueryDenomAdminRequest) (*QueryDenomAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomAdmin not implemented")
+}
+func (UnimplementedQueryServer) DenomsFromCreator(context.Context, *QueryDenomsFromCreatorRequest) (*QueryDenomsFromCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomsFromCreator not implemented")
+}
+func (UnimplementedQueryServer) BalanceHistory(context.Context, *QueryBalanceHistoryRequest) (*QueryBalanceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalanceHistory not implemented")
+}
+func (UnimplementedQueryServer) HistoricalBalance(context.Context, *QueryHistoricalBalanceRequest) (*QueryHistoricalBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalBalance not implemented")
+}
+func (UnimplementedQueryServer) AddressTransfers(context.Context, *QueryAddressTransfersRequest) (*QueryAddressTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressTransfers not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -566,6 +668,114 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SendHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySendHooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SendHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SendHooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SendHooks(ctx, req.(*QuerySendHooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomAdmin(ctx, req.(*QueryDenomAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomsFromCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomsFromCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomsFromCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomsFromCreator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomsFromCreator(ctx, req.(*QueryDenomsFromCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BalanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BalanceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalanceHistory(ctx, req.(*QueryBalanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HistoricalBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricalBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricalBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_HistoricalBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricalBalance(ctx, req.(*QueryHistoricalBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AddressTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressTransfers(ctx, req.(*QueryAddressTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -625,6 +835,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "SendHooks",
+			Handler:    _Query_SendHooks_Handler,
+		},
+		{
+			MethodName: "DenomAdmin",
+			Handler:    _Query_DenomAdmin_Handler,
+		},
+		{
+			MethodName: "DenomsFromCreator",
+			Handler:    _Query_DenomsFromCreator_Handler,
+		},
+		{
+			MethodName: "BalanceHistory",
+			Handler:    _Query_BalanceHistory_Handler,
+		},
+		{
+			MethodName: "HistoricalBalance",
+			Handler:    _Query_HistoricalBalance_Handler,
+		},
+		{
+			MethodName: "AddressTransfers",
+			Handler:    _Query_AddressTransfers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -19,11 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Send_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Send"
-	Msg_MultiSend_FullMethodName      = "/cosmos.bank.v1beta1.Msg/MultiSend"
-	Msg_Burn_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Burn"
-	Msg_UpdateParams_FullMethodName   = "/cosmos.bank.v1beta1.Msg/UpdateParams"
-	Msg_SetSendEnabled_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_Send_FullMethodName             = "/cosmos.bank.v1beta1.Msg/Send"
+	Msg_MultiSend_FullMethodName        = "/cosmos.bank.v1beta1.Msg/MultiSend"
+	Msg_Burn_FullMethodName             = "/cosmos.bank.v1beta1.Msg/Burn"
+	Msg_UpdateParams_FullMethodName     = "/cosmos.bank.v1beta1.Msg/UpdateParams"
+	Msg_SetSendEnabled_FullMethodName   = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_CreateDenom_FullMethodName      = "/cosmos.bank.v1beta1.Msg/CreateDenom"
+	Msg_FactoryMint_FullMethodName      = "/cosmos.bank.v1beta1.Msg/FactoryMint"
+	Msg_FactoryBurn_FullMethodName      = "/cosmos.bank.v1beta1.Msg/FactoryBurn"
+	Msg_ChangeDenomAdmin_FullMethodName = "/cosmos.bank.v1beta1.Msg/ChangeDenomAdmin"
+	Msg_SetDenomMetadata_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetDenomMetadata"
 )
 
 // MsgClient is the client API for Msg service.
@@ -44,6 +49,17 @@ type MsgClient interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// CreateDenom creates a token factory denom, in the form factory/{sender}/{subdenom}.
+	// The sender becomes the admin of the denom and pays the denom creation fee.
+	CreateDenom(ctx context.Context, in *MsgCreateDenom, opts ...grpc.CallOption) (*MsgCreateDenomResponse, error)
+	// FactoryMint mints coins of a token factory denom. Only the admin of the denom can mint.
+	FactoryMint(ctx context.Context, in *MsgFactoryMint, opts ...grpc.CallOption) (*MsgFactoryMintResponse, error)
+	// FactoryBurn burns coins of a token factory denom. Only the admin of the denom can burn.
+	FactoryBurn(ctx context.Context, in *MsgFactoryBurn, opts ...grpc.CallOption) (*MsgFactoryBurnResponse, error)
+	// ChangeDenomAdmin transfers the admin rights of a token factory denom.
+	ChangeDenomAdmin(ctx context.Context, in *MsgChangeDenomAdmin, opts ...grpc.CallOption) (*MsgChangeDenomAdminResponse, error)
+	// SetDenomMetadata sets the metadata of a token factory denom. Only the admin of the denom can set it.
+	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
 }
 
 type msgClient struct {
@@ -99,6 +115,51 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) CreateDenom(ctx context.Context, in *MsgCreateDenom, opts ...grpc.CallOption) (*MsgCreateDenomResponse, error) {
+	out := new(MsgCreateDenomResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateDenom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FactoryMint(ctx context.Context, in *MsgFactoryMint, opts ...grpc.CallOption) (*MsgFactoryMintResponse, error) {
+	out := new(MsgFactoryMintResponse)
+	err := c.cc.Invoke(ctx, Msg_FactoryMint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FactoryBurn(ctx context.Context, in *MsgFactoryBurn, opts ...grpc.CallOption) (*MsgFactoryBurnResponse, error) {
+	out := new(MsgFactoryBurnResponse)
+	err := c.cc.Invoke(ctx, Msg_FactoryBurn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ChangeDenomAdmin(ctx context.Context, in *MsgChangeDenomAdmin, opts ...grpc.CallOption) (*MsgChangeDenomAdminResponse, error) {
+	out := new(MsgChangeDenomAdminResponse)
+	err := c.cc.Invoke(ctx, Msg_ChangeDenomAdmin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error) {
+	out := new(MsgSetDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -117,6 +178,17 @@ type MsgServer interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// CreateDenom creates a token factory denom, in the form factory/{sender}/{subdenom}.
+	// The sender becomes the admin of the denom and pays the denom creation fee.
+	CreateDenom(context.Context, *MsgCreateDenom) (*MsgCreateDenomResponse, error)
+	// FactoryMint mints coins of a token factory denom. Only the admin of the denom can mint.
+	FactoryMint(context.Context, *MsgFactoryMint) (*MsgFactoryMintResponse, error)
+	// FactoryBurn burns coins of a token factory denom. Only the admin of the denom can burn.
+	FactoryBurn(context.Context, *MsgFactoryBurn) (*MsgFactoryBurnResponse, error)
+	// ChangeDenomAdmin transfers the admin rights of a token factory denom.
+	ChangeDenomAdmin(context.Context, *MsgChangeDenomAdmin) (*MsgChangeDenomAdminResponse, error)
+	// SetDenomMetadata sets the metadata of a token factory denom. Only the admin of the denom can set it.
+	SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -139,6 +211,21 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (UnimplementedMsgServer) CreateDenom(context.Context, *MsgCreateDenom) (*MsgCreateDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDenom not implemented")
+}
+func (UnimplementedMsgServer) FactoryMint(context.Context, *MsgFactoryMint) (*MsgFactoryMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FactoryMint not implemented")
+}
+func (UnimplementedMsgServer) FactoryBurn(context.Context, *MsgFactoryBurn) (*MsgFactoryBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FactoryBurn not implemented")
+}
+func (UnimplementedMsgServer) ChangeDenomAdmin(context.Context, *MsgChangeDenomAdmin) (*MsgChangeDenomAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeDenomAdmin not implemented")
+}
+func (UnimplementedMsgServer) SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadata not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -242,6 +329,96 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDenom(ctx, req.(*MsgCreateDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FactoryMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFactoryMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FactoryMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FactoryMint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FactoryMint(ctx, req.(*MsgFactoryMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FactoryBurn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFactoryBurn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FactoryBurn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FactoryBurn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FactoryBurn(ctx, req.(*MsgFactoryBurn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeDenomAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeDenomAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeDenomAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ChangeDenomAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeDenomAdmin(ctx, req.(*MsgChangeDenomAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDenomMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomMetadata(ctx, req.(*MsgSetDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -269,6 +446,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "CreateDenom",
+			Handler:    _Msg_CreateDenom_Handler,
+		},
+		{
+			MethodName: "FactoryMint",
+			Handler:    _Msg_FactoryMint_Handler,
+		},
+		{
+			MethodName: "FactoryBurn",
+			Handler:    _Msg_FactoryBurn_Handler,
+		},
+		{
+			MethodName: "ChangeDenomAdmin",
+			Handler:    _Msg_ChangeDenomAdmin_Handler,
+		},
+		{
+			MethodName: "SetDenomMetadata",
+			Handler:    _Msg_SetDenomMetadata_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
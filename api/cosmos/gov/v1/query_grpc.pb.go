@@ -32,6 +32,12 @@ const (
 	Query_TallyResult_FullMethodName         = "/cosmos.gov.v1.Query/TallyResult"
 	Query_ProposalVoteOptions_FullMethodName = "/cosmos.gov.v1.Query/ProposalVoteOptions"
 	Query_MessageBasedParams_FullMethodName  = "/cosmos.gov.v1.Query/MessageBasedParams"
+	Query_TallyStrategies_FullMethodName     = "/cosmos.gov.v1.Query/TallyStrategies"
+	Query_Ballot_FullMethodName              = "/cosmos.gov.v1.Query/Ballot"
+	Query_Ballots_FullMethodName             = "/cosmos.gov.v1.Query/Ballots"
+	Query_BallotTally_FullMethodName         = "/cosmos.gov.v1.Query/BallotTally"
+	Query_VoteDelegation_FullMethodName      = "/cosmos.gov.v1.Query/VoteDelegation"
+	Query_VoteDelegations_FullMethodName     = "/cosmos.gov.v1.Query/VoteDelegations"
 )
 
 // QueryClient is the client API for Query service.
@@ -60,6 +66,19 @@ type QueryClient interface {
 	ProposalVoteOptions(ctx context.Context, in *QueryProposalVoteOptionsRequest, opts ...grpc.CallOption) (*QueryProposalVoteOptionsResponse, error)
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error)
+	// TallyStrategies queries the registered tally strategies and their params.
+	TallyStrategies(ctx context.Context, in *QueryTallyStrategiesRequest, opts ...grpc.CallOption) (*QueryTallyStrategiesResponse, error)
+	// Ballot queries the ballot of a voter on a ranked-choice or approval proposal.
+	Ballot(ctx context.Context, in *QueryBallotRequest, opts ...grpc.CallOption) (*QueryBallotResponse, error)
+	// Ballots queries the ballots of a ranked-choice or approval proposal.
+	Ballots(ctx context.Context, in *QueryBallotsRequest, opts ...grpc.CallOption) (*QueryBallotsResponse, error)
+	// BallotTally queries the round-by-round tally of the ballots of a ranked-choice or approval proposal.
+	BallotTally(ctx context.Context, in *QueryBallotTallyRequest, opts ...grpc.CallOption) (*QueryBallotTallyResponse, error)
+	// VoteDelegation queries the vote delegation of an account for a topic.
+	// The topic is passed as a query parameter, an empty topic queries the global vote delegation.
+	VoteDelegation(ctx context.Context, in *QueryVoteDelegationRequest, opts ...grpc.CallOption) (*QueryVoteDelegationResponse, error)
+	// VoteDelegations queries all the vote delegations of an account.
+	VoteDelegations(ctx context.Context, in *QueryVoteDelegationsRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsResponse, error)
 }
 
 type queryClient struct {
@@ -169,6 +188,60 @@ func (c *queryClient) MessageBasedParams(ctx context.Context, in *QueryMessageBa
 	return out, nil
 }
 
+func (c *queryClient) TallyStrategies(ctx context.Context, in *QueryTallyStrategiesRequest, opts ...grpc.CallOption) (*QueryTallyStrategiesResponse, error) {
+	out := new(QueryTallyStrategiesResponse)
+	err := c.cc.Invoke(ctx, Query_TallyStrategies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Ballot(ctx context.Context, in *QueryBallotRequest, opts ...grpc.CallOption) (*QueryBallotResponse, error) {
+	out := new(QueryBallotResponse)
+	err := c.cc.Invoke(ctx, Query_Ballot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Ballots(ctx context.Context, in *QueryBallotsRequest, opts ...grpc.CallOption) (*QueryBallotsResponse, error) {
+	out := new(QueryBallotsResponse)
+	err := c.cc.Invoke(ctx, Query_Ballots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BallotTally(ctx context.Context, in *QueryBallotTallyRequest, opts ...grpc.CallOption) (*QueryBallotTallyResponse, error) {
+	out := new(QueryBallotTallyResponse)
+	err := c.cc.Invoke(ctx, Query_BallotTally_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VoteDelegation(ctx context.Context, in *QueryVoteDelegationRequest, opts ...grpc.CallOption) (*QueryVoteDelegationResponse, error) {
+	out := new(QueryVoteDelegationResponse)
+	err := c.cc.Invoke(ctx, Query_VoteDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VoteDelegations(ctx context.Context, in *QueryVoteDelegationsRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsResponse, error) {
+	out := new(QueryVoteDelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_VoteDelegations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -195,6 +268,19 @@ type QueryServer interface {
 	ProposalVoteOptions(context.Context, *QueryProposalVoteOptionsRequest) (*QueryProposalVoteOptionsResponse, error)
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error)
+	// TallyStrategies queries the registered tally strategies and their params.
+	TallyStrategies(context.Context, *QueryTallyStrategiesRequest) (*QueryTallyStrategiesResponse, error)
+	// Ballot queries the ballot of a voter on a ranked-choice or approval proposal.
+	Ballot(context.Context, *QueryBallotRequest) (*QueryBallotResponse, error)
+	// Ballots queries the ballots of a ranked-choice or approval proposal.
+	Ballots(context.Context, *QueryBallotsRequest) (*QueryBallotsResponse, error)
+	// BallotTally queries the round-by-round tally of the ballots of a ranked-choice or approval proposal.
+	BallotTally(context.Context, *QueryBallotTallyRequest) (*QueryBallotTallyResponse, error)
+	// VoteDelegation queries the vote delegation of an account for a topic.
+	// The topic is passed as a query parameter, an empty topic queries the global vote delegation.
+	VoteDelegation(context.Context, *QueryVoteDelegationRequest) (*QueryVoteDelegationResponse, error)
+	// VoteDelegations queries all the vote delegations of an account.
+	VoteDelegations(context.Context, *QueryVoteDelegationsRequest) (*QueryVoteDelegationsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -235,6 +321,24 @@ func (UnimplementedQueryServer) ProposalVoteOptions(context.Context, *QueryPropo
 func (UnimplementedQueryServer) MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MessageBasedParams not implemented")
 }
+func (UnimplementedQueryServer) TallyStrategies(context.Context, *QueryTallyStrategiesRequest) (*QueryTallyStrategiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TallyStrategies not implemented")
+}
+func (UnimplementedQueryServer) Ballot(context.Context, *QueryBallotRequest) (*QueryBallotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ballot not implemented")
+}
+func (UnimplementedQueryServer) Ballots(context.Context, *QueryBallotsRequest) (*QueryBallotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ballots not implemented")
+}
+func (UnimplementedQueryServer) BallotTally(context.Context, *QueryBallotTallyRequest) (*QueryBallotTallyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BallotTally not implemented")
+}
+func (UnimplementedQueryServer) VoteDelegation(context.Context, *QueryVoteDelegationRequest) (*QueryVoteDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDelegation not implemented")
+}
+func (UnimplementedQueryServer) VoteDelegations(context.Context, *QueryVoteDelegationsRequest) (*QueryVoteDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDelegations not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -446,6 +550,114 @@ func _Query_MessageBasedParams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TallyStrategies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTallyStrategiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TallyStrategies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TallyStrategies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TallyStrategies(ctx, req.(*QueryTallyStrategiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Ballot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBallotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Ballot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Ballot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Ballot(ctx, req.(*QueryBallotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Ballots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBallotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Ballots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Ballots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Ballots(ctx, req.(*QueryBallotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BallotTally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBallotTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BallotTally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BallotTally_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BallotTally(ctx, req.(*QueryBallotTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VoteDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VoteDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteDelegation(ctx, req.(*QueryVoteDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VoteDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VoteDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteDelegations(ctx, req.(*QueryVoteDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -497,6 +709,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MessageBasedParams",
 			Handler:    _Query_MessageBasedParams_Handler,
 		},
+		{
+			MethodName: "TallyStrategies",
+			Handler:    _Query_TallyStrategies_Handler,
+		},
+		{
+			MethodName: "Ballot",
+			Handler:    _Query_Ballot_Handler,
+		},
+		{
+			MethodName: "Ballots",
+			Handler:    _Query_Ballots_Handler,
+		},
+		{
+			MethodName: "BallotTally",
+			Handler:    _Query_BallotTally_Handler,
+		},
+		{
+			MethodName: "VoteDelegation",
+			Handler:    _Query_VoteDelegation_Handler,
+		},
+		{
+			MethodName: "VoteDelegations",
+			Handler:    _Query_VoteDelegations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
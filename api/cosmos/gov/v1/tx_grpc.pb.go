@@ -31,6 +31,11 @@ const (
 	Msg_SubmitMultipleChoiceProposal_FullMethodName = "/cosmos.gov.v1.Msg/SubmitMultipleChoiceProposal"
 	Msg_UpdateMessageParams_FullMethodName          = "/cosmos.gov.v1.Msg/UpdateMessageParams"
 	Msg_SudoExec_FullMethodName                     = "/cosmos.gov.v1.Msg/SudoExec"
+	Msg_UpdateTallyStrategyParams_FullMethodName    = "/cosmos.gov.v1.Msg/UpdateTallyStrategyParams"
+	Msg_VoteRankedChoice_FullMethodName             = "/cosmos.gov.v1.Msg/VoteRankedChoice"
+	Msg_VoteApproval_FullMethodName                 = "/cosmos.gov.v1.Msg/VoteApproval"
+	Msg_DelegateVote_FullMethodName                 = "/cosmos.gov.v1.Msg/DelegateVote"
+	Msg_UndelegateVote_FullMethodName               = "/cosmos.gov.v1.Msg/UndelegateVote"
 )
 
 // MsgClient is the client API for Msg service.
@@ -60,6 +65,17 @@ type MsgClient interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.CallOption) (*MsgSudoExecResponse, error)
+	// UpdateTallyStrategyParams defines a method to set the params of a tally strategy when used in a governance proposal.
+	UpdateTallyStrategyParams(ctx context.Context, in *MsgUpdateTallyStrategyParams, opts ...grpc.CallOption) (*MsgUpdateTallyStrategyParamsResponse, error)
+	// VoteRankedChoice defines a method to cast a ranked ballot on a ranked-choice multiple choice proposal.
+	VoteRankedChoice(ctx context.Context, in *MsgVoteRankedChoice, opts ...grpc.CallOption) (*MsgVoteRankedChoiceResponse, error)
+	// VoteApproval defines a method to cast an approval ballot on an approval multiple choice proposal.
+	VoteApproval(ctx context.Context, in *MsgVoteApproval, opts ...grpc.CallOption) (*MsgVoteApprovalResponse, error)
+	// DelegateVote defines a method to delegate the governance voting power of an account to another account,
+	// globally or for a topic.
+	DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error)
+	// UndelegateVote defines a method to remove a vote delegation.
+	UndelegateVote(ctx context.Context, in *MsgUndelegateVote, opts ...grpc.CallOption) (*MsgUndelegateVoteResponse, error)
 }
 
 type msgClient struct {
@@ -160,6 +176,51 @@ func (c *msgClient) SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) UpdateTallyStrategyParams(ctx context.Context, in *MsgUpdateTallyStrategyParams, opts ...grpc.CallOption) (*MsgUpdateTallyStrategyParamsResponse, error) {
+	out := new(MsgUpdateTallyStrategyParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateTallyStrategyParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VoteRankedChoice(ctx context.Context, in *MsgVoteRankedChoice, opts ...grpc.CallOption) (*MsgVoteRankedChoiceResponse, error) {
+	out := new(MsgVoteRankedChoiceResponse)
+	err := c.cc.Invoke(ctx, Msg_VoteRankedChoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VoteApproval(ctx context.Context, in *MsgVoteApproval, opts ...grpc.CallOption) (*MsgVoteApprovalResponse, error) {
+	out := new(MsgVoteApprovalResponse)
+	err := c.cc.Invoke(ctx, Msg_VoteApproval_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error) {
+	out := new(MsgDelegateVoteResponse)
+	err := c.cc.Invoke(ctx, Msg_DelegateVote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateVote(ctx context.Context, in *MsgUndelegateVote, opts ...grpc.CallOption) (*MsgUndelegateVoteResponse, error) {
+	out := new(MsgUndelegateVoteResponse)
+	err := c.cc.Invoke(ctx, Msg_UndelegateVote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -187,6 +248,17 @@ type MsgServer interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error)
+	// UpdateTallyStrategyParams defines a method to set the params of a tally strategy when used in a governance proposal.
+	UpdateTallyStrategyParams(context.Context, *MsgUpdateTallyStrategyParams) (*MsgUpdateTallyStrategyParamsResponse, error)
+	// VoteRankedChoice defines a method to cast a ranked ballot on a ranked-choice multiple choice proposal.
+	VoteRankedChoice(context.Context, *MsgVoteRankedChoice) (*MsgVoteRankedChoiceResponse, error)
+	// VoteApproval defines a method to cast an approval ballot on an approval multiple choice proposal.
+	VoteApproval(context.Context, *MsgVoteApproval) (*MsgVoteApprovalResponse, error)
+	// DelegateVote defines a method to delegate the governance voting power of an account to another account,
+	// globally or for a topic.
+	DelegateVote(context.Context, *MsgDelegateVote) (*MsgDelegateVoteResponse, error)
+	// UndelegateVote defines a method to remove a vote delegation.
+	UndelegateVote(context.Context, *MsgUndelegateVote) (*MsgUndelegateVoteResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -224,6 +296,21 @@ func (UnimplementedMsgServer) UpdateMessageParams(context.Context, *MsgUpdateMes
 func (UnimplementedMsgServer) SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SudoExec not implemented")
 }
+func (UnimplementedMsgServer) UpdateTallyStrategyParams(context.Context, *MsgUpdateTallyStrategyParams) (*MsgUpdateTallyStrategyParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTallyStrategyParams not implemented")
+}
+func (UnimplementedMsgServer) VoteRankedChoice(context.Context, *MsgVoteRankedChoice) (*MsgVoteRankedChoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteRankedChoice not implemented")
+}
+func (UnimplementedMsgServer) VoteApproval(context.Context, *MsgVoteApproval) (*MsgVoteApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteApproval not implemented")
+}
+func (UnimplementedMsgServer) DelegateVote(context.Context, *MsgDelegateVote) (*MsgDelegateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateVote not implemented")
+}
+func (UnimplementedMsgServer) UndelegateVote(context.Context, *MsgUndelegateVote) (*MsgUndelegateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateVote not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -417,6 +504,96 @@ func _Msg_SudoExec_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateTallyStrategyParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTallyStrategyParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTallyStrategyParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateTallyStrategyParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTallyStrategyParams(ctx, req.(*MsgUpdateTallyStrategyParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VoteRankedChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteRankedChoice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteRankedChoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_VoteRankedChoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteRankedChoice(ctx, req.(*MsgVoteRankedChoice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VoteApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteApproval)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_VoteApproval_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteApproval(ctx, req.(*MsgVoteApproval))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelegateVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateVote(ctx, req.(*MsgDelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UndelegateVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateVote(ctx, req.(*MsgUndelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -464,6 +641,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SudoExec",
 			Handler:    _Msg_SudoExec_Handler,
 		},
+		{
+			MethodName: "UpdateTallyStrategyParams",
+			Handler:    _Msg_UpdateTallyStrategyParams_Handler,
+		},
+		{
+			MethodName: "VoteRankedChoice",
+			Handler:    _Msg_VoteRankedChoice_Handler,
+		},
+		{
+			MethodName: "VoteApproval",
+			Handler:    _Msg_VoteApproval_Handler,
+		},
+		{
+			MethodName: "DelegateVote",
+			Handler:    _Msg_DelegateVote_Handler,
+		},
+		{
+			MethodName: "UndelegateVote",
+			Handler:    _Msg_UndelegateVote_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
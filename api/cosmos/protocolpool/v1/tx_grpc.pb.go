@@ -26,6 +26,10 @@ const (
 	Msg_CreateContinuousFund_FullMethodName   = "/cosmos.protocolpool.v1.Msg/CreateContinuousFund"
 	Msg_WithdrawContinuousFund_FullMethodName = "/cosmos.protocolpool.v1.Msg/WithdrawContinuousFund"
 	Msg_CancelContinuousFund_FullMethodName   = "/cosmos.protocolpool.v1.Msg/CancelContinuousFund"
+	Msg_CreatePaymentStream_FullMethodName    = "/cosmos.protocolpool.v1.Msg/CreatePaymentStream"
+	Msg_TopUpPaymentStream_FullMethodName     = "/cosmos.protocolpool.v1.Msg/TopUpPaymentStream"
+	Msg_CancelPaymentStream_FullMethodName    = "/cosmos.protocolpool.v1.Msg/CancelPaymentStream"
+	Msg_ClaimPaymentStream_FullMethodName     = "/cosmos.protocolpool.v1.Msg/ClaimPaymentStream"
 )
 
 // MsgClient is the client API for Msg service.
@@ -50,6 +54,14 @@ type MsgClient interface {
 	WithdrawContinuousFund(ctx context.Context, in *MsgWithdrawContinuousFund, opts ...grpc.CallOption) (*MsgWithdrawContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(ctx context.Context, in *MsgCancelContinuousFund, opts ...grpc.CallOption) (*MsgCancelContinuousFundResponse, error)
+	// CreatePaymentStream defines a method for any account to escrow funds and stream them to a recipient.
+	CreatePaymentStream(ctx context.Context, in *MsgCreatePaymentStream, opts ...grpc.CallOption) (*MsgCreatePaymentStreamResponse, error)
+	// TopUpPaymentStream defines a method for the sender of a payment stream to add funds to its deposit.
+	TopUpPaymentStream(ctx context.Context, in *MsgTopUpPaymentStream, opts ...grpc.CallOption) (*MsgTopUpPaymentStreamResponse, error)
+	// CancelPaymentStream defines a method for the sender of a payment stream to cancel it.
+	CancelPaymentStream(ctx context.Context, in *MsgCancelPaymentStream, opts ...grpc.CallOption) (*MsgCancelPaymentStreamResponse, error)
+	// ClaimPaymentStream defines a method for the recipient of a payment stream to claim the released funds.
+	ClaimPaymentStream(ctx context.Context, in *MsgClaimPaymentStream, opts ...grpc.CallOption) (*MsgClaimPaymentStreamResponse, error)
 }
 
 type msgClient struct {
@@ -123,6 +135,42 @@ func (c *msgClient) CancelContinuousFund(ctx context.Context, in *MsgCancelConti
 	return out, nil
 }
 
+func (c *msgClient) CreatePaymentStream(ctx context.Context, in *MsgCreatePaymentStream, opts ...grpc.CallOption) (*MsgCreatePaymentStreamResponse, error) {
+	out := new(MsgCreatePaymentStreamResponse)
+	err := c.cc.Invoke(ctx, Msg_CreatePaymentStream_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TopUpPaymentStream(ctx context.Context, in *MsgTopUpPaymentStream, opts ...grpc.CallOption) (*MsgTopUpPaymentStreamResponse, error) {
+	out := new(MsgTopUpPaymentStreamResponse)
+	err := c.cc.Invoke(ctx, Msg_TopUpPaymentStream_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelPaymentStream(ctx context.Context, in *MsgCancelPaymentStream, opts ...grpc.CallOption) (*MsgCancelPaymentStreamResponse, error) {
+	out := new(MsgCancelPaymentStreamResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelPaymentStream_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimPaymentStream(ctx context.Context, in *MsgClaimPaymentStream, opts ...grpc.CallOption) (*MsgClaimPaymentStreamResponse, error) {
+	out := new(MsgClaimPaymentStreamResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimPaymentStream_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -145,6 +193,14 @@ type MsgServer interface {
 	WithdrawContinuousFund(context.Context, *MsgWithdrawContinuousFund) (*MsgWithdrawContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error)
+	// CreatePaymentStream defines a method for any account to escrow funds and stream them to a recipient.
+	CreatePaymentStream(context.Context, *MsgCreatePaymentStream) (*MsgCreatePaymentStreamResponse, error)
+	// TopUpPaymentStream defines a method for the sender of a payment stream to add funds to its deposit.
+	TopUpPaymentStream(context.Context, *MsgTopUpPaymentStream) (*MsgTopUpPaymentStreamResponse, error)
+	// CancelPaymentStream defines a method for the sender of a payment stream to cancel it.
+	CancelPaymentStream(context.Context, *MsgCancelPaymentStream) (*MsgCancelPaymentStreamResponse, error)
+	// ClaimPaymentStream defines a method for the recipient of a payment stream to claim the released funds.
+	ClaimPaymentStream(context.Context, *MsgClaimPaymentStream) (*MsgClaimPaymentStreamResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -173,6 +229,18 @@ func (UnimplementedMsgServer) WithdrawContinuousFund(context.Context, *MsgWithdr
 func (UnimplementedMsgServer) CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelContinuousFund not implemented")
 }
+func (UnimplementedMsgServer) CreatePaymentStream(context.Context, *MsgCreatePaymentStream) (*MsgCreatePaymentStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePaymentStream not implemented")
+}
+func (UnimplementedMsgServer) TopUpPaymentStream(context.Context, *MsgTopUpPaymentStream) (*MsgTopUpPaymentStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpPaymentStream not implemented")
+}
+func (UnimplementedMsgServer) CancelPaymentStream(context.Context, *MsgCancelPaymentStream) (*MsgCancelPaymentStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPaymentStream not implemented")
+}
+func (UnimplementedMsgServer) ClaimPaymentStream(context.Context, *MsgClaimPaymentStream) (*MsgClaimPaymentStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimPaymentStream not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -312,6 +380,78 @@ func _Msg_CancelContinuousFund_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreatePaymentStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePaymentStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePaymentStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreatePaymentStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePaymentStream(ctx, req.(*MsgCreatePaymentStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TopUpPaymentStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTopUpPaymentStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TopUpPaymentStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TopUpPaymentStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TopUpPaymentStream(ctx, req.(*MsgTopUpPaymentStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelPaymentStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPaymentStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPaymentStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelPaymentStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPaymentStream(ctx, req.(*MsgCancelPaymentStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimPaymentStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimPaymentStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimPaymentStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimPaymentStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimPaymentStream(ctx, req.(*MsgClaimPaymentStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -347,6 +487,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelContinuousFund",
 			Handler:    _Msg_CancelContinuousFund_Handler,
 		},
+		{
+			MethodName: "CreatePaymentStream",
+			Handler:    _Msg_CreatePaymentStream_Handler,
+		},
+		{
+			MethodName: "TopUpPaymentStream",
+			Handler:    _Msg_TopUpPaymentStream_Handler,
+		},
+		{
+			MethodName: "CancelPaymentStream",
+			Handler:    _Msg_CancelPaymentStream_Handler,
+		},
+		{
+			MethodName: "ClaimPaymentStream",
+			Handler:    _Msg_ClaimPaymentStream_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/protocolpool/v1/tx.proto",
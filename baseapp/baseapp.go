@@ -177,6 +177,9 @@ type BaseApp struct {
 	// streamingManager for managing instances and configuration of ABCIListener services
 	streamingManager storetypes.StreamingManager
 
+	// startIndexer starts the built-in indexer once the latest version is loaded, if it is enabled
+	startIndexer func() error
+
 	chainID string
 
 	cdc codec.Codec
@@ -362,7 +365,17 @@ func (app *BaseApp) LoadLatestVersion() error {
 		return fmt.Errorf("failed to load latest version: %w", err)
 	}
 
-	return app.Init()
+	if err := app.Init(); err != nil {
+		return err
+	}
+
+	if app.startIndexer != nil {
+		if err := app.startIndexer(); err != nil {
+			return fmt.Errorf("failed to start indexer: %w", err)
+		}
+	}
+
+	return nil
 }
 
 // DefaultStoreLoader will be used by default and loads the latest version
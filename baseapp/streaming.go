@@ -30,29 +30,61 @@ const (
 
 // EnableIndexer enables the built-in indexer with the provided options (usually from the app.toml indexer key),
 // kv-store keys, and app modules. Using the built-in indexer framework is mutually exclusive from using other
-// types of streaming listeners.
+// types of streaming listeners. The indexers are started by LoadLatestVersion, so that indexers which have
+// missed blocks can catch up from the committed state. Indexers which have persisted blocks past
+// the last committed height, for instance after the rollback command, are rolled back to it.
 func (app *BaseApp) EnableIndexer(indexerOpts interface{}, keys map[string]*storetypes.KVStoreKey, appModules map[string]any) error {
-	listener, err := indexer.StartManager(indexer.ManagerOptions{
-		Config:     indexerOpts,
-		Resolver:   decoding.ModuleSetDecoderResolver(appModules),
-		SyncSource: nil,
-		Logger:     app.logger.With("module", "indexer"),
-	})
-	if err != nil {
-		return err
+	wrapper := &listenerWrapper{}
+	app.startIndexer = func() error {
+		listener, err := indexer.StartManager(indexer.ManagerOptions{
+			Config:              indexerOpts,
+			Resolver:            decoding.ModuleSetDecoderResolver(appModules),
+			SyncSource:          storeSyncSource{cms: app.cms, keys: keys},
+			Logger:              app.logger.With("module", "indexer"),
+			LastCommittedHeight: app.LastBlockHeight(),
+		})
+		if err != nil {
+			return err
+		}
+
+		wrapper.listener = listener
+		return nil
 	}
 
 	exposedKeys := exposeStoreKeysSorted([]string{"*"}, keys)
 	app.cms.AddListeners(exposedKeys)
 
 	app.streamingManager = storetypes.StreamingManager{
-		ABCIListeners: []storetypes.ABCIListener{listenerWrapper{listener}},
+		ABCIListeners: []storetypes.ABCIListener{wrapper},
 		StopNodeOnErr: true,
 	}
 
 	return nil
 }
 
+// storeSyncSource is the indexer sync source which reads the committed state of the modules' kv-stores.
+type storeSyncSource struct {
+	cms  storetypes.CommitMultiStore
+	keys map[string]*storetypes.KVStoreKey
+}
+
+func (s storeSyncSource) IterateAllKVPairs(moduleName string, fn func(key, value []byte) error) error {
+	key, ok := s.keys[moduleName]
+	if !ok {
+		return nil
+	}
+
+	it := s.cms.GetKVStore(key).Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if err := fn(it.Key(), it.Value()); err != nil {
+			return err
+		}
+	}
+
+	return it.Error()
+}
+
 // RegisterStreamingServices registers streaming services with the BaseApp.
 func (app *BaseApp) RegisterStreamingServices(appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey) error {
 	// register streaming services
@@ -146,7 +178,7 @@ type listenerWrapper struct {
 	listener appdata.Listener
 }
 
-func (p listenerWrapper) ListenFinalizeBlock(_ context.Context, req abci.FinalizeBlockRequest, res abci.FinalizeBlockResponse) error {
+func (p *listenerWrapper) ListenFinalizeBlock(_ context.Context, req abci.FinalizeBlockRequest, res abci.FinalizeBlockResponse) error {
 	if p.listener.StartBlock != nil {
 		err := p.listener.StartBlock(appdata.StartBlockData{
 			Height: uint64(req.Height),
@@ -161,7 +193,7 @@ func (p listenerWrapper) ListenFinalizeBlock(_ context.Context, req abci.Finaliz
 	return nil
 }
 
-func (p listenerWrapper) ListenCommit(ctx context.Context, res abci.CommitResponse, changeSet []*storetypes.StoreKVPair) error {
+func (p *listenerWrapper) ListenCommit(ctx context.Context, res abci.CommitResponse, changeSet []*storetypes.StoreKVPair) error {
 	if cb := p.listener.OnKVPair; cb != nil {
 		updates := make([]appdata.ModuleKVPairUpdate, len(changeSet))
 		for i, pair := range changeSet {
@@ -7,8 +7,13 @@ import (
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	tmproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/log"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/indexer"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -146,3 +151,79 @@ func Test_Ctx_with_StreamingManager(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+type kvIndexedModule struct{}
+
+func (kvIndexedModule) ModuleCodec() (schema.ModuleCodec, error) {
+	modSchema, err := schema.NewModuleSchema([]schema.ObjectType{{
+		Name:        "kv",
+		KeyFields:   []schema.Field{{Name: "key", Kind: schema.StringKind}},
+		ValueFields: []schema.Field{{Name: "value", Kind: schema.StringKind}},
+	}})
+	if err != nil {
+		return schema.ModuleCodec{}, err
+	}
+
+	return schema.ModuleCodec{
+		Schema: modSchema,
+		KVDecoder: func(update schema.KVPairUpdate) ([]schema.ObjectUpdate, error) {
+			return []schema.ObjectUpdate{{TypeName: "kv", Key: string(update.Key), Value: string(update.Value)}}, nil
+		},
+	}, nil
+}
+
+func TestEnableIndexer_catchUp(t *testing.T) {
+	db := dbm.NewMemDB()
+	key := storetypes.NewKVStoreKey("kvmod")
+
+	// commit two blocks, the first one writing a kv pair
+	app := baseapp.NewBaseApp(t.Name(), log.NewTestLogger(t), db, nil)
+	app.MountStores(key)
+	require.NoError(t, app.LoadLatestVersion())
+	for height := int64(1); height <= 2; height++ {
+		_, err := app.FinalizeBlock(&abci.FinalizeBlockRequest{Height: height})
+		require.NoError(t, err)
+		if height == 1 {
+			app.CommitMultiStore().GetKVStore(key).Set([]byte("foo"), []byte("bar"))
+		}
+		_, err = app.Commit()
+		require.NoError(t, err)
+	}
+
+	// the indexer has only persisted the first block
+	var calls []string
+	indexer.Register("baseapp_catch_up_test", func(params indexer.InitParams) (indexer.InitResult, error) {
+		return indexer.InitResult{
+			Listener: appdata.Listener{
+				StartBlock: func(data appdata.StartBlockData) error {
+					calls = append(calls, fmt.Sprintf("start %d", data.Height))
+					return nil
+				},
+				OnObjectUpdate: func(data appdata.ObjectUpdateData) error {
+					for _, update := range data.Updates {
+						calls = append(calls, fmt.Sprintf("update %s %v=%v", data.ModuleName, update.Key, update.Value))
+					}
+					return nil
+				},
+				Commit: func(appdata.CommitData) error {
+					calls = append(calls, "commit")
+					return nil
+				},
+			},
+			LastBlockPersisted: 1,
+		}, nil
+	})
+
+	app = baseapp.NewBaseApp(t.Name(), log.NewTestLogger(t), db, nil)
+	app.MountStores(key)
+	require.NoError(t, app.EnableIndexer(
+		map[string]interface{}{"target": map[string]interface{}{"test": map[string]interface{}{"type": "baseapp_catch_up_test"}}},
+		map[string]*storetypes.KVStoreKey{"kvmod": key},
+		map[string]any{"kvmod": kvIndexedModule{}},
+	))
+	require.Empty(t, calls)
+
+	// the indexer is started and caught up from the committed state when the latest version is loaded
+	require.NoError(t, app.LoadLatestVersion())
+	require.Equal(t, []string{"start 2", "update kvmod foo=bar", "commit"}, calls)
+}
@@ -4,15 +4,18 @@ import (
 	gocontext "context"
 	"errors"
 	"reflect"
+	"slices"
 	"strconv"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtcrypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/encoding"
 	"google.golang.org/grpc/metadata"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -56,7 +59,12 @@ func (ctx Context) Invoke(grpcCtx gocontext.Context, method string, req, reply i
 		return err
 	}
 
-	if ctx.GRPCClient != nil {
+	// The proofs of a query are only returned by ABCI queries, so queries requesting them
+	// are always made through ABCI.
+	md, _ := metadata.FromOutgoingContext(grpcCtx)
+	prove := slices.Contains(md.Get(grpctypes.GRPCQueryProveHeader), "true")
+
+	if ctx.GRPCClient != nil && !prove {
 		// Case 2-1. Invoke grpc.
 		return ctx.GRPCClient.Invoke(grpcCtx, method, req, reply, opts...)
 	}
@@ -68,7 +76,6 @@ func (ctx Context) Invoke(grpcCtx gocontext.Context, method string, req, reply i
 	}
 
 	// parse height header
-	md, _ := metadata.FromOutgoingContext(grpcCtx)
 	if heights := md.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
 		height, err := strconv.ParseInt(heights[0], 10, 64)
 		if err != nil {
@@ -87,6 +94,7 @@ func (ctx Context) Invoke(grpcCtx gocontext.Context, method string, req, reply i
 		Path:   method,
 		Data:   reqBz,
 		Height: ctx.Height,
+		Prove:  prove,
 	}
 
 	res, err := ctx.QueryABCI(abciReq)
@@ -101,10 +109,18 @@ func (ctx Context) Invoke(grpcCtx gocontext.Context, method string, req, reply i
 
 	// Create header metadata. For now the headers contain:
 	// - block height
+	// - proofs, if requested
 	// We then parse all the call options, if the call option is a
 	// HeaderCallOption, then we manually set the value of that header to the
 	// metadata.
 	md = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(res.Height, 10))
+	if prove {
+		proofs, err := splitProofOps(res.ProofOps)
+		if err != nil {
+			return err
+		}
+		md.Append(grpctypes.GRPCQueryProofHeader, proofs...)
+	}
 	for _, callOpt := range opts {
 		header, ok := callOpt.(grpc.HeaderCallOption)
 		if !ok {
@@ -121,6 +137,33 @@ func (ctx Context) Invoke(grpcCtx gocontext.Context, method string, req, reply i
 	return nil
 }
 
+// splitProofOps splits the proof ops of an ABCI query into the proofs of the values read
+// from each store, each one ending with the proof of the store hash in the commit info,
+// and returns them protobuf encoded.
+func splitProofOps(proofOps *cmtcrypto.ProofOps) ([]string, error) {
+	var (
+		proofs []string
+		proof  cmtcrypto.ProofOps
+	)
+	for _, op := range proofOps.GetOps() {
+		proof.Ops = append(proof.Ops, op)
+		if op.Type != storetypes.ProofOpSimpleMerkleCommitment {
+			continue
+		}
+
+		bz, err := proof.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		proofs = append(proofs, string(bz))
+		proof = cmtcrypto.ProofOps{}
+	}
+	if len(proof.Ops) > 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "the proof of the query does not end with the proof of a store hash")
+	}
+	return proofs, nil
+}
+
 // NewStream implements the grpc ClientConn.NewStream method
 func (Context) NewStream(gocontext.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
 	return nil, errors.New("streaming rpc not supported")
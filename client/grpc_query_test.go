@@ -4,23 +4,30 @@ import (
 	"context"
 	"testing"
 
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtcrypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/testutil"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/integration"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	"github.com/cosmos/cosmos-sdk/testutil/x/counter"
 	counterkeeper "github.com/cosmos/cosmos-sdk/testutil/x/counter/keeper"
 	countertypes "github.com/cosmos/cosmos-sdk/testutil/x/counter/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
@@ -72,3 +79,42 @@ func (s *IntegrationTestSuite) TestGRPCQuery() {
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
+
+func TestInvokeProve(t *testing.T) {
+	cfg := moduletestutil.MakeTestEncodingConfig(testutil.CodecOptions{}, counter.AppModule{})
+	value, err := cfg.Codec.Marshal(&countertypes.QueryGetCountResponse{TotalCount: 3})
+	require.NoError(t, err)
+
+	storeProof := func(storeKey string) []cmtcrypto.ProofOp {
+		return []cmtcrypto.ProofOp{
+			{Type: storetypes.ProofOpIAVLCommitment, Key: []byte("key"), Data: []byte("tree proof")},
+			{Type: storetypes.ProofOpSimpleMerkleCommitment, Key: []byte(storeKey), Data: []byte("store proof")},
+		}
+	}
+	// the proofs are only returned by ABCI queries, so the gRPC client must not be used
+	grpcClient, err := grpc.NewClient("localhost:1", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	clientCtx := client.Context{}.
+		WithCodec(cfg.Codec).
+		WithGRPCClient(grpcClient).
+		WithClient(clitestutil.NewMockCometRPC(abci.QueryResponse{
+			Value:    value,
+			Height:   5,
+			ProofOps: &cmtcrypto.ProofOps{Ops: append(storeProof("counter"), storeProof("stf")...)},
+		}))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), grpctypes.GRPCQueryProveHeader, "true")
+	var header metadata.MD
+	res, err := countertypes.NewQueryClient(clientCtx).GetCount(ctx, &countertypes.QueryGetCountRequest{}, grpc.Header(&header))
+	require.NoError(t, err)
+	require.Equal(t, int64(3), res.TotalCount)
+	require.Equal(t, []string{"5"}, header.Get(grpctypes.GRPCBlockHeightHeader))
+
+	rawProofs := header.Get(grpctypes.GRPCQueryProofHeader)
+	require.Len(t, rawProofs, 2)
+	for i, storeKey := range []string{"counter", "stf"} {
+		var proof cmtcrypto.ProofOps
+		require.NoError(t, proof.Unmarshal([]byte(rawProofs[i])))
+		require.Equal(t, storeProof(storeKey), proof.Ops)
+	}
+}
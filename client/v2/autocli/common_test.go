@@ -6,9 +6,11 @@ import (
 	"net"
 	"testing"
 
+	cmtcrypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/reflect/protoregistry"
 	"gotest.tools/v3/assert"
 
@@ -25,6 +27,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/testutil"
 	sdkkeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
@@ -139,7 +142,20 @@ type testEchoServer struct {
 	testpb.UnimplementedQueryServer
 }
 
-func (t testEchoServer) Echo(_ context.Context, request *testpb.EchoRequest) (*testpb.EchoResponse, error) {
+func (t testEchoServer) Echo(ctx context.Context, request *testpb.EchoRequest) (*testpb.EchoResponse, error) {
+	if md, _ := metadata.FromIncomingContext(ctx); len(md.Get(grpctypes.GRPCQueryProveHeader)) > 0 {
+		proof := cmtcrypto.ProofOps{Ops: []cmtcrypto.ProofOp{
+			{Type: "ics23:iavl", Key: []byte("key"), Data: []byte("data")},
+			{Type: "ics23:simple", Key: []byte("echo"), Data: []byte("data")},
+		}}
+		bz, err := proof.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		if err := grpc.SetHeader(ctx, metadata.Pairs(grpctypes.GRPCQueryProofHeader, string(bz))); err != nil {
+			return nil, err
+		}
+	}
 	return &testpb.EchoResponse{Request: request}, nil
 }
 
@@ -2,6 +2,7 @@ package autocli
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -12,13 +13,17 @@ import (
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/tx/signing/aminojson"
 
+	cmtcrypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"cosmossdk.io/client/v2/internal/flags"
 	"cosmossdk.io/client/v2/internal/util"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 )
 
 // BuildQueryCommand builds the query commands for all the provided modules. If a custom command is provided for a
@@ -132,12 +137,20 @@ func (b *Builder) BuildQueryMethodCommand(ctx context.Context, descriptor protor
 			return err
 		}
 
+		ctx := cmd.Context()
+		prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+		if prove {
+			ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCQueryProveHeader, "true")
+		}
+
+		var header metadata.MD
 		output := outputType.New()
-		if err := clientConn.Invoke(cmd.Context(), methodName, input.Interface(), output.Interface()); err != nil {
+		if err := clientConn.Invoke(ctx, methodName, input.Interface(), output.Interface(), grpc.Header(&header)); err != nil {
 			return err
 		}
 
-		if noIndent, _ := cmd.Flags().GetBool(flags.FlagNoIndent); noIndent {
+		noIndent, _ := cmd.Flags().GetBool(flags.FlagNoIndent)
+		if noIndent {
 			encoderOptions.Indent = ""
 		}
 
@@ -147,6 +160,13 @@ func (b *Builder) BuildQueryMethodCommand(ctx context.Context, descriptor protor
 			return fmt.Errorf("cannot marshal response %v: %w", output.Interface(), err)
 		}
 
+		if prove {
+			bz, err = provenResponse(bz, header, noIndent)
+			if err != nil {
+				return err
+			}
+		}
+
 		return b.outOrStdoutFormat(cmd, bz)
 	})
 	if err != nil {
@@ -157,6 +177,7 @@ func (b *Builder) BuildQueryMethodCommand(ctx context.Context, descriptor protor
 		b.AddQueryConnFlags(cmd)
 
 		cmd.Flags().BoolP(flags.FlagNoIndent, "", false, "Do not indent JSON output")
+		cmd.Flags().Bool(flags.FlagProve, false, "Output the merkle proofs of the response along with it, if the query supports them")
 	}
 
 	// silence usage only for inner txs & queries commands
@@ -167,6 +188,37 @@ func (b *Builder) BuildQueryMethodCommand(ctx context.Context, descriptor protor
 	return cmd, nil
 }
 
+// provenResponse wraps the JSON response of a query along with the height and the proofs returned
+// in the header of the response.
+func provenResponse(response []byte, header metadata.MD, noIndent bool) ([]byte, error) {
+	rawProofs := header.Get(grpctypes.GRPCQueryProofHeader)
+	if len(rawProofs) == 0 {
+		return nil, errors.New("the query did not return any proof, it might not support proofs")
+	}
+
+	proofs := make([]cmtcrypto.ProofOps, len(rawProofs))
+	for i, rawProof := range rawProofs {
+		if err := proofs[i].Unmarshal([]byte(rawProof)); err != nil {
+			return nil, fmt.Errorf("cannot unmarshal proof: %w", err)
+		}
+	}
+
+	var height string
+	if heights := header.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+		height = heights[0]
+	}
+
+	proven := struct {
+		Response json.RawMessage      `json:"response"`
+		Height   string               `json:"height,omitempty"`
+		Proofs   []cmtcrypto.ProofOps `json:"proofs"`
+	}{Response: response, Height: height, Proofs: proofs}
+	if noIndent {
+		return json.Marshal(proven)
+	}
+	return json.MarshalIndent(proven, "", "  ")
+}
+
 func encoder(encoder aminojson.Encoder) aminojson.Encoder {
 	return encoder.DefineTypeEncoding("google.protobuf.Duration", func(_ *aminojson.Encoder, msg protoreflect.Message, w io.Writer) error {
 		var (
@@ -621,6 +621,28 @@ func TestOutputFormat(t *testing.T) {
 	assert.Assert(t, strings.Contains(out.String(), "  positional1: 1"))
 }
 
+func TestProveFlag(t *testing.T) {
+	fixture := initFixture(t)
+
+	out, err := runCmd(fixture, buildModuleQueryCommand,
+		"echo",
+		"1", "abc", "1foo",
+		"--prove",
+		"--output", "json",
+	)
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(out.String(), `"response": {`))
+	assert.Assert(t, strings.Contains(out.String(), `"type": "ics23:iavl"`))
+
+	out, err = runCmd(fixture, buildModuleQueryCommand,
+		"echo",
+		"1", "abc", "1foo",
+		"--output", "json",
+	)
+	assert.NilError(t, err)
+	assert.Assert(t, !strings.Contains(out.String(), "proofs"))
+}
+
 func TestHelpQuery(t *testing.T) {
 	fixture := initFixture(t)
 
@@ -11,6 +11,9 @@ const (
 	// FlagNoIndent is the flag to not indent the output.
 	FlagNoIndent = "no-indent"
 
+	// FlagProve is the flag to request the proofs of a query response.
+	FlagProve = "prove"
+
 	// FlagNoPrompt is the flag to not use a prompt for commands.
 	FlagNoPrompt = "no-prompt"
 
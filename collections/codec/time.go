@@ -0,0 +1,67 @@
+package codec
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+const timeKeySize = 12
+
+// NewTimeKey returns a KeyCodec for time.Time. The time is encoded as its unix seconds,
+// ordered like an int64 key, followed by its nanoseconds as big endian uint32, which retains
+// the ordering of the times. Times are decoded in UTC and the monotonic clock reading is dropped.
+// JSON encoding represents the time in the RFC 3339 format with nanoseconds.
+func NewTimeKey() KeyCodec[time.Time] { return timeKey{} }
+
+type timeKey struct{}
+
+func (timeKey) Encode(buffer []byte, key time.Time) (int, error) {
+	if len(buffer) < timeKeySize {
+		return 0, fmt.Errorf("%w: invalid buffer size, wanted: %d", ErrEncoding, timeKeySize)
+	}
+	binary.BigEndian.PutUint64(buffer, uint64(key.Unix()))
+	buffer[0] ^= 0x80
+	binary.BigEndian.PutUint32(buffer[8:], uint32(key.Nanosecond()))
+	return timeKeySize, nil
+}
+
+func (timeKey) Decode(buffer []byte) (int, time.Time, error) {
+	if len(buffer) < timeKeySize {
+		return 0, time.Time{}, fmt.Errorf("%w: invalid buffer size, wanted: %d", ErrEncoding, timeKeySize)
+	}
+	seconds := int64(binary.BigEndian.Uint64(buffer) ^ (0x80 << 56))
+	nanos := binary.BigEndian.Uint32(buffer[8:])
+	if nanos >= uint32(time.Second) {
+		return 0, time.Time{}, fmt.Errorf("%w: invalid nanoseconds %d", ErrEncoding, nanos)
+	}
+	return timeKeySize, time.Unix(seconds, int64(nanos)).UTC(), nil
+}
+
+func (timeKey) Size(_ time.Time) int { return timeKeySize }
+
+func (timeKey) EncodeJSON(value time.Time) ([]byte, error) {
+	return []byte(`"` + value.UTC().Format(time.RFC3339Nano) + `"`), nil
+}
+
+func (timeKey) DecodeJSON(b []byte) (time.Time, error) {
+	var t time.Time
+	if err := t.UnmarshalJSON(b); err != nil {
+		return time.Time{}, err
+	}
+	return t.UTC(), nil
+}
+
+func (timeKey) Stringify(key time.Time) string { return key.UTC().Format(time.RFC3339Nano) }
+
+func (timeKey) KeyType() string { return "time.Time" }
+
+func (t timeKey) EncodeNonTerminal(buffer []byte, key time.Time) (int, error) {
+	return t.Encode(buffer, key)
+}
+
+func (t timeKey) DecodeNonTerminal(buffer []byte) (int, time.Time, error) {
+	return t.Decode(buffer)
+}
+
+func (timeKey) SizeNonTerminal(_ time.Time) int { return timeKeySize }
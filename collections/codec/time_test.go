@@ -0,0 +1,50 @@
+package codec
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+)
+
+// TestTimeKeys applies the same logic as TestInt64Keys to random times,
+// including times before the unix epoch.
+func TestTimeKeys(t *testing.T) {
+	kc := NewTimeKey()
+	rapid.Check(t, func(t *rapid.T) {
+		seconds := rapid.SliceOfN(rapid.Int64Range(-62135596800, 253402300799), 1_000, 10_000).Draw(t, "random seconds")
+		times := make([]time.Time, len(seconds))
+		for i, s := range seconds {
+			times[i] = time.Unix(s, rapid.Int64Range(0, 999_999_999).Draw(t, "random nanos"))
+		}
+		sort.Slice(times, func(i, j int) bool {
+			return times[i].Before(times[j])
+		})
+
+		var current []byte
+		for _, tm := range times {
+			next := make([]byte, kc.Size(tm))
+			_, err := kc.Encode(next, tm)
+			require.NoError(t, err)
+			cmp := bytes.Compare(current, next)
+			require.True(t, cmp == 0 || cmp == -1)
+			current = next
+		}
+	})
+}
+
+func TestTimeKeyDecodeInvalid(t *testing.T) {
+	kc := NewTimeKey()
+	_, _, err := kc.Decode([]byte{0x1})
+	require.ErrorIs(t, err, ErrEncoding)
+
+	buffer := make([]byte, kc.Size(time.Time{}))
+	_, err = kc.Encode(buffer, time.Unix(0, 0))
+	require.NoError(t, err)
+	buffer[8] = 0xff
+	_, _, err = kc.Decode(buffer)
+	require.ErrorIs(t, err, ErrEncoding)
+}
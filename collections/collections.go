@@ -51,6 +51,9 @@ var (
 	// BoolKey can be used to encode booleans. It uses a single byte to represent the boolean.
 	// 0x0 is used to represent false, and 0x1 is used to represent true.
 	BoolKey = codec.NewBoolKey[bool]()
+	// TimeKey can be used to encode time.Time keys. Encoding retains ordering, times
+	// are decoded in UTC. JSON encoding represents the time in the RFC 3339 format.
+	TimeKey = codec.NewTimeKey()
 )
 
 // VALUES
@@ -98,6 +101,13 @@ type Prefix []byte
 // Bytes returns the raw Prefix bytes.
 func (n Prefix) Bytes() []byte { return n }
 
+// WithSuffix returns a new Prefix made of the Prefix followed by the provided suffix,
+// used by collections made of several state objects to derive their prefixes.
+// The returned Prefix never shares its backing array with n.
+func (n Prefix) WithSuffix(suffix byte) Prefix {
+	return append(n[:len(n):len(n)], suffix)
+}
+
 // NewPrefix returns a Prefix given the provided namespace identifier.
 // In the same module, no prefixes should share the same starting bytes
 // meaning that having two namespaces whose bytes representation is:
@@ -40,4 +40,12 @@ func TestPrefix(t *testing.T) {
 		bytes[0] = 0x0
 		require.Equal(t, []byte("prefix"), prefix.Bytes())
 	})
+
+	t.Run("with suffix", func(t *testing.T) {
+		prefix := append(make(Prefix, 0, 8), "prefix"...)
+		p1, p2 := prefix.WithSuffix(1), prefix.WithSuffix(2)
+		require.Equal(t, []byte("prefix\x01"), p1.Bytes())
+		require.Equal(t, []byte("prefix\x02"), p2.Bytes())
+		require.Equal(t, []byte("prefix"), prefix.Bytes())
+	})
 }
@@ -0,0 +1,120 @@
+package collections
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/core/store"
+)
+
+var (
+	// ErrReadOnly is returned when trying to write to a collection read at a past height.
+	ErrReadOnly = errors.New("collections: read-only historical store")
+	// ErrFutureHeight is returned when trying to read a collection at a height which was not committed yet.
+	ErrFutureHeight = errors.New("collections: height not committed yet")
+)
+
+// VersionedReader defines the read operations of a versioned storage, which keeps the state of every
+// store at every version. It is implemented by the store/v2 state storage, store.VersionedDatabase.
+type VersionedReader interface {
+	GetLatestVersion() (uint64, error)
+	Has(storeKey []byte, version uint64, key []byte) (bool, error)
+	Get(storeKey []byte, version uint64, key []byte) ([]byte, error)
+	Iterator(storeKey []byte, version uint64, start, end []byte) (store.Iterator, error)
+	ReverseIterator(storeKey []byte, version uint64, start, end []byte) (store.Iterator, error)
+}
+
+// HistoricalStore provides the state of a module store at past heights, so that collections can be
+// read as of a height, for example to serve historical queries or to index past state.
+type HistoricalStore struct {
+	reader   VersionedReader
+	storeKey []byte
+}
+
+// NewHistoricalStore returns a HistoricalStore reading the state of the provided store key,
+// the name of the module store, from the versioned storage.
+func NewHistoricalStore(reader VersionedReader, storeKey []byte) HistoricalStore {
+	return HistoricalStore{reader: reader, storeKey: storeKey}
+}
+
+// LatestHeight returns the latest height committed to the versioned storage.
+func (h HistoricalStore) LatestHeight() (uint64, error) {
+	return h.reader.GetLatestVersion()
+}
+
+// At returns a read-only store.KVStore with the state of the module store at the provided height.
+// Fails with ErrFutureHeight if the height was not committed yet. Writing to the returned store
+// fails with ErrReadOnly.
+func (h HistoricalStore) At(height uint64) (store.KVStore, error) {
+	latest, err := h.reader.GetLatestVersion()
+	if err != nil {
+		return nil, err
+	}
+	if height > latest {
+		return nil, fmt.Errorf("%w: height %d, latest height %d", ErrFutureHeight, height, latest)
+	}
+	return historicalKVStore{reader: h.reader, storeKey: h.storeKey, version: height}, nil
+}
+
+// accessorAt returns a store accessor always returning the state at the provided height.
+func (h HistoricalStore) accessorAt(height uint64) (func(context.Context) store.KVStore, error) {
+	kvStore, err := h.At(height)
+	if err != nil {
+		return nil, err
+	}
+	return func(context.Context) store.KVStore { return kvStore }, nil
+}
+
+var _ store.KVStore = historicalKVStore{}
+
+type historicalKVStore struct {
+	reader   VersionedReader
+	storeKey []byte
+	version  uint64
+}
+
+func (s historicalKVStore) Get(key []byte) ([]byte, error) {
+	return s.reader.Get(s.storeKey, s.version, key)
+}
+
+func (s historicalKVStore) Has(key []byte) (bool, error) {
+	return s.reader.Has(s.storeKey, s.version, key)
+}
+
+func (s historicalKVStore) Set(_, _ []byte) error { return ErrReadOnly }
+
+func (s historicalKVStore) Delete(_ []byte) error { return ErrReadOnly }
+
+func (s historicalKVStore) Iterator(start, end []byte) (store.Iterator, error) {
+	return s.reader.Iterator(s.storeKey, s.version, start, end)
+}
+
+func (s historicalKVStore) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	return s.reader.ReverseIterator(s.storeKey, s.version, start, end)
+}
+
+// At returns the Map as of the provided height. The context provided to the methods
+// of the returned Map is ignored, and writing to it fails with ErrReadOnly.
+func (m Map[K, V]) At(h HistoricalStore, height uint64) (Map[K, V], error) {
+	sa, err := h.accessorAt(height)
+	if err != nil {
+		return Map[K, V]{}, err
+	}
+	m.sa = sa
+	return m, nil
+}
+
+// At returns the KeySet as of the provided height. The context provided to the methods
+// of the returned KeySet is ignored, and writing to it fails with ErrReadOnly.
+func (k KeySet[K]) At(h HistoricalStore, height uint64) (KeySet[K], error) {
+	m, err := (Map[K, NoValue])(k).At(h, height)
+	return (KeySet[K])(m), err
+}
+
+// At returns the Item as of the provided height. The context provided to the methods
+// of the returned Item is ignored, and writing to it fails with ErrReadOnly.
+func (i Item[V]) At(h HistoricalStore, height uint64) (Item[V], error) {
+	m, err := (Map[noKey, V])(i).At(h, height)
+	return (Item[V])(m), err
+}
@@ -0,0 +1,133 @@
+package collections
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+)
+
+// versionedReader is a VersionedReader keeping a copy of the store at every committed version.
+type versionedReader struct {
+	storeKey []byte
+	versions []coretesting.MemKV
+}
+
+func (v *versionedReader) commit(t *testing.T, kvStore store.KVStore) {
+	t.Helper()
+	snapshot := coretesting.NewMemKV()
+	iter, err := kvStore.Iterator(nil, nil)
+	require.NoError(t, err)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		require.NoError(t, snapshot.Set(iter.Key(), iter.Value()))
+	}
+	v.versions = append(v.versions, snapshot)
+}
+
+func (v *versionedReader) at(storeKey []byte, version uint64) store.KVStore {
+	if string(storeKey) != string(v.storeKey) {
+		return coretesting.NewMemKV()
+	}
+	return v.versions[version-1]
+}
+
+func (v *versionedReader) GetLatestVersion() (uint64, error) { return uint64(len(v.versions)), nil }
+
+func (v *versionedReader) Has(storeKey []byte, version uint64, key []byte) (bool, error) {
+	return v.at(storeKey, version).Has(key)
+}
+
+func (v *versionedReader) Get(storeKey []byte, version uint64, key []byte) ([]byte, error) {
+	return v.at(storeKey, version).Get(key)
+}
+
+func (v *versionedReader) Iterator(storeKey []byte, version uint64, start, end []byte) (store.Iterator, error) {
+	return v.at(storeKey, version).Iterator(start, end)
+}
+
+func (v *versionedReader) ReverseIterator(storeKey []byte, version uint64, start, end []byte) (store.Iterator, error) {
+	return v.at(storeKey, version).ReverseIterator(start, end)
+}
+
+func TestHistoricalReads(t *testing.T) {
+	sk, ctx := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	m := NewMap(schemaBuilder, NewPrefix(0), "map", StringKey, Uint64Value)
+	ks := NewKeySet(schemaBuilder, NewPrefix(1), "key_set", StringKey)
+	item := NewItem(schemaBuilder, NewPrefix(2), "item", StringValue)
+	_, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	reader := &versionedReader{storeKey: []byte("test")}
+	historical := NewHistoricalStore(reader, []byte("test"))
+
+	// height 1
+	require.NoError(t, m.Set(ctx, "a", 1))
+	require.NoError(t, ks.Set(ctx, "a"))
+	require.NoError(t, item.Set(ctx, "one"))
+	reader.commit(t, sk.OpenKVStore(ctx))
+
+	// height 2
+	require.NoError(t, m.Set(ctx, "a", 2))
+	require.NoError(t, m.Set(ctx, "b", 3))
+	require.NoError(t, ks.Remove(ctx, "a"))
+	require.NoError(t, item.Set(ctx, "two"))
+	reader.commit(t, sk.OpenKVStore(ctx))
+
+	latest, err := historical.LatestHeight()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), latest)
+
+	// reads at height 1
+	m1, err := m.At(historical, 1)
+	require.NoError(t, err)
+	value, err := m1.Get(context.Background(), "a")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), value)
+	_, err = m1.Get(context.Background(), "b")
+	require.ErrorIs(t, err, ErrNotFound)
+	iter, err := m1.Iterate(context.Background(), nil)
+	require.NoError(t, err)
+	keys, err := iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"a"}, keys)
+
+	ks1, err := ks.At(historical, 1)
+	require.NoError(t, err)
+	has, err := ks1.Has(context.Background(), "a")
+	require.NoError(t, err)
+	require.True(t, has)
+
+	item1, err := item.At(historical, 1)
+	require.NoError(t, err)
+	itemValue, err := item1.Get(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "one", itemValue)
+
+	// reads at height 2
+	m2, err := m.At(historical, 2)
+	require.NoError(t, err)
+	iter, err = m2.Iterate(context.Background(), nil)
+	require.NoError(t, err)
+	kvs, err := iter.KeyValues()
+	require.NoError(t, err)
+	require.Equal(t, []KeyValue[string, uint64]{{"a", 2}, {"b", 3}}, kvs)
+
+	ks2, err := ks.At(historical, 2)
+	require.NoError(t, err)
+	has, err = ks2.Has(context.Background(), "a")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// historical collections are read-only
+	require.ErrorIs(t, m1.Set(context.Background(), "c", 1), ErrReadOnly)
+	require.ErrorIs(t, item1.Remove(context.Background()), ErrReadOnly)
+
+	// future heights can't be read
+	_, err = m.At(historical, 3)
+	require.ErrorIs(t, err, ErrFutureHeight)
+}
@@ -0,0 +1,428 @@
+package indexes
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+)
+
+// ErrAggregateOverflow is returned when the sum of an Aggregate index overflows.
+var ErrAggregateOverflow = errors.New("collections: aggregate overflow")
+
+const (
+	AggregatesNameSuffix         = "_aggregates"
+	AggregateMembersNameSuffix   = "_members"
+	AggregatesPrefixSuffix       = 0x0
+	AggregateMembersPrefixSuffix = 0x1
+
+	aggregationCountSize    = 8
+	aggregationAmountFields = 3
+)
+
+// Integer defines the types of the amounts which can be aggregated by an Aggregate index.
+type Integer interface {
+	~int64 | ~uint64
+}
+
+// Aggregation is the aggregate of the amounts of the values referenced by the same reference key.
+// The zero Aggregation is the aggregate of no values.
+type Aggregation[A Integer] struct {
+	Count uint64
+	Sum   A
+	Min   A
+	Max   A
+}
+
+// Aggregate is an index which maintains the count, the sum, the minimum and the maximum
+// of an amount of the values which share the same reference key, such as the number of
+// grants of a granter or the total delegated to a validator. The aggregate of a reference
+// key is read in O(1).
+// It relies on two collections, one for the aggregates which is a Map[ReferenceKey, Aggregation],
+// the other for the members which is a KeySet[Triple[ReferenceKey, A, PrimaryKey]],
+// used to recompute the minimum and the maximum when a value is removed.
+type Aggregate[ReferenceKey, PrimaryKey, Value any, A Integer] struct {
+	getRefKey  func(pk PrimaryKey, value Value) (ReferenceKey, error)
+	getAmount  func(pk PrimaryKey, value Value) (A, error)
+	aggregates collections.Map[ReferenceKey, Aggregation[A]]
+	members    collections.KeySet[collections.Triple[ReferenceKey, A, PrimaryKey]]
+}
+
+// NewAggregate instantiates a new Aggregate index. Since Aggregate relies on two collections,
+// it will register two state objects on the schema builder. The first is the aggregates, whose
+// prefix is the provided prefix with a suffix which equals to AggregatesPrefixSuffix, the name
+// is also suffixed with AggregatesNameSuffix. The second is the members, whose prefix is the
+// provided prefix with a suffix which equals to AggregateMembersPrefixSuffix, the name is also
+// suffixed with AggregateMembersNameSuffix.
+// The amount codec must retain the ordering of the amounts in their non-terminal encoding,
+// such as collections.Int64Key and collections.Uint64Key.
+func NewAggregate[ReferenceKey, PrimaryKey, Value any, A Integer](
+	schema *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	refCodec codec.KeyCodec[ReferenceKey],
+	pkCodec codec.KeyCodec[PrimaryKey],
+	amountCodec codec.KeyCodec[A],
+	getRefKeyFunc func(pk PrimaryKey, value Value) (ReferenceKey, error),
+	getAmountFunc func(pk PrimaryKey, value Value) (A, error),
+) *Aggregate[ReferenceKey, PrimaryKey, Value, A] {
+	return &Aggregate[ReferenceKey, PrimaryKey, Value, A]{
+		getRefKey: getRefKeyFunc,
+		getAmount: getAmountFunc,
+		aggregates: collections.NewMap(
+			schema, prefix.WithSuffix(AggregatesPrefixSuffix), name+AggregatesNameSuffix,
+			refCodec, aggregationValueCodec[A]{amountCodec: amountCodec},
+		),
+		members: collections.NewKeySet(
+			schema, prefix.WithSuffix(AggregateMembersPrefixSuffix), name+AggregateMembersNameSuffix,
+			collections.TripleKeyCodec(refCodec, amountCodec, pkCodec),
+		),
+	}
+}
+
+func (a *Aggregate[ReferenceKey, PrimaryKey, Value, A]) Reference(ctx context.Context, pk PrimaryKey, newValue Value, lazyOldValue func() (Value, error)) error {
+	oldValue, err := lazyOldValue()
+	switch {
+	// if no error it means the value existed, and we need to remove it from the old aggregate
+	case err == nil:
+		err = a.unreference(ctx, pk, oldValue)
+		if err != nil {
+			return err
+		}
+	// if error is ErrNotFound, it means that the object does not exist, so there is nothing to remove.
+	case errors.Is(err, collections.ErrNotFound):
+	// default case means that there was some other error
+	default:
+		return err
+	}
+
+	refKey, amount, err := a.refKeyAndAmount(pk, newValue)
+	if err != nil {
+		return err
+	}
+	aggregation, err := a.Get(ctx, refKey)
+	if err != nil {
+		return err
+	}
+	sum, err := addAmounts(aggregation.Sum, amount)
+	if err != nil {
+		return err
+	}
+	if aggregation.Count == 0 || amount < aggregation.Min {
+		aggregation.Min = amount
+	}
+	if aggregation.Count == 0 || amount > aggregation.Max {
+		aggregation.Max = amount
+	}
+	aggregation.Count++
+	aggregation.Sum = sum
+
+	err = a.members.Set(ctx, collections.Join3(refKey, amount, pk))
+	if err != nil {
+		return err
+	}
+	return a.aggregates.Set(ctx, refKey, aggregation)
+}
+
+func (a *Aggregate[ReferenceKey, PrimaryKey, Value, A]) Unreference(ctx context.Context, pk PrimaryKey, getValue func() (Value, error)) error {
+	value, err := getValue()
+	if err != nil {
+		return err
+	}
+	return a.unreference(ctx, pk, value)
+}
+
+func (a *Aggregate[ReferenceKey, PrimaryKey, Value, A]) unreference(ctx context.Context, pk PrimaryKey, value Value) error {
+	refKey, amount, err := a.refKeyAndAmount(pk, value)
+	if err != nil {
+		return err
+	}
+	member := collections.Join3(refKey, amount, pk)
+	has, err := a.members.Has(ctx, member)
+	if err != nil {
+		return err
+	}
+	// the value was never aggregated
+	if !has {
+		return nil
+	}
+	err = a.members.Remove(ctx, member)
+	if err != nil {
+		return err
+	}
+
+	aggregation, err := a.Get(ctx, refKey)
+	if err != nil {
+		return err
+	}
+	if aggregation.Count <= 1 {
+		return a.aggregates.Remove(ctx, refKey)
+	}
+	sum, err := subAmounts(aggregation.Sum, amount)
+	if err != nil {
+		return err
+	}
+	aggregation.Count--
+	aggregation.Sum = sum
+	// the removed amount might have been the minimum or the maximum, in which
+	// case they're recomputed from the remaining members.
+	if amount == aggregation.Min {
+		aggregation.Min, err = a.boundary(ctx, refKey, false)
+		if err != nil {
+			return err
+		}
+	}
+	if amount == aggregation.Max {
+		aggregation.Max, err = a.boundary(ctx, refKey, true)
+		if err != nil {
+			return err
+		}
+	}
+	return a.aggregates.Set(ctx, refKey, aggregation)
+}
+
+// boundary returns the lowest, or the highest if descending, amount referenced by the reference key.
+func (a *Aggregate[ReferenceKey, PrimaryKey, Value, A]) boundary(ctx context.Context, refKey ReferenceKey, descending bool) (amount A, err error) {
+	rng := new(collections.Range[collections.Triple[ReferenceKey, A, PrimaryKey]]).
+		Prefix(collections.TriplePrefix[ReferenceKey, A, PrimaryKey](refKey))
+	if descending {
+		rng = rng.Descending()
+	}
+	iter, err := a.members.Iterate(ctx, rng)
+	if err != nil {
+		return amount, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return amount, fmt.Errorf("%w: no members for aggregate %s", collections.ErrInvalidIterator, a.aggregates.KeyCodec().Stringify(refKey))
+	}
+	member, err := iter.Key()
+	if err != nil {
+		return amount, err
+	}
+	return member.K2(), nil
+}
+
+func (a *Aggregate[ReferenceKey, PrimaryKey, Value, A]) refKeyAndAmount(pk PrimaryKey, value Value) (refKey ReferenceKey, amount A, err error) {
+	refKey, err = a.getRefKey(pk, value)
+	if err != nil {
+		return refKey, amount, err
+	}
+	amount, err = a.getAmount(pk, value)
+	return refKey, amount, err
+}
+
+// Get returns the aggregate of the values referenced by the provided reference key.
+// If no value is referenced, the zero Aggregation is returned.
+func (a *Aggregate[ReferenceKey, PrimaryKey, Value, A]) Get(ctx context.Context, refKey ReferenceKey) (Aggregation[A], error) {
+	aggregation, err := a.aggregates.Get(ctx, refKey)
+	if errors.Is(err, collections.ErrNotFound) {
+		return Aggregation[A]{}, nil
+	}
+	return aggregation, err
+}
+
+// Walk walks over the aggregates of the reference keys in the provided range.
+func (a *Aggregate[ReferenceKey, PrimaryKey, Value, A]) Walk(
+	ctx context.Context,
+	ranger collections.Ranger[ReferenceKey],
+	walkFunc func(refKey ReferenceKey, aggregation Aggregation[A]) (stop bool, err error),
+) error {
+	return a.aggregates.Walk(ctx, ranger, walkFunc)
+}
+
+// MatchExact returns an AggregateIterator containing all the primary keys referenced by the
+// provided reference key, ordered by their amount.
+func (a *Aggregate[ReferenceKey, PrimaryKey, Value, A]) MatchExact(ctx context.Context, refKey ReferenceKey) (AggregateIterator[ReferenceKey, PrimaryKey, A], error) {
+	iter, err := a.members.Iterate(ctx, collections.NewPrefixedTripleRange[ReferenceKey, A, PrimaryKey](refKey))
+	return (AggregateIterator[ReferenceKey, PrimaryKey, A])(iter), err
+}
+
+func (a *Aggregate[ReferenceKey, PrimaryKey, Value, A]) KeyCodec() codec.KeyCodec[ReferenceKey] {
+	return a.aggregates.KeyCodec()
+}
+
+// AggregateIterator is just a KeySetIterator with key as Triple[ReferenceKey, A, PrimaryKey], where A is the amount.
+type AggregateIterator[ReferenceKey, PrimaryKey any, A Integer] collections.KeySetIterator[collections.Triple[ReferenceKey, A, PrimaryKey]]
+
+// PrimaryKey returns the iterator's current primary key.
+func (i AggregateIterator[ReferenceKey, PrimaryKey, A]) PrimaryKey() (PrimaryKey, error) {
+	fullKey, err := (collections.KeySetIterator[collections.Triple[ReferenceKey, A, PrimaryKey]])(i).Key()
+	return fullKey.K3(), err
+}
+
+// PrimaryKeys fully consumes the iterator and returns the list of primary keys.
+func (i AggregateIterator[ReferenceKey, PrimaryKey, A]) PrimaryKeys() ([]PrimaryKey, error) {
+	fullKeys, err := (collections.KeySetIterator[collections.Triple[ReferenceKey, A, PrimaryKey]])(i).Keys()
+	if err != nil {
+		return nil, err
+	}
+	pks := make([]PrimaryKey, len(fullKeys))
+	for i, fullKey := range fullKeys {
+		pks[i] = fullKey.K3()
+	}
+	return pks, nil
+}
+
+// Next advances the iterator.
+func (i AggregateIterator[ReferenceKey, PrimaryKey, A]) Next() {
+	(collections.KeySetIterator[collections.Triple[ReferenceKey, A, PrimaryKey]])(i).Next()
+}
+
+// Valid asserts if the iterator is still valid or not.
+func (i AggregateIterator[ReferenceKey, PrimaryKey, A]) Valid() bool {
+	return (collections.KeySetIterator[collections.Triple[ReferenceKey, A, PrimaryKey]])(i).Valid()
+}
+
+// Close closes the iterator.
+func (i AggregateIterator[ReferenceKey, PrimaryKey, A]) Close() error {
+	return (collections.KeySetIterator[collections.Triple[ReferenceKey, A, PrimaryKey]])(i).Close()
+}
+
+func addAmounts[A Integer](x, y A) (A, error) {
+	sum := x + y
+	if (y > 0 && sum < x) || (y < 0 && sum > x) {
+		return 0, ErrAggregateOverflow
+	}
+	return sum, nil
+}
+
+func subAmounts[A Integer](x, y A) (A, error) {
+	diff := x - y
+	if (y > 0 && diff > x) || (y < 0 && diff < x) {
+		return 0, ErrAggregateOverflow
+	}
+	return diff, nil
+}
+
+func amountToBig[A Integer](amount A) *big.Int {
+	if amount < 0 {
+		return new(big.Int).SetInt64(int64(amount))
+	}
+	return new(big.Int).SetUint64(uint64(amount))
+}
+
+// validate asserts that the aggregation is the aggregate of at least one amount,
+// which means that its sum is between count times its minimum and count times its maximum.
+func (a Aggregation[A]) validate() error {
+	if a.Count == 0 {
+		return fmt.Errorf("%w: aggregate with zero count", codec.ErrEncoding)
+	}
+	if a.Min > a.Max {
+		return fmt.Errorf("%w: aggregate minimum %d greater than its maximum %d", codec.ErrEncoding, a.Min, a.Max)
+	}
+	count := new(big.Int).SetUint64(a.Count)
+	sum := amountToBig(a.Sum)
+	if sum.Cmp(new(big.Int).Mul(count, amountToBig(a.Min))) < 0 || sum.Cmp(new(big.Int).Mul(count, amountToBig(a.Max))) > 0 {
+		return fmt.Errorf("%w: aggregate sum %d out of the bounds of %d amounts between %d and %d", codec.ErrEncoding, a.Sum, a.Count, a.Min, a.Max)
+	}
+	return nil
+}
+
+// aggregationValueCodec encodes an Aggregation as its count as big endian uint64,
+// followed by its sum, minimum and maximum encoded with the amount codec.
+// Decoding an Aggregation, from its binary or its JSON encoding, validates it.
+type aggregationValueCodec[A Integer] struct {
+	amountCodec codec.KeyCodec[A]
+}
+
+type jsonAggregation struct {
+	Count string          `json:"count"`
+	Sum   json.RawMessage `json:"sum"`
+	Min   json.RawMessage `json:"min"`
+	Max   json.RawMessage `json:"max"`
+}
+
+func (c aggregationValueCodec[A]) Encode(value Aggregation[A]) ([]byte, error) {
+	buffer := make([]byte, aggregationCountSize, aggregationCountSize+aggregationAmountFields*c.amountCodec.Size(value.Max))
+	binary.BigEndian.PutUint64(buffer, value.Count)
+	for _, amount := range []A{value.Sum, value.Min, value.Max} {
+		amountBytes := make([]byte, c.amountCodec.Size(amount))
+		_, err := c.amountCodec.Encode(amountBytes, amount)
+		if err != nil {
+			return nil, err
+		}
+		buffer = append(buffer, amountBytes...)
+	}
+	return buffer, nil
+}
+
+func (c aggregationValueCodec[A]) Decode(b []byte) (value Aggregation[A], err error) {
+	if len(b) < aggregationCountSize {
+		return value, fmt.Errorf("%w: invalid buffer size, wanted at least: %d", codec.ErrEncoding, aggregationCountSize)
+	}
+	value.Count = binary.BigEndian.Uint64(b)
+	b = b[aggregationCountSize:]
+	for _, amount := range []*A{&value.Sum, &value.Min, &value.Max} {
+		n, decoded, err := c.amountCodec.Decode(b)
+		if err != nil {
+			return value, err
+		}
+		*amount = decoded
+		b = b[n:]
+	}
+	if len(b) != 0 {
+		return value, fmt.Errorf("%w: invalid buffer size, %d trailing bytes", codec.ErrEncoding, len(b))
+	}
+	return value, value.validate()
+}
+
+func (c aggregationValueCodec[A]) EncodeJSON(value Aggregation[A]) ([]byte, error) {
+	var (
+		aggregation = jsonAggregation{Count: strconv.FormatUint(value.Count, 10)}
+		err         error
+	)
+	aggregation.Sum, err = c.amountCodec.EncodeJSON(value.Sum)
+	if err != nil {
+		return nil, err
+	}
+	aggregation.Min, err = c.amountCodec.EncodeJSON(value.Min)
+	if err != nil {
+		return nil, err
+	}
+	aggregation.Max, err = c.amountCodec.EncodeJSON(value.Max)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(aggregation)
+}
+
+func (c aggregationValueCodec[A]) DecodeJSON(b []byte) (value Aggregation[A], err error) {
+	var aggregation jsonAggregation
+	err = json.Unmarshal(b, &aggregation)
+	if err != nil {
+		return value, err
+	}
+	value.Count, err = strconv.ParseUint(aggregation.Count, 10, 64)
+	if err != nil {
+		return value, err
+	}
+	value.Sum, err = c.amountCodec.DecodeJSON(aggregation.Sum)
+	if err != nil {
+		return value, err
+	}
+	value.Min, err = c.amountCodec.DecodeJSON(aggregation.Min)
+	if err != nil {
+		return value, err
+	}
+	value.Max, err = c.amountCodec.DecodeJSON(aggregation.Max)
+	if err != nil {
+		return value, err
+	}
+	return value, value.validate()
+}
+
+func (c aggregationValueCodec[A]) Stringify(value Aggregation[A]) string {
+	return fmt.Sprintf("Aggregation{Count: %d, Sum: %s, Min: %s, Max: %s}",
+		value.Count, c.amountCodec.Stringify(value.Sum), c.amountCodec.Stringify(value.Min), c.amountCodec.Stringify(value.Max))
+}
+
+func (c aggregationValueCodec[A]) ValueType() string {
+	return "indexes.Aggregation[" + c.amountCodec.KeyType() + "]"
+}
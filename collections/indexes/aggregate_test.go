@@ -0,0 +1,180 @@
+package indexes
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+)
+
+type (
+	Delegator = string
+	Validator = string
+)
+
+// our delegations index, allows us to efficiently know the total amount delegated to a validator
+// and the number of its delegators, where delegations are saved as a collections.Pair[Delegator, Validator].
+type delegationIndex struct {
+	Validator *Aggregate[Validator, collections.Pair[Delegator, Validator], int64, int64]
+}
+
+func (d delegationIndex) IndexesList() []collections.Index[collections.Pair[Delegator, Validator], int64] {
+	return []collections.Index[collections.Pair[Delegator, Validator], int64]{d.Validator}
+}
+
+func newDelegations(sb *collections.SchemaBuilder) *collections.IndexedMap[collections.Pair[Delegator, Validator], int64, delegationIndex] {
+	keyCodec := collections.PairKeyCodec(collections.StringKey, collections.StringKey)
+	return collections.NewIndexedMap(
+		sb,
+		collections.NewPrefix("delegations"), "delegations",
+		keyCodec,
+		collections.Int64Value,
+		delegationIndex{
+			Validator: NewAggregate(
+				sb, collections.NewPrefix("validator_index"), "validator_index",
+				collections.StringKey, keyCodec, collections.Int64Key,
+				func(pk collections.Pair[Delegator, Validator], _ int64) (Validator, error) {
+					return pk.K2(), nil
+				},
+				func(_ collections.Pair[Delegator, Validator], amount int64) (int64, error) {
+					return amount, nil
+				},
+			),
+		},
+	)
+}
+
+func TestAggregate(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+	delegations := newDelegations(sb)
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	// no delegations
+	aggregation, err := delegations.Indexes.Validator.Get(ctx, "val1")
+	require.NoError(t, err)
+	require.Equal(t, Aggregation[int64]{}, aggregation)
+
+	require.NoError(t, delegations.Set(ctx, collections.Join("alice", "val1"), 10))
+	require.NoError(t, delegations.Set(ctx, collections.Join("bob", "val1"), 30))
+	require.NoError(t, delegations.Set(ctx, collections.Join("carol", "val1"), -5))
+	require.NoError(t, delegations.Set(ctx, collections.Join("alice", "val2"), 100))
+
+	aggregation, err = delegations.Indexes.Validator.Get(ctx, "val1")
+	require.NoError(t, err)
+	require.Equal(t, Aggregation[int64]{Count: 3, Sum: 35, Min: -5, Max: 30}, aggregation)
+	aggregation, err = delegations.Indexes.Validator.Get(ctx, "val2")
+	require.NoError(t, err)
+	require.Equal(t, Aggregation[int64]{Count: 1, Sum: 100, Min: 100, Max: 100}, aggregation)
+
+	// members are ordered by amount
+	iter, err := delegations.Indexes.Validator.MatchExact(ctx, "val1")
+	require.NoError(t, err)
+	pks, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Pair[Delegator, Validator]{
+		collections.Join("carol", "val1"),
+		collections.Join("alice", "val1"),
+		collections.Join("bob", "val1"),
+	}, pks)
+
+	// updating the maximum recomputes it
+	require.NoError(t, delegations.Set(ctx, collections.Join("bob", "val1"), 20))
+	aggregation, err = delegations.Indexes.Validator.Get(ctx, "val1")
+	require.NoError(t, err)
+	require.Equal(t, Aggregation[int64]{Count: 3, Sum: 25, Min: -5, Max: 20}, aggregation)
+
+	// removing the minimum recomputes it
+	require.NoError(t, delegations.Remove(ctx, collections.Join("carol", "val1")))
+	aggregation, err = delegations.Indexes.Validator.Get(ctx, "val1")
+	require.NoError(t, err)
+	require.Equal(t, Aggregation[int64]{Count: 2, Sum: 30, Min: 10, Max: 20}, aggregation)
+
+	// removing the maximum recomputes it
+	require.NoError(t, delegations.Remove(ctx, collections.Join("bob", "val1")))
+	aggregation, err = delegations.Indexes.Validator.Get(ctx, "val1")
+	require.NoError(t, err)
+	require.Equal(t, Aggregation[int64]{Count: 1, Sum: 10, Min: 10, Max: 10}, aggregation)
+
+	// moving a delegation to another validator updates both aggregates
+	require.NoError(t, delegations.Remove(ctx, collections.Join("alice", "val1")))
+	require.NoError(t, delegations.Set(ctx, collections.Join("alice", "val2"), 50))
+	aggregation, err = delegations.Indexes.Validator.Get(ctx, "val1")
+	require.NoError(t, err)
+	require.Equal(t, Aggregation[int64]{}, aggregation)
+	aggregation, err = delegations.Indexes.Validator.Get(ctx, "val2")
+	require.NoError(t, err)
+	require.Equal(t, Aggregation[int64]{Count: 1, Sum: 50, Min: 50, Max: 50}, aggregation)
+
+	// walk the aggregates
+	var walked []Validator
+	err = delegations.Indexes.Validator.Walk(ctx, nil, func(validator Validator, _ Aggregation[int64]) (bool, error) {
+		walked = append(walked, validator)
+		return false, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []Validator{"val2"}, walked)
+
+	// overflowing sums are rejected
+	require.NoError(t, delegations.Set(ctx, collections.Join("bob", "val2"), math.MaxInt64-50))
+	err = delegations.Set(ctx, collections.Join("carol", "val2"), 1)
+	require.ErrorIs(t, err, ErrAggregateOverflow)
+}
+
+func TestAggregateGenesis(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+	delegations := newDelegations(sb)
+	schema, err := sb.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, delegations.Set(ctx, collections.Join("alice", "val1"), 10))
+	require.NoError(t, delegations.Set(ctx, collections.Join("bob", "val1"), 30))
+
+	genesis := map[string]*bytes.Buffer{}
+	require.NoError(t, schema.ExportGenesis(ctx, func(field string) (io.WriteCloser, error) {
+		genesis[field] = &bytes.Buffer{}
+		return nopWriteCloser{genesis[field]}, nil
+	}))
+	require.Equal(t,
+		`[{"key":"val1","value":{"count":"2","sum":"40","min":"10","max":"30"}}]`,
+		genesis["validator_index"+AggregatesNameSuffix].String(),
+	)
+
+	source := func(field string) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(genesis[field].Bytes())), nil
+	}
+	require.NoError(t, schema.ValidateGenesis(source))
+
+	sk, ctx = deps()
+	sb = collections.NewSchemaBuilder(sk)
+	delegations = newDelegations(sb)
+	schema, err = sb.Build()
+	require.NoError(t, err)
+	require.NoError(t, schema.InitGenesis(ctx, source))
+
+	// the imported aggregates keep being maintained
+	require.NoError(t, delegations.Remove(ctx, collections.Join("bob", "val1")))
+	aggregation, err := delegations.Indexes.Validator.Get(ctx, "val1")
+	require.NoError(t, err)
+	require.Equal(t, Aggregation[int64]{Count: 1, Sum: 10, Min: 10, Max: 10}, aggregation)
+
+	// inconsistent aggregates are rejected
+	for _, invalid := range []string{
+		`[{"key":"val1","value":{"count":"0","sum":"0","min":"0","max":"0"}}]`,
+		`[{"key":"val1","value":{"count":"2","sum":"40","min":"30","max":"10"}}]`,
+		`[{"key":"val1","value":{"count":"2","sum":"70","min":"10","max":"30"}}]`,
+	} {
+		genesis["validator_index"+AggregatesNameSuffix] = bytes.NewBufferString(invalid)
+		require.Error(t, schema.ValidateGenesis(source), invalid)
+	}
+}
+
+type nopWriteCloser struct{ io.Writer }
+
+func (nopWriteCloser) Close() error { return nil }
@@ -0,0 +1,369 @@
+package collections
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/store"
+)
+
+const (
+	MigratorStepNameSuffix       = "_step"
+	MigratorCursorNameSuffix     = "_cursor"
+	MigratorMigratedNameSuffix   = "_migrated"
+	MigratorStepPrefixSuffix     = 0x0
+	MigratorCursorPrefixSuffix   = 0x1
+	MigratorMigratedPrefixSuffix = 0x2
+)
+
+// Migration defines a change of the layout of some state, such as a key re-encoding,
+// a value transform or a prefix move, which can be run in bounded batches.
+type Migration interface {
+	// Name returns the human-readable name of the migration.
+	Name() string
+	// Migrate migrates at most limit entries, resuming from the provided cursor, which is
+	// nil when the migration starts. It returns the cursor to resume from, the number of
+	// migrated entries and whether the migration is done.
+	Migrate(ctx context.Context, cursor []byte, limit uint64) (next []byte, migrated uint64, done bool, err error)
+}
+
+// MigrationProgress reports the progress of a Migrator.
+type MigrationProgress struct {
+	// Name is the name of the migration being run, or the last one if the Migrator is done.
+	Name string
+	// Step is the index of the migration being run.
+	Step uint64
+	// Migrated is the number of entries migrated by the migration being run, across batches.
+	Migrated uint64
+	// Done reports if all the migrations were run.
+	Done bool
+}
+
+// NewMigrator creates a new Migrator instance, running the provided migrations in order.
+// A Migrator defines a versioned schema change, which is generally registered as the
+// migration of a module from a consensus version to the next one.
+// Since the Migrator keeps its progress in state, it registers three state objects on the
+// schema builder, whose prefixes are the provided prefix with the MigratorStepPrefixSuffix,
+// MigratorCursorPrefixSuffix and MigratorMigratedPrefixSuffix suffixes, and whose names are
+// suffixed with MigratorStepNameSuffix, MigratorCursorNameSuffix and MigratorMigratedNameSuffix.
+// The collections in the old layout should be instantiated with a schema builder which is not
+// used to build the module's schema, since they can share the prefixes of the new ones.
+func NewMigrator(sb *SchemaBuilder, prefix Prefix, name string, migrations ...Migration) *Migrator {
+	return &Migrator{
+		migrations: migrations,
+		step:       NewItem(sb, prefix.WithSuffix(MigratorStepPrefixSuffix), name+MigratorStepNameSuffix, Uint64Value),
+		cursor:     NewItem(sb, prefix.WithSuffix(MigratorCursorPrefixSuffix), name+MigratorCursorNameSuffix, BytesValue),
+		migrated:   NewItem(sb, prefix.WithSuffix(MigratorMigratedPrefixSuffix), name+MigratorMigratedNameSuffix, Uint64Value),
+	}
+}
+
+// Migrator runs a list of migrations in bounded batches, either all at once in the upgrade
+// handler, or across multiple blocks so that large migrations do not halt the chain at the
+// upgrade height. The gas of the store accesses is consumed as usual, so limiting the batch
+// sizes limits the gas consumed by each batch.
+type Migrator struct {
+	migrations []Migration
+	// step is the index of the migration being run, it is only set while the Migrator runs.
+	step     Item[uint64]
+	cursor   Item[[]byte]
+	migrated Item[uint64]
+}
+
+// Start starts the Migrator, whose migrations are then run by calls to Migrate.
+func (m *Migrator) Start(ctx context.Context) error {
+	inProgress, err := m.InProgress(ctx)
+	if err != nil {
+		return err
+	}
+	if inProgress {
+		return fmt.Errorf("%w: migration already in progress", ErrConflict)
+	}
+	return m.step.Set(ctx, 0)
+}
+
+// InProgress reports if the Migrator was started and did not run all of its migrations yet.
+func (m *Migrator) InProgress(ctx context.Context) (bool, error) {
+	return m.step.Has(ctx)
+}
+
+// Migrate migrates at most limit entries, across the migrations, resuming from where the
+// previous call stopped. It is a no-op returning a done progress when the Migrator is not
+// in progress, so it can be called in every block.
+func (m *Migrator) Migrate(ctx context.Context, limit uint64) (progress MigrationProgress, err error) {
+	step, err := m.step.Get(ctx)
+	if errors.Is(err, ErrNotFound) {
+		return MigrationProgress{Step: uint64(len(m.migrations)), Done: true}, nil
+	}
+	if err != nil {
+		return progress, err
+	}
+	cursor, err := m.cursor.Get(ctx)
+	switch {
+	// the cursor of an entry whose key encoding is empty must not be mistaken for no cursor.
+	case err == nil && cursor == nil:
+		cursor = []byte{}
+	case errors.Is(err, ErrNotFound):
+	case err != nil:
+		return progress, err
+	}
+	migrated, err := m.migrated.Get(ctx)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return progress, err
+	}
+
+	remaining := limit
+	for step < uint64(len(m.migrations)) && remaining > 0 {
+		migration := m.migrations[step]
+		next, n, done, err := migration.Migrate(ctx, cursor, remaining)
+		if err != nil {
+			return progress, fmt.Errorf("migration %s: %w", migration.Name(), err)
+		}
+		migrated += n
+		// a migration which is not done has migrated as many entries as it was allowed to.
+		if !done {
+			cursor = next
+			break
+		}
+		remaining -= min(n, remaining)
+		step++
+		cursor = nil
+		migrated = 0
+	}
+
+	if step >= uint64(len(m.migrations)) {
+		progress = MigrationProgress{Step: step, Done: true}
+		if len(m.migrations) != 0 {
+			progress.Name = m.migrations[len(m.migrations)-1].Name()
+		}
+		if err := m.cursor.Remove(ctx); err != nil {
+			return progress, err
+		}
+		if err := m.migrated.Remove(ctx); err != nil {
+			return progress, err
+		}
+		return progress, m.step.Remove(ctx)
+	}
+
+	progress = MigrationProgress{Name: m.migrations[step].Name(), Step: step, Migrated: migrated}
+	if err := m.step.Set(ctx, step); err != nil {
+		return progress, err
+	}
+	if cursor == nil {
+		err = m.cursor.Remove(ctx)
+	} else {
+		err = m.cursor.Set(ctx, cursor)
+	}
+	if err != nil {
+		return progress, err
+	}
+	return progress, m.migrated.Set(ctx, migrated)
+}
+
+// MigrateAll starts the Migrator and runs all of its migrations in batches of batchSize entries.
+func (m *Migrator) MigrateAll(ctx context.Context, batchSize uint64) error {
+	if batchSize == 0 {
+		return fmt.Errorf("invalid batch size: %d", batchSize)
+	}
+	if err := m.Start(ctx); err != nil {
+		return err
+	}
+	for {
+		progress, err := m.Migrate(ctx, batchSize)
+		if err != nil {
+			return err
+		}
+		if progress.Done {
+			return nil
+		}
+	}
+}
+
+// Handler returns a migration handler which runs all the migrations in the upgrade, in batches of batchSize entries.
+func (m *Migrator) Handler(batchSize uint64) appmodule.MigrationHandler {
+	return func(ctx context.Context) error {
+		return m.MigrateAll(ctx, batchSize)
+	}
+}
+
+// StartHandler returns a migration handler which only starts the Migrator. The migrations are then
+// run across multiple blocks by the module, which calls Migrate in its BeginBlock or PreBlock.
+// Until the Migrator is done, the module must expect the state to be partially migrated.
+func (m *Migrator) StartHandler() appmodule.MigrationHandler {
+	return m.Start
+}
+
+// NewMapMigration returns a Migration which moves the entries of the from Map to the to Map,
+// transforming their keys and values with the provided function, such as re-encoding the keys
+// with a new KeyCodec. The migrated entries are removed from the from Map, so the maps must
+// not have overlapping prefixes.
+func NewMapMigration[OldK, OldV, NewK, NewV any](
+	name string,
+	from Map[OldK, OldV],
+	to Map[NewK, NewV],
+	transform func(ctx context.Context, key OldK, value OldV) (NewK, NewV, error),
+) Migration {
+	return mapMigration[OldK, OldV, NewK, NewV]{name: name, from: from, to: to, transform: transform}
+}
+
+type mapMigration[OldK, OldV, NewK, NewV any] struct {
+	name      string
+	from      Map[OldK, OldV]
+	to        Map[NewK, NewV]
+	transform func(ctx context.Context, key OldK, value OldV) (NewK, NewV, error)
+}
+
+func (m mapMigration[OldK, OldV, NewK, NewV]) Name() string { return m.name }
+
+func (m mapMigration[OldK, OldV, NewK, NewV]) Migrate(ctx context.Context, _ []byte, limit uint64) ([]byte, uint64, bool, error) {
+	if bytes.HasPrefix(m.from.prefix, m.to.prefix) || bytes.HasPrefix(m.to.prefix, m.from.prefix) {
+		return nil, 0, false, fmt.Errorf("overlapping prefixes 0x%x and 0x%x", m.from.prefix, m.to.prefix)
+	}
+	// since the migrated entries are removed, the migration always resumes from the first entry.
+	kvs, more, err := collectBatch(ctx, m.from, nil, limit)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	for _, kv := range kvs {
+		key, value, err := m.transform(ctx, kv.Key, kv.Value)
+		if err != nil {
+			return nil, 0, false, err
+		}
+		if err := m.to.Set(ctx, key, value); err != nil {
+			return nil, 0, false, err
+		}
+		if err := m.from.Remove(ctx, kv.Key); err != nil {
+			return nil, 0, false, err
+		}
+	}
+	return nil, uint64(len(kvs)), !more, nil
+}
+
+// NewValueMigration returns a Migration which rewrites in place the values of a Map with the
+// provided function. The from and to maps are the same Map, with the old and the new value codecs.
+func NewValueMigration[K, OldV, NewV any](
+	name string,
+	from Map[K, OldV],
+	to Map[K, NewV],
+	transform func(ctx context.Context, key K, value OldV) (NewV, error),
+) Migration {
+	return valueMigration[K, OldV, NewV]{name: name, from: from, to: to, transform: transform}
+}
+
+type valueMigration[K, OldV, NewV any] struct {
+	name      string
+	from      Map[K, OldV]
+	to        Map[K, NewV]
+	transform func(ctx context.Context, key K, value OldV) (NewV, error)
+}
+
+func (m valueMigration[K, OldV, NewV]) Name() string { return m.name }
+
+func (m valueMigration[K, OldV, NewV]) Migrate(ctx context.Context, cursor []byte, limit uint64) ([]byte, uint64, bool, error) {
+	if !bytes.Equal(m.from.prefix, m.to.prefix) {
+		return nil, 0, false, fmt.Errorf("different prefixes 0x%x and 0x%x, use a map migration", m.from.prefix, m.to.prefix)
+	}
+	// the rewritten values can't be told apart from the old ones, so the migration resumes after the last migrated key.
+	kvs, more, err := collectBatch(ctx, m.from, cursor, limit)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	for _, kv := range kvs {
+		value, err := m.transform(ctx, kv.Key, kv.Value)
+		if err != nil {
+			return nil, 0, false, err
+		}
+		if err := m.to.Set(ctx, kv.Key, value); err != nil {
+			return nil, 0, false, err
+		}
+	}
+	if len(kvs) == 0 {
+		return cursor, 0, !more, nil
+	}
+	next, err := EncodeKeyWithPrefix(nil, m.from.kc, kvs[len(kvs)-1].Key)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	return next, uint64(len(kvs)), !more, nil
+}
+
+// collectBatch collects at most limit entries of the map, after the provided raw key if not nil.
+// It reports if there are more entries to collect. Entries are collected before being migrated,
+// since the store can't be written while iterating.
+func collectBatch[K, V any](ctx context.Context, m Map[K, V], after []byte, limit uint64) (kvs []KeyValue[K, V], more bool, err error) {
+	var start []byte
+	if after != nil {
+		start = append(bytes.Clone(after), 0)
+	}
+	iter, err := m.IterateRaw(ctx, start, nil, OrderAscending)
+	if err != nil {
+		return nil, false, err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if uint64(len(kvs)) == limit {
+			return kvs, true, nil
+		}
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, false, err
+		}
+		kvs = append(kvs, kv)
+	}
+	return kvs, false, nil
+}
+
+// NewPrefixMigration returns a Migration which moves every raw entry under the from prefix
+// to the to prefix, without decoding them. It can move any collection, or a group of them.
+// The schema builder only provides the store, it can be the one of the old layout.
+func NewPrefixMigration(sb *SchemaBuilder, name string, from, to Prefix) Migration {
+	return prefixMigration{name: name, sa: sb.schema.storeAccessor, from: from.Bytes(), to: to.Bytes()}
+}
+
+type prefixMigration struct {
+	name     string
+	sa       func(context.Context) store.KVStore
+	from, to []byte
+}
+
+func (m prefixMigration) Name() string { return m.name }
+
+func (m prefixMigration) Migrate(ctx context.Context, _ []byte, limit uint64) ([]byte, uint64, bool, error) {
+	if bytes.HasPrefix(m.from, m.to) || bytes.HasPrefix(m.to, m.from) {
+		return nil, 0, false, fmt.Errorf("overlapping prefixes 0x%x and 0x%x", m.from, m.to)
+	}
+	kvStore := m.sa(ctx)
+	iter, err := kvStore.Iterator(m.from, nextBytesPrefixKey(m.from))
+	if err != nil {
+		return nil, 0, false, err
+	}
+	var (
+		keys, values [][]byte
+		more         bool
+	)
+	for ; iter.Valid(); iter.Next() {
+		if uint64(len(keys)) == limit {
+			more = true
+			break
+		}
+		// the iterator may reuse its buffers, and the pairs are rewritten once it is closed.
+		keys = append(keys, bytes.Clone(iter.Key()))
+		values = append(values, bytes.Clone(iter.Value()))
+	}
+	if err := iter.Close(); err != nil {
+		return nil, 0, false, err
+	}
+
+	for i, key := range keys {
+		newKey := append(bytes.Clone(m.to), key[len(m.from):]...)
+		if err := kvStore.Set(newKey, values[i]); err != nil {
+			return nil, 0, false, err
+		}
+		if err := kvStore.Delete(key); err != nil {
+			return nil, 0, false, err
+		}
+	}
+	return nil, uint64(len(keys)), !more, nil
+}
@@ -0,0 +1,144 @@
+package collections
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrator(t *testing.T) {
+	sk, ctx := deps()
+
+	// the old layout
+	legacy := NewSchemaBuilder(sk)
+	oldBalances := NewMap(legacy, NewPrefix(1), "balances", Uint64Key, Uint64Value)
+	oldNames := NewMap(legacy, NewPrefix(3), "names", StringKey, Uint64Value)
+	oldFlags := NewKeySet(legacy, NewPrefix(4), "flags", StringKey)
+
+	// the new layout
+	schemaBuilder := NewSchemaBuilder(sk)
+	balances := NewMap(schemaBuilder, NewPrefix(2), "balances", StringKey, Uint64Value)
+	names := NewMap(schemaBuilder, NewPrefix(3), "names", StringKey, StringValue)
+	flags := NewKeySet(schemaBuilder, NewPrefix(5), "flags", StringKey)
+	migrator := NewMigrator(schemaBuilder, NewPrefix(6), "migrator",
+		NewMapMigration("balances", oldBalances, balances, func(_ context.Context, key, value uint64) (string, uint64, error) {
+			return strconv.FormatUint(key, 10), value, nil
+		}),
+		NewValueMigration("names", oldNames, names, func(_ context.Context, _ string, value uint64) (string, error) {
+			return strconv.FormatUint(value, 10), nil
+		}),
+		NewPrefixMigration(schemaBuilder, "flags", NewPrefix(4), NewPrefix(5)),
+	)
+	_, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	for i := uint64(0); i < 5; i++ {
+		require.NoError(t, oldBalances.Set(ctx, i, i*10))
+	}
+	require.NoError(t, oldNames.Set(ctx, "a", 1))
+	require.NoError(t, oldNames.Set(ctx, "b", 2))
+	require.NoError(t, oldNames.Set(ctx, "c", 3))
+	require.NoError(t, oldFlags.Set(ctx, "x"))
+
+	// not started, so nothing is migrated
+	progress, err := migrator.Migrate(ctx, 100)
+	require.NoError(t, err)
+	require.True(t, progress.Done)
+	has, err := oldBalances.Has(ctx, 0)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	require.NoError(t, migrator.Start(ctx))
+	require.ErrorIs(t, migrator.Start(ctx), ErrConflict)
+	inProgress, err := migrator.InProgress(ctx)
+	require.NoError(t, err)
+	require.True(t, inProgress)
+
+	// migrate across multiple batches
+	progress, err = migrator.Migrate(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, MigrationProgress{Name: "balances", Step: 0, Migrated: 3}, progress)
+
+	// the remaining balances and the first name
+	progress, err = migrator.Migrate(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, MigrationProgress{Name: "names", Step: 1, Migrated: 1}, progress)
+
+	// the names are rewritten in place, resuming after the last migrated one
+	progress, err = migrator.Migrate(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, MigrationProgress{Name: "flags", Step: 2}, progress)
+
+	progress, err = migrator.Migrate(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, MigrationProgress{Name: "flags", Step: 3, Done: true}, progress)
+	inProgress, err = migrator.InProgress(ctx)
+	require.NoError(t, err)
+	require.False(t, inProgress)
+
+	// assert the new layout
+	iter, err := balances.Iterate(ctx, nil)
+	require.NoError(t, err)
+	kvs, err := iter.KeyValues()
+	require.NoError(t, err)
+	require.Equal(t, []KeyValue[string, uint64]{
+		{"0", 0}, {"1", 10}, {"2", 20}, {"3", 30}, {"4", 40},
+	}, kvs)
+	oldIter, err := oldBalances.Iterate(ctx, nil)
+	require.NoError(t, err)
+	require.False(t, oldIter.Valid())
+	require.NoError(t, oldIter.Close())
+
+	nameIter, err := names.Iterate(ctx, nil)
+	require.NoError(t, err)
+	nameKvs, err := nameIter.KeyValues()
+	require.NoError(t, err)
+	require.Equal(t, []KeyValue[string, string]{{"a", "1"}, {"b", "2"}, {"c", "3"}}, nameKvs)
+
+	has, err = flags.Has(ctx, "x")
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = oldFlags.Has(ctx, "x")
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestMigratorHandler(t *testing.T) {
+	sk, ctx := deps()
+	legacy := NewSchemaBuilder(sk)
+	oldMap := NewMap(legacy, NewPrefix(1), "map", Uint64Key, Uint64Value)
+
+	schemaBuilder := NewSchemaBuilder(sk)
+	newMap := NewMap(schemaBuilder, NewPrefix(2), "map", Uint64Key, Uint64Value)
+	overlapping := NewMap(schemaBuilder, NewPrefix([]byte{1, 0}), "overlapping", Uint64Key, Uint64Value)
+	migrator := NewMigrator(schemaBuilder, NewPrefix(3), "migrator",
+		NewMapMigration("double", oldMap, newMap, func(_ context.Context, key, value uint64) (uint64, uint64, error) {
+			return key, value * 2, nil
+		}),
+	)
+	overlappingMigrator := NewMigrator(schemaBuilder, NewPrefix(4), "overlapping_migrator",
+		NewMapMigration("overlapping", oldMap, overlapping, func(_ context.Context, key, value uint64) (uint64, uint64, error) {
+			return key, value, nil
+		}),
+	)
+	_, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	for i := uint64(0); i < 10; i++ {
+		require.NoError(t, oldMap.Set(ctx, i, i))
+	}
+
+	require.ErrorContains(t, overlappingMigrator.Handler(3)(ctx), "overlapping prefixes")
+
+	require.NoError(t, migrator.Handler(3)(ctx))
+	for i := uint64(0); i < 10; i++ {
+		value, err := newMap.Get(ctx, i)
+		require.NoError(t, err)
+		require.Equal(t, i*2, value)
+	}
+	inProgress, err := migrator.InProgress(ctx)
+	require.NoError(t, err)
+	require.False(t, inProgress)
+}
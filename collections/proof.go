@@ -0,0 +1,71 @@
+package collections
+
+import "fmt"
+
+// Prover queries the raw value of a key of a module store at a height, along with the merkle proof
+// of its existence or absence up to the app hash of that height. The proof type P depends on the
+// store, store/v2's root.Prover implements Prover[[]proof.CommitmentOp].
+type Prover[P any] interface {
+	GetWithProof(storeKey []byte, height uint64, key []byte) (value []byte, proof P, err error)
+}
+
+// ProvableStore provides the values of the collections of a module store along with their proofs.
+type ProvableStore[P any] struct {
+	prover   Prover[P]
+	storeKey []byte
+}
+
+// NewProvableStore returns a ProvableStore proving the values of the provided store key,
+// the name of the module store.
+func NewProvableStore[P any](prover Prover[P], storeKey []byte) ProvableStore[P] {
+	return ProvableStore[P]{prover: prover, storeKey: storeKey}
+}
+
+// Proven is a value of a collection read at a height, along with its proof.
+type Proven[V, P any] struct {
+	// Key is the raw key of the value in the module store, which is proven.
+	Key []byte
+	// RawValue is the raw value in the module store, which is proven, it is nil if the value was not found.
+	RawValue []byte
+	// Value is the decoded value, it is the zero value if the value was not found.
+	Value V
+	// Found reports if the value was found, otherwise the proof is a proof of absence.
+	Found bool
+	// Height is the height at which the value was read.
+	Height uint64
+	// Proof is the proof of the raw key and value, up to the app hash.
+	Proof P
+}
+
+// GetWithProof returns the value of the provided key of the Map at the provided height, along with its proof.
+// If the key is not found, no error is returned, and the returned Proven holds the proof of absence of the key.
+func GetWithProof[K, V, P any](s ProvableStore[P], m Map[K, V], height uint64, key K) (Proven[V, P], error) {
+	bytesKey, err := EncodeKeyWithPrefix(m.prefix, m.kc, key)
+	if err != nil {
+		return Proven[V, P]{}, err
+	}
+	rawValue, proof, err := s.prover.GetWithProof(s.storeKey, height, bytesKey)
+	if err != nil {
+		return Proven[V, P]{}, err
+	}
+	proven := Proven[V, P]{
+		Key:    bytesKey,
+		Height: height,
+		Proof:  proof,
+	}
+	if rawValue == nil {
+		return proven, nil
+	}
+	proven.Value, err = m.vc.Decode(rawValue)
+	if err != nil {
+		return Proven[V, P]{}, fmt.Errorf("%w: value decode: %w", ErrEncoding, err)
+	}
+	proven.RawValue, proven.Found = rawValue, true
+	return proven, nil
+}
+
+// GetItemWithProof returns the value of the Item at the provided height, along with its proof.
+// If the Item is not set, no error is returned, and the returned Proven holds the proof of its absence.
+func GetItemWithProof[V, P any](s ProvableStore[P], i Item[V], height uint64) (Proven[V, P], error) {
+	return GetWithProof(s, (Map[noKey, V])(i), height, noKey{})
+}
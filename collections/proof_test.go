@@ -0,0 +1,67 @@
+package collections
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+)
+
+// testProof is the proof returned by testProver, it only records what was proven.
+type testProof struct {
+	storeKey []byte
+	height   uint64
+	key      []byte
+}
+
+// testProver proves the values of the current state of a store.
+type testProver struct {
+	kvStore store.KVStore
+}
+
+func (p testProver) GetWithProof(storeKey []byte, height uint64, key []byte) ([]byte, testProof, error) {
+	value, err := p.kvStore.Get(key)
+	return value, testProof{storeKey: storeKey, height: height, key: key}, err
+}
+
+func TestGetWithProof(t *testing.T) {
+	sk, ctx := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	m := NewMap(schemaBuilder, NewPrefix(1), "map", StringKey, Uint64Value)
+	item := NewItem(schemaBuilder, NewPrefix(2), "item", StringValue)
+	_, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, m.Set(ctx, "a", 1))
+	require.NoError(t, item.Set(ctx, "value"))
+
+	provable := NewProvableStore[testProof](testProver{kvStore: sk.OpenKVStore(ctx)}, []byte("test"))
+
+	proven, err := GetWithProof(provable, m, 10, "a")
+	require.NoError(t, err)
+	expectedKey, err := EncodeKeyWithPrefix(NewPrefix(1), StringKey, "a")
+	require.NoError(t, err)
+	require.Equal(t, Proven[uint64, testProof]{
+		Key:      expectedKey,
+		RawValue: []byte{0, 0, 0, 0, 0, 0, 0, 1},
+		Value:    1,
+		Found:    true,
+		Height:   10,
+		Proof:    testProof{storeKey: []byte("test"), height: 10, key: expectedKey},
+	}, proven)
+
+	// absence proof
+	proven, err = GetWithProof(provable, m, 10, "b")
+	require.NoError(t, err)
+	require.False(t, proven.Found)
+	require.Nil(t, proven.RawValue)
+	require.Equal(t, uint64(0), proven.Value)
+	require.Equal(t, proven.Key, proven.Proof.key)
+
+	provenItem, err := GetItemWithProof(provable, item, 10)
+	require.NoError(t, err)
+	require.True(t, provenItem.Found)
+	require.Equal(t, "value", provenItem.Value)
+	require.Equal(t, []byte{2}, provenItem.Key)
+}
@@ -0,0 +1,197 @@
+package collections
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections/codec"
+)
+
+// ErrEmptyQueue is returned when trying to peek or pop an element from an empty queue.
+var ErrEmptyQueue = errors.New("collections: queue is empty")
+
+const (
+	QueueEntriesNameSuffix    = "_entries"
+	QueueSequenceNameSuffix   = "_sequence"
+	QueueEntriesPrefixSuffix  = 0x0
+	QueueSequencePrefixSuffix = 0x1
+)
+
+// QueueEntry is an element of a PriorityQueue along with its priority
+// and its sequence, which identifies it among the elements with the same priority.
+type QueueEntry[P, V any] struct {
+	Priority P
+	Sequence uint64
+	Value    V
+}
+
+// NewPriorityQueue creates a new PriorityQueue instance. Since PriorityQueue relies on two collections,
+// one for the elements and the other for the sequence, it will register two state objects on the schema builder.
+// The first is the elements which is a map, whose prefix is the provided prefix with a suffix
+// which equals to QueueEntriesPrefixSuffix, the name is also suffixed with QueueEntriesNameSuffix.
+// The second is the sequence, whose prefix is the provided prefix with a suffix
+// which equals to QueueSequencePrefixSuffix, the name is also suffixed with QueueSequenceNameSuffix.
+// The priority codec must retain the ordering of the priorities in their non-terminal encoding.
+func NewPriorityQueue[P, V any](
+	sb *SchemaBuilder,
+	prefix Prefix,
+	name string,
+	priorityCodec codec.KeyCodec[P],
+	vc codec.ValueCodec[V],
+) PriorityQueue[P, V] {
+	return PriorityQueue[P, V]{
+		sequence: NewSequence(sb, prefix.WithSuffix(QueueSequencePrefixSuffix), name+QueueSequenceNameSuffix),
+		entries:  NewMap(sb, prefix.WithSuffix(QueueEntriesPrefixSuffix), name+QueueEntriesNameSuffix, PairKeyCodec(priorityCodec, Uint64Key), vc),
+	}
+}
+
+// PriorityQueue is a queue sitting on top of a KVStore, whose elements are ordered by priority,
+// lowest first. Elements with the same priority are ordered by insertion.
+// It relies on two collections, one for the elements which is a Map[Pair[P, uint64], V],
+// where the uint64 is the sequence of the element, the other for the sequence which is a Sequence.
+type PriorityQueue[P, V any] struct {
+	sequence Sequence
+	entries  Map[Pair[P, uint64], V]
+}
+
+// Enqueue adds an element with the given priority to the queue.
+// It returns the sequence of the element, which is required to remove it.
+func (q PriorityQueue[P, V]) Enqueue(ctx context.Context, priority P, value V) (uint64, error) {
+	seq, err := q.sequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return seq, q.entries.Set(ctx, Join(priority, seq), value)
+}
+
+// Remove removes the element with the given priority and sequence from the queue.
+// It won't report through the error if the element was removed or not.
+func (q PriorityQueue[P, V]) Remove(ctx context.Context, priority P, sequence uint64) error {
+	return q.entries.Remove(ctx, Join(priority, sequence))
+}
+
+// Has reports if the element with the given priority and sequence is in the queue.
+func (q PriorityQueue[P, V]) Has(ctx context.Context, priority P, sequence uint64) (bool, error) {
+	return q.entries.Has(ctx, Join(priority, sequence))
+}
+
+// Peek returns the element with the lowest priority without removing it.
+// Fails with ErrEmptyQueue if the queue is empty.
+func (q PriorityQueue[P, V]) Peek(ctx context.Context) (entry QueueEntry[P, V], err error) {
+	iter, err := q.entries.Iterate(ctx, nil)
+	if err != nil {
+		return entry, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return entry, ErrEmptyQueue
+	}
+	return queueEntry(iter)
+}
+
+// Pop removes the element with the lowest priority from the queue and returns it.
+// Fails with ErrEmptyQueue if the queue is empty.
+func (q PriorityQueue[P, V]) Pop(ctx context.Context) (entry QueueEntry[P, V], err error) {
+	entry, err = q.Peek(ctx)
+	if err != nil {
+		return entry, err
+	}
+	return entry, q.Remove(ctx, entry.Priority, entry.Sequence)
+}
+
+// WalkDue walks over the elements whose priority is lower than or equal to until, lowest first.
+// At most limit elements are walked, or all of them if limit is zero. The queue must not be
+// modified while walking.
+func (q PriorityQueue[P, V]) WalkDue(ctx context.Context, until P, limit uint64, walkFn func(entry QueueEntry[P, V]) (stop bool, err error)) error {
+	iter, err := q.entries.Iterate(ctx, NewPrefixUntilPairRange[P, uint64](until))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for n := uint64(0); iter.Valid() && (limit == 0 || n < limit); n++ {
+		entry, err := queueEntry(iter)
+		if err != nil {
+			return err
+		}
+		stop, err := walkFn(entry)
+		if err != nil {
+			return err
+		}
+		if stop {
+			return nil
+		}
+		iter.Next()
+	}
+	return nil
+}
+
+// PopDue removes the elements whose priority is lower than or equal to until from the queue
+// and returns them, lowest first. At most limit elements are removed, or all of them if
+// limit is zero.
+func (q PriorityQueue[P, V]) PopDue(ctx context.Context, until P, limit uint64) ([]QueueEntry[P, V], error) {
+	var entries []QueueEntry[P, V]
+	err := q.WalkDue(ctx, until, limit, func(entry QueueEntry[P, V]) (bool, error) {
+		entries = append(entries, entry)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if err := q.Remove(ctx, entry.Priority, entry.Sequence); err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+// IsEmpty reports if the queue has no elements.
+func (q PriorityQueue[P, V]) IsEmpty(ctx context.Context) (bool, error) {
+	_, err := q.Peek(ctx)
+	switch {
+	case err == nil:
+		return false, nil
+	case errors.Is(err, ErrEmptyQueue):
+		return true, nil
+	default:
+		return false, err
+	}
+}
+
+// Iterate iterates over the elements of the queue. It returns an Iterator whose key is the
+// priority and the sequence of the element and the value is the element.
+func (q PriorityQueue[P, V]) Iterate(ctx context.Context, rng Ranger[Pair[P, uint64]]) (Iterator[Pair[P, uint64], V], error) {
+	return q.entries.Iterate(ctx, rng)
+}
+
+// Walk walks over the elements of the queue, in priority order unless the ranger says otherwise.
+func (q PriorityQueue[P, V]) Walk(ctx context.Context, rng Ranger[Pair[P, uint64]], walkFn func(entry QueueEntry[P, V]) (stop bool, err error)) error {
+	return q.entries.Walk(ctx, rng, func(key Pair[P, uint64], value V) (bool, error) {
+		return walkFn(QueueEntry[P, V]{Priority: key.K1(), Sequence: key.K2(), Value: value})
+	})
+}
+
+func queueEntry[P, V any](iter Iterator[Pair[P, uint64], V]) (entry QueueEntry[P, V], err error) {
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return entry, err
+	}
+	return QueueEntry[P, V]{Priority: kv.Key.K1(), Sequence: kv.Key.K2(), Value: kv.Value}, nil
+}
+
+// NewQueue creates a new Queue instance, whose elements are ordered by the time they are due at.
+// It registers the same state objects as NewPriorityQueue.
+func NewQueue[V any](sb *SchemaBuilder, prefix Prefix, name string, vc codec.ValueCodec[V]) Queue[V] {
+	return Queue[V]{NewPriorityQueue(sb, prefix, name, TimeKey, vc)}
+}
+
+// Queue is a PriorityQueue whose elements are ordered by the time they are due at,
+// earliest first. It can be used for elements maturing at a given time, such as
+// expirations or unbondings, which are popped with PopDue once the block time is reached.
+type Queue[V any] struct {
+	PriorityQueue[time.Time, V]
+}
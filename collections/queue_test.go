@@ -0,0 +1,142 @@
+package collections
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	sk, ctx := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	q := NewPriorityQueue(schemaBuilder, NewPrefix(0), "queue", Uint64Key, StringValue)
+	_, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	// peek and pop when empty should error with an empty queue error
+	_, err = q.Peek(ctx)
+	require.ErrorIs(t, err, ErrEmptyQueue)
+	_, err = q.Pop(ctx)
+	require.ErrorIs(t, err, ErrEmptyQueue)
+	empty, err := q.IsEmpty(ctx)
+	require.NoError(t, err)
+	require.True(t, empty)
+
+	// enqueue out of order, elements with the same priority keep their insertion order
+	for _, e := range []struct {
+		priority uint64
+		value    string
+	}{{5, "e"}, {1, "a"}, {3, "c1"}, {3, "c2"}, {10, "j"}} {
+		_, err := q.Enqueue(ctx, e.priority, e.value)
+		require.NoError(t, err)
+	}
+
+	// peek does not remove
+	entry, err := q.Peek(ctx)
+	require.NoError(t, err)
+	require.Equal(t, QueueEntry[uint64, string]{Priority: 1, Sequence: 1, Value: "a"}, entry)
+	entry, err = q.Peek(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "a", entry.Value)
+
+	// pop removes the lowest priority
+	entry, err = q.Pop(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "a", entry.Value)
+	entry, err = q.Peek(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "c1", entry.Value)
+
+	// walk due does not remove and is bounded by the limit
+	var walked []string
+	err = q.WalkDue(ctx, 5, 2, func(entry QueueEntry[uint64, string]) (bool, error) {
+		walked = append(walked, entry.Value)
+		return false, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"c1", "c2"}, walked)
+
+	// pop due is bounded by the limit
+	entries, err := q.PopDue(ctx, 5, 1)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "c1", entries[0].Value)
+
+	// pop due includes the elements with the until priority
+	entries, err = q.PopDue(ctx, 5, 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "c2", entries[0].Value)
+	require.Equal(t, "e", entries[1].Value)
+
+	// nothing due
+	entries, err = q.PopDue(ctx, 9, 0)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	// remove by priority and sequence
+	has, err := q.Has(ctx, 10, 4)
+	require.NoError(t, err)
+	require.True(t, has)
+	require.NoError(t, q.Remove(ctx, 10, 4))
+	empty, err = q.IsEmpty(ctx)
+	require.NoError(t, err)
+	require.True(t, empty)
+}
+
+func TestQueue(t *testing.T) {
+	sk, ctx := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	q := NewQueue(schemaBuilder, NewPrefix(0), "queue", StringValue)
+	schema, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 500, time.UTC)
+	_, err = q.Enqueue(ctx, now.Add(time.Hour), "later")
+	require.NoError(t, err)
+	_, err = q.Enqueue(ctx, now.Add(-time.Hour), "earlier")
+	require.NoError(t, err)
+	_, err = q.Enqueue(ctx, now, "now")
+	require.NoError(t, err)
+	// times before the unix epoch retain their ordering
+	_, err = q.Enqueue(ctx, time.Time{}, "zero")
+	require.NoError(t, err)
+
+	// export and import the queue in a new store
+	genesis := map[string]*bytes.Buffer{}
+	require.NoError(t, schema.ExportGenesis(ctx, func(field string) (io.WriteCloser, error) {
+		genesis[field] = &bytes.Buffer{}
+		return nopWriteCloser{genesis[field]}, nil
+	}))
+	require.Len(t, genesis, 2)
+
+	sk, ctx = deps()
+	schemaBuilder = NewSchemaBuilder(sk)
+	q = NewQueue(schemaBuilder, NewPrefix(0), "queue", StringValue)
+	schema, err = schemaBuilder.Build()
+	require.NoError(t, err)
+	require.NoError(t, schema.InitGenesis(ctx, func(field string) (io.ReadCloser, error) {
+		return io.NopCloser(genesis[field]), nil
+	}))
+
+	entries, err := q.PopDue(ctx, now, 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.Equal(t, "zero", entries[0].Value)
+	require.Equal(t, "earlier", entries[1].Value)
+	require.Equal(t, now.Add(-time.Hour), entries[1].Priority)
+	require.Equal(t, "now", entries[2].Value)
+	require.Equal(t, now, entries[2].Priority)
+
+	// the sequence is imported too
+	seq, err := q.Enqueue(ctx, now, "new")
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), seq)
+}
+
+type nopWriteCloser struct{ io.Writer }
+
+func (nopWriteCloser) Close() error { return nil }
@@ -30,4 +30,22 @@ CREATE TABLE IF NOT EXISTS event
     type         TEXT   NOT NULL,
     data         JSONB  NOT NULL
 );
+
+CREATE TABLE IF NOT EXISTS undo_record
+(
+    id           BIGSERIAL PRIMARY KEY,
+    block_number BIGINT NOT NULL,
+    table_name   TEXT   NOT NULL,
+    key          JSONB  NOT NULL,
+    prev_value   JSONB  NULL
+);
+
+CREATE INDEX IF NOT EXISTS undo_record_block_number_idx ON undo_record (block_number);
+
+CREATE TABLE IF NOT EXISTS undo_state
+(
+    id            INTEGER NOT NULL PRIMARY KEY CHECK (id = 1),
+    retained_from BIGINT  NOT NULL,
+    last_block    BIGINT  NOT NULL DEFAULT 0
+);
 `
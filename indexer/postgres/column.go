@@ -111,10 +111,40 @@ func simpleColumnType(kind schema.Kind) string {
 // This is the field name in most cases, except for time columns which are stored as nanos
 // and then converted to timestamp generated columns.
 func (tm *ObjectIndexer) updatableColumnName(field schema.Field) (name string, err error) {
-	name = field.Name
-	if field.Kind == schema.TimeKind {
-		name = fmt.Sprintf("%s_nanos", name)
-	}
-	name = fmt.Sprintf("%q", name)
+	name = fmt.Sprintf("%q", rawColumnName(field))
 	return
 }
+
+// columnType returns the postgres type of the updatable column for the field which is used to cast parameters.
+func (tm *ObjectIndexer) columnType(field schema.Field) string {
+	switch field.Kind {
+	case schema.EnumKind:
+		return fmt.Sprintf("%q", enumTypeName(tm.moduleName, field.EnumType))
+	case schema.TimeKind:
+		return "BIGINT"
+	default:
+		return simpleColumnType(field.Kind)
+	}
+}
+
+// keyColumnNames returns the quoted names of the primary key columns.
+func (tm *ObjectIndexer) keyColumnNames() ([]string, error) {
+	if len(tm.typ.KeyFields) == 0 {
+		return []string{"_id"}, nil
+	}
+
+	return tm.columnNames(tm.typ.KeyFields)
+}
+
+// columnNames returns the quoted updatable column names for the fields.
+func (tm *ObjectIndexer) columnNames(fields []schema.Field) ([]string, error) {
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		name, err := tm.updatableColumnName(field)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
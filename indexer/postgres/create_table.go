@@ -29,9 +29,7 @@ func (tm *ObjectIndexer) CreateTableSql(writer io.Writer) error {
 	if err != nil {
 		return err
 	}
-	isSingleton := false
 	if len(tm.typ.KeyFields) == 0 {
-		isSingleton = true
 		_, err = fmt.Fprintf(writer, "_id INTEGER NOT NULL CHECK (_id = 1),\n\t")
 		if err != nil {
 			return err
@@ -53,25 +51,16 @@ func (tm *ObjectIndexer) CreateTableSql(writer io.Writer) error {
 	}
 
 	// add _deleted column when we have RetainDeletions set and enabled
-	if !tm.options.DisableRetainDeletions && tm.typ.RetainDeletions {
+	if tm.retainDeletions() {
 		_, err = fmt.Fprintf(writer, "_deleted BOOLEAN NOT NULL DEFAULT FALSE,\n\t")
 		if err != nil {
 			return err
 		}
 	}
 
-	var pKeys []string
-	if !isSingleton {
-		for _, field := range tm.typ.KeyFields {
-			name, err := tm.updatableColumnName(field)
-			if err != nil {
-				return err
-			}
-
-			pKeys = append(pKeys, name)
-		}
-	} else {
-		pKeys = []string{"_id"}
+	pKeys, err := tm.keyColumnNames()
+	if err != nil {
+		return err
 	}
 
 	_, err = fmt.Fprintf(writer, "PRIMARY KEY (%s)", strings.Join(pKeys, ", "))
@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Delete deletes the row with the provided key from the table. If the object type retains
+// deletions, the row is marked as deleted with the _deleted column instead.
+func (tm *ObjectIndexer) Delete(ctx context.Context, conn DBConn, key interface{}) error {
+	buf := new(strings.Builder)
+	params, err := tm.DeleteSql(buf, key)
+	if err != nil {
+		return err
+	}
+
+	sqlStr := buf.String()
+	if tm.options.Logger != nil {
+		tm.options.Logger("Delete", sqlStr, params...)
+	}
+	_, err = conn.ExecContext(ctx, sqlStr, params...)
+	return err
+}
+
+// DeleteSql generates a DELETE or UPDATE statement for the provided key and returns the parameters to bind to it.
+func (tm *ObjectIndexer) DeleteSql(w io.Writer, key interface{}) ([]interface{}, error) {
+	_, params, err := tm.bindKeyParams(key)
+	if err != nil {
+		return nil, err
+	}
+
+	keyCols, err := tm.keyColumnNames()
+	if err != nil {
+		return nil, err
+	}
+
+	if tm.retainDeletions() {
+		_, err = fmt.Fprintf(w, "UPDATE %q SET _deleted = TRUE", tm.TableName())
+	} else {
+		_, err = fmt.Fprintf(w, "DELETE FROM %q", tm.TableName())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = writeWhereKey(w, keyCols, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return params, nil
+}
@@ -3,12 +3,21 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 
 	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/indexer"
 )
 
+// IndexerType is the name that the PostgreSQL indexer is registered with in the indexer registry.
+const IndexerType = "postgres"
+
+func init() {
+	indexer.Register(IndexerType, initIndexer)
+}
+
 type Config struct {
 	// DatabaseURL is the PostgreSQL connection URL to use to connect to the database.
 	DatabaseURL string `json:"database_url"`
@@ -18,13 +27,23 @@ type Config struct {
 
 	// DisableRetainDeletions disables the retain deletions functionality even if it is set in an object type schema.
 	DisableRetainDeletions bool `json:"disable_retain_deletions"`
+
+	// DisableRollback disables recording undo records for object updates. When it is set, the indexer
+	// can't be rolled back and its listener won't have a Rollback callback.
+	DisableRollback bool `json:"disable_rollback"`
+
+	// UndoRetentionBlocks is the number of blocks for which undo records are retained which is the maximum
+	// number of blocks the indexer can be rolled back. If it is zero, undo records are never pruned.
+	UndoRetentionBlocks uint64 `json:"undo_retention_blocks"`
 }
 
 type SqlLogger = func(msg, sql string, params ...interface{})
 
-func StartIndexer(ctx context.Context, logger SqlLogger, config Config) (appdata.Listener, error) {
+// StartIndexer opens the PostgreSQL database, creates the base schema and returns the indexer's listener
+// together with the last block that was persisted to the database.
+func StartIndexer(ctx context.Context, logger SqlLogger, config Config) (indexer.InitResult, error) {
 	if config.DatabaseURL == "" {
-		return appdata.Listener{}, errors.New("missing database URL")
+		return indexer.InitResult{}, errors.New("missing database URL")
 	}
 
 	driver := config.DatabaseDriver
@@ -34,18 +53,28 @@ func StartIndexer(ctx context.Context, logger SqlLogger, config Config) (appdata
 
 	db, err := sql.Open(driver, config.DatabaseURL)
 	if err != nil {
-		return appdata.Listener{}, err
+		return indexer.InitResult{}, err
 	}
 
 	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
-		return appdata.Listener{}, err
+		return indexer.InitResult{}, err
 	}
 
 	// commit base schema
 	_, err = tx.Exec(BaseSQL)
 	if err != nil {
-		return appdata.Listener{}, err
+		return indexer.InitResult{}, err
+	}
+
+	err = InitUndoState(ctx, tx)
+	if err != nil {
+		return indexer.InitResult{}, err
+	}
+
+	lastBlock, err := LastBlockPersisted(ctx, tx)
+	if err != nil {
+		return indexer.InitResult{}, err
 	}
 
 	moduleIndexers := map[string]*ModuleIndexer{}
@@ -54,7 +83,9 @@ func StartIndexer(ctx context.Context, logger SqlLogger, config Config) (appdata
 		Logger:                 logger,
 	}
 
-	return appdata.Listener{
+	var blockNumber uint64
+
+	listener := appdata.Listener{
 		InitializeModuleData: func(data appdata.ModuleInitializationData) error {
 			moduleName := data.ModuleName
 			modSchema := data.Schema
@@ -68,7 +99,63 @@ func StartIndexer(ctx context.Context, logger SqlLogger, config Config) (appdata
 
 			return mm.InitializeSchema(ctx, tx)
 		},
+		StartBlock: func(data appdata.StartBlockData) error {
+			blockNumber = data.Height
+
+			var header interface{}
+			if data.HeaderJSON != nil {
+				bz, err := data.HeaderJSON()
+				if err != nil {
+					return err
+				}
+				header = string(bz)
+			}
+
+			_, err := tx.ExecContext(ctx,
+				"INSERT INTO block (number, header) VALUES ($1, $2) ON CONFLICT (number) DO UPDATE SET header = EXCLUDED.header;",
+				int64(blockNumber), header)
+			return err
+		},
+		OnObjectUpdate: func(data appdata.ObjectUpdateData) error {
+			mm, ok := moduleIndexers[data.ModuleName]
+			if !ok {
+				return fmt.Errorf("module %s not initialized", data.ModuleName)
+			}
+
+			for _, update := range data.Updates {
+				if !config.DisableRollback {
+					err := mm.RecordUndo(ctx, tx, blockNumber, update)
+					if err != nil {
+						return err
+					}
+				}
+
+				err := mm.ApplyUpdate(ctx, tx, update)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
 		Commit: func(data appdata.CommitData) error {
+			err = SetLastBlockPersisted(ctx, tx, blockNumber)
+			if err != nil {
+				return err
+			}
+
+			if config.DisableRollback {
+				// without undo records, the indexed data can't be reverted to any previous block
+				err = DiscardUndoState(ctx, tx, blockNumber)
+				if err != nil {
+					return err
+				}
+			} else if config.UndoRetentionBlocks != 0 && blockNumber > config.UndoRetentionBlocks {
+				err = PruneUndoRecords(ctx, tx, blockNumber-config.UndoRetentionBlocks)
+				if err != nil {
+					return err
+				}
+			}
+
 			err = tx.Commit()
 			if err != nil {
 				return err
@@ -77,5 +164,64 @@ func StartIndexer(ctx context.Context, logger SqlLogger, config Config) (appdata
 			tx, err = db.BeginTx(ctx, nil)
 			return err
 		},
+	}
+
+	if !config.DisableRollback {
+		listener.Rollback = func(data appdata.RollbackData) error {
+			tables := map[string]*ObjectIndexer{}
+			for _, mm := range moduleIndexers {
+				for _, tm := range mm.ObjectIndexers() {
+					tables[tm.TableName()] = tm
+				}
+			}
+
+			err = Rollback(ctx, tx, tables, data.Height, logger)
+			if err != nil {
+				return err
+			}
+
+			err = tx.Commit()
+			if err != nil {
+				return err
+			}
+
+			blockNumber = data.Height
+			tx, err = db.BeginTx(ctx, nil)
+			return err
+		}
+	}
+
+	return indexer.InitResult{
+		Listener:           listener,
+		LastBlockPersisted: lastBlock,
 	}, nil
 }
+
+// initIndexer is the indexer.InitFunc that is registered for the PostgreSQL indexer.
+func initIndexer(params indexer.InitParams) (indexer.InitResult, error) {
+	// the indexer specific config is decoded by round-tripping it through JSON
+	bz, err := json.Marshal(params.Config.Config)
+	if err != nil {
+		return indexer.InitResult{}, err
+	}
+
+	var config Config
+	err = json.Unmarshal(bz, &config)
+	if err != nil {
+		return indexer.InitResult{}, fmt.Errorf("invalid postgres indexer config: %v", err) //nolint:errorlint // using %v for go 1.12 compat
+	}
+
+	var logger SqlLogger
+	if params.Logger != nil {
+		logger = func(msg, sql string, sqlParams ...interface{}) {
+			params.Logger.Debug(msg, "sql", sql, "params", sqlParams)
+		}
+	}
+
+	ctx := params.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return StartIndexer(ctx, logger, config)
+}
@@ -0,0 +1,191 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"cosmossdk.io/schema"
+)
+
+// InsertUpdate inserts or updates the row with the provided key and value.
+func (tm *ObjectIndexer) InsertUpdate(ctx context.Context, conn DBConn, key, value interface{}) error {
+	// NOT NULL constraints are checked before conflicts are resolved so a partial update
+	// can't be expressed as an upsert, we try updating the existing row first instead
+	if _, ok := value.(schema.ValueUpdates); ok {
+		updated, err := tm.Update(ctx, conn, key, value)
+		if err != nil || updated {
+			return err
+		}
+	}
+
+	buf := new(strings.Builder)
+	params, err := tm.InsertUpdateSql(buf, key, value)
+	if err != nil {
+		return err
+	}
+
+	sqlStr := buf.String()
+	if tm.options.Logger != nil {
+		tm.options.Logger("Insert or update", sqlStr, params...)
+	}
+	_, err = conn.ExecContext(ctx, sqlStr, params...)
+	return err
+}
+
+// InsertUpdateSql generates an INSERT ... ON CONFLICT DO UPDATE statement for the provided key and value
+// and returns the parameters to bind to it.
+func (tm *ObjectIndexer) InsertUpdateSql(w io.Writer, key, value interface{}) ([]interface{}, error) {
+	_, keyParams, err := tm.bindKeyParams(key)
+	if err != nil {
+		return nil, err
+	}
+
+	keyCols, err := tm.keyColumnNames()
+	if err != nil {
+		return nil, err
+	}
+
+	valueFields, valueParams, err := tm.bindValueParams(value)
+	if err != nil {
+		return nil, err
+	}
+
+	valueCols, err := tm.columnNames(valueFields)
+	if err != nil {
+		return nil, err
+	}
+
+	allCols := make([]string, 0, len(keyCols)+len(valueCols)+1)
+	allCols = append(allCols, keyCols...)
+	allCols = append(allCols, valueCols...)
+	placeholders := paramPlaceholders(1, len(allCols))
+
+	var setExprs []string
+	for _, col := range valueCols {
+		setExprs = append(setExprs, fmt.Sprintf("%s = EXCLUDED.%s", col, col))
+	}
+
+	// a re-inserted row which was previously deleted is no longer deleted
+	if tm.retainDeletions() {
+		allCols = append(allCols, "_deleted")
+		placeholders = append(placeholders, "FALSE")
+		setExprs = append(setExprs, "_deleted = FALSE")
+	}
+
+	_, err = fmt.Fprintf(w, "INSERT INTO %q (%s) VALUES (%s) ON CONFLICT (%s) ",
+		tm.TableName(),
+		strings.Join(allCols, ", "),
+		strings.Join(placeholders, ", "),
+		strings.Join(keyCols, ", "),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(setExprs) == 0 {
+		_, err = fmt.Fprintf(w, "DO NOTHING;")
+	} else {
+		_, err = fmt.Fprintf(w, "DO UPDATE SET %s;", strings.Join(setExprs, ", "))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return append(keyParams, valueParams...), nil
+}
+
+// Update updates the columns of an existing row with the provided key and value and
+// returns true if a row was updated.
+func (tm *ObjectIndexer) Update(ctx context.Context, conn DBConn, key, value interface{}) (bool, error) {
+	buf := new(strings.Builder)
+	params, err := tm.UpdateSql(buf, key, value)
+	if err != nil {
+		return false, err
+	}
+
+	sqlStr := buf.String()
+	if tm.options.Logger != nil {
+		tm.options.Logger("Update", sqlStr, params...)
+	}
+	res, err := conn.ExecContext(ctx, sqlStr, params...)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+// UpdateSql generates an UPDATE statement for the provided key and value and returns the parameters to bind to it.
+func (tm *ObjectIndexer) UpdateSql(w io.Writer, key, value interface{}) ([]interface{}, error) {
+	_, keyParams, err := tm.bindKeyParams(key)
+	if err != nil {
+		return nil, err
+	}
+
+	keyCols, err := tm.keyColumnNames()
+	if err != nil {
+		return nil, err
+	}
+
+	valueFields, valueParams, err := tm.bindValueParams(value)
+	if err != nil {
+		return nil, err
+	}
+
+	valueCols, err := tm.columnNames(valueFields)
+	if err != nil {
+		return nil, err
+	}
+
+	setExprs := make([]string, 0, len(valueCols)+1)
+	for i, col := range valueCols {
+		setExprs = append(setExprs, fmt.Sprintf("%s = $%d", col, i+1))
+	}
+	if tm.retainDeletions() {
+		setExprs = append(setExprs, "_deleted = FALSE")
+	}
+	if len(setExprs) == 0 {
+		// there is nothing to update but the row should still be matched
+		setExprs = append(setExprs, fmt.Sprintf("%s = %s", keyCols[0], keyCols[0]))
+	}
+
+	_, err = fmt.Fprintf(w, "UPDATE %q SET %s", tm.TableName(), strings.Join(setExprs, ", "))
+	if err != nil {
+		return nil, err
+	}
+
+	err = writeWhereKey(w, keyCols, len(valueParams)+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(valueParams, keyParams...), nil
+}
+
+// paramPlaceholders returns n parameter placeholders starting at $start.
+func paramPlaceholders(start, n int) []string {
+	placeholders := make([]string, n)
+	for i := range placeholders {
+		placeholders[i] = fmt.Sprintf("$%d", start+i)
+	}
+	return placeholders
+}
+
+// writeWhereKey writes a WHERE clause matching each of the key columns to a parameter starting at $start.
+func writeWhereKey(w io.Writer, keyCols []string, start int) error {
+	placeholders := paramPlaceholders(start, len(keyCols))
+	conds := make([]string, len(keyCols))
+	for i, col := range keyCols {
+		conds[i] = fmt.Sprintf("%s = %s", col, placeholders[i])
+	}
+
+	_, err := fmt.Fprintf(w, " WHERE %s;", strings.Join(conds, " AND "))
+	return err
+}
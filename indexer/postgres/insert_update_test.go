@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"fmt"
+	"os"
+
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+	"cosmossdk.io/schema"
+)
+
+func ExampleObjectIndexer_InsertUpdateSql_vote() {
+	tm := NewObjectIndexer("test", testdata.VoteObject, Options{})
+	params, err := tm.InsertUpdateSql(os.Stdout, []interface{}{int64(1), []byte{0x01}}, "yes")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	fmt.Println(params)
+	// Output:
+	// INSERT INTO "test_vote" ("proposal", "address", "vote", _deleted) VALUES ($1, $2, $3, FALSE) ON CONFLICT ("proposal", "address") DO UPDATE SET "vote" = EXCLUDED."vote", _deleted = FALSE;
+	// [1 01 yes]
+}
+
+func ExampleObjectIndexer_UpdateSql_singleton() {
+	tm := NewObjectIndexer("test", testdata.SingletonObject, Options{})
+	params, err := tm.UpdateSql(os.Stdout, nil, schema.MapValueUpdates{"bar": int32(3)})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	fmt.Println(params)
+	// Output:
+	// UPDATE "test_singleton" SET "bar" = $1 WHERE _id = $2;
+	// [3 1]
+}
+
+func ExampleObjectIndexer_DeleteSql_vote() {
+	tm := NewObjectIndexer("test", testdata.VoteObject, Options{})
+	params, err := tm.DeleteSql(os.Stdout, []interface{}{int64(1), []byte{0x01}})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	fmt.Println(params)
+	// Output:
+	// UPDATE "test_vote" SET _deleted = TRUE WHERE "proposal" = $1 AND "address" = $2;
+	// [1 01]
+}
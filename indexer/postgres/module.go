@@ -57,3 +57,28 @@ func (m *ModuleIndexer) InitializeSchema(ctx context.Context, conn DBConn) error
 func (m *ModuleIndexer) ObjectIndexers() map[string]*ObjectIndexer {
 	return m.tables
 }
+
+// RecordUndo records the current state of the object which is modified by the update in the provided block
+// so that it can be restored by a rollback.
+func (m *ModuleIndexer) RecordUndo(ctx context.Context, conn DBConn, blockNumber uint64, update schema.ObjectUpdate) error {
+	tm, ok := m.tables[update.TypeName]
+	if !ok {
+		return fmt.Errorf("object type %s not found in schema for module %s", update.TypeName, m.moduleName)
+	}
+
+	return tm.RecordUndo(ctx, conn, blockNumber, update.Key)
+}
+
+// ApplyUpdate applies an object update to the table of the object type it refers to.
+func (m *ModuleIndexer) ApplyUpdate(ctx context.Context, conn DBConn, update schema.ObjectUpdate) error {
+	tm, ok := m.tables[update.TypeName]
+	if !ok {
+		return fmt.Errorf("object type %s not found in schema for module %s", update.TypeName, m.moduleName)
+	}
+
+	if update.Delete {
+		return tm.Delete(ctx, conn, update.Key)
+	}
+
+	return tm.InsertUpdate(ctx, conn, update.Key, update.Value)
+}
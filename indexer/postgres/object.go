@@ -42,3 +42,9 @@ func NewObjectIndexer(moduleName string, typ schema.ObjectType, options Options)
 func (tm *ObjectIndexer) TableName() string {
 	return fmt.Sprintf("%s_%s", tm.moduleName, tm.typ.Name)
 }
+
+// retainDeletions returns true if deleted rows should be marked with the _deleted column
+// rather than being removed from the table.
+func (tm *ObjectIndexer) retainDeletions() bool {
+	return !tm.options.DisableRetainDeletions && tm.typ.RetainDeletions
+}
@@ -7,4 +7,12 @@ type Options struct {
 
 	// Logger is the logger for the indexer to use.
 	Logger SqlLogger
+
+	// AddressCodec is the codec used to convert addresses to strings. If it is nil, addresses are hex encoded.
+	AddressCodec AddressCodec
+}
+
+// AddressCodec converts address bytes to strings. It is implemented by cosmossdk.io/core/address.Codec.
+type AddressCodec interface {
+	BytesToString(bz []byte) (string, error)
 }
@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/schema"
+)
+
+// bindKeyParams binds the key to the key columns and returns the column names and parameter values.
+func (tm *ObjectIndexer) bindKeyParams(key interface{}) ([]schema.Field, []interface{}, error) {
+	n := len(tm.typ.KeyFields)
+	if n == 0 {
+		// singleton object
+		return nil, []interface{}{1}, nil
+	} else if n == 1 {
+		params, err := tm.bindParams(tm.typ.KeyFields, []interface{}{key})
+		return tm.typ.KeyFields, params, err
+	}
+
+	keys, ok := key.([]interface{})
+	if !ok {
+		return nil, nil, fmt.Errorf("expected key to be a slice")
+	}
+
+	params, err := tm.bindParams(tm.typ.KeyFields, keys)
+	return tm.typ.KeyFields, params, err
+}
+
+// bindValueParams binds the value to the value columns and returns the fields and parameter values.
+// If value is an instance of schema.ValueUpdates, only the updated fields are returned.
+func (tm *ObjectIndexer) bindValueParams(value interface{}) ([]schema.Field, []interface{}, error) {
+	if valueUpdates, ok := value.(schema.ValueUpdates); ok {
+		var fields []schema.Field
+		var values []interface{}
+		var err error
+		iterErr := valueUpdates.Iterate(func(name string, value interface{}) bool {
+			field, ok := tm.valueFields[name]
+			if !ok {
+				err = fmt.Errorf("unknown column %q in object type %s", name, tm.typ.Name)
+				return false
+			}
+			fields = append(fields, field)
+			values = append(values, value)
+			return true
+		})
+		if iterErr != nil {
+			return nil, nil, iterErr
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		params, err := tm.bindParams(fields, values)
+		return fields, params, err
+	}
+
+	n := len(tm.typ.ValueFields)
+	if n == 0 {
+		return nil, nil, nil
+	} else if n == 1 {
+		params, err := tm.bindParams(tm.typ.ValueFields, []interface{}{value})
+		return tm.typ.ValueFields, params, err
+	}
+
+	values, ok := value.([]interface{})
+	if !ok {
+		return nil, nil, fmt.Errorf("expected values to be a slice")
+	}
+
+	params, err := tm.bindParams(tm.typ.ValueFields, values)
+	return tm.typ.ValueFields, params, err
+}
+
+func (tm *ObjectIndexer) bindParams(fields []schema.Field, values []interface{}) ([]interface{}, error) {
+	if len(values) != len(fields) {
+		return nil, fmt.Errorf("expected %d values, got %d", len(fields), len(values))
+	}
+
+	params := make([]interface{}, 0, len(fields))
+	for i, field := range fields {
+		param, err := tm.bindParam(field, values[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to bind field %s: %v", field.Name, err) //nolint:errorlint // using %v for go 1.12 compat
+		}
+
+		params = append(params, param)
+	}
+
+	return params, nil
+}
+
+// bindParam converts a value for the field into a value that can be passed to the database driver.
+func (tm *ObjectIndexer) bindParam(field schema.Field, value interface{}) (interface{}, error) {
+	if value == nil {
+		if !field.Nullable {
+			return nil, fmt.Errorf("expected non-null value")
+		}
+		return nil, nil
+	}
+
+	switch field.Kind {
+	case schema.Uint64Kind:
+		x, ok := value.(uint64)
+		if !ok {
+			return nil, fmt.Errorf("expected uint64, got %T", value)
+		}
+		return strconv.FormatUint(x, 10), nil
+	case schema.TimeKind:
+		t, ok := value.(time.Time)
+		if !ok {
+			return nil, fmt.Errorf("expected time.Time, got %T", value)
+		}
+		return t.UnixNano(), nil
+	case schema.DurationKind:
+		d, ok := value.(time.Duration)
+		if !ok {
+			return nil, fmt.Errorf("expected time.Duration, got %T", value)
+		}
+		return int64(d), nil
+	case schema.JSONKind:
+		switch x := value.(type) {
+		case json.RawMessage:
+			return string(x), nil
+		case string:
+			return x, nil
+		default:
+			return nil, fmt.Errorf("expected json.RawMessage, got %T", value)
+		}
+	case schema.AddressKind:
+		bz, ok := value.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("expected []byte, got %T", value)
+		}
+		if tm.options.AddressCodec == nil {
+			return hex.EncodeToString(bz), nil
+		}
+		return tm.options.AddressCodec.BytesToString(bz)
+	default:
+		return value, nil
+	}
+}
@@ -0,0 +1,190 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// SelectOptions are the options for selecting the objects of an object type.
+type SelectOptions struct {
+	// KeyFilter filters objects by the values of their key fields. It maps key field names to values which
+	// must be valid for the kind of the field.
+	KeyFilter map[string]interface{}
+
+	// Limit is the maximum number of objects to return. If it is zero, all matching objects are returned.
+	Limit uint64
+
+	// Offset is the number of matching objects to skip, ordered by their key.
+	Offset uint64
+
+	// AsOfBlock selects the objects as they were when the provided block was committed if it is non-zero.
+	// Historical objects are reconstructed from undo records so this only works for blocks which are
+	// more recent than the undo retention window and when rollbacks are not disabled. Select returns a
+	// BlockOutOfRangeError for other blocks.
+	AsOfBlock uint64
+}
+
+// Select returns the JSON representations of the objects matching the options ordered by their key.
+func (tm *ObjectIndexer) Select(ctx context.Context, conn DBConn, opts SelectOptions) ([]json.RawMessage, error) {
+	if opts.AsOfBlock != 0 {
+		err := CheckUndoRetained(ctx, conn, opts.AsOfBlock)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	buf := new(strings.Builder)
+	params, err := tm.SelectSql(buf, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlStr := buf.String()
+	if tm.options.Logger != nil {
+		tm.options.Logger("Select", sqlStr, params...)
+	}
+	rows, err := conn.QueryContext(ctx, sqlStr, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []json.RawMessage
+	for rows.Next() {
+		var bz []byte
+		err = rows.Scan(&bz)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, json.RawMessage(bz))
+	}
+
+	return res, rows.Err()
+}
+
+// SelectSql generates a SELECT statement which returns the JSON representations of the objects matching the
+// options and returns the parameters to bind to it.
+func (tm *ObjectIndexer) SelectSql(w io.Writer, opts SelectOptions) ([]interface{}, error) {
+	var params []interface{}
+	bind := func(param interface{}, typ string) string {
+		params = append(params, param)
+		return fmt.Sprintf("$%d::%s", len(params), typ)
+	}
+
+	keyCols, err := tm.keyColumnNames()
+	if err != nil {
+		return nil, err
+	}
+
+	source := fmt.Sprintf("%q", tm.TableName())
+	if opts.AsOfBlock != 0 {
+		tableName := bind(tm.TableName(), "TEXT")
+		blockNumber := bind(int64(opts.AsOfBlock), "BIGINT")
+		source, err = tm.asOfBlockSource(tableName, blockNumber)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for name := range opts.KeyFilter {
+		if !tm.isKeyField(name) {
+			return nil, fmt.Errorf("%s is not a key field of object type %s", name, tm.typ.Name)
+		}
+	}
+
+	var conds []string
+	for _, field := range tm.typ.KeyFields {
+		value, ok := opts.KeyFilter[field.Name]
+		if !ok {
+			continue
+		}
+
+		param, err := tm.bindParam(field, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter for key field %s: %v", field.Name, err) //nolint:errorlint // using %v for go 1.12 compat
+		}
+
+		conds = append(conds, fmt.Sprintf("r.%q = %s", rawColumnName(field), bind(param, tm.columnType(field))))
+	}
+	if tm.retainDeletions() {
+		conds = append(conds, "NOT r._deleted")
+	}
+
+	_, err = fmt.Fprintf(w, "SELECT to_jsonb(r.*) FROM %s r", source)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(conds) != 0 {
+		_, err = fmt.Fprintf(w, " WHERE %s", strings.Join(conds, " AND "))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	orderBy := make([]string, len(keyCols))
+	for i, col := range keyCols {
+		orderBy[i] = fmt.Sprintf("r.%s", col)
+	}
+	_, err = fmt.Fprintf(w, " ORDER BY %s", strings.Join(orderBy, ", "))
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Limit != 0 {
+		_, err = fmt.Fprintf(w, " LIMIT %d", opts.Limit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.Offset != 0 {
+		_, err = fmt.Fprintf(w, " OFFSET %d", opts.Offset)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = fmt.Fprintf(w, ";")
+	return params, err
+}
+
+// asOfBlockSource returns a subquery which reconstructs the rows of the table as they were when the block
+// was committed. Rows which haven't been modified since that block are read from the table and the others are
+// read from the first undo record which was recorded after the block.
+func (tm *ObjectIndexer) asOfBlockSource(tableName, blockNumber string) (string, error) {
+	keyCols, err := tm.keyColumnNames()
+	if err != nil {
+		return "", err
+	}
+
+	keyObjectArgs := make([]string, 0, 2*len(keyCols))
+	if len(tm.typ.KeyFields) == 0 {
+		keyObjectArgs = append(keyObjectArgs, "'_id'", "t._id")
+	} else {
+		for _, field := range tm.typ.KeyFields {
+			keyObjectArgs = append(keyObjectArgs, fmt.Sprintf("'%s'", rawColumnName(field)), fmt.Sprintf("t.%q", rawColumnName(field)))
+		}
+	}
+
+	undoRecords := fmt.Sprintf("undo_record u WHERE u.table_name = %s AND u.block_number > %s", tableName, blockNumber)
+	return fmt.Sprintf(
+		"(SELECT t.* FROM %q t WHERE NOT EXISTS (SELECT 1 FROM %s AND u.key = jsonb_build_object(%s)) "+
+			"UNION ALL SELECT p.* FROM (SELECT DISTINCT ON (u.key) u.prev_value FROM %s ORDER BY u.key, u.id) f, "+
+			"jsonb_populate_record(NULL::%q, f.prev_value) p WHERE f.prev_value IS NOT NULL)",
+		tm.TableName(), undoRecords, strings.Join(keyObjectArgs, ", "), undoRecords, tm.TableName(),
+	), nil
+}
+
+// isKeyField returns true if the object type has a key field with the provided name.
+func (tm *ObjectIndexer) isKeyField(name string) bool {
+	for _, field := range tm.typ.KeyFields {
+		if field.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"fmt"
+	"os"
+
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+)
+
+func ExampleObjectIndexer_SelectSql_vote() {
+	tm := NewObjectIndexer("test", testdata.VoteObject, Options{})
+	params, err := tm.SelectSql(os.Stdout, SelectOptions{
+		KeyFilter: map[string]interface{}{"proposal": int64(1)},
+		Limit:     10,
+		Offset:    20,
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	fmt.Println(params)
+	// Output:
+	// SELECT to_jsonb(r.*) FROM "test_vote" r WHERE r."proposal" = $1::BIGINT AND NOT r._deleted ORDER BY r."proposal", r."address" LIMIT 10 OFFSET 20;
+	// [1]
+}
+
+func ExampleObjectIndexer_SelectSql_asOfBlock() {
+	tm := NewObjectIndexer("test", testdata.VoteObject, Options{})
+	params, err := tm.SelectSql(os.Stdout, SelectOptions{
+		KeyFilter: map[string]interface{}{"proposal": int64(1)},
+		AsOfBlock: 5,
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	fmt.Println(params)
+	// Output:
+	// SELECT to_jsonb(r.*) FROM (SELECT t.* FROM "test_vote" t WHERE NOT EXISTS (SELECT 1 FROM undo_record u WHERE u.table_name = $1::TEXT AND u.block_number > $2::BIGINT AND u.key = jsonb_build_object('proposal', t."proposal", 'address', t."address")) UNION ALL SELECT p.* FROM (SELECT DISTINCT ON (u.key) u.prev_value FROM undo_record u WHERE u.table_name = $1::TEXT AND u.block_number > $2::BIGINT ORDER BY u.key, u.id) f, jsonb_populate_record(NULL::"test_vote", f.prev_value) p WHERE f.prev_value IS NOT NULL) r WHERE r."proposal" = $3::BIGINT AND NOT r._deleted ORDER BY r."proposal", r."address";
+	// [test_vote 5 1]
+}
+
+func ExampleObjectIndexer_SelectSql_singleton() {
+	tm := NewObjectIndexer("test", testdata.SingletonObject, Options{})
+	params, err := tm.SelectSql(os.Stdout, SelectOptions{AsOfBlock: 5})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	fmt.Println(params)
+	// Output:
+	// SELECT to_jsonb(r.*) FROM (SELECT t.* FROM "test_singleton" t WHERE NOT EXISTS (SELECT 1 FROM undo_record u WHERE u.table_name = $1::TEXT AND u.block_number > $2::BIGINT AND u.key = jsonb_build_object('_id', t._id)) UNION ALL SELECT p.* FROM (SELECT DISTINCT ON (u.key) u.prev_value FROM undo_record u WHERE u.table_name = $1::TEXT AND u.block_number > $2::BIGINT ORDER BY u.key, u.id) f, jsonb_populate_record(NULL::"test_singleton", f.prev_value) p WHERE f.prev_value IS NOT NULL) r ORDER BY r._id;
+	// [test_singleton 5]
+}
@@ -45,11 +45,12 @@ func testInitSchema(t *testing.T, disableRetainDeletions bool, goldenFileName st
 		_, err = fmt.Fprintln(buf)
 		require.NoError(t, err)
 	}
-	listener, err := postgres.StartIndexer(context.Background(), logger, postgres.Config{
+	res, err := postgres.StartIndexer(context.Background(), logger, postgres.Config{
 		DatabaseURL:            connectionUrl,
 		DisableRetainDeletions: disableRetainDeletions,
 	})
 	require.NoError(t, err)
+	listener := res.Listener
 
 	require.NotNil(t, listener.InitializeModuleData)
 	require.NoError(t, listener.InitializeModuleData(appdata.ModuleInitializationData{
@@ -0,0 +1,127 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/indexer/postgres"
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/decoding"
+	"cosmossdk.io/schema/indexer"
+)
+
+// voteModule stores votes with the proposal as the single byte key and the vote option as the value.
+type voteModule struct{}
+
+func (voteModule) ModuleCodec() (schema.ModuleCodec, error) {
+	return schema.ModuleCodec{
+		Schema: testdata.ExampleSchema,
+		KVDecoder: func(update schema.KVPairUpdate) ([]schema.ObjectUpdate, error) {
+			return []schema.ObjectUpdate{{
+				TypeName: testdata.VoteObject.Name,
+				Key:      []interface{}{int64(update.Key[0]), []byte{0x01}},
+				Value:    string(update.Value),
+				Delete:   update.Delete,
+			}}, nil
+		},
+	}, nil
+}
+
+type voteSyncSource map[byte]string
+
+func (s voteSyncSource) IterateAllKVPairs(moduleName string, fn func(key, value []byte) error) error {
+	for proposal, option := range s {
+		if err := fn([]byte{proposal}, []byte(option)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestManagerRollbackAndCatchUp(t *testing.T) {
+	connectionUrl := createTestDB(t)
+
+	startManager := func(lastCommittedHeight int64, syncSource decoding.SyncSource) appdata.Listener {
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+
+		listener, err := indexer.StartManager(indexer.ManagerOptions{
+			Config: map[string]interface{}{
+				"target": map[string]interface{}{
+					"postgres": map[string]interface{}{
+						"type":   postgres.IndexerType,
+						"config": map[string]interface{}{"database_url": connectionUrl},
+					},
+				},
+			},
+			Resolver:            decoding.ModuleSetDecoderResolver(map[string]interface{}{"test": voteModule{}}),
+			SyncSource:          syncSource,
+			Context:             ctx,
+			LastCommittedHeight: lastCommittedHeight,
+		})
+		require.NoError(t, err)
+		return listener
+	}
+
+	vote := func(proposal byte, option string) appdata.ModuleKVPairUpdate {
+		return appdata.ModuleKVPairUpdate{
+			ModuleName: "test",
+			Update:     schema.KVPairUpdate{Key: []byte{proposal}, Value: []byte(option), Delete: option == ""},
+		}
+	}
+
+	// block 1 inserts two votes, block 2 updates the first one, deletes the second one and inserts a third one
+	listener := startManager(0, nil)
+	for height, updates := range [][]appdata.ModuleKVPairUpdate{
+		{vote(1, "yes"), vote(2, "no")},
+		{vote(1, "abstain"), vote(2, ""), vote(3, "yes")},
+	} {
+		require.NoError(t, listener.StartBlock(appdata.StartBlockData{Height: uint64(height + 1)}))
+		require.NoError(t, listener.OnKVPair(appdata.KVPairData{Updates: updates}))
+		require.NoError(t, listener.Commit(appdata.CommitData{}))
+	}
+
+	db, err := sql.Open("pgx", connectionUrl)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	selectVotes := func() []string {
+		rows, err := db.Query(`SELECT "vote" FROM "test_vote" WHERE NOT _deleted ORDER BY "proposal"`)
+		require.NoError(t, err)
+		var votes []string
+		for rows.Next() {
+			var option string
+			require.NoError(t, rows.Scan(&option))
+			votes = append(votes, option)
+		}
+		require.NoError(t, rows.Err())
+		require.NoError(t, rows.Close())
+		return votes
+	}
+
+	lastBlock, err := postgres.LastBlockPersisted(context.Background(), db)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), lastBlock)
+	require.Equal(t, []string{"abstain", "yes"}, selectVotes())
+
+	// the app was rolled back to block 1, so the manager rolls the indexer back when it starts
+	startManager(1, nil)
+	lastBlock, err = postgres.LastBlockPersisted(context.Background(), db)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), lastBlock)
+	require.Equal(t, []string{"yes", "no"}, selectVotes())
+
+	// the app has committed block 3 which the indexer missed, so the manager catches it up from the sync source
+	startManager(3, voteSyncSource{1: "no", 2: "no", 3: "abstain"})
+	lastBlock, err = postgres.LastBlockPersisted(context.Background(), db)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), lastBlock)
+	require.Equal(t, []string{"no", "no", "abstain"}, selectVotes())
+}
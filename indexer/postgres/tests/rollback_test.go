@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/indexer/postgres"
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+)
+
+func TestRollback(t *testing.T) {
+	connectionUrl := createTestDB(t)
+
+	res, err := postgres.StartIndexer(context.Background(), nil, postgres.Config{
+		DatabaseURL: connectionUrl,
+	})
+	require.NoError(t, err)
+	listener := res.Listener
+	require.NotNil(t, listener.Rollback)
+
+	require.NoError(t, listener.InitializeModuleData(appdata.ModuleInitializationData{
+		ModuleName: "test",
+		Schema:     testdata.ExampleSchema,
+	}))
+
+	vote := func(proposal int64, option string) schema.ObjectUpdate {
+		return schema.ObjectUpdate{
+			TypeName: testdata.VoteObject.Name,
+			Key:      []interface{}{proposal, []byte{0x01}},
+			Value:    option,
+		}
+	}
+
+	// block 1 inserts two votes
+	require.NoError(t, listener.StartBlock(appdata.StartBlockData{Height: 1}))
+	require.NoError(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{
+		ModuleName: "test",
+		Updates:    []schema.ObjectUpdate{vote(1, "yes"), vote(2, "no")},
+	}))
+	require.NoError(t, listener.Commit(appdata.CommitData{}))
+
+	// block 2 updates the first vote, deletes the second one and inserts a third one
+	require.NoError(t, listener.StartBlock(appdata.StartBlockData{Height: 2}))
+	deleteVote := vote(2, "")
+	deleteVote.Delete = true
+	require.NoError(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{
+		ModuleName: "test",
+		Updates:    []schema.ObjectUpdate{vote(1, "abstain"), deleteVote, vote(3, "yes")},
+	}))
+	require.NoError(t, listener.Commit(appdata.CommitData{}))
+
+	require.NoError(t, listener.Rollback(appdata.RollbackData{Height: 1}))
+
+	db, err := sql.Open("pgx", connectionUrl)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	rows, err := db.Query(`SELECT "proposal", "vote", _deleted FROM "test_vote" ORDER BY "proposal"`)
+	require.NoError(t, err)
+	type voteRow struct {
+		proposal int64
+		option   string
+		deleted  bool
+	}
+	var votes []voteRow
+	for rows.Next() {
+		var row voteRow
+		require.NoError(t, rows.Scan(&row.proposal, &row.option, &row.deleted))
+		votes = append(votes, row)
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	require.Equal(t, []voteRow{
+		{proposal: 1, option: "yes"},
+		{proposal: 2, option: "no"},
+	}, votes)
+
+	var lastBlock int64
+	require.NoError(t, db.QueryRow(`SELECT MAX(number) FROM block`).Scan(&lastBlock))
+	require.Equal(t, int64(1), lastBlock)
+}
+
+func TestSelectAsOfBlockOutOfRange(t *testing.T) {
+	connectionUrl := createTestDB(t)
+
+	res, err := postgres.StartIndexer(context.Background(), nil, postgres.Config{
+		DatabaseURL:         connectionUrl,
+		UndoRetentionBlocks: 2,
+	})
+	require.NoError(t, err)
+	listener := res.Listener
+
+	require.NoError(t, listener.InitializeModuleData(appdata.ModuleInitializationData{
+		ModuleName: "test",
+		Schema:     testdata.ExampleSchema,
+	}))
+
+	for height := uint64(1); height <= 5; height++ {
+		require.NoError(t, listener.StartBlock(appdata.StartBlockData{Height: height}))
+		require.NoError(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{
+			ModuleName: "test",
+			Updates: []schema.ObjectUpdate{{
+				TypeName: testdata.VoteObject.Name,
+				Key:      []interface{}{int64(height), []byte{0x01}},
+				Value:    "yes",
+			}},
+		}))
+		require.NoError(t, listener.Commit(appdata.CommitData{}))
+	}
+
+	db, err := sql.Open("pgx", connectionUrl)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	tm := postgres.NewObjectIndexer("test", testdata.VoteObject, postgres.Options{})
+
+	// the undo records of blocks 1 to 3 are pruned, so the votes can only be selected as of blocks 3 to 5
+	votes, err := tm.Select(context.Background(), db, postgres.SelectOptions{AsOfBlock: 3})
+	require.NoError(t, err)
+	require.Len(t, votes, 3)
+
+	for _, height := range []uint64{2, 6} {
+		_, err = tm.Select(context.Background(), db, postgres.SelectOptions{AsOfBlock: height})
+		require.Equal(t, postgres.BlockOutOfRangeError{Height: height, Oldest: 3, Latest: 5}, err)
+	}
+
+	// the indexer can't be rolled back past the retained undo records either
+	require.Error(t, listener.Rollback(appdata.RollbackData{Height: 2}))
+}
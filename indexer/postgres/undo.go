@@ -0,0 +1,302 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"cosmossdk.io/schema"
+)
+
+// RecordUndo records the current state of the row with the provided key in the undo_record table
+// before it is modified in the provided block so that it can be restored by a rollback.
+func (tm *ObjectIndexer) RecordUndo(ctx context.Context, conn DBConn, blockNumber uint64, key interface{}) error {
+	buf := new(strings.Builder)
+	params, err := tm.RecordUndoSql(buf, blockNumber, key)
+	if err != nil {
+		return err
+	}
+
+	sqlStr := buf.String()
+	if tm.options.Logger != nil {
+		tm.options.Logger("Record undo", sqlStr, params...)
+	}
+	_, err = conn.ExecContext(ctx, sqlStr, params...)
+	return err
+}
+
+// RecordUndoSql generates an INSERT statement which stores the key and the current value of the row
+// with the provided key (or NULL if there is no such row) in the undo_record table.
+func (tm *ObjectIndexer) RecordUndoSql(w io.Writer, blockNumber uint64, key interface{}) ([]interface{}, error) {
+	keyFields, keyParams, err := tm.bindKeyParams(key)
+	if err != nil {
+		return nil, err
+	}
+
+	keyCols, err := tm.keyColumnNames()
+	if err != nil {
+		return nil, err
+	}
+
+	// the key object is built with the raw column names so that it can be converted back into
+	// a row of the table with jsonb_populate_record
+	placeholders := paramPlaceholders(3, len(keyCols))
+	keyObjectArgs := make([]string, 0, 2*len(keyCols))
+	if len(keyFields) == 0 {
+		keyObjectArgs = append(keyObjectArgs, "'_id'", fmt.Sprintf("%s::INTEGER", placeholders[0]))
+	} else {
+		for i, field := range keyFields {
+			keyObjectArgs = append(keyObjectArgs,
+				fmt.Sprintf("'%s'", rawColumnName(field)),
+				fmt.Sprintf("%s::%s", placeholders[i], tm.columnType(field)),
+			)
+		}
+	}
+
+	conds := make([]string, len(keyCols))
+	for i, col := range keyCols {
+		conds[i] = fmt.Sprintf("%s = %s", col, placeholders[i])
+	}
+
+	_, err = fmt.Fprintf(w,
+		"INSERT INTO undo_record (block_number, table_name, key, prev_value) SELECT $1::BIGINT, $2::TEXT, jsonb_build_object(%s), (SELECT to_jsonb(r.*) FROM %q r WHERE %s);",
+		strings.Join(keyObjectArgs, ", "),
+		tm.TableName(),
+		strings.Join(conds, " AND "),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	params := make([]interface{}, 0, len(keyParams)+2)
+	params = append(params, int64(blockNumber), tm.TableName())
+	params = append(params, keyParams...)
+	return params, nil
+}
+
+// RestoreSql generates a statement which reverts a row to the state stored in an undo record. The statement
+// takes the JSON value of the row as its only parameter. If the row existed before it was modified, the
+// statement takes the previous value of the row and writes it back, otherwise it takes the key of the
+// row and deletes it.
+func (tm *ObjectIndexer) RestoreSql(w io.Writer, existed bool) error {
+	keyCols, err := tm.keyColumnNames()
+	if err != nil {
+		return err
+	}
+	keyColsStr := strings.Join(keyCols, ", ")
+
+	if !existed {
+		_, err = fmt.Fprintf(w, "DELETE FROM %q WHERE (%s) IN (SELECT %s FROM jsonb_populate_record(NULL::%q, $1));",
+			tm.TableName(), keyColsStr, keyColsStr, tm.TableName())
+		return err
+	}
+
+	valueCols, err := tm.columnNames(tm.typ.ValueFields)
+	if err != nil {
+		return err
+	}
+	if tm.retainDeletions() {
+		valueCols = append(valueCols, "_deleted")
+	}
+
+	allCols := strings.Join(append(keyCols, valueCols...), ", ")
+	_, err = fmt.Fprintf(w, "INSERT INTO %q (%s) SELECT %s FROM jsonb_populate_record(NULL::%q, $1) ON CONFLICT (%s) ",
+		tm.TableName(), allCols, allCols, tm.TableName(), keyColsStr)
+	if err != nil {
+		return err
+	}
+
+	if len(valueCols) == 0 {
+		_, err = fmt.Fprintf(w, "DO NOTHING;")
+		return err
+	}
+
+	setExprs := make([]string, len(valueCols))
+	for i, col := range valueCols {
+		setExprs[i] = fmt.Sprintf("%s = EXCLUDED.%s", col, col)
+	}
+	_, err = fmt.Fprintf(w, "DO UPDATE SET %s;", strings.Join(setExprs, ", "))
+	return err
+}
+
+// rollbackStatements delete all data for blocks after the rollback height once the undo records have been applied.
+var rollbackStatements = []string{
+	"DELETE FROM undo_record WHERE block_number > $1;",
+	"DELETE FROM event WHERE block_number > $1;",
+	"DELETE FROM tx WHERE block_number > $1;",
+	"DELETE FROM block WHERE number > $1;",
+	"UPDATE undo_state SET last_block = LEAST(last_block, $1);",
+}
+
+// Rollback reverts all the object updates recorded in the undo_record table for blocks after the provided
+// height in reverse order and then deletes all blocks, transactions, events and undo records after that
+// height. tables maps table names to their object indexers and must contain every table referenced by the
+// undo records which are reverted.
+func Rollback(ctx context.Context, conn DBConn, tables map[string]*ObjectIndexer, height uint64, logger SqlLogger) error {
+	err := CheckUndoRetained(ctx, conn, height)
+	if err != nil {
+		return err
+	}
+
+	type undoRecord struct {
+		tableName string
+		key       []byte
+		prevValue []byte
+	}
+
+	rows, err := conn.QueryContext(ctx,
+		"SELECT table_name, key, prev_value FROM undo_record WHERE block_number > $1 ORDER BY id DESC;", int64(height))
+	if err != nil {
+		return err
+	}
+
+	// undo records are read completely before they are applied because the connection
+	// can't be used for other statements while rows are being read
+	var records []undoRecord
+	for rows.Next() {
+		var record undoRecord
+		err = rows.Scan(&record.tableName, &record.key, &record.prevValue)
+		if err != nil {
+			_ = rows.Close()
+			return err
+		}
+		records = append(records, record)
+	}
+	err = rows.Err()
+	if err != nil {
+		return err
+	}
+	err = rows.Close()
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		tm, ok := tables[record.tableName]
+		if !ok {
+			return fmt.Errorf("can't roll back table %s which has not been initialized", record.tableName)
+		}
+
+		existed := record.prevValue != nil
+		value := record.key
+		if existed {
+			value = record.prevValue
+		}
+
+		buf := new(strings.Builder)
+		err = tm.RestoreSql(buf, existed)
+		if err != nil {
+			return err
+		}
+
+		sqlStr := buf.String()
+		if logger != nil {
+			logger("Restore", sqlStr, string(value))
+		}
+		_, err = conn.ExecContext(ctx, sqlStr, string(value))
+		if err != nil {
+			return fmt.Errorf("failed to restore row in table %s: %v", record.tableName, err) //nolint:errorlint // using %v for go 1.12 compat
+		}
+	}
+
+	for _, stmt := range rollbackStatements {
+		if logger != nil {
+			logger("Rollback", stmt, height)
+		}
+		_, err = conn.ExecContext(ctx, stmt, int64(height))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PruneUndoRecords deletes the undo records for blocks up to and including the provided height,
+// after which the indexer can no longer be rolled back to a height before it.
+func PruneUndoRecords(ctx context.Context, conn DBConn, height uint64) error {
+	_, err := conn.ExecContext(ctx, "DELETE FROM undo_record WHERE block_number <= $1;", int64(height))
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.ExecContext(ctx, "UPDATE undo_state SET retained_from = GREATEST(retained_from, $1);", int64(height)+1)
+	return err
+}
+
+// InitUndoState records that undo records are retained from the block after the last block persisted in
+// the database, unless it was recorded before.
+func InitUndoState(ctx context.Context, conn DBConn) error {
+	_, err := conn.ExecContext(ctx,
+		"INSERT INTO undo_state (id, retained_from, last_block) SELECT 1, COALESCE(MAX(number), 0) + 1, COALESCE(MAX(number), 0) FROM block ON CONFLICT (id) DO NOTHING;")
+	return err
+}
+
+// SetLastBlockPersisted records the provided height as the last block persisted in the database. It is
+// called in the database transaction which commits the block.
+func SetLastBlockPersisted(ctx context.Context, conn DBConn, height uint64) error {
+	_, err := conn.ExecContext(ctx, "UPDATE undo_state SET last_block = $1;", int64(height))
+	return err
+}
+
+// LastBlockPersisted returns the last block persisted in the database as recorded in the undo_state table,
+// or 0 if no block has been persisted.
+func LastBlockPersisted(ctx context.Context, conn DBConn) (int64, error) {
+	var lastBlock int64
+	err := conn.QueryRowContext(ctx, "SELECT COALESCE((SELECT last_block FROM undo_state WHERE id = 1), 0);").Scan(&lastBlock)
+	return lastBlock, err
+}
+
+// DiscardUndoState records that no undo records are retained up to and including the provided height,
+// which is used when the indexer doesn't record undo records.
+func DiscardUndoState(ctx context.Context, conn DBConn, height uint64) error {
+	_, err := conn.ExecContext(ctx, "UPDATE undo_state SET retained_from = $1;", int64(height)+1)
+	return err
+}
+
+// BlockOutOfRangeError is returned when the indexed data can't be reverted to a block, either because
+// the block hasn't been indexed yet or because the undo records for the blocks after it are not retained.
+type BlockOutOfRangeError struct {
+	// Height is the requested block.
+	Height uint64
+
+	// Oldest is the oldest block the indexed data can be reverted to.
+	Oldest uint64
+
+	// Latest is the latest indexed block.
+	Latest uint64
+}
+
+func (e BlockOutOfRangeError) Error() string {
+	return fmt.Sprintf("block %d is out of the range of retained blocks [%d, %d]", e.Height, e.Oldest, e.Latest)
+}
+
+// CheckUndoRetained returns a BlockOutOfRangeError if the indexed data can't be reverted to the provided
+// height with the retained undo records.
+func CheckUndoRetained(ctx context.Context, conn DBConn, height uint64) error {
+	var latest, retainedFrom int64
+	err := conn.QueryRowContext(ctx,
+		"SELECT COALESCE((SELECT MAX(number) FROM block), 0), "+
+			"COALESCE((SELECT retained_from FROM undo_state WHERE id = 1), (SELECT COALESCE(MAX(number), 0) + 1 FROM block));",
+	).Scan(&latest, &retainedFrom)
+	if err != nil {
+		return err
+	}
+
+	// the undo records of all the blocks after the requested one are needed to revert to it
+	oldest := uint64(retainedFrom) - 1
+	if height > uint64(latest) || height < oldest {
+		return BlockOutOfRangeError{Height: height, Oldest: oldest, Latest: uint64(latest)}
+	}
+
+	return nil
+}
+
+// rawColumnName returns the unquoted name of the updatable column for the field.
+func rawColumnName(field schema.Field) string {
+	if field.Kind == schema.TimeKind {
+		return fmt.Sprintf("%s_nanos", field.Name)
+	}
+	return field.Name
+}
@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"fmt"
+	"os"
+
+	"cosmossdk.io/indexer/postgres/internal/testdata"
+)
+
+func ExampleObjectIndexer_RecordUndoSql_vote() {
+	tm := NewObjectIndexer("test", testdata.VoteObject, Options{})
+	params, err := tm.RecordUndoSql(os.Stdout, 5, []interface{}{int64(1), []byte{0x01}})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	fmt.Println(params)
+	// Output:
+	// INSERT INTO undo_record (block_number, table_name, key, prev_value) SELECT $1::BIGINT, $2::TEXT, jsonb_build_object('proposal', $3::BIGINT, 'address', $4::TEXT), (SELECT to_jsonb(r.*) FROM "test_vote" r WHERE "proposal" = $3 AND "address" = $4);
+	// [5 test_vote 1 01]
+}
+
+func ExampleObjectIndexer_RecordUndoSql_singleton() {
+	tm := NewObjectIndexer("test", testdata.SingletonObject, Options{})
+	params, err := tm.RecordUndoSql(os.Stdout, 5, nil)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	fmt.Println(params)
+	// Output:
+	// INSERT INTO undo_record (block_number, table_name, key, prev_value) SELECT $1::BIGINT, $2::TEXT, jsonb_build_object('_id', $3::INTEGER), (SELECT to_jsonb(r.*) FROM "test_singleton" r WHERE _id = $3);
+	// [5 test_singleton 1]
+}
+
+func ExampleObjectIndexer_RestoreSql_existed() {
+	tm := NewObjectIndexer("test", testdata.AllKindsObject, Options{})
+	err := tm.RestoreSql(os.Stdout, true)
+	if err != nil {
+		panic(err)
+	}
+	// Output:
+	// INSERT INTO "test_all_kinds" ("id", "ts_nanos", "string", "bytes", "int8", "uint8", "int16", "uint16", "int32", "uint32", "int64", "uint64", "integer", "decimal", "bool", "time_nanos", "duration", "float32", "float64", "address", "enum", "json") SELECT "id", "ts_nanos", "string", "bytes", "int8", "uint8", "int16", "uint16", "int32", "uint32", "int64", "uint64", "integer", "decimal", "bool", "time_nanos", "duration", "float32", "float64", "address", "enum", "json" FROM jsonb_populate_record(NULL::"test_all_kinds", $1) ON CONFLICT ("id", "ts_nanos") DO UPDATE SET "string" = EXCLUDED."string", "bytes" = EXCLUDED."bytes", "int8" = EXCLUDED."int8", "uint8" = EXCLUDED."uint8", "int16" = EXCLUDED."int16", "uint16" = EXCLUDED."uint16", "int32" = EXCLUDED."int32", "uint32" = EXCLUDED."uint32", "int64" = EXCLUDED."int64", "uint64" = EXCLUDED."uint64", "integer" = EXCLUDED."integer", "decimal" = EXCLUDED."decimal", "bool" = EXCLUDED."bool", "time_nanos" = EXCLUDED."time_nanos", "duration" = EXCLUDED."duration", "float32" = EXCLUDED."float32", "float64" = EXCLUDED."float64", "address" = EXCLUDED."address", "enum" = EXCLUDED."enum", "json" = EXCLUDED."json";
+}
+
+func ExampleObjectIndexer_RestoreSql_vote() {
+	tm := NewObjectIndexer("test", testdata.VoteObject, Options{})
+	err := tm.RestoreSql(os.Stdout, true)
+	if err != nil {
+		panic(err)
+	}
+	// Output:
+	// INSERT INTO "test_vote" ("proposal", "address", "vote", _deleted) SELECT "proposal", "address", "vote", _deleted FROM jsonb_populate_record(NULL::"test_vote", $1) ON CONFLICT ("proposal", "address") DO UPDATE SET "vote" = EXCLUDED."vote", _deleted = EXCLUDED._deleted;
+}
+
+func ExampleObjectIndexer_RestoreSql_notExisted() {
+	tm := NewObjectIndexer("test", testdata.VoteObject, Options{})
+	err := tm.RestoreSql(os.Stdout, false)
+	if err != nil {
+		panic(err)
+	}
+	// Output:
+	// DELETE FROM "test_vote" WHERE ("proposal", "address") IN (SELECT "proposal", "address" FROM jsonb_populate_record(NULL::"test_vote", $1));
+}
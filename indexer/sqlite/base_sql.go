@@ -0,0 +1,29 @@
+package sqlite
+
+// BaseSQL is the base SQL that is always included in the schema.
+const BaseSQL = `
+CREATE TABLE IF NOT EXISTS block
+(
+    number INTEGER NOT NULL PRIMARY KEY,
+    header TEXT    NULL
+);
+
+CREATE TABLE IF NOT EXISTS tx
+(
+    id             INTEGER PRIMARY KEY AUTOINCREMENT,
+    block_number   INTEGER NOT NULL REFERENCES block (number),
+    index_in_block INTEGER NOT NULL,
+    data           TEXT    NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS event
+(
+    id           INTEGER PRIMARY KEY AUTOINCREMENT,
+    block_number INTEGER NOT NULL REFERENCES block (number),
+    tx_index     INTEGER NULL,
+    msg_index    INTEGER NULL,
+    event_index  INTEGER NULL,
+    type         TEXT    NOT NULL,
+    data         TEXT    NOT NULL
+);
+`
@@ -0,0 +1,106 @@
+package sqlite
+
+import (
+	"fmt"
+	"io"
+
+	"cosmossdk.io/schema"
+)
+
+// createColumnDefinition writes a column definition within a CREATE TABLE statement for the field.
+func (tm *ObjectIndexer) createColumnDefinition(writer io.Writer, field schema.Field) error {
+	typ := columnType(field.Kind)
+	if typ == "" {
+		return fmt.Errorf("unexpected kind: %v", field.Kind)
+	}
+
+	_, err := fmt.Fprintf(writer, "%q %s", field.Name, typ)
+	if err != nil {
+		return err
+	}
+
+	if !field.Nullable {
+		_, err = fmt.Fprintf(writer, " NOT NULL")
+		if err != nil {
+			return err
+		}
+	}
+
+	// SQLite has neither enum nor boolean types so we restrict the allowed values
+	// of these columns with CHECK constraints instead
+	switch field.Kind {
+	case schema.EnumKind:
+		_, err = fmt.Fprintf(writer, " CHECK (%q IN (", field.Name)
+		if err != nil {
+			return err
+		}
+
+		err = writeEnumValues(writer, field.EnumType)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(writer, "))")
+		if err != nil {
+			return err
+		}
+	case schema.BoolKind:
+		_, err = fmt.Fprintf(writer, " CHECK (%q IN (0, 1))", field.Name)
+		if err != nil {
+			return err
+		}
+	default:
+	}
+
+	_, err = fmt.Fprintf(writer, ",\n\t")
+	return err
+}
+
+// columnType returns the SQLite column type for the kind.
+func columnType(kind schema.Kind) string {
+	//nolint:goconst // adding constants for these sqlite type names would impede readability
+	switch kind {
+	case schema.StringKind:
+		return "TEXT"
+	case schema.BoolKind:
+		return "INTEGER"
+	case schema.BytesKind:
+		return "BLOB"
+	case schema.Int8Kind:
+		return "INTEGER"
+	case schema.Int16Kind:
+		return "INTEGER"
+	case schema.Int32Kind:
+		return "INTEGER"
+	case schema.Int64Kind:
+		return "INTEGER"
+	case schema.Uint8Kind:
+		return "INTEGER"
+	case schema.Uint16Kind:
+		return "INTEGER"
+	case schema.Uint32Kind:
+		return "INTEGER"
+	case schema.Uint64Kind:
+		return "TEXT"
+	case schema.IntegerStringKind:
+		return "TEXT"
+	case schema.DecimalStringKind:
+		return "TEXT"
+	case schema.Float32Kind:
+		return "REAL"
+	case schema.Float64Kind:
+		return "REAL"
+	case schema.JSONKind:
+		return "TEXT"
+	case schema.TimeKind:
+		return "INTEGER"
+	case schema.DurationKind:
+		return "INTEGER"
+	case schema.AddressKind:
+		return "BLOB"
+	case schema.EnumKind:
+		return "TEXT"
+	default:
+		return ""
+	}
+}
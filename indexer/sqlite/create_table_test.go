@@ -0,0 +1,88 @@
+package sqlite
+
+import (
+	"os"
+
+	"cosmossdk.io/indexer/sqlite/internal/testdata"
+	"cosmossdk.io/schema"
+)
+
+func ExampleObjectIndexer_CreateTableSql_allKinds() {
+	exampleCreateTable(testdata.AllKindsObject)
+	// Output:
+	// CREATE TABLE IF NOT EXISTS "test_all_kinds" (
+	// 	"id" INTEGER NOT NULL,
+	// 	"ts" INTEGER NOT NULL,
+	// 	"string" TEXT NOT NULL,
+	// 	"bytes" BLOB NOT NULL,
+	// 	"int8" INTEGER NOT NULL,
+	// 	"uint8" INTEGER NOT NULL,
+	// 	"int16" INTEGER NOT NULL,
+	// 	"uint16" INTEGER NOT NULL,
+	// 	"int32" INTEGER NOT NULL,
+	// 	"uint32" INTEGER NOT NULL,
+	// 	"int64" INTEGER NOT NULL,
+	// 	"uint64" TEXT NOT NULL,
+	// 	"integer" TEXT NOT NULL,
+	// 	"decimal" TEXT NOT NULL,
+	// 	"bool" INTEGER NOT NULL CHECK ("bool" IN (0, 1)),
+	// 	"time" INTEGER NOT NULL,
+	// 	"duration" INTEGER NOT NULL,
+	// 	"float32" REAL NOT NULL,
+	// 	"float64" REAL NOT NULL,
+	// 	"address" BLOB NOT NULL,
+	// 	"enum" TEXT NOT NULL CHECK ("enum" IN ('a', 'b', 'c')),
+	// 	"json" TEXT NOT NULL,
+	// 	PRIMARY KEY ("id", "ts")
+	// );
+}
+
+func ExampleObjectIndexer_CreateTableSql_singleton() {
+	exampleCreateTable(testdata.SingletonObject)
+	// Output:
+	// CREATE TABLE IF NOT EXISTS "test_singleton" (
+	// 	_id INTEGER NOT NULL CHECK (_id = 1),
+	// 	"foo" TEXT NOT NULL,
+	// 	"bar" INTEGER,
+	// 	"an_enum" TEXT NOT NULL CHECK ("an_enum" IN ('a', 'b', 'c')),
+	// 	PRIMARY KEY (_id)
+	// );
+}
+
+func ExampleObjectIndexer_CreateTableSql_vote() {
+	exampleCreateTable(testdata.VoteObject)
+	// Output:
+	// CREATE TABLE IF NOT EXISTS "test_vote" (
+	// 	"proposal" INTEGER NOT NULL,
+	// 	"address" BLOB NOT NULL,
+	// 	"vote" TEXT NOT NULL CHECK ("vote" IN ('yes', 'no', 'abstain')),
+	// 	_deleted INTEGER NOT NULL DEFAULT 0 CHECK (_deleted IN (0, 1)),
+	// 	PRIMARY KEY ("proposal", "address")
+	// );
+}
+
+func ExampleObjectIndexer_CreateTableSql_vote_no_retain_delete() {
+	exampleCreateTableOpt(testdata.VoteObject, true)
+	// Output:
+	// CREATE TABLE IF NOT EXISTS "test_vote" (
+	// 	"proposal" INTEGER NOT NULL,
+	// 	"address" BLOB NOT NULL,
+	// 	"vote" TEXT NOT NULL CHECK ("vote" IN ('yes', 'no', 'abstain')),
+	// 	PRIMARY KEY ("proposal", "address")
+	// );
+}
+
+func exampleCreateTable(objectType schema.ObjectType) {
+	exampleCreateTableOpt(objectType, false)
+}
+
+func exampleCreateTableOpt(objectType schema.ObjectType, noRetainDelete bool) {
+	tm := NewObjectIndexer("test", objectType, Options{
+		Logger:                 func(msg, sql string, params ...interface{}) {},
+		DisableRetainDeletions: noRetainDelete,
+	})
+	err := tm.CreateTableSql(os.Stdout)
+	if err != nil {
+		panic(err)
+	}
+}
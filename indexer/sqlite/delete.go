@@ -0,0 +1,60 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Delete deletes the row with the provided key from the table. If the object type retains
+// deletions, the row is marked as deleted with the _deleted column instead.
+func (tm *ObjectIndexer) Delete(ctx context.Context, conn DBConn, key interface{}) error {
+	buf := new(strings.Builder)
+	params, err := tm.DeleteSql(buf, key)
+	if err != nil {
+		return err
+	}
+
+	sqlStr := buf.String()
+	if tm.options.Logger != nil {
+		tm.options.Logger("Delete", sqlStr, params...)
+	}
+	_, err = conn.ExecContext(ctx, sqlStr, params...)
+	return err
+}
+
+// DeleteSql generates a DELETE or UPDATE statement for the provided key and returns the parameters to bind to it.
+func (tm *ObjectIndexer) DeleteSql(w io.Writer, key interface{}) ([]interface{}, error) {
+	keyCols, params, err := tm.bindKeyParams(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if tm.retainDeletions() {
+		_, err = fmt.Fprintf(w, "UPDATE %q SET _deleted = 1", tm.TableName())
+	} else {
+		_, err = fmt.Fprintf(w, "DELETE FROM %q", tm.TableName())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = writeWhereKey(w, keyCols)
+	if err != nil {
+		return nil, err
+	}
+
+	return params, nil
+}
+
+// writeWhereKey writes a WHERE clause matching each of the key columns to a bound parameter.
+func writeWhereKey(w io.Writer, keyCols []string) error {
+	conds := make([]string, len(keyCols))
+	for i, col := range keyCols {
+		conds[i] = fmt.Sprintf("%s = ?", col)
+	}
+
+	_, err := fmt.Fprintf(w, " WHERE %s;", strings.Join(conds, " AND "))
+	return err
+}
@@ -0,0 +1,27 @@
+package sqlite
+
+import (
+	"fmt"
+	"io"
+
+	"cosmossdk.io/schema"
+)
+
+// writeEnumValues writes the comma separated list of quoted enum values which is used in the
+// CHECK constraint of enum columns because SQLite has no native enum types.
+func writeEnumValues(writer io.Writer, enum schema.EnumType) error {
+	for i, value := range enum.Values {
+		if i > 0 {
+			_, err := fmt.Fprintf(writer, ", ")
+			if err != nil {
+				return err
+			}
+		}
+		_, err := fmt.Fprintf(writer, "'%s'", value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
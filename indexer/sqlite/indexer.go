@@ -0,0 +1,223 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/indexer"
+)
+
+// IndexerType is the name that the SQLite indexer is registered with in the indexer registry.
+const IndexerType = "sqlite"
+
+func init() {
+	indexer.Register(IndexerType, initIndexer)
+}
+
+type Config struct {
+	// DatabasePath is the path to the SQLite database file or a SQLite URI filename (file:...) which
+	// will be passed as the data source name to the database/sql driver.
+	DatabasePath string `json:"database_path"`
+
+	// DatabaseDriver is the SQLite database/sql driver to use. This defaults to "sqlite3".
+	DatabaseDriver string `json:"database_driver"`
+
+	// DisableRetainDeletions disables the retain deletions functionality even if it is set in an object type schema.
+	DisableRetainDeletions bool `json:"disable_retain_deletions"`
+}
+
+type SqlLogger = func(msg, sql string, params ...interface{})
+
+// StartIndexer opens the SQLite database, creates the base schema and returns the indexer's listener
+// together with the last block that was persisted to the database.
+func StartIndexer(ctx context.Context, logger SqlLogger, config Config) (indexer.InitResult, error) {
+	if config.DatabasePath == "" {
+		return indexer.InitResult{}, errors.New("missing database path")
+	}
+
+	driver := config.DatabaseDriver
+	if driver == "" {
+		driver = "sqlite3"
+	}
+
+	db, err := sql.Open(driver, config.DatabasePath)
+	if err != nil {
+		return indexer.InitResult{}, err
+	}
+
+	// SQLite only supports a single writer so we use a single connection for all writes
+	db.SetMaxOpenConns(1)
+
+	// commit base schema
+	_, err = db.ExecContext(ctx, BaseSQL)
+	if err != nil {
+		return indexer.InitResult{}, err
+	}
+
+	lastBlock, err := lastBlockPersisted(ctx, db)
+	if err != nil {
+		return indexer.InitResult{}, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return indexer.InitResult{}, err
+	}
+
+	moduleIndexers := map[string]*ModuleIndexer{}
+	opts := Options{
+		DisableRetainDeletions: config.DisableRetainDeletions,
+		Logger:                 logger,
+	}
+
+	var blockNumber uint64
+
+	listener := appdata.Listener{
+		InitializeModuleData: func(data appdata.ModuleInitializationData) error {
+			moduleName := data.ModuleName
+			modSchema := data.Schema
+			_, ok := moduleIndexers[moduleName]
+			if ok {
+				return fmt.Errorf("module %s already initialized", moduleName)
+			}
+
+			mm := NewModuleIndexer(moduleName, modSchema, opts)
+			moduleIndexers[moduleName] = mm
+
+			return mm.InitializeSchema(ctx, tx)
+		},
+		StartBlock: func(data appdata.StartBlockData) error {
+			blockNumber = data.Height
+
+			var header interface{}
+			if data.HeaderJSON != nil {
+				bz, err := data.HeaderJSON()
+				if err != nil {
+					return err
+				}
+				header = string(bz)
+			}
+
+			_, err := tx.ExecContext(ctx, "INSERT OR REPLACE INTO block (number, header) VALUES (?, ?);", int64(blockNumber), header)
+			return err
+		},
+		OnTx: func(data appdata.TxData) error {
+			bz, err := txDataJSON(data)
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.ExecContext(ctx, "INSERT INTO tx (block_number, index_in_block, data) VALUES (?, ?, ?);",
+				int64(blockNumber), data.TxIndex, string(bz))
+			return err
+		},
+		OnEvent: func(data appdata.EventData) error {
+			bz, err := toJSON(data.Data)
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.ExecContext(ctx, "INSERT INTO event (block_number, tx_index, msg_index, event_index, type, data) VALUES (?, ?, ?, ?, ?, ?);",
+				int64(blockNumber), data.TxIndex, data.MsgIndex, data.EventIndex, data.Type, string(bz))
+			return err
+		},
+		OnObjectUpdate: func(data appdata.ObjectUpdateData) error {
+			mm, ok := moduleIndexers[data.ModuleName]
+			if !ok {
+				return fmt.Errorf("module %s not initialized", data.ModuleName)
+			}
+
+			for _, update := range data.Updates {
+				err := mm.ApplyUpdate(ctx, tx, update)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Commit: func(data appdata.CommitData) error {
+			err = tx.Commit()
+			if err != nil {
+				return err
+			}
+
+			tx, err = db.BeginTx(ctx, nil)
+			return err
+		},
+	}
+
+	return indexer.InitResult{
+		Listener:           listener,
+		LastBlockPersisted: lastBlock,
+	}, nil
+}
+
+// initIndexer is the indexer.InitFunc that is registered for the SQLite indexer.
+func initIndexer(params indexer.InitParams) (indexer.InitResult, error) {
+	// the indexer specific config is decoded by round-tripping it through JSON
+	bz, err := json.Marshal(params.Config.Config)
+	if err != nil {
+		return indexer.InitResult{}, err
+	}
+
+	var config Config
+	err = json.Unmarshal(bz, &config)
+	if err != nil {
+		return indexer.InitResult{}, fmt.Errorf("invalid sqlite indexer config: %v", err) //nolint:errorlint // using %v for go 1.12 compat
+	}
+
+	var logger SqlLogger
+	if params.Logger != nil {
+		logger = func(msg, sql string, sqlParams ...interface{}) {
+			params.Logger.Debug(msg, "sql", sql, "params", sqlParams)
+		}
+	}
+
+	ctx := params.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return StartIndexer(ctx, logger, config)
+}
+
+// lastBlockPersisted returns the highest block number stored in the database or 0 if no block has been stored.
+func lastBlockPersisted(ctx context.Context, conn DBConn) (int64, error) {
+	var lastBlock sql.NullInt64
+	err := conn.QueryRowContext(ctx, "SELECT MAX(number) FROM block;").Scan(&lastBlock)
+	if err != nil {
+		return 0, err
+	}
+
+	return lastBlock.Int64, nil
+}
+
+func txDataJSON(data appdata.TxData) (json.RawMessage, error) {
+	if data.JSON != nil {
+		return data.JSON()
+	}
+
+	if data.Bytes == nil {
+		return json.RawMessage("null"), nil
+	}
+
+	// fall back to storing the raw transaction bytes, which are base64 encoded by encoding/json
+	bz, err := data.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(bz)
+}
+
+func toJSON(f appdata.ToJSON) (json.RawMessage, error) {
+	if f == nil {
+		return json.RawMessage("null"), nil
+	}
+
+	return f()
+}
@@ -0,0 +1,156 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"cosmossdk.io/schema"
+)
+
+// InsertUpdate inserts or updates the row with the provided key and value.
+func (tm *ObjectIndexer) InsertUpdate(ctx context.Context, conn DBConn, key, value interface{}) error {
+	// SQLite checks NOT NULL constraints before resolving conflicts so a partial update
+	// can't be expressed as an upsert, we try updating the existing row first instead
+	if _, ok := value.(schema.ValueUpdates); ok {
+		updated, err := tm.Update(ctx, conn, key, value)
+		if err != nil || updated {
+			return err
+		}
+	}
+
+	buf := new(strings.Builder)
+	params, err := tm.InsertUpdateSql(buf, key, value)
+	if err != nil {
+		return err
+	}
+
+	sqlStr := buf.String()
+	if tm.options.Logger != nil {
+		tm.options.Logger("Insert or update", sqlStr, params...)
+	}
+	_, err = conn.ExecContext(ctx, sqlStr, params...)
+	return err
+}
+
+// InsertUpdateSql generates an INSERT ... ON CONFLICT DO UPDATE statement for the provided key and value
+// and returns the parameters to bind to it. If value is an instance of schema.ValueUpdates, only the
+// updated columns will be written when the row already exists.
+func (tm *ObjectIndexer) InsertUpdateSql(w io.Writer, key, value interface{}) ([]interface{}, error) {
+	keyCols, keyParams, err := tm.bindKeyParams(key)
+	if err != nil {
+		return nil, err
+	}
+
+	valueCols, valueParams, err := tm.bindValueParams(value)
+	if err != nil {
+		return nil, err
+	}
+
+	allCols := make([]string, 0, len(keyCols)+len(valueCols)+1)
+	allCols = append(allCols, keyCols...)
+	allCols = append(allCols, valueCols...)
+
+	var setExprs []string
+	for _, col := range valueCols {
+		setExprs = append(setExprs, fmt.Sprintf("%s = excluded.%s", col, col))
+	}
+
+	params := append(keyParams, valueParams...)
+	placeholders := make([]string, len(allCols))
+	for i := range placeholders {
+		placeholders[i] = "?"
+	}
+
+	// a re-inserted row which was previously deleted is no longer deleted
+	if tm.retainDeletions() {
+		allCols = append(allCols, "_deleted")
+		placeholders = append(placeholders, "0")
+		setExprs = append(setExprs, "_deleted = 0")
+	}
+
+	_, err = fmt.Fprintf(w, "INSERT INTO %q (%s) VALUES (%s) ON CONFLICT (%s) ",
+		tm.TableName(),
+		strings.Join(allCols, ", "),
+		strings.Join(placeholders, ", "),
+		strings.Join(keyCols, ", "),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(setExprs) == 0 {
+		_, err = fmt.Fprintf(w, "DO NOTHING;")
+	} else {
+		_, err = fmt.Fprintf(w, "DO UPDATE SET %s;", strings.Join(setExprs, ", "))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return params, nil
+}
+
+// Update updates the columns of an existing row with the provided key and value and
+// returns true if a row was updated.
+func (tm *ObjectIndexer) Update(ctx context.Context, conn DBConn, key, value interface{}) (bool, error) {
+	buf := new(strings.Builder)
+	params, err := tm.UpdateSql(buf, key, value)
+	if err != nil {
+		return false, err
+	}
+
+	sqlStr := buf.String()
+	if tm.options.Logger != nil {
+		tm.options.Logger("Update", sqlStr, params...)
+	}
+	res, err := conn.ExecContext(ctx, sqlStr, params...)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+// UpdateSql generates an UPDATE statement for the provided key and value and returns the parameters to bind to it.
+func (tm *ObjectIndexer) UpdateSql(w io.Writer, key, value interface{}) ([]interface{}, error) {
+	keyCols, keyParams, err := tm.bindKeyParams(key)
+	if err != nil {
+		return nil, err
+	}
+
+	valueCols, valueParams, err := tm.bindValueParams(value)
+	if err != nil {
+		return nil, err
+	}
+
+	setExprs := make([]string, 0, len(valueCols)+1)
+	for _, col := range valueCols {
+		setExprs = append(setExprs, fmt.Sprintf("%s = ?", col))
+	}
+	if tm.retainDeletions() {
+		setExprs = append(setExprs, "_deleted = 0")
+	}
+	if len(setExprs) == 0 {
+		// there is nothing to update but the row should still be matched
+		setExprs = append(setExprs, fmt.Sprintf("%s = %s", keyCols[0], keyCols[0]))
+	}
+
+	_, err = fmt.Fprintf(w, "UPDATE %q SET %s", tm.TableName(), strings.Join(setExprs, ", "))
+	if err != nil {
+		return nil, err
+	}
+
+	err = writeWhereKey(w, keyCols)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(valueParams, keyParams...), nil
+}
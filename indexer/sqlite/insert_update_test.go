@@ -0,0 +1,61 @@
+package sqlite
+
+import (
+	"fmt"
+	"os"
+
+	"cosmossdk.io/indexer/sqlite/internal/testdata"
+	"cosmossdk.io/schema"
+)
+
+func ExampleObjectIndexer_InsertUpdateSql_vote() {
+	tm := NewObjectIndexer("test", testdata.VoteObject, Options{})
+	params, err := tm.InsertUpdateSql(os.Stdout, []interface{}{int64(1), []byte{0x01}}, "yes")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	fmt.Println(params)
+	// Output:
+	// INSERT INTO "test_vote" ("proposal", "address", "vote", _deleted) VALUES (?, ?, ?, 0) ON CONFLICT ("proposal", "address") DO UPDATE SET "vote" = excluded."vote", _deleted = 0;
+	// [1 [1] yes]
+}
+
+func ExampleObjectIndexer_UpdateSql_singleton() {
+	tm := NewObjectIndexer("test", testdata.SingletonObject, Options{})
+	params, err := tm.UpdateSql(os.Stdout, nil, schema.MapValueUpdates{"bar": int32(3)})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	fmt.Println(params)
+	// Output:
+	// UPDATE "test_singleton" SET "bar" = ? WHERE _id = ?;
+	// [3 1]
+}
+
+func ExampleObjectIndexer_DeleteSql_vote() {
+	tm := NewObjectIndexer("test", testdata.VoteObject, Options{})
+	params, err := tm.DeleteSql(os.Stdout, []interface{}{int64(1), []byte{0x01}})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	fmt.Println(params)
+	// Output:
+	// UPDATE "test_vote" SET _deleted = 1 WHERE "proposal" = ? AND "address" = ?;
+	// [1 [1]]
+}
+
+func ExampleObjectIndexer_DeleteSql_vote_no_retain_delete() {
+	tm := NewObjectIndexer("test", testdata.VoteObject, Options{DisableRetainDeletions: true})
+	params, err := tm.DeleteSql(os.Stdout, []interface{}{int64(1), []byte{0x01}})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	fmt.Println(params)
+	// Output:
+	// DELETE FROM "test_vote" WHERE "proposal" = ? AND "address" = ?;
+	// [1 [1]]
+}
@@ -0,0 +1,139 @@
+package sqlite
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/schema"
+)
+
+// bindKeyParams returns the quoted key column names and the bound parameter values for an object key.
+func (tm *ObjectIndexer) bindKeyParams(key interface{}) ([]string, []interface{}, error) {
+	n := len(tm.typ.KeyFields)
+	if n == 0 {
+		// singleton object
+		return []string{"_id"}, []interface{}{1}, nil
+	} else if n == 1 {
+		return tm.bindParams(tm.typ.KeyFields, []interface{}{key})
+	}
+
+	keys, ok := key.([]interface{})
+	if !ok {
+		return nil, nil, fmt.Errorf("expected key to be a slice")
+	}
+
+	return tm.bindParams(tm.typ.KeyFields, keys)
+}
+
+// bindValueParams returns the quoted value column names and the bound parameter values for an object value.
+// If value is an instance of schema.ValueUpdates, only the updated columns are returned.
+func (tm *ObjectIndexer) bindValueParams(value interface{}) ([]string, []interface{}, error) {
+	if valueUpdates, ok := value.(schema.ValueUpdates); ok {
+		var fields []schema.Field
+		var values []interface{}
+		var err error
+		iterErr := valueUpdates.Iterate(func(name string, value interface{}) bool {
+			field, ok := tm.valueFields[name]
+			if !ok {
+				err = fmt.Errorf("unknown column %q in object type %s", name, tm.typ.Name)
+				return false
+			}
+			fields = append(fields, field)
+			values = append(values, value)
+			return true
+		})
+		if iterErr != nil {
+			return nil, nil, iterErr
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return tm.bindParams(fields, values)
+	}
+
+	n := len(tm.typ.ValueFields)
+	if n == 0 {
+		return nil, nil, nil
+	} else if n == 1 {
+		return tm.bindParams(tm.typ.ValueFields, []interface{}{value})
+	}
+
+	values, ok := value.([]interface{})
+	if !ok {
+		return nil, nil, fmt.Errorf("expected values to be a slice")
+	}
+
+	return tm.bindParams(tm.typ.ValueFields, values)
+}
+
+func (tm *ObjectIndexer) bindParams(fields []schema.Field, values []interface{}) ([]string, []interface{}, error) {
+	if len(values) != len(fields) {
+		return nil, nil, fmt.Errorf("expected %d values, got %d", len(fields), len(values))
+	}
+
+	names := make([]string, 0, len(fields))
+	params := make([]interface{}, 0, len(fields))
+	for i, field := range fields {
+		param, err := bindParam(field, values[i])
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to bind field %s: %v", field.Name, err) //nolint:errorlint // using %v for go 1.12 compat
+		}
+
+		names = append(names, fmt.Sprintf("%q", field.Name))
+		params = append(params, param)
+	}
+
+	return names, params, nil
+}
+
+// bindParam converts a value for the field into a value that can be passed to the SQLite driver.
+func bindParam(field schema.Field, value interface{}) (interface{}, error) {
+	if value == nil {
+		if !field.Nullable {
+			return nil, fmt.Errorf("expected non-null value")
+		}
+		return nil, nil
+	}
+
+	switch field.Kind {
+	case schema.Uint64Kind:
+		// uint64 values don't fit in SQLite's signed 64-bit integers so they are stored as text
+		x, ok := value.(uint64)
+		if !ok {
+			return nil, fmt.Errorf("expected uint64, got %T", value)
+		}
+		return strconv.FormatUint(x, 10), nil
+	case schema.Float32Kind:
+		x, ok := value.(float32)
+		if !ok {
+			return nil, fmt.Errorf("expected float32, got %T", value)
+		}
+		return float64(x), nil
+	case schema.TimeKind:
+		t, ok := value.(time.Time)
+		if !ok {
+			return nil, fmt.Errorf("expected time.Time, got %T", value)
+		}
+		return t.UnixNano(), nil
+	case schema.DurationKind:
+		d, ok := value.(time.Duration)
+		if !ok {
+			return nil, fmt.Errorf("expected time.Duration, got %T", value)
+		}
+		return int64(d), nil
+	case schema.JSONKind:
+		switch x := value.(type) {
+		case json.RawMessage:
+			return string(x), nil
+		case string:
+			return x, nil
+		default:
+			return nil, fmt.Errorf("expected json.RawMessage, got %T", value)
+		}
+	default:
+		return value, nil
+	}
+}
@@ -0,0 +1,20 @@
+module cosmossdk.io/indexer/sqlite/testing
+
+require (
+	cosmossdk.io/indexer/sqlite v0.0.0-00010101000000-000000000000
+	cosmossdk.io/schema v0.1.1
+	github.com/mattn/go-sqlite3 v1.14.22
+	github.com/stretchr/testify v1.9.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace cosmossdk.io/indexer/sqlite => ../.
+
+replace cosmossdk.io/schema => ../../../schema
+
+go 1.22
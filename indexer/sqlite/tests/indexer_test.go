@@ -0,0 +1,178 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3" // this is where we get our sqlite database driver from
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/indexer/sqlite"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+)
+
+var testSchema = mustModuleSchema([]schema.ObjectType{
+	{
+		Name: "balance",
+		KeyFields: []schema.Field{
+			{Name: "address", Kind: schema.AddressKind},
+			{Name: "denom", Kind: schema.StringKind},
+		},
+		ValueFields: []schema.Field{
+			{Name: "amount", Kind: schema.Uint64Kind},
+		},
+	},
+	{
+		Name: "params",
+		ValueFields: []schema.Field{
+			{Name: "enabled", Kind: schema.BoolKind},
+			{Name: "max_time", Kind: schema.DurationKind},
+			{Name: "genesis", Kind: schema.TimeKind},
+			{Name: "extra", Kind: schema.JSONKind, Nullable: true},
+		},
+	},
+	{
+		Name: "vote",
+		KeyFields: []schema.Field{
+			{Name: "proposal", Kind: schema.Int64Kind},
+		},
+		ValueFields: []schema.Field{
+			{
+				Name:     "option",
+				Kind:     schema.EnumKind,
+				EnumType: schema.EnumType{Name: "vote_option", Values: []string{"yes", "no", "abstain"}},
+			},
+			{Name: "weight", Kind: schema.Float32Kind},
+		},
+		RetainDeletions: true,
+	},
+})
+
+func TestIndexer(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "index.db")
+	res, err := sqlite.StartIndexer(context.Background(), nil, sqlite.Config{DatabasePath: dbPath})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), res.LastBlockPersisted)
+
+	listener := res.Listener
+	require.NoError(t, listener.InitializeModuleData(appdata.ModuleInitializationData{
+		ModuleName: "test",
+		Schema:     testSchema,
+	}))
+
+	genesis := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+	require.NoError(t, listener.StartBlock(appdata.StartBlockData{Height: 1}))
+	require.NoError(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{
+		ModuleName: "test",
+		Updates: []schema.ObjectUpdate{
+			{TypeName: "balance", Key: []interface{}{[]byte{1, 2}, "foo"}, Value: uint64(18446744073709551615)},
+			{TypeName: "balance", Key: []interface{}{[]byte{1, 2}, "bar"}, Value: uint64(10)},
+			{
+				TypeName: "params",
+				Value:    []interface{}{true, time.Minute, genesis, json.RawMessage(`{"a":1}`)},
+			},
+			{TypeName: "vote", Key: int64(1), Value: []interface{}{"yes", float32(0.5)}},
+			{TypeName: "vote", Key: int64(2), Value: []interface{}{"no", float32(1)}},
+		},
+	}))
+	require.NoError(t, listener.Commit(appdata.CommitData{}))
+
+	require.NoError(t, listener.StartBlock(appdata.StartBlockData{Height: 2}))
+	require.NoError(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{
+		ModuleName: "test",
+		Updates: []schema.ObjectUpdate{
+			{TypeName: "balance", Key: []interface{}{[]byte{1, 2}, "bar"}, Delete: true},
+			{TypeName: "params", Value: schema.MapValueUpdates{"enabled": false, "extra": nil}},
+			{TypeName: "vote", Key: int64(1), Delete: true},
+			{TypeName: "vote", Key: int64(2), Value: schema.MapValueUpdates{"option": "abstain"}},
+		},
+	}))
+	require.NoError(t, listener.Commit(appdata.CommitData{}))
+
+	db, err := sql.Open("sqlite3", dbPath)
+	require.NoError(t, err)
+	defer db.Close()
+
+	var amount string
+	require.NoError(t, db.QueryRow(`SELECT amount FROM test_balance WHERE address = ? AND denom = ?`, []byte{1, 2}, "foo").Scan(&amount))
+	require.Equal(t, "18446744073709551615", amount)
+
+	var count int
+	require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM test_balance`).Scan(&count))
+	require.Equal(t, 1, count)
+
+	var (
+		enabled bool
+		maxTime int64
+		genNs   int64
+		extra   sql.NullString
+	)
+	require.NoError(t, db.QueryRow(`SELECT enabled, max_time, genesis, extra FROM test_params`).Scan(&enabled, &maxTime, &genNs, &extra))
+	require.False(t, enabled)
+	require.Equal(t, int64(time.Minute), maxTime)
+	require.Equal(t, genesis.UnixNano(), genNs)
+	require.False(t, extra.Valid)
+
+	var (
+		option  string
+		weight  float64
+		deleted bool
+	)
+	require.NoError(t, db.QueryRow(`SELECT option, weight, _deleted FROM test_vote WHERE proposal = 1`).Scan(&option, &weight, &deleted))
+	require.Equal(t, "yes", option)
+	require.True(t, deleted)
+	require.NoError(t, db.QueryRow(`SELECT option, weight, _deleted FROM test_vote WHERE proposal = 2`).Scan(&option, &weight, &deleted))
+	require.Equal(t, "abstain", option)
+	require.Equal(t, float64(1), weight)
+	require.False(t, deleted)
+
+	// enum values which are not part of the enum type are rejected
+	require.Error(t, listener.OnObjectUpdate(appdata.ObjectUpdateData{
+		ModuleName: "test",
+		Updates: []schema.ObjectUpdate{
+			{TypeName: "vote", Key: int64(3), Value: []interface{}{"maybe", float32(1)}},
+		},
+	}))
+}
+
+func TestLastBlockPersisted(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "index.db")
+	res, err := sqlite.StartIndexer(context.Background(), nil, sqlite.Config{DatabasePath: dbPath})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), res.LastBlockPersisted)
+
+	listener := res.Listener
+	require.NoError(t, listener.InitializeModuleData(appdata.ModuleInitializationData{
+		ModuleName: "test",
+		Schema:     testSchema,
+	}))
+	for height := uint64(1); height <= 3; height++ {
+		require.NoError(t, listener.StartBlock(appdata.StartBlockData{
+			Height: height,
+			HeaderJSON: func() (json.RawMessage, error) {
+				return json.RawMessage(`{}`), nil
+			},
+		}))
+		require.NoError(t, listener.Commit(appdata.CommitData{}))
+	}
+
+	// a block which is started but not committed is not persisted
+	require.NoError(t, listener.StartBlock(appdata.StartBlockData{Height: 4}))
+
+	res, err = sqlite.StartIndexer(context.Background(), nil, sqlite.Config{DatabasePath: dbPath})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), res.LastBlockPersisted)
+}
+
+func mustModuleSchema(objectTypes []schema.ObjectType) schema.ModuleSchema {
+	s, err := schema.NewModuleSchema(objectTypes)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
@@ -21,15 +21,19 @@ type AsyncListenerOptions struct {
 
 // AsyncListenerMux returns a listener that forwards received events to all the provided listeners asynchronously
 // with each listener processing in a separate go routine. All callbacks in the returned listener will return nil
-// except for Commit which will return an error or nil once all listeners have processed the commit. The context
-// is used to signal that the listeners should stop listening and return. bufferSize is the size of the buffer for the
-// channels used to send events to the listeners.
+// except for Commit and Rollback which will return an error or nil once all listeners have processed the commit
+// or rollback. The context is used to signal that the listeners should stop listening and return. bufferSize is
+// the size of the buffer for the channels used to send events to the listeners.
 func AsyncListenerMux(opts AsyncListenerOptions, listeners ...Listener) Listener {
 	asyncListeners := make([]Listener, len(listeners))
 	commitChans := make([]chan error, len(listeners))
+	var rollbackChans []chan error
 	for i, l := range listeners {
 		commitChan := make(chan error)
 		commitChans[i] = commitChan
+		if l.Rollback != nil {
+			rollbackChans = append(rollbackChans, commitChan)
+		}
 		asyncListeners[i] = AsyncListener(opts, commitChan, l)
 	}
 	mux := ListenerMux(asyncListeners...)
@@ -42,22 +46,39 @@ func AsyncListenerMux(opts AsyncListenerOptions, listeners ...Listener) Listener
 			}
 		}
 
-		for _, commitChan := range commitChans {
-			err := <-commitChan
+		return waitAll(commitChans)
+	}
+
+	muxRollback := mux.Rollback
+	if muxRollback != nil {
+		mux.Rollback = func(data RollbackData) error {
+			err := muxRollback(data)
 			if err != nil {
 				return err
 			}
+
+			return waitAll(rollbackChans)
 		}
-		return nil
 	}
 
 	return mux
 }
 
+// waitAll waits for a result on each of the channels in order and returns the first error received.
+func waitAll(chans []chan error) error {
+	for _, ch := range chans {
+		err := <-ch
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // AsyncListener returns a listener that forwards received events to the provided listener listening in asynchronously
 // in a separate go routine. The listener that is returned will return nil for all methods including Commit and
-// an error or nil will only be returned in commitChan once the sender has sent commit and the receiving listener has
-// processed it. Thus commitChan can be used as a synchronization and error checking mechanism. The go routine
+// Rollback and an error or nil will only be returned in commitChan once the sender has sent commit or rollback and
+// the receiving listener has processed it. Thus commitChan can be used as a synchronization and error checking mechanism. The go routine
 // that is being used for listening will exit when context.Done() returns and no more events will be received by the listener.
 // bufferSize is the size of the buffer for the channel that is used to send events to the listener.
 // Instead of using AsyncListener directly, it is recommended to use AsyncListenerMux which does coordination directly
@@ -82,16 +103,16 @@ func AsyncListener(opts AsyncListenerOptions, commitChan chan<- error, listener
 			case packet := <-packetChan:
 				if err != nil {
 					// if we have an error, don't process any more packets
-					// and return the error and finish when it's time to commit
-					if _, ok := packet.(CommitData); ok {
+					// and return the error and finish when it's time to commit or roll back
+					if isSyncPacket(packet) {
 						commitChan <- err
 						return
 					}
 				} else {
 					// process the packet
 					err = listener.SendPacket(packet)
-					// if it's a commit
-					if _, ok := packet.(CommitData); ok {
+					// if it's a commit or rollback
+					if isSyncPacket(packet) {
 						commitChan <- err
 						if err != nil {
 							return
@@ -158,5 +179,22 @@ func AsyncListener(opts AsyncListenerOptions, commitChan chan<- error, listener
 		}
 	}
 
+	if listener.Rollback != nil {
+		res.Rollback = func(data RollbackData) error {
+			packetChan <- data
+			return nil
+		}
+	}
+
 	return res
 }
+
+// isSyncPacket returns true for packets whose result is reported back on the commit channel.
+func isSyncPacket(packet Packet) bool {
+	switch packet.(type) {
+	case CommitData, RollbackData:
+		return true
+	default:
+		return false
+	}
+}
@@ -85,6 +85,44 @@ func TestAsyncListenerMux(t *testing.T) {
 			t.Fatalf("expected error, got %v", err)
 		}
 	})
+
+	t.Run("rollback", func(t *testing.T) {
+		var calls1, calls2 []string
+		listener1 := callCollector(1, func(name string, _ int, _ Packet) {
+			calls1 = append(calls1, name)
+		})
+		listener2 := callCollector(2, func(name string, _ int, _ Packet) {
+			calls2 = append(calls2, name)
+		})
+		listener2.Rollback = nil
+		res := AsyncListenerMux(AsyncListenerOptions{}, listener1, listener2)
+
+		err := res.Rollback(RollbackData{Height: 1})
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+
+		err = res.Commit(CommitData{})
+		if err != nil {
+			t.Fatalf("expected nil, got %v", err)
+		}
+
+		checkExpectedCallOrder(t, calls1, []string{"Rollback", "Commit"})
+		checkExpectedCallOrder(t, calls2, []string{"Commit"})
+	})
+
+	t.Run("error on rollback", func(t *testing.T) {
+		listener1 := callCollector(1, func(string, int, Packet) {})
+		listener1.Rollback = func(data RollbackData) error {
+			return fmt.Errorf("error")
+		}
+		res := AsyncListenerMux(AsyncListenerOptions{}, listener1)
+
+		err := res.Rollback(RollbackData{Height: 1})
+		if err == nil || err.Error() != "error" {
+			t.Fatalf("expected error, got %v", err)
+		}
+	})
 }
 
 func TestAsyncListener(t *testing.T) {
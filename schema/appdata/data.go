@@ -95,3 +95,11 @@ type ObjectUpdateData struct {
 
 // CommitData represents commit data. It is empty for now, but fields could be added later.
 type CommitData struct{}
+
+// RollbackData represents a request for the listener to revert all the data it has persisted
+// for blocks after a given height, usually because the node's state has been rolled back.
+type RollbackData struct {
+	// Height is the height of the last block whose data should be retained. All data persisted
+	// for blocks after this height should be reverted.
+	Height uint64
+}
@@ -11,5 +11,6 @@ func PacketForwarder(f func(Packet) error) Listener {
 		OnObjectUpdate:       func(data ObjectUpdateData) error { return f(data) },
 		StartBlock:           func(data StartBlockData) error { return f(data) },
 		Commit:               func(data CommitData) error { return f(data) },
+		Rollback:             func(data RollbackData) error { return f(data) },
 	}
 }
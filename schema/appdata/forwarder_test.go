@@ -20,6 +20,7 @@ func TestPacketForwarder(t *testing.T) {
 		KVPairData{},
 		ObjectUpdateData{},
 		CommitData{},
+		RollbackData{},
 	}
 
 	for i, packet := range expected {
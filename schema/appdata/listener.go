@@ -38,4 +38,12 @@ type Listener struct {
 	// they are unable to commit. Data sources MUST call Commit when data is committed,
 	// otherwise it should be assumed that indexers have not persisted their state.
 	Commit func(CommitData) error
+
+	// Rollback is called when the listener should revert all the data it has persisted for blocks after
+	// the provided height, for instance because the node's state has been rolled back to an earlier height.
+	// Rollback is called after InitializeModuleData has been called for all modules and before any
+	// new block is started. Listeners which persist data but are unable to revert it should leave
+	// this nil, in which case the data source should treat a rollback past the listener's persisted
+	// height as an error.
+	Rollback func(RollbackData) error
 }
@@ -124,5 +124,22 @@ func ListenerMux(listeners ...Listener) Listener {
 		}
 	}
 
+	rollbackCbs := make([]func(RollbackData) error, 0, len(listeners))
+	for _, l := range listeners {
+		if l.Rollback != nil {
+			rollbackCbs = append(rollbackCbs, l.Rollback)
+		}
+	}
+	if len(rollbackCbs) > 0 {
+		mux.Rollback = func(data RollbackData) error {
+			for _, cb := range rollbackCbs {
+				if err := cb(data); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
 	return mux
 }
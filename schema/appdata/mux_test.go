@@ -30,6 +30,9 @@ func TestListenerMux(t *testing.T) {
 		if listener.Commit != nil {
 			t.Error("expected nil")
 		}
+		if listener.Rollback != nil {
+			t.Error("expected nil")
+		}
 	})
 
 	t.Run("all called once", func(t *testing.T) {
@@ -59,6 +62,26 @@ func TestListenerMux(t *testing.T) {
 			"Commit 2",
 		})
 	})
+
+	t.Run("rollback", func(t *testing.T) {
+		var calls []string
+		onCall := func(name string, i int, _ Packet) {
+			calls = append(calls, fmt.Sprintf("%s %d", name, i))
+		}
+
+		listener2 := callCollector(2, onCall)
+		listener2.Rollback = nil
+		res := ListenerMux(callCollector(1, onCall), listener2, callCollector(3, onCall))
+
+		if err := res.Rollback(RollbackData{Height: 1}); err != nil {
+			t.Fatal(err)
+		}
+
+		checkExpectedCallOrder(t, calls, []string{
+			"Rollback 1",
+			"Rollback 3",
+		})
+	})
 }
 
 func callAllCallbacksOnces(t *testing.T, listener Listener) {
@@ -116,6 +139,10 @@ func callCollector(i int, onCall func(string, int, Packet)) Listener {
 			onCall("Commit", i, nil)
 			return nil
 		},
+		Rollback: func(RollbackData) error {
+			onCall("Rollback", i, nil)
+			return nil
+		},
 	}
 }
 
@@ -59,3 +59,10 @@ func (c CommitData) apply(l *Listener) error {
 	}
 	return l.Commit(c)
 }
+
+func (r RollbackData) apply(l *Listener) error {
+	if l.Rollback == nil {
+		return nil
+	}
+	return l.Rollback(r)
+}
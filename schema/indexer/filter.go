@@ -0,0 +1,98 @@
+package indexer
+
+import "cosmossdk.io/schema/appdata"
+
+// newModuleFilter returns a filter for the modules whose state the indexer receives, or nil if it receives
+// the state of all modules.
+func newModuleFilter(cfg Config) func(moduleName string) bool {
+	if len(cfg.IncludeModules) > 0 {
+		include := make(map[string]bool, len(cfg.IncludeModules))
+		for _, moduleName := range cfg.IncludeModules {
+			include[moduleName] = true
+		}
+		return func(moduleName string) bool {
+			return include[moduleName]
+		}
+	}
+
+	if len(cfg.ExcludeModules) > 0 {
+		exclude := make(map[string]bool, len(cfg.ExcludeModules))
+		for _, moduleName := range cfg.ExcludeModules {
+			exclude[moduleName] = true
+		}
+		return func(moduleName string) bool {
+			return !exclude[moduleName]
+		}
+	}
+
+	return nil
+}
+
+// filterListener removes the data the indexer is not configured to receive from its listener.
+func filterListener(listener appdata.Listener, cfg Config, moduleFilter func(moduleName string) bool) appdata.Listener {
+	if cfg.ExcludeState {
+		listener.InitializeModuleData = nil
+		listener.OnKVPair = nil
+		listener.OnObjectUpdate = nil
+	}
+
+	if cfg.ExcludeEvents {
+		listener.OnEvent = nil
+	}
+
+	if cfg.ExcludeTxs {
+		listener.OnTx = nil
+	}
+
+	if cfg.ExcludeBlockHeaders && listener.StartBlock != nil {
+		startBlock := listener.StartBlock
+		listener.StartBlock = func(data appdata.StartBlockData) error {
+			return startBlock(appdata.StartBlockData{Height: data.Height})
+		}
+	}
+
+	// object updates are filtered by the decoding middleware, raw kv-pair updates are filtered here
+	if moduleFilter != nil && listener.OnKVPair != nil {
+		onKVPair := listener.OnKVPair
+		listener.OnKVPair = func(data appdata.KVPairData) error {
+			updates := make([]appdata.ModuleKVPairUpdate, 0, len(data.Updates))
+			for _, update := range data.Updates {
+				if moduleFilter(update.ModuleName) {
+					updates = append(updates, update)
+				}
+			}
+			if len(updates) == 0 {
+				return nil
+			}
+			return onKVPair(appdata.KVPairData{Updates: updates})
+		}
+	}
+
+	return listener
+}
+
+// initializeModulesOnce makes sure that the listener's InitializeModuleData callback is only called once
+// for each module.
+func initializeModulesOnce(listener appdata.Listener) appdata.Listener {
+	if listener.InitializeModuleData == nil {
+		return listener
+	}
+
+	initializeModuleData := listener.InitializeModuleData
+	initialized := map[string]bool{}
+	listener.InitializeModuleData = func(data appdata.ModuleInitializationData) error {
+		if initialized[data.ModuleName] {
+			return nil
+		}
+
+		err := initializeModuleData(data)
+		if err != nil {
+			return err
+		}
+
+		initialized[data.ModuleName] = true
+		return nil
+	}
+
+	return listener
+}
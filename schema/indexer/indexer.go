@@ -70,9 +70,15 @@ type InitResult struct {
 	// LastBlockPersisted indicates the last block that the indexer persisted (if it is persisting data). It
 	// should be 0 if the indexer has no data stored and wants to start syncing state. It should be -1 if the indexer
 	// does not care to persist state at all and is just listening for some other streaming purpose. If the indexer
-	// has persisted state and has missed some blocks, a runtime error will occur to prevent the indexer from continuing
-	// in an invalid state. If an indexer starts indexing after a chain's genesis (returning 0), the indexer manager
+	// has persisted state and has missed some blocks, for instance because the node stopped before the indexer
+	// committed the last block, the indexer manager will catch it up with a sync of the current state as part of the
+	// last committed block, which can't remove the objects deleted in the missed blocks. If no sync source is
+	// available, a runtime error will occur to prevent the indexer from continuing in an invalid state. If an indexer starts indexing after a chain's genesis (returning 0), the indexer manager
 	// will attempt to perform a catch-up sync of state. Historical events will not be replayed, but an accurate
 	// representation of the current state at the height at which indexing began can be reproduced.
+	// If the indexer has persisted blocks past the last block committed by the app, for instance because
+	// the node's state has been rolled back, the indexer manager will call the listener's Rollback callback
+	// to revert the indexer to the app's last committed block. If the listener doesn't implement Rollback,
+	// a runtime error will occur.
 	LastBlockPersisted int64
 }
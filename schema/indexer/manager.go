@@ -2,7 +2,11 @@ package indexer
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
 
+	"cosmossdk.io/schema"
 	"cosmossdk.io/schema/appdata"
 	"cosmossdk.io/schema/decoding"
 	"cosmossdk.io/schema/logutil"
@@ -19,7 +23,8 @@ type ManagerOptions struct {
 
 	// SyncSource is a representation of the current state of key-value data to be used in a catch-up sync.
 	// Catch-up syncs will be performed at initialization when necessary. SyncSource is optional but if
-	// it is omitted, indexers will only be able to start indexing state from genesis.
+	// it is omitted, indexers will only be able to start indexing state from genesis and can't catch up
+	// with blocks they have missed.
 	SyncSource decoding.SyncSource
 
 	// Logger is the logger that indexers can use to write logs. It is optional.
@@ -29,6 +34,11 @@ type ManagerOptions struct {
 	// be used to pass down other parameters to indexers if necessary. If it is omitted, context.Background
 	// will be used.
 	Context context.Context
+
+	// LastCommittedHeight is the height of the last block committed by the app. Indexers which have persisted
+	// blocks past this height, for instance because the node's state was rolled back with the rollback
+	// command, will be asked to revert their data to this height using their listener's Rollback callback.
+	LastCommittedHeight int64
 }
 
 // ManagerConfig is the configuration of the indexer manager and contains the configuration for each indexer target.
@@ -40,5 +50,189 @@ type ManagerConfig struct {
 // StartManager starts the indexer manager with the given options. The state machine should write all relevant app data to
 // the returned listener.
 func StartManager(opts ManagerOptions) (appdata.Listener, error) {
-	panic("TODO: this will be implemented in a follow-up PR, this function is just a stub to demonstrate the API")
+	if opts.Resolver == nil {
+		return appdata.Listener{}, fmt.Errorf("missing decoder resolver")
+	}
+
+	cfg, err := unmarshalManagerConfig(opts.Config)
+	if err != nil {
+		return appdata.Listener{}, err
+	}
+
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	// targets are started in a deterministic order
+	names := make([]string, 0, len(cfg.Target))
+	for name := range cfg.Target {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	listeners := make([]appdata.Listener, 0, len(names))
+	for _, name := range names {
+		targetCfg := cfg.Target[name]
+		initFunc, ok := indexerRegistry[targetCfg.Type]
+		if !ok {
+			return appdata.Listener{}, fmt.Errorf("indexer type %s for target %s not found", targetCfg.Type, name)
+		}
+
+		res, err := initFunc(InitParams{
+			Config:  targetCfg,
+			Context: ctx,
+			Logger:  opts.Logger,
+		})
+		if err != nil {
+			return appdata.Listener{}, fmt.Errorf("failed to initialize indexer %s: %v", name, err) //nolint:errorlint // using %v for go 1.12 compat
+		}
+
+		listener, err := startTarget(name, targetCfg, res, opts)
+		if err != nil {
+			return appdata.Listener{}, err
+		}
+		listeners = append(listeners, listener)
+	}
+
+	return appdata.AsyncListenerMux(appdata.AsyncListenerOptions{Context: ctx}, listeners...), nil
+}
+
+// startTarget filters the data that the indexer's listener receives according to its config, brings the indexer
+// in line with the app's last committed height, by rolling it back or catching it up, and returns a listener decoding kv-pair updates for the indexer.
+func startTarget(name string, cfg Config, res InitResult, opts ManagerOptions) (appdata.Listener, error) {
+	moduleFilter := newModuleFilter(cfg)
+	res.Listener = filterListener(res.Listener, cfg, moduleFilter)
+
+	// modules may be initialized by a rollback or a catch-up sync before they are seen in the kv-pair stream
+	res.Listener = initializeModulesOnce(res.Listener)
+
+	err := rollbackIfNeeded(name, res, opts.LastCommittedHeight, opts.Resolver, moduleFilter, opts.Logger)
+	if err != nil {
+		return appdata.Listener{}, err
+	}
+
+	err = catchUpIfNeeded(name, res, opts, moduleFilter)
+	if err != nil {
+		return appdata.Listener{}, err
+	}
+
+	if res.LastBlockPersisted == 0 && opts.SyncSource != nil {
+		err = decoding.Sync(res.Listener, opts.SyncSource, opts.Resolver, decoding.SyncOptions{ModuleFilter: moduleFilter})
+		if err != nil {
+			return appdata.Listener{}, fmt.Errorf("failed to sync indexer %s: %v", name, err) //nolint:errorlint // using %v for go 1.12 compat
+		}
+	}
+
+	return decoding.Middleware(res.Listener, opts.Resolver, decoding.MiddlewareOptions{ModuleFilter: moduleFilter})
+}
+
+// rollbackIfNeeded makes sure that an indexer has not persisted any blocks past the app's last committed
+// height by calling its listener's Rollback callback if it is ahead of the app. As listeners expect all modules
+// to be initialized before they are rolled back, the modules known to the resolver are initialized first.
+// An error is returned if the indexer is ahead of the app and doesn't support rollbacks.
+func rollbackIfNeeded(name string, res InitResult, lastCommittedHeight int64, resolver decoding.DecoderResolver,
+	moduleFilter func(string) bool, logger logutil.Logger,
+) error {
+	if res.LastBlockPersisted <= lastCommittedHeight {
+		return nil
+	}
+
+	if res.Listener.Rollback == nil {
+		return fmt.Errorf("indexer %s has persisted block %d which is past the last committed block %d "+
+			"and does not support rollbacks", name, res.LastBlockPersisted, lastCommittedHeight)
+	}
+
+	if logger != nil {
+		logger.Info("rolling back indexer", "indexer", name, "from", res.LastBlockPersisted, "to", lastCommittedHeight)
+	}
+
+	if res.Listener.InitializeModuleData != nil && resolver != nil {
+		err := resolver.IterateAll(func(moduleName string, cdc schema.ModuleCodec) error {
+			if moduleFilter != nil && !moduleFilter(moduleName) {
+				return nil
+			}
+
+			return res.Listener.InitializeModuleData(appdata.ModuleInitializationData{
+				ModuleName: moduleName,
+				Schema:     cdc.Schema,
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("failed to initialize modules of indexer %s: %v", name, err) //nolint:errorlint // using %v for go 1.12 compat
+		}
+	}
+
+	err := res.Listener.Rollback(appdata.RollbackData{Height: uint64(lastCommittedHeight)})
+	if err != nil {
+		return fmt.Errorf("failed to roll back indexer %s to block %d: %v", name, lastCommittedHeight, err) //nolint:errorlint // using %v for go 1.12 compat
+	}
+
+	return nil
+}
+
+// catchUpIfNeeded brings an indexer which has missed blocks, usually the last block committed before the node
+// stopped, up to the app's last committed height with a catch-up sync of the current state from the sync source,
+// which is indexed as part of the last committed block. As the sync only sees the objects which currently exist,
+// objects deleted in the missed blocks are left in the indexer. An error is returned if the indexer has missed
+// blocks and there is no sync source to catch up from.
+func catchUpIfNeeded(name string, res InitResult, opts ManagerOptions, moduleFilter func(string) bool) error {
+	if res.LastBlockPersisted <= 0 || res.LastBlockPersisted >= opts.LastCommittedHeight {
+		return nil
+	}
+
+	if opts.SyncSource == nil {
+		return fmt.Errorf("indexer %s has persisted block %d but the app has committed block %d and there is no sync source "+
+			"to catch up from, the indexer has missed blocks and must be rebuilt", name, res.LastBlockPersisted, opts.LastCommittedHeight)
+	}
+
+	if opts.Logger != nil {
+		opts.Logger.Info("catching up indexer", "indexer", name, "from", res.LastBlockPersisted, "to", opts.LastCommittedHeight)
+	}
+
+	if res.Listener.StartBlock != nil {
+		err := res.Listener.StartBlock(appdata.StartBlockData{Height: uint64(opts.LastCommittedHeight)})
+		if err != nil {
+			return fmt.Errorf("failed to catch up indexer %s: %v", name, err) //nolint:errorlint // using %v for go 1.12 compat
+		}
+	}
+
+	err := decoding.Sync(res.Listener, opts.SyncSource, opts.Resolver, decoding.SyncOptions{ModuleFilter: moduleFilter})
+	if err != nil {
+		return fmt.Errorf("failed to catch up indexer %s: %v", name, err) //nolint:errorlint // using %v for go 1.12 compat
+	}
+
+	if res.Listener.Commit != nil {
+		err = res.Listener.Commit(appdata.CommitData{})
+		if err != nil {
+			return fmt.Errorf("failed to catch up indexer %s: %v", name, err) //nolint:errorlint // using %v for go 1.12 compat
+		}
+	}
+
+	return nil
+}
+
+// unmarshalManagerConfig converts the manager config, which is usually a map[string]interface{} read from
+// the app config, to a ManagerConfig by round-tripping it through JSON.
+func unmarshalManagerConfig(config interface{}) (ManagerConfig, error) {
+	if cfg, ok := config.(ManagerConfig); ok {
+		return cfg, nil
+	}
+
+	var cfg ManagerConfig
+	if config == nil {
+		return cfg, nil
+	}
+
+	bz, err := json.Marshal(config)
+	if err != nil {
+		return cfg, err
+	}
+
+	err = json.Unmarshal(bz, &cfg)
+	if err != nil {
+		return cfg, fmt.Errorf("invalid indexer manager config: %v", err) //nolint:errorlint // using %v for go 1.12 compat
+	}
+
+	return cfg, nil
 }
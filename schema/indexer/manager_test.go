@@ -0,0 +1,255 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/schema/decoding"
+)
+
+func TestRollbackIfNeeded(t *testing.T) {
+	var rolledBackTo []uint64
+	listener := appdata.Listener{
+		Rollback: func(data appdata.RollbackData) error {
+			rolledBackTo = append(rolledBackTo, data.Height)
+			return nil
+		},
+	}
+
+	t.Run("not ahead", func(t *testing.T) {
+		for _, lastBlock := range []int64{-1, 0, 5, 10} {
+			err := rollbackIfNeeded("test", InitResult{Listener: listener, LastBlockPersisted: lastBlock}, 10, nil, nil, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+		if len(rolledBackTo) != 0 {
+			t.Fatalf("expected no rollback, got %v", rolledBackTo)
+		}
+	})
+
+	t.Run("ahead", func(t *testing.T) {
+		err := rollbackIfNeeded("test", InitResult{Listener: listener, LastBlockPersisted: 12}, 10, nil, nil, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(rolledBackTo) != 1 || rolledBackTo[0] != 10 {
+			t.Fatalf("expected rollback to 10, got %v", rolledBackTo)
+		}
+	})
+
+	t.Run("ahead without rollback support", func(t *testing.T) {
+		err := rollbackIfNeeded("test", InitResult{LastBlockPersisted: 12}, 10, nil, nil, nil)
+		if err == nil {
+			t.Fatalf("expected error")
+		}
+	})
+
+	t.Run("rollback error", func(t *testing.T) {
+		res := InitResult{
+			Listener: appdata.Listener{
+				Rollback: func(appdata.RollbackData) error {
+					return fmt.Errorf("rollback failed")
+				},
+			},
+			LastBlockPersisted: 12,
+		}
+		err := rollbackIfNeeded("test", res, 10, nil, nil, nil)
+		if err == nil {
+			t.Fatalf("expected error")
+		}
+	})
+}
+
+type testModule struct{}
+
+func (testModule) ModuleCodec() (schema.ModuleCodec, error) {
+	modSchema, err := schema.NewModuleSchema([]schema.ObjectType{{Name: "obj", KeyFields: []schema.Field{{Name: "key", Kind: schema.StringKind}}}})
+	if err != nil {
+		return schema.ModuleCodec{}, err
+	}
+	return schema.ModuleCodec{Schema: modSchema}, nil
+}
+
+func TestStartManager(t *testing.T) {
+	var calls []string
+	Register("manager_test", func(params InitParams) (InitResult, error) {
+		return InitResult{
+			Listener: appdata.Listener{
+				InitializeModuleData: func(data appdata.ModuleInitializationData) error {
+					calls = append(calls, "init "+data.ModuleName)
+					return nil
+				},
+				StartBlock: func(data appdata.StartBlockData) error {
+					calls = append(calls, fmt.Sprintf("start %d header=%t", data.Height, data.HeaderJSON != nil))
+					return nil
+				},
+				OnKVPair: func(data appdata.KVPairData) error {
+					for _, update := range data.Updates {
+						calls = append(calls, "kv "+update.ModuleName)
+					}
+					return nil
+				},
+				OnEvent: func(data appdata.EventData) error {
+					calls = append(calls, "event")
+					return nil
+				},
+				Commit: func(data appdata.CommitData) error {
+					calls = append(calls, "commit")
+					return nil
+				},
+				Rollback: func(data appdata.RollbackData) error {
+					calls = append(calls, fmt.Sprintf("rollback %d", data.Height))
+					return nil
+				},
+			},
+			LastBlockPersisted: 12,
+		}, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	listener, err := StartManager(ManagerOptions{
+		Config: map[string]interface{}{
+			"target": map[string]interface{}{
+				"test": map[string]interface{}{
+					"type":                  "manager_test",
+					"exclude_events":        true,
+					"exclude_block_headers": true,
+					"exclude_modules":       []string{"modB"},
+				},
+			},
+		},
+		Resolver:            decoding.ModuleSetDecoderResolver(map[string]interface{}{"modA": testModule{}, "modB": testModule{}}),
+		Context:             ctx,
+		LastCommittedHeight: 10,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the modules are initialized before the indexer is rolled back to the last committed height
+	expected := []string{"init modA", "rollback 10"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Fatalf("expected %v, got %v", expected, calls)
+	}
+
+	headerJSON := func() (json.RawMessage, error) { return json.RawMessage("{}"), nil }
+	err = listener.StartBlock(appdata.StartBlockData{Height: 11, HeaderJSON: headerJSON})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if listener.OnEvent != nil {
+		t.Fatalf("expected events to be excluded")
+	}
+	err = listener.OnKVPair(appdata.KVPairData{Updates: []appdata.ModuleKVPairUpdate{
+		{ModuleName: "modA", Update: schema.KVPairUpdate{Key: []byte("a")}},
+		{ModuleName: "modB", Update: schema.KVPairUpdate{Key: []byte("b")}},
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = listener.Commit(appdata.CommitData{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// excluded data is filtered out and modA is not initialized twice
+	expected = append(expected, "start 11 header=false", "kv modA", "commit")
+	if !reflect.DeepEqual(calls, expected) {
+		t.Fatalf("expected %v, got %v", expected, calls)
+	}
+}
+
+type testSyncSource map[string]map[string]string
+
+func (s testSyncSource) IterateAllKVPairs(moduleName string, fn func(key, value []byte) error) error {
+	for key, value := range s[moduleName] {
+		if err := fn([]byte(key), []byte(value)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type testKVModule struct{}
+
+func (testKVModule) ModuleCodec() (schema.ModuleCodec, error) {
+	modSchema, err := schema.NewModuleSchema([]schema.ObjectType{{Name: "obj", KeyFields: []schema.Field{{Name: "key", Kind: schema.StringKind}}}})
+	if err != nil {
+		return schema.ModuleCodec{}, err
+	}
+	return schema.ModuleCodec{
+		Schema: modSchema,
+		KVDecoder: func(update schema.KVPairUpdate) ([]schema.ObjectUpdate, error) {
+			return []schema.ObjectUpdate{{TypeName: "obj", Key: string(update.Key), Value: string(update.Value)}}, nil
+		},
+	}, nil
+}
+
+func TestStartManager_missedBlocks(t *testing.T) {
+	var calls []string
+	Register("manager_test_missed", func(params InitParams) (InitResult, error) {
+		return InitResult{
+			Listener: appdata.Listener{
+				InitializeModuleData: func(data appdata.ModuleInitializationData) error {
+					calls = append(calls, "init "+data.ModuleName)
+					return nil
+				},
+				StartBlock: func(data appdata.StartBlockData) error {
+					calls = append(calls, fmt.Sprintf("start %d", data.Height))
+					return nil
+				},
+				OnObjectUpdate: func(data appdata.ObjectUpdateData) error {
+					for _, update := range data.Updates {
+						calls = append(calls, fmt.Sprintf("update %s %v=%v", data.ModuleName, update.Key, update.Value))
+					}
+					return nil
+				},
+				Commit: func(data appdata.CommitData) error {
+					calls = append(calls, "commit")
+					return nil
+				},
+			},
+			LastBlockPersisted: 9,
+		}, nil
+	})
+
+	opts := ManagerOptions{
+		Config: ManagerConfig{Target: map[string]Config{
+			"test": {Type: "manager_test_missed"},
+		}},
+		Resolver:            decoding.ModuleSetDecoderResolver(map[string]interface{}{"modA": testKVModule{}}),
+		LastCommittedHeight: 10,
+	}
+
+	t.Run("without sync source", func(t *testing.T) {
+		_, err := StartManager(opts)
+		if err == nil {
+			t.Fatalf("expected error")
+		}
+		if len(calls) != 0 {
+			t.Fatalf("expected no calls, got %v", calls)
+		}
+	})
+
+	t.Run("with sync source", func(t *testing.T) {
+		opts.SyncSource = testSyncSource{"modA": {"a": "1"}}
+		_, err := StartManager(opts)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// the current state is synced as part of the last committed block
+		expected := []string{"start 10", "init modA", "update modA a=1", "commit"}
+		if !reflect.DeepEqual(calls, expected) {
+			t.Fatalf("expected %v, got %v", expected, calls)
+		}
+	})
+}
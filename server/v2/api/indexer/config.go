@@ -0,0 +1,47 @@
+package indexer
+
+func DefaultConfig() *Config {
+	return &Config{
+		Enable:         false,
+		Address:        "localhost:8081",
+		DatabaseDriver: "pgx",
+		MaxLimit:       100,
+	}
+}
+
+// Config defines configuration for the indexer query server.
+type Config struct {
+	// Enable defines if the indexer query server should be enabled.
+	Enable bool `mapstructure:"enable" toml:"enable" comment:"Enable defines if the indexer query server should be enabled."`
+
+	// Address defines the address the indexer query server listens on.
+	Address string `mapstructure:"address" toml:"address" comment:"Address defines the indexer query server address to bind to."`
+
+	// DatabaseURL is the connection URL of the postgres database populated by the postgres indexer.
+	DatabaseURL string `mapstructure:"database-url" toml:"database-url" comment:"DatabaseURL is the connection URL of the postgres database populated by the postgres indexer."`
+
+	// DatabaseDriver is the name of the database/sql driver used to connect to the database.
+	// The driver must be registered by the application, the default is pgx, which is registered
+	// by importing github.com/jackc/pgx/v5/stdlib.
+	DatabaseDriver string `mapstructure:"database-driver" toml:"database-driver" comment:"DatabaseDriver is the name of the database/sql driver used to connect to the database.\nThe driver must be registered by the application, the default is pgx."`
+
+	// MaxLimit is the maximum number of objects returned by a single query.
+	MaxLimit uint64 `mapstructure:"max-limit" toml:"max-limit" comment:"MaxLimit is the maximum number of objects returned by a single query."`
+}
+
+// CfgOption is a function that allows to overwrite the default server configuration.
+type CfgOption func(*Config)
+
+// OverwriteDefaultConfig overwrites the default config with the new config.
+func OverwriteDefaultConfig(newCfg *Config) CfgOption {
+	return func(cfg *Config) {
+		*cfg = *newCfg
+	}
+}
+
+// Enable enables the indexer query server (default disabled).
+func Enable() CfgOption {
+	return func(cfg *Config) {
+		cfg.Enable = true
+	}
+}
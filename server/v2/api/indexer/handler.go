@@ -0,0 +1,344 @@
+package indexer
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/indexer/postgres"
+	"cosmossdk.io/schema"
+)
+
+const (
+	// QueryLimit is the query parameter which limits the number of returned objects.
+	QueryLimit = "pagination.limit"
+	// QueryOffset is the query parameter which skips a number of objects.
+	QueryOffset = "pagination.offset"
+
+	readHeaderTimeout = 10 * time.Second
+)
+
+// selectFunc selects the objects of the object type matching the options.
+type selectFunc func(ctx context.Context, tm *postgres.ObjectIndexer, opts postgres.SelectOptions) ([]json.RawMessage, error)
+
+// objectTable is the table of an object type in the indexer database.
+type objectTable struct {
+	typ     schema.ObjectType
+	indexer *postgres.ObjectIndexer
+}
+
+// handler serves the query API for a set of module schemas.
+type handler struct {
+	schemas      map[string]schema.ModuleSchema
+	tables       map[string]map[string]objectTable
+	addressCodec address.Codec
+	maxLimit     uint64
+	selectFn     selectFunc
+}
+
+func newHandler(schemas map[string]schema.ModuleSchema, opts postgres.Options, addressCodec address.Codec, maxLimit uint64, selectFn selectFunc) *handler {
+	tables := make(map[string]map[string]objectTable, len(schemas))
+	for moduleName, modSchema := range schemas {
+		moduleTables := map[string]objectTable{}
+		modSchema.ObjectTypes(func(typ schema.ObjectType) bool {
+			moduleTables[typ.Name] = objectTable{typ: typ, indexer: postgres.NewObjectIndexer(moduleName, typ, opts)}
+			return true
+		})
+		tables[moduleName] = moduleTables
+	}
+
+	return &handler{
+		schemas:      schemas,
+		tables:       tables,
+		addressCodec: addressCodec,
+		maxLimit:     maxLimit,
+		selectFn:     selectFn,
+	}
+}
+
+func (h *handler) router() *mux.Router {
+	r := mux.NewRouter()
+	r.HandleFunc("/indexer/v1/modules", h.listModules).Methods("GET")
+	r.HandleFunc("/indexer/v1/modules/{module}/{object_type}", h.queryObjects).Methods("GET")
+	return r
+}
+
+// fieldResponse describes a field of an object type.
+type fieldResponse struct {
+	Name       string   `json:"name"`
+	Kind       string   `json:"kind"`
+	Nullable   bool     `json:"nullable,omitempty"`
+	EnumValues []string `json:"enum_values,omitempty"`
+}
+
+// objectTypeResponse describes an object type and the fields which can be used to filter it.
+type objectTypeResponse struct {
+	Name        string          `json:"name"`
+	KeyFields   []fieldResponse `json:"key_fields"`
+	ValueFields []fieldResponse `json:"value_fields"`
+}
+
+// moduleResponse describes the object types of a module.
+type moduleResponse struct {
+	Name        string               `json:"name"`
+	ObjectTypes []objectTypeResponse `json:"object_types"`
+}
+
+// objectsResponse contains the objects matching a query. NextOffset is set when more objects are available.
+type objectsResponse struct {
+	Objects    []json.RawMessage `json:"objects"`
+	NextOffset *uint64           `json:"next_offset,omitempty"`
+}
+
+// errorResponse defines the attributes of a JSON error response.
+type errorResponse struct {
+	Code  int    `json:"code,omitempty"`
+	Error string `json:"error"`
+}
+
+func (h *handler) listModules(w http.ResponseWriter, _ *http.Request) {
+	moduleNames := make([]string, 0, len(h.schemas))
+	for moduleName := range h.schemas {
+		moduleNames = append(moduleNames, moduleName)
+	}
+	sort.Strings(moduleNames)
+
+	modules := make([]moduleResponse, 0, len(moduleNames))
+	for _, moduleName := range moduleNames {
+		mod := moduleResponse{Name: moduleName, ObjectTypes: []objectTypeResponse{}}
+		h.schemas[moduleName].ObjectTypes(func(typ schema.ObjectType) bool {
+			mod.ObjectTypes = append(mod.ObjectTypes, objectTypeResponse{
+				Name:        typ.Name,
+				KeyFields:   fieldResponses(typ.KeyFields),
+				ValueFields: fieldResponses(typ.ValueFields),
+			})
+			return true
+		})
+		modules = append(modules, mod)
+	}
+
+	writeJSON(w, http.StatusOK, map[string][]moduleResponse{"modules": modules})
+}
+
+func (h *handler) queryObjects(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	table, ok := h.tables[vars["module"]][vars["object_type"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("unknown object type %s/%s", vars["module"], vars["object_type"]))
+		return
+	}
+
+	opts, err := h.selectOptions(table.typ, r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// one more object than requested is selected to find out if there is a next page
+	limit := opts.Limit
+	opts.Limit++
+	objects, err := h.selectFn(r.Context(), table.indexer, opts)
+	if err != nil {
+		code := http.StatusInternalServerError
+		if errors.As(err, &postgres.BlockOutOfRangeError{}) {
+			code = http.StatusBadRequest
+		}
+		writeError(w, code, err)
+		return
+	}
+
+	res := objectsResponse{Objects: objects}
+	if uint64(len(objects)) > limit {
+		nextOffset := opts.Offset + limit
+		res.Objects = objects[:limit]
+		res.NextOffset = &nextOffset
+	}
+	if res.Objects == nil {
+		res.Objects = []json.RawMessage{}
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+// selectOptions parses the key field filters, the pagination parameters and the block height header of the request.
+func (h *handler) selectOptions(typ schema.ObjectType, r *http.Request) (postgres.SelectOptions, error) {
+	query := r.URL.Query()
+	opts := postgres.SelectOptions{Limit: h.maxLimit}
+
+	if limitStr := query.Get(QueryLimit); limitStr != "" {
+		limit, err := strconv.ParseUint(limitStr, 10, 64)
+		if err != nil {
+			return opts, fmt.Errorf("invalid %s: %w", QueryLimit, err)
+		}
+		if limit != 0 && limit < h.maxLimit {
+			opts.Limit = limit
+		}
+	}
+
+	if offsetStr := query.Get(QueryOffset); offsetStr != "" {
+		offset, err := strconv.ParseUint(offsetStr, 10, 64)
+		if err != nil {
+			return opts, fmt.Errorf("invalid %s: %w", QueryOffset, err)
+		}
+		opts.Offset = offset
+	}
+
+	if heightStr := r.Header.Get(BlockHeightHeader); heightStr != "" {
+		height, err := strconv.ParseUint(heightStr, 10, 64)
+		if err != nil {
+			return opts, fmt.Errorf("invalid %s header: %w", BlockHeightHeader, err)
+		}
+		opts.AsOfBlock = height
+	}
+
+	for name := range query {
+		if name == QueryLimit || name == QueryOffset {
+			continue
+		}
+
+		if !isKeyField(typ, name) {
+			return opts, fmt.Errorf("unknown filter %s, only key fields of %s can be used as filters", name, typ.Name)
+		}
+	}
+
+	for _, field := range typ.KeyFields {
+		valueStr := query.Get(field.Name)
+		if valueStr == "" {
+			continue
+		}
+
+		value, err := h.parseValue(field, valueStr)
+		if err != nil {
+			return opts, fmt.Errorf("invalid value for key field %s: %w", field.Name, err)
+		}
+
+		if opts.KeyFilter == nil {
+			opts.KeyFilter = map[string]interface{}{}
+		}
+		opts.KeyFilter[field.Name] = value
+	}
+
+	return opts, nil
+}
+
+// parseValue parses a query parameter into a value of the kind of the field.
+func (h *handler) parseValue(field schema.Field, value string) (interface{}, error) {
+	var (
+		res interface{}
+		err error
+	)
+	switch field.Kind {
+	case schema.StringKind, schema.IntegerStringKind, schema.DecimalStringKind, schema.EnumKind:
+		res = value
+	case schema.BytesKind:
+		res, err = base64.StdEncoding.DecodeString(value)
+	case schema.AddressKind:
+		if h.addressCodec != nil {
+			res, err = h.addressCodec.StringToBytes(value)
+		} else {
+			res, err = hex.DecodeString(value)
+		}
+	case schema.Int8Kind:
+		var x int64
+		x, err = strconv.ParseInt(value, 10, 8)
+		res = int8(x)
+	case schema.Uint8Kind:
+		var x uint64
+		x, err = strconv.ParseUint(value, 10, 8)
+		res = uint8(x)
+	case schema.Int16Kind:
+		var x int64
+		x, err = strconv.ParseInt(value, 10, 16)
+		res = int16(x)
+	case schema.Uint16Kind:
+		var x uint64
+		x, err = strconv.ParseUint(value, 10, 16)
+		res = uint16(x)
+	case schema.Int32Kind:
+		var x int64
+		x, err = strconv.ParseInt(value, 10, 32)
+		res = int32(x)
+	case schema.Uint32Kind:
+		var x uint64
+		x, err = strconv.ParseUint(value, 10, 32)
+		res = uint32(x)
+	case schema.Int64Kind:
+		res, err = strconv.ParseInt(value, 10, 64)
+	case schema.Uint64Kind:
+		res, err = strconv.ParseUint(value, 10, 64)
+	case schema.BoolKind:
+		res, err = strconv.ParseBool(value)
+	case schema.TimeKind:
+		res, err = time.Parse(time.RFC3339Nano, value)
+	case schema.DurationKind:
+		res, err = time.ParseDuration(value)
+	case schema.Float32Kind:
+		var x float64
+		x, err = strconv.ParseFloat(value, 32)
+		res = float32(x)
+	case schema.Float64Kind:
+		res, err = strconv.ParseFloat(value, 64)
+	default:
+		return nil, fmt.Errorf("filtering by %s fields is not supported", field.Kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return res, field.ValidateValue(res)
+}
+
+func isKeyField(typ schema.ObjectType, name string) bool {
+	for _, field := range typ.KeyFields {
+		if field.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func fieldResponses(fields []schema.Field) []fieldResponse {
+	res := make([]fieldResponse, len(fields))
+	for i, field := range fields {
+		res[i] = fieldResponse{
+			Name:       field.Name,
+			Kind:       field.Kind.String(),
+			Nullable:   field.Nullable,
+			EnumValues: field.EnumType.Values,
+		}
+	}
+	return res
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(bz)
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	bz, err := json.Marshal(errorResponse{Code: code, Error: err.Error()})
+	if err != nil {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(bz)
+}
@@ -0,0 +1,153 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/indexer/postgres"
+	"cosmossdk.io/schema"
+)
+
+var testSchema = func() schema.ModuleSchema {
+	s, err := schema.NewModuleSchema([]schema.ObjectType{
+		{
+			Name: "balance",
+			KeyFields: []schema.Field{
+				{Name: "address", Kind: schema.AddressKind},
+				{Name: "denom", Kind: schema.StringKind},
+			},
+			ValueFields: []schema.Field{
+				{Name: "amount", Kind: schema.IntegerStringKind},
+			},
+		},
+		{
+			Name: "params",
+			ValueFields: []schema.Field{
+				{Name: "enabled", Kind: schema.BoolKind},
+			},
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return s
+}()
+
+type selectCall struct {
+	table string
+	opts  postgres.SelectOptions
+}
+
+func setupHandler(t *testing.T, numObjects int) (http.Handler, *[]selectCall) {
+	t.Helper()
+	var calls []selectCall
+	h := newHandler(map[string]schema.ModuleSchema{"bank": testSchema}, postgres.Options{}, nil, 2,
+		func(_ context.Context, tm *postgres.ObjectIndexer, opts postgres.SelectOptions) ([]json.RawMessage, error) {
+			calls = append(calls, selectCall{table: tm.TableName(), opts: opts})
+			var res []json.RawMessage
+			for i := int(opts.Offset); i < numObjects && uint64(len(res)) < opts.Limit; i++ {
+				res = append(res, json.RawMessage(fmt.Sprintf(`{"n":%d}`, i)))
+			}
+			return res, nil
+		})
+	return h.router(), &calls
+}
+
+func doRequest(t *testing.T, h http.Handler, url string, header http.Header) (int, map[string]any) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, url, nil)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	var res map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	return rec.Code, res
+}
+
+func TestListModules(t *testing.T) {
+	h, _ := setupHandler(t, 0)
+	code, res := doRequest(t, h, "/indexer/v1/modules", nil)
+	require.Equal(t, http.StatusOK, code)
+
+	bz, err := json.Marshal(res)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"modules":[{"name":"bank","object_types":[
+		{"name":"balance","key_fields":[{"name":"address","kind":"address"},{"name":"denom","kind":"string"}],"value_fields":[{"name":"amount","kind":"integer"}]},
+		{"name":"params","key_fields":[],"value_fields":[{"name":"enabled","kind":"bool"}]}
+	]}]}`, string(bz))
+}
+
+func TestQueryObjects(t *testing.T) {
+	h, calls := setupHandler(t, 3)
+
+	code, res := doRequest(t, h, "/indexer/v1/modules/bank/balance?address=0102&denom=foo", http.Header{
+		"X-Cosmos-Block-Height": []string{"5"},
+	})
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, res["objects"], 2)
+	require.Equal(t, float64(2), res["next_offset"])
+	require.Equal(t, selectCall{
+		table: "bank_balance",
+		opts: postgres.SelectOptions{
+			KeyFilter: map[string]interface{}{"address": []byte{1, 2}, "denom": "foo"},
+			Limit:     3,
+			AsOfBlock: 5,
+		},
+	}, (*calls)[0])
+
+	code, res = doRequest(t, h, "/indexer/v1/modules/bank/balance?pagination.offset=2&pagination.limit=1", nil)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, []any{map[string]any{"n": float64(2)}}, res["objects"])
+	require.NotContains(t, res, "next_offset")
+	require.Equal(t, postgres.SelectOptions{Limit: 2, Offset: 2}, (*calls)[1].opts)
+
+	code, res = doRequest(t, h, "/indexer/v1/modules/bank/balance?pagination.offset=10", nil)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, []any{}, res["objects"])
+}
+
+func TestQueryObjectsErrors(t *testing.T) {
+	h, calls := setupHandler(t, 1)
+
+	testCases := []struct {
+		name string
+		url  string
+		code int
+	}{
+		{"unknown module", "/indexer/v1/modules/staking/balance", http.StatusNotFound},
+		{"unknown object type", "/indexer/v1/modules/bank/supply", http.StatusNotFound},
+		{"value field filter", "/indexer/v1/modules/bank/balance?amount=1", http.StatusBadRequest},
+		{"invalid address", "/indexer/v1/modules/bank/balance?address=xyz", http.StatusBadRequest},
+		{"invalid limit", "/indexer/v1/modules/bank/balance?pagination.limit=-1", http.StatusBadRequest},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			code, res := doRequest(t, h, tc.url, nil)
+			require.Equal(t, tc.code, code)
+			require.NotEmpty(t, res["error"])
+		})
+	}
+	require.Empty(t, *calls)
+}
+
+func TestQueryObjectsBlockOutOfRange(t *testing.T) {
+	h := newHandler(map[string]schema.ModuleSchema{"bank": testSchema}, postgres.Options{}, nil, 2,
+		func(_ context.Context, _ *postgres.ObjectIndexer, opts postgres.SelectOptions) ([]json.RawMessage, error) {
+			return nil, postgres.BlockOutOfRangeError{Height: opts.AsOfBlock, Oldest: 3, Latest: 5}
+		}).router()
+
+	code, res := doRequest(t, h, "/indexer/v1/modules/bank/balance", http.Header{
+		"X-Cosmos-Block-Height": []string{"2"},
+	})
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Equal(t, "block 2 is out of the range of retained blocks [3, 5]", res["error"])
+}
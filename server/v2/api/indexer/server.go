@@ -0,0 +1,166 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/indexer/postgres"
+	"cosmossdk.io/log"
+	"cosmossdk.io/schema"
+	"cosmossdk.io/schema/decoding"
+	serverv2 "cosmossdk.io/server/v2"
+)
+
+var _ serverv2.ServerComponent[transaction.Tx] = (*Server[transaction.Tx])(nil)
+
+const (
+	ServerName = "indexer-api"
+
+	// BlockHeightHeader is the HTTP header used to query objects as of a block height.
+	BlockHeightHeader = "x-cosmos-block-height"
+)
+
+// Server serves the data written by the postgres indexer over a JSON-over-HTTP query API
+// which is generated from the module schemas.
+type Server[T transaction.Tx] struct {
+	logger     log.Logger
+	config     *Config
+	cfgOptions []CfgOption
+
+	resolver     decoding.DecoderResolver
+	addressCodec address.Codec
+
+	db      *sql.DB
+	httpSrv *http.Server
+}
+
+// New creates a new indexer query server. The module schemas are discovered with the resolver and
+// addresses are encoded and decoded with the address codec, which may be nil to use hex.
+func New[T transaction.Tx](resolver decoding.DecoderResolver, addressCodec address.Codec, cfgOptions ...CfgOption) *Server[T] {
+	return &Server[T]{
+		resolver:     resolver,
+		addressCodec: addressCodec,
+		cfgOptions:   cfgOptions,
+	}
+}
+
+func (s *Server[T]) Name() string {
+	return ServerName
+}
+
+func (s *Server[T]) Config() any {
+	if s.config == nil || s.config == (&Config{}) {
+		cfg := DefaultConfig()
+		// overwrite the default config with the provided options
+		for _, opt := range s.cfgOptions {
+			opt(cfg)
+		}
+
+		return cfg
+	}
+
+	return s.config
+}
+
+// Init opens the database and builds the query handlers for all module schemas.
+// Note, the caller is responsible for starting the server.
+func (s *Server[T]) Init(appI serverv2.AppI[T], v *viper.Viper, logger log.Logger) error {
+	cfg := s.Config().(*Config)
+	if v != nil {
+		if err := serverv2.UnmarshalSubConfig(v, s.Name(), &cfg); err != nil {
+			return fmt.Errorf("failed to unmarshal config: %w", err)
+		}
+	}
+
+	s.config = cfg
+	s.logger = logger.With(log.ModuleKey, s.Name())
+
+	if !cfg.Enable {
+		return nil
+	}
+
+	if cfg.DatabaseURL == "" {
+		return errors.New("database url is required when the indexer query server is enabled")
+	}
+
+	schemas := map[string]schema.ModuleSchema{}
+	err := s.resolver.IterateAll(func(moduleName string, cdc schema.ModuleCodec) error {
+		schemas[moduleName] = cdc.Schema
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to resolve module schemas: %w", err)
+	}
+
+	db, err := sql.Open(cfg.DatabaseDriver, cfg.DatabaseURL)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+
+	var opts postgres.Options
+	if s.addressCodec != nil {
+		opts.AddressCodec = s.addressCodec
+	}
+	h := newHandler(schemas, opts, s.addressCodec, cfg.MaxLimit, func(ctx context.Context, tm *postgres.ObjectIndexer, selectOpts postgres.SelectOptions) ([]json.RawMessage, error) {
+		return tm.Select(ctx, db, selectOpts)
+	})
+
+	s.db = db
+	s.httpSrv = &http.Server{
+		Addr:              cfg.Address,
+		Handler:           h.router(),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	return nil
+}
+
+func (s *Server[T]) StartCmdFlags() *pflag.FlagSet {
+	flags := pflag.NewFlagSet(s.Name(), pflag.ExitOnError)
+	flags.String(FlagAddress, "localhost:8081", "Listen address")
+	flags.String(FlagDatabaseURL, "", "Connection URL of the postgres indexer database")
+	return flags
+}
+
+func (s *Server[T]) Start(ctx context.Context) error {
+	if !s.config.Enable {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", s.config.Address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on address %s: %w", s.config.Address, err)
+	}
+
+	s.logger.Info("starting indexer query server...", "address", s.config.Address)
+	err = s.httpSrv.Serve(listener)
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		s.logger.Error("failed to start indexer query server", "err", err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *Server[T]) Stop(ctx context.Context) error {
+	if !s.config.Enable {
+		return nil
+	}
+
+	s.logger.Info("stopping indexer query server...", "address", s.config.Address)
+	if err := s.httpSrv.Shutdown(ctx); err != nil {
+		return err
+	}
+
+	return s.db.Close()
+}
@@ -182,6 +182,13 @@ func (c *Consensus[T]) Query(ctx context.Context, req *abciproto.QueryRequest) (
 		if err != nil {
 			return nil, fmt.Errorf("unable to decode gRPC request with path %s from ABCI.Query: %w", req.Path, err)
 		}
+		if req.Prove {
+			resp, err = c.handleProvenQueryGRPC(ctx, protoRequest, req)
+			if err != nil {
+				return QueryResult(err, c.cfg.AppTomlConfig.Trace), nil
+			}
+			return resp, nil
+		}
 		res, err := c.app.Query(ctx, uint64(req.Height), protoRequest)
 		if err != nil {
 			resp := queryResult(err)
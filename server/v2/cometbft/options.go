@@ -16,6 +16,8 @@ type ServerOptions[T transaction.Tx] struct {
 	VerifyVoteExtensionHandler handlers.VerifyVoteExtensionhandler
 	ExtendVoteHandler          handlers.ExtendVoteHandler
 
+	// SnapshotOptions are the default snapshot options, overridden by the
+	// snapshots section of the store config.
 	SnapshotOptions snapshots.SnapshotOptions
 
 	AddrPeerFilter types.PeerFilter // filter peers by address and port
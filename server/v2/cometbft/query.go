@@ -1,15 +1,27 @@
 package cometbft
 
 import (
+	"bytes"
 	"context"
+	"slices"
 	"strings"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	crypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
 
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/server/v2/cometbft/types"
 	cometerrors "cosmossdk.io/server/v2/cometbft/types/errors"
+	storev2 "cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/proof"
+)
+
+// Store query paths of the batch and range proofs.
+const (
+	queryPathKeys     = "keys"
+	queryPathSubspace = "subspace"
 )
 
 func (c *Consensus[T]) handleQueryP2P(path []string) (*abci.QueryResponse, error) {
@@ -96,6 +108,15 @@ func (c *Consensus[T]) handleQueryStore(path []string, _ types.Store, req *abci.
 	// "/store/<storeName>" for store queries
 	storeName := path[1]
 	storeNameBz := []byte(storeName) // TODO fastpath?
+
+	// "/store/<storeName>/keys" and "/store/<storeName>/subspace" for batch and range proofs
+	if len(path) > 2 {
+		switch path[2] {
+		case queryPathKeys, queryPathSubspace:
+			return c.handleQueryStoreBatchProof(path[2], storeNameBz, req)
+		}
+	}
+
 	qRes, err := c.store.Query(storeNameBz, uint64(req.Height), req.Data, req.Prove)
 	if err != nil {
 		return nil, err
@@ -109,23 +130,244 @@ func (c *Consensus[T]) handleQueryStore(path []string, _ types.Store, req *abci.
 	}
 
 	if req.Prove {
-		for _, proof := range qRes.ProofOps {
-			bz, err := proof.Proof.Marshal()
-			if err != nil {
-				return nil, errorsmod.Wrap(err, "failed to marshal proof")
-			}
-
-			res.ProofOps = &crypto.ProofOps{
-				Ops: []crypto.ProofOp{
-					{
-						Type: proof.Type,
-						Key:  proof.Key,
-						Data: bz,
-					},
-				},
-			}
+		res.ProofOps, err = intoABCIProofOps(qRes.ProofOps)
+		if err != nil {
+			return nil, err
 		}
 	}
 
 	return res, nil
 }
+
+// handleQueryStoreBatchProof handles the batch proof queries of a store, which must
+// be proven. The proven values are returned in the proof ops only:
+//   - "keys": the data are at most proof.MaxBatchProofKeys keys encoded with
+//     proof.EncodeKeys, proven by a batch proof to be verified with
+//     proof.VerifyBatchProof.
+//   - "subspace": the data is a range query encoded with proof.EncodeRangeQuery,
+//     the keys with its non-empty prefix are proven from its start key by a range
+//     proof to be verified with proof.VerifyRangeProof, up to proof.PrefixEndBytes
+//     of the prefix. At most the limit of the query, capped to
+//     proof.MaxBatchProofKeys, keys are proven: the value of the response is the
+//     start key of the next page, which the proven range ends before, if any.
+func (c *Consensus[T]) handleQueryStoreBatchProof(queryPath string, storeKey []byte, req *abci.QueryRequest) (*abci.QueryResponse, error) {
+	if !req.Prove {
+		return nil, errorsmod.Wrapf(cometerrors.ErrInvalidRequest, "%s queries must be proven", queryPath)
+	}
+	prover, ok := c.store.GetStateCommitment().(storev2.BatchProver)
+	if !ok {
+		return nil, errorsmod.Wrap(cometerrors.ErrInvalidRequest, "the state commitment does not support batch proofs")
+	}
+
+	var (
+		ops  []proof.CommitmentOp
+		next []byte
+		err  error
+	)
+	switch queryPath {
+	case queryPathKeys:
+		keys, decodeErr := proof.DecodeKeys(req.Data)
+		if decodeErr != nil {
+			return nil, errorsmod.Wrap(cometerrors.ErrInvalidRequest, decodeErr.Error())
+		}
+		if len(keys) == 0 || len(keys) > proof.MaxBatchProofKeys {
+			return nil, errorsmod.Wrapf(cometerrors.ErrInvalidRequest, "the number of keys must be between 1 and %d, got %d", proof.MaxBatchProofKeys, len(keys))
+		}
+		ops, err = prover.GetBatchProof(storeKey, uint64(req.Height), keys)
+	default:
+		prefix, start, limit, decodeErr := proof.DecodeRangeQuery(req.Data)
+		if decodeErr != nil {
+			return nil, errorsmod.Wrap(cometerrors.ErrInvalidRequest, decodeErr.Error())
+		}
+		if len(prefix) == 0 {
+			return nil, errorsmod.Wrap(cometerrors.ErrInvalidRequest, "the prefix of a subspace query cannot be empty")
+		}
+		if len(start) == 0 {
+			start = prefix
+		}
+		if !bytes.HasPrefix(start, prefix) {
+			return nil, errorsmod.Wrapf(cometerrors.ErrInvalidRequest, "the start key %X does not have the prefix %X", start, prefix)
+		}
+		if limit == 0 || limit > proof.MaxBatchProofKeys {
+			limit = proof.MaxBatchProofKeys
+		}
+		ops, next, err = prover.GetRangeProof(storeKey, uint64(req.Height), start, proof.PrefixEndBytes(prefix), int(limit))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	proofOps, err := intoABCIProofOps(ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &abci.QueryResponse{
+		Codespace: cometerrors.RootCodespace,
+		Height:    req.Height,
+		Key:       req.Data,
+		Value:     next,
+		ProofOps:  proofOps,
+	}, nil
+}
+
+// handleProvenQueryGRPC runs a gRPC query on the state of the requested height, the latest one if it
+// is zero, and returns its response along with the proofs of the values it read, see proveReads.
+func (c *Consensus[T]) handleProvenQueryGRPC(ctx context.Context, protoRequest transaction.Msg, req *abci.QueryRequest) (*abci.QueryResponse, error) {
+	version := uint64(req.Height)
+	if version == 0 {
+		var err error
+		version, err = c.store.GetLatestVersion()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if version <= 1 {
+		return nil, errorsmod.Wrap(
+			cometerrors.ErrInvalidRequest,
+			"cannot query with proof when height <= 1; please provide a valid height",
+		)
+	}
+
+	var res transaction.Msg
+	proofOps, err := proveReads(c.store, version, func(state corestore.ReaderMap) (err error) {
+		res, err = c.app.QueryWithState(ctx, state, protoRequest)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := queryResponse(res, int64(version))
+	if err != nil {
+		return nil, err
+	}
+	resp.ProofOps = proofOps
+	return resp, nil
+}
+
+// proveReads runs the query on the state of the version, while recording the keys it reads, and returns
+// the proofs of the recorded keys, including the keys which were not found. The proofs of the stores are
+// concatenated, in the order of the store keys, and the proof of each store is a CommitmentOp chain up to
+// the app hash of the version: the proof of the key in the store tree, or the batch proof of the keys if
+// several keys were read, followed by the proof of the store hash in the commit info.
+// Queries iterating over a store can't be proven, as the proofs of the keys don't prove that no other key
+// was in the iterated range.
+func proveReads(st types.Store, version uint64, query func(corestore.ReaderMap) error) (*crypto.ProofOps, error) {
+	state, err := st.StateAt(version)
+	if err != nil {
+		return nil, err
+	}
+
+	recorder := &readRecorder{state: state, reads: map[string][][]byte{}}
+	if err := query(recorder); err != nil {
+		return nil, err
+	}
+	if recorder.iterated {
+		return nil, errorsmod.Wrap(cometerrors.ErrInvalidRequest, "queries iterating over a store cannot be proven")
+	}
+
+	storeKeys := make([]string, 0, len(recorder.reads))
+	for storeKey := range recorder.reads {
+		storeKeys = append(storeKeys, storeKey)
+	}
+	slices.Sort(storeKeys)
+
+	var ops []proof.CommitmentOp
+	for _, storeKey := range storeKeys {
+		keys := recorder.reads[storeKey]
+		slices.SortFunc(keys, bytes.Compare)
+		keys = slices.CompactFunc(keys, bytes.Equal)
+
+		storeOps, err := proveKeys(st, []byte(storeKey), version, keys)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, storeOps...)
+	}
+
+	return intoABCIProofOps(ops)
+}
+
+// proveKeys returns the proof of the keys of a store at the version.
+func proveKeys(st types.Store, storeKey []byte, version uint64, keys [][]byte) ([]proof.CommitmentOp, error) {
+	if len(keys) == 1 {
+		qRes, err := st.Query(storeKey, version, keys[0], true)
+		if err != nil {
+			return nil, err
+		}
+		return qRes.ProofOps, nil
+	}
+
+	if len(keys) > proof.MaxBatchProofKeys {
+		return nil, errorsmod.Wrapf(cometerrors.ErrInvalidRequest, "the query read more than %d keys of store %s", proof.MaxBatchProofKeys, storeKey)
+	}
+	prover, ok := st.GetStateCommitment().(storev2.BatchProver)
+	if !ok {
+		return nil, errorsmod.Wrap(cometerrors.ErrInvalidRequest, "the state commitment does not support batch proofs")
+	}
+	return prover.GetBatchProof(storeKey, version, keys)
+}
+
+// readRecorder is a ReaderMap recording the keys read from the stores of the state.
+type readRecorder struct {
+	state    corestore.ReaderMap
+	reads    map[string][][]byte
+	iterated bool
+}
+
+func (r *readRecorder) GetReader(actor []byte) (corestore.Reader, error) {
+	reader, err := r.state.GetReader(actor)
+	if err != nil {
+		return nil, err
+	}
+	return recordingReader{Reader: reader, storeKey: string(actor), recorder: r}, nil
+}
+
+// recordingReader records the keys read from a store in its readRecorder.
+type recordingReader struct {
+	corestore.Reader
+	storeKey string
+	recorder *readRecorder
+}
+
+func (r recordingReader) record(key []byte) {
+	r.recorder.reads[r.storeKey] = append(r.recorder.reads[r.storeKey], bytes.Clone(key))
+}
+
+func (r recordingReader) Has(key []byte) (bool, error) {
+	r.record(key)
+	return r.Reader.Has(key)
+}
+
+func (r recordingReader) Get(key []byte) ([]byte, error) {
+	r.record(key)
+	return r.Reader.Get(key)
+}
+
+func (r recordingReader) Iterator(start, end []byte) (corestore.Iterator, error) {
+	r.recorder.iterated = true
+	return r.Reader.Iterator(start, end)
+}
+
+func (r recordingReader) ReverseIterator(start, end []byte) (corestore.Iterator, error) {
+	r.recorder.iterated = true
+	return r.Reader.ReverseIterator(start, end)
+}
+
+func intoABCIProofOps(ops []proof.CommitmentOp) (*crypto.ProofOps, error) {
+	proofOps := &crypto.ProofOps{Ops: make([]crypto.ProofOp, 0, len(ops))}
+	for _, op := range ops {
+		bz, err := op.Proof.Marshal()
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to marshal proof")
+		}
+
+		proofOps.Ops = append(proofOps.Ops, crypto.ProofOp{
+			Type: op.Type,
+			Key:  op.Key,
+			Data: bz,
+		})
+	}
+	return proofOps, nil
+}
@@ -0,0 +1,128 @@
+package cometbft
+
+import (
+	"testing"
+
+	crypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/stretchr/testify/require"
+
+	corestore "cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/proof"
+	"cosmossdk.io/store/v2/pruning"
+	"cosmossdk.io/store/v2/root"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/sqlite"
+)
+
+// commitmentOp decodes the CommitmentOp of an ABCI proof op.
+func commitmentOp(t *testing.T, op crypto.ProofOp) proof.CommitmentOp {
+	t.Helper()
+	commitmentProof := &ics23.CommitmentProof{}
+	require.NoError(t, commitmentProof.Unmarshal(op.Data))
+	spec := ics23.IavlSpec
+	if op.Type == proof.ProofOpSimpleMerkleCommitment {
+		spec = proof.SimpleMerkleSpec
+	}
+	return proof.CommitmentOp{Type: op.Type, Spec: spec, Key: op.Key, Proof: commitmentProof}
+}
+
+func TestProveReads(t *testing.T) {
+	logger := coretesting.NewNopLogger()
+	sqliteDB, err := sqlite.New(t.TempDir())
+	require.NoError(t, err)
+	ss := storage.NewStorageStore(sqliteDB, logger)
+	sc, err := commitment.NewCommitStore(map[string]commitment.Tree{
+		"bank": iavl.NewIavlTree(dbm.NewMemDB(), logger, iavl.DefaultConfig()),
+		"stf":  iavl.NewIavlTree(dbm.NewMemDB(), logger, iavl.DefaultConfig()),
+	}, dbm.NewMemDB(), logger)
+	require.NoError(t, err)
+	st, err := root.New(logger, ss, sc, pruning.NewManager(sc, ss, nil, nil), nil, nil)
+	require.NoError(t, err)
+
+	for version := 1; version <= 2; version++ {
+		cs := corestore.NewChangeset()
+		cs.Add([]byte("bank"), []byte("alice"), []byte{byte(version)}, false)
+		cs.Add([]byte("bank"), []byte("bob"), []byte("100"), false)
+		cs.Add([]byte("stf"), []byte("header"), []byte("header"), false)
+		_, err = st.Commit(cs)
+		require.NoError(t, err)
+	}
+	commitInfo, err := sc.GetCommitInfo(2)
+	require.NoError(t, err)
+	appHash := commitInfo.Hash()
+
+	readKeys := func(reads map[string][]string) func(corestore.ReaderMap) error {
+		return func(state corestore.ReaderMap) error {
+			for storeKey, keys := range reads {
+				reader, err := state.GetReader([]byte(storeKey))
+				if err != nil {
+					return err
+				}
+				for _, key := range keys {
+					if _, err := reader.Get([]byte(key)); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}
+	}
+
+	// the proofs of the stores are sorted by store key, the bank store proves several keys, one of
+	// which is absent, in a batch, and the stf store proves its single key
+	proofOps, err := proveReads(st, 2, readKeys(map[string][]string{
+		"stf":  {"header"},
+		"bank": {"bob", "alice", "carol", "alice"},
+	}))
+	require.NoError(t, err)
+	ops := make([]proof.CommitmentOp, len(proofOps.Ops))
+	for i, op := range proofOps.Ops {
+		ops[i] = commitmentOp(t, op)
+	}
+	require.Len(t, ops, 4)
+	require.Equal(t, proof.ProofOpIAVLBatchCommitment, ops[0].Type)
+	require.Equal(t, []byte("bank"), ops[1].Key)
+
+	values, err := proof.VerifyBatchProof(ops[:2], appHash, [][]byte{[]byte("alice"), []byte("bob"), []byte("carol")})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{{2}, []byte("100"), nil}, values)
+
+	require.Equal(t, proof.ProofOpIAVLCommitment, ops[2].Type)
+	require.Equal(t, []byte("stf"), ops[3].Key)
+	roots, err := ops[2].Run([][]byte{[]byte("header")})
+	require.NoError(t, err)
+	roots, err = ops[3].Run(roots)
+	require.NoError(t, err)
+	require.Equal(t, appHash, roots[0])
+
+	// a single absent key is proven by a proof of non-existence
+	proofOps, err = proveReads(st, 2, readKeys(map[string][]string{"bank": {"carol"}}))
+	require.NoError(t, err)
+	require.Len(t, proofOps.Ops, 2)
+	nonExistence := commitmentOp(t, proofOps.Ops[0])
+	storeProof := commitmentOp(t, proofOps.Ops[1])
+	roots, err = nonExistence.Run(nil)
+	require.NoError(t, err)
+	roots, err = storeProof.Run(roots)
+	require.NoError(t, err)
+	require.Equal(t, appHash, roots[0])
+
+	// iterating queries can't be proven
+	_, err = proveReads(st, 2, func(state corestore.ReaderMap) error {
+		reader, err := state.GetReader([]byte("bank"))
+		if err != nil {
+			return err
+		}
+		it, err := reader.Iterator(nil, nil)
+		if err != nil {
+			return err
+		}
+		return it.Close()
+	})
+	require.ErrorContains(t, err, "iterating")
+}
@@ -69,6 +69,17 @@ func (s *CometBFTServer[T]) Init(appI serverv2.AppI[T], v *viper.Viper, logger l
 		AppTomlConfig:    appTomlConfig,
 	}
 
+	// the snapshot options are configured in the store config, shared with the snapshots commands
+	if v != nil {
+		storeCfg := struct {
+			Snapshots snapshots.SnapshotOptions `mapstructure:"snapshots"`
+		}{Snapshots: s.serverOptions.SnapshotOptions}
+		if err := serverv2.UnmarshalSubConfig(v, "store", &storeCfg); err != nil {
+			return fmt.Errorf("failed to unmarshal store config: %w", err)
+		}
+		s.serverOptions.SnapshotOptions = storeCfg.Snapshots
+	}
+
 	chainID := v.GetString(FlagChainID)
 	if chainID == "" {
 		// fallback to genesis chain-id
@@ -2,16 +2,19 @@ package store
 
 import (
 	"cosmossdk.io/store/v2/root"
+	"cosmossdk.io/store/v2/snapshots"
 )
 
 func DefaultConfig() *Config {
 	return &Config{
 		AppDBBackend: "goleveldb",
 		Options:      root.DefaultStoreOptions(),
+		Snapshots:    snapshots.NewSnapshotOptions(0, 0),
 	}
 }
 
 type Config struct {
-	AppDBBackend string       `mapstructure:"app-db-backend" toml:"app-db-backend" comment:"The type of database for application and snapshots databases."`
-	Options      root.Options `mapstructure:"options" toml:"options"`
+	AppDBBackend string                    `mapstructure:"app-db-backend" toml:"app-db-backend" comment:"The type of database for application and snapshots databases."`
+	Options      root.Options              `mapstructure:"options" toml:"options"`
+	Snapshots    snapshots.SnapshotOptions `mapstructure:"snapshots" toml:"snapshots" comment:"Options of the state sync snapshots, taken by the consensus server and the snapshots commands."`
 }
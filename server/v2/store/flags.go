@@ -3,4 +3,6 @@ package store
 const (
 	FlagAppDBBackend      = "app-db-backend"
 	FlagPruningKeepRecent = "keep-recent"
+	FlagHeight            = "height"
+	FlagDelta             = "delta"
 )
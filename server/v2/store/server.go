@@ -13,7 +13,7 @@ import (
 )
 
 // StoreComponent manages store config
-// and contains prune & snapshot commands
+// and contains prune & snapshots commands
 type StoreComponent[T transaction.Tx] struct {
 	config *Config
 }
@@ -48,6 +48,7 @@ func (s *StoreComponent[T]) Stop(ctx context.Context) error {
 func (s *StoreComponent[T]) GetCommands() []*cobra.Command {
 	return []*cobra.Command{
 		s.PrunesCmd(),
+		s.SnapshotsCmd(),
 	}
 }
 
@@ -63,6 +64,7 @@ func (s *StoreComponent[T]) CLICommands() serverv2.CLIConfig {
 	return serverv2.CLIConfig{
 		Commands: []*cobra.Command{
 			s.PrunesCmd(),
+			s.SnapshotsCmd(),
 		},
 	}
 }
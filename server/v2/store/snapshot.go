@@ -0,0 +1,209 @@
+package store
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	serverv2 "cosmossdk.io/server/v2"
+	storev2 "cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/snapshots"
+	"cosmossdk.io/store/v2/snapshots/types"
+)
+
+// SnapshotsCmd returns the snapshots group command
+func (s *StoreComponent[T]) SnapshotsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage local snapshots",
+	}
+	cmd.AddCommand(
+		s.ListSnapshotsCmd(),
+		s.ExportSnapshotCmd(),
+		s.RestoreSnapshotCmd(),
+	)
+	return cmd
+}
+
+// ListSnapshotsCmd returns the command to list local snapshots
+func (s *StoreComponent[T]) ListSnapshotsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List local snapshots",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			home, err := cmd.Flags().GetString(serverv2.FlagHome)
+			if err != nil {
+				return err
+			}
+			snapshotStore, err := getSnapshotStore(home)
+			if err != nil {
+				return err
+			}
+			snapshots, err := snapshotStore.List()
+			if err != nil {
+				return fmt.Errorf("failed to list snapshots: %w", err)
+			}
+			for _, snapshot := range snapshots {
+				if snapshot.Format == types.DeltaFormat {
+					cmd.Println("height:", snapshot.Height, "format:", snapshot.Format, "chunks:", snapshot.Chunks,
+						"base height:", snapshot.Metadata.BaseHeight, "base format:", snapshot.Metadata.BaseFormat)
+					continue
+				}
+				cmd.Println("height:", snapshot.Height, "format:", snapshot.Format, "chunks:", snapshot.Chunks)
+			}
+
+			return nil
+		},
+	}
+}
+
+// ExportSnapshotCmd returns a command to take a snapshot of the application state
+func (s *StoreComponent[T]) ExportSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export app state to snapshot store",
+		Long: `Export app state to snapshot store.
+With --delta, only the changes since the latest snapshot are exported, the delta snapshot
+is restored on top of the snapshots it is chained to.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := cmd.Flags().GetUint64(FlagHeight)
+			if err != nil {
+				return err
+			}
+			delta, err := cmd.Flags().GetBool(FlagDelta)
+			if err != nil {
+				return err
+			}
+
+			rootStore, sm, err := s.createSnapshotManager(cmd)
+			if err != nil {
+				return err
+			}
+			defer rootStore.Close()
+
+			if height == 0 {
+				height, err = rootStore.GetLatestVersion()
+				if err != nil {
+					return err
+				}
+			}
+
+			cmd.Printf("Exporting snapshot for height %d\n", height)
+
+			var snapshot *types.Snapshot
+			if delta {
+				snapshot, err = sm.CreateDelta(height)
+			} else {
+				snapshot, err = sm.Create(height)
+			}
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Snapshot created at height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagHeight, 0, "Height to export, default to latest state height")
+	cmd.Flags().Bool(FlagDelta, false, "Export the changes since the latest snapshot only")
+	cmd.Flags().String(FlagAppDBBackend, "", "The type of database for application and snapshots databases")
+
+	return cmd
+}
+
+// RestoreSnapshotCmd returns a command to restore a snapshot
+func (s *StoreComponent[T]) RestoreSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "restore <height> <format>",
+		Aliases: []string{"import"},
+		Short:   "Restore app state from local snapshot",
+		Long: fmt.Sprintf(`Restore app state from local snapshot.
+A delta snapshot (format %d) is restored by restoring the full snapshot it is chained to,
+then applying every delta snapshot of the chain.`, types.DeltaFormat),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			format, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			rootStore, sm, err := s.createSnapshotManager(cmd)
+			if err != nil {
+				return err
+			}
+			defer rootStore.Close()
+
+			return sm.RestoreLocalSnapshot(height, uint32(format))
+		},
+	}
+
+	cmd.Flags().String(FlagAppDBBackend, "", "The type of database for application and snapshots databases")
+
+	return cmd
+}
+
+// createSnapshotManager opens the root store of the node and returns it along with its snapshot manager.
+// The caller must close the root store.
+func (s *StoreComponent[T]) createSnapshotManager(cmd *cobra.Command) (rootStore storev2.RootStore, sm *snapshots.Manager, err error) {
+	vp := serverv2.GetViperFromCmd(cmd)
+	if err := vp.BindPFlags(cmd.Flags()); err != nil {
+		return nil, nil, err
+	}
+
+	home, err := cmd.Flags().GetString(serverv2.FlagHome)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logger := log.NewLogger(cmd.OutOrStdout())
+	rootStore, _, err = createRootStore(cmd, home, vp, logger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can not create root store %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = rootStore.Close()
+		}
+	}()
+
+	sc, ok := rootStore.GetStateCommitment().(snapshots.CommitSnapshotter)
+	if !ok {
+		return nil, nil, fmt.Errorf("state commitment %T does not support snapshots", rootStore.GetStateCommitment())
+	}
+	ss, ok := rootStore.GetStateStorage().(snapshots.StorageSnapshotter)
+	if !ok {
+		return nil, nil, fmt.Errorf("state storage %T does not support snapshots", rootStore.GetStateStorage())
+	}
+
+	snapshotStore, err := getSnapshotStore(home)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cfg := DefaultConfig()
+	if err := serverv2.UnmarshalSubConfig(vp, s.Name(), &cfg); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+
+	return rootStore, snapshots.NewManager(snapshotStore, cfg.Snapshots, sc, ss, nil, logger), nil
+}
+
+// getSnapshotStore returns the snapshot store of the node, the same one as the consensus server's.
+func getSnapshotStore(rootDir string) (*snapshots.Store, error) {
+	snapshotDir := filepath.Join(rootDir, "data", "snapshots")
+	if err := os.MkdirAll(snapshotDir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create snapshots directory: %w", err)
+	}
+
+	return snapshots.NewStore(snapshotDir)
+}
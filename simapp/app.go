@@ -25,6 +25,10 @@ import (
 	"cosmossdk.io/x/accounts/accountstd"
 	baseaccount "cosmossdk.io/x/accounts/defaults/base"
 	lockup "cosmossdk.io/x/accounts/defaults/lockup"
+	passkeyaccount "cosmossdk.io/x/accounts/defaults/passkey"
+	paymasteraccount "cosmossdk.io/x/accounts/defaults/paymaster"
+	recoveryaccount "cosmossdk.io/x/accounts/defaults/recovery"
+	scheduleraccount "cosmossdk.io/x/accounts/defaults/scheduler"
 	"cosmossdk.io/x/accounts/testing/account_abstraction"
 	"cosmossdk.io/x/accounts/testing/counter"
 	"cosmossdk.io/x/auth"
@@ -129,6 +133,7 @@ var (
 		pooltypes.ModuleName:               nil,
 		pooltypes.StreamAccount:            nil,
 		pooltypes.ProtocolPoolDistrAccount: nil,
+		pooltypes.PaymentStreamAccount:     nil,
 		minttypes.ModuleName:               {authtypes.Minter},
 		stakingtypes.BondedPoolName:        {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName:     {authtypes.Burner, authtypes.Staking},
@@ -296,6 +301,7 @@ func NewSimApp(
 		runtime.NewEnvironment(runtime.NewKVStoreService(keys[accounts.StoreKey]), logger.With(log.ModuleKey, "x/accounts"), runtime.EnvWithMsgRouterService(app.MsgServiceRouter()), runtime.EnvWithQueryRouterService(app.GRPCQueryRouter())),
 		signingCtx.AddressCodec(),
 		appCodec.InterfaceRegistry(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		// TESTING: do not add
 		accountstd.AddAccount("counter", counter.NewAccount),
 		accountstd.AddAccount("aa_minimal", account_abstraction.NewMinimalAbstractedAccount),
@@ -306,6 +312,10 @@ func NewSimApp(
 		accountstd.AddAccount(lockup.PERMANENT_LOCKING_ACCOUNT, lockup.NewPermanentLockingAccount),
 		// PRODUCTION: add
 		baseaccount.NewAccount("base", txConfig.SignModeHandler()),
+		passkeyaccount.NewAccount("passkey", txConfig.SignModeHandler()),
+		recoveryaccount.NewAccount("recovery", txConfig.SignModeHandler(), "base"),
+		paymasteraccount.NewAccount("paymaster"),
+		scheduleraccount.NewAccount("scheduler"),
 	)
 	if err != nil {
 		panic(err)
@@ -435,7 +445,7 @@ func NewSimApp(
 
 	app.EpochsKeeper.SetHooks(
 		epochstypes.NewMultiEpochHooks(
-		// insert epoch hooks receivers here
+			app.PoolKeeper.Hooks(),
 		),
 	)
 
@@ -495,6 +505,7 @@ func NewSimApp(
 		feegrant.ModuleName,
 		group.ModuleName,
 		pooltypes.ModuleName,
+		accounts.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
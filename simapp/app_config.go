@@ -82,6 +82,7 @@ var (
 		{Account: pooltypes.ModuleName},
 		{Account: pooltypes.StreamAccount},
 		{Account: pooltypes.ProtocolPoolDistrAccount},
+		{Account: pooltypes.PaymentStreamAccount},
 		{Account: minttypes.ModuleName, Permissions: []string{authtypes.Minter}},
 		{Account: stakingtypes.BondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
 		{Account: stakingtypes.NotBondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
@@ -133,6 +134,7 @@ var (
 						feegrant.ModuleName,
 						group.ModuleName,
 						pooltypes.ModuleName,
+						accounts.ModuleName,
 					},
 					// The following is mostly only needed when ModuleName != StoreKey name.
 					OverrideStoreKeys: []*runtimev1alpha1.StoreKeyConfig{
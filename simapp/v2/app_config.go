@@ -78,6 +78,7 @@ var (
 		{Account: pooltypes.ModuleName},
 		{Account: pooltypes.StreamAccount},
 		{Account: pooltypes.ProtocolPoolDistrAccount},
+		{Account: pooltypes.PaymentStreamAccount},
 		{Account: minttypes.ModuleName, Permissions: []string{authtypes.Minter}},
 		{Account: stakingtypes.BondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
 		{Account: stakingtypes.NotBondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
@@ -128,6 +129,7 @@ var (
 						feegrant.ModuleName,
 						group.ModuleName,
 						pooltypes.ModuleName,
+						accounts.ModuleName,
 					},
 					OverrideStoreKeys: []*runtimev2.StoreKeyConfig{
 						{
@@ -10,11 +10,14 @@ import (
 	"github.com/spf13/viper"
 
 	"cosmossdk.io/client/v2/offchain"
+	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/transaction"
 	"cosmossdk.io/log"
 	runtimev2 "cosmossdk.io/runtime/v2"
+	"cosmossdk.io/schema/decoding"
 	serverv2 "cosmossdk.io/server/v2"
 	"cosmossdk.io/server/v2/api/grpc"
+	"cosmossdk.io/server/v2/api/indexer"
 	"cosmossdk.io/server/v2/cometbft"
 	"cosmossdk.io/server/v2/store"
 	"cosmossdk.io/simapp/v2"
@@ -42,6 +45,7 @@ func newApp[T transaction.Tx](
 func initRootCmd[T transaction.Tx](
 	rootCmd *cobra.Command,
 	txConfig client.TxConfig,
+	addressCodec address.Codec,
 	moduleManager *runtimev2.MM[T],
 ) {
 	cfg := sdk.GetConfig()
@@ -70,6 +74,11 @@ func initRootCmd[T transaction.Tx](
 		offchain.OffChain(),
 	)
 
+	moduleSet := make(map[string]any, len(moduleManager.Modules()))
+	for name, mod := range moduleManager.Modules() {
+		moduleSet[name] = mod
+	}
+
 	// wire server commands
 	if err = serverv2.AddCommands(
 		rootCmd,
@@ -78,6 +87,7 @@ func initRootCmd[T transaction.Tx](
 		cometbft.New(&genericTxDecoder[T]{txConfig}, cometbft.DefaultServerOptions[T]()),
 		grpc.New[T](),
 		store.New[T](),
+		indexer.New[T](decoding.ModuleSetDecoderResolver(moduleSet), addressCodec),
 	); err != nil {
 		panic(err)
 	}
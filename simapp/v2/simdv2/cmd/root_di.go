@@ -80,7 +80,7 @@ func NewRootCmd[T transaction.Tx]() *cobra.Command {
 		},
 	}
 
-	initRootCmd[T](rootCmd, clientCtx.TxConfig, moduleManager)
+	initRootCmd[T](rootCmd, clientCtx.TxConfig, clientCtx.AddressCodec, moduleManager)
 	if err := autoCliOpts.EnhanceRootCommand(rootCmd); err != nil {
 		panic(err)
 	}
@@ -14,8 +14,10 @@ import (
 )
 
 var (
-	_ commitment.Tree      = (*IavlTree)(nil)
-	_ store.PausablePruner = (*IavlTree)(nil)
+	_ commitment.Tree           = (*IavlTree)(nil)
+	_ commitment.ChangesetTree  = (*IavlTree)(nil)
+	_ commitment.BatchProofTree = (*IavlTree)(nil)
+	_ store.PausablePruner      = (*IavlTree)(nil)
 )
 
 // IavlTree is a wrapper around iavl.MutableTree.
@@ -77,6 +79,85 @@ func (t *IavlTree) GetProof(version uint64, key []byte) (*ics23.CommitmentProof,
 	return immutableTree.GetProof(key)
 }
 
+// GetBatchProof returns a batch proof for the given keys and version.
+func (t *IavlTree) GetBatchProof(version uint64, keys [][]byte) (*ics23.CommitmentProof, error) {
+	immutableTree, err := t.tree.GetImmutable(int64(version))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get immutable tree at version %d: %w", version, err)
+	}
+
+	proofs := make([]*ics23.CommitmentProof, 0, len(keys))
+	for _, key := range keys {
+		proof, err := immutableTree.GetProof(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get proof of key %X: %w", key, err)
+		}
+		proofs = append(proofs, proof)
+	}
+
+	return ics23.CombineProofs(proofs)
+}
+
+// GetRangeProof returns a batch proof for at most limit keys of the given range and
+// version, along with the closest key before start and the proof of the end of the
+// proven range, which proves the closest key from it, either existing or as the
+// neighbor of a non-existence proof. If the range has more than limit keys, the
+// proven range ends before the next key, which is returned to prove the rest of
+// the range from it.
+func (t *IavlTree) GetRangeProof(version uint64, start, end []byte, limit int) (*ics23.CommitmentProof, []byte, error) {
+	if limit <= 0 {
+		return nil, nil, fmt.Errorf("invalid range proof limit %d", limit)
+	}
+	immutableTree, err := t.tree.GetImmutable(int64(version))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get immutable tree at version %d: %w", version, err)
+	}
+
+	var keys [][]byte
+	if len(start) > 0 {
+		itr, err := immutableTree.Iterator(nil, start, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		if itr.Valid() {
+			keys = append(keys, itr.Key())
+		}
+		if err := itr.Close(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	itr, err := immutableTree.Iterator(start, end, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	var next []byte
+	for count := 0; itr.Valid(); itr.Next() {
+		if count == limit {
+			next = itr.Key()
+			break
+		}
+		keys = append(keys, itr.Key())
+		count++
+	}
+	if err := itr.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	switch {
+	case next != nil:
+		keys = append(keys, next)
+	case end != nil:
+		keys = append(keys, end)
+	}
+
+	batchProof, err := t.GetBatchProof(version, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	return batchProof, next, nil
+}
+
 func (t *IavlTree) Get(version uint64, key []byte) ([]byte, error) {
 	immutableTree, err := t.tree.GetImmutable(int64(version))
 	if err != nil {
@@ -139,6 +220,35 @@ func (t *IavlTree) Import(version uint64) (commitment.Importer, error) {
 	}, nil
 }
 
+// VersionExists returns true if the given version is committed and not pruned.
+func (t *IavlTree) VersionExists(version uint64) bool {
+	return t.tree.VersionExists(int64(version))
+}
+
+// GetChangeset returns the changes made by the given version, sorted by key.
+func (t *IavlTree) GetChangeset(version uint64) (corestore.KVPairs, error) {
+	if !t.tree.VersionExists(int64(version)) {
+		return nil, fmt.Errorf("version %d does not exist", version)
+	}
+
+	var pairs corestore.KVPairs
+	// the end version is documented as exclusive but traversed by iavl, so the other versions are skipped
+	err := t.tree.TraverseStateChanges(int64(version), int64(version)+1, func(v int64, changeSet *iavl.ChangeSet) error {
+		if v != int64(version) {
+			return nil
+		}
+		for _, pair := range changeSet.Pairs {
+			pairs = append(pairs, corestore.KVPair{Key: pair.Key, Value: pair.Value, Remove: pair.Delete})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the changeset of version %d: %w", version, err)
+	}
+
+	return pairs, nil
+}
+
 // Close closes the iavl tree.
 func (t *IavlTree) Close() error {
 	return t.tree.Close()
@@ -1,10 +1,12 @@
 package commitment
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"math"
+	"sort"
 
 	protoio "github.com/cosmos/gogoproto/io"
 
@@ -19,9 +21,12 @@ import (
 )
 
 var (
-	_ store.Committer             = (*CommitStore)(nil)
-	_ snapshots.CommitSnapshotter = (*CommitStore)(nil)
-	_ store.PausablePruner        = (*CommitStore)(nil)
+	_ store.Committer                     = (*CommitStore)(nil)
+	_ store.BatchProver                   = (*CommitStore)(nil)
+	_ snapshots.CommitSnapshotter         = (*CommitStore)(nil)
+	_ snapshots.ParallelCommitSnapshotter = (*CommitStore)(nil)
+	_ snapshots.DeltaCommitSnapshotter    = (*CommitStore)(nil)
+	_ store.PausablePruner                = (*CommitStore)(nil)
 )
 
 // CommitStore is a wrapper around multiple Tree objects mapped by a unique store
@@ -184,6 +189,59 @@ func (c *CommitStore) GetProof(storeKey []byte, version uint64, key []byte) ([]p
 	if err != nil {
 		return nil, err
 	}
+
+	return c.withStoreProof(storeKey, version, proof.NewIAVLCommitmentOp(key, iProof))
+}
+
+// GetBatchProof implements store.BatchProver.
+func (c *CommitStore) GetBatchProof(storeKey []byte, version uint64, keys [][]byte) ([]proof.CommitmentOp, error) {
+	tree, err := c.getBatchProofTree(storeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	iProof, err := tree.GetBatchProof(version, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.withStoreProof(storeKey, version, proof.NewIAVLBatchCommitmentOp(iProof))
+}
+
+// GetRangeProof implements store.BatchProver.
+func (c *CommitStore) GetRangeProof(storeKey []byte, version uint64, start, end []byte, limit int) ([]proof.CommitmentOp, []byte, error) {
+	tree, err := c.getBatchProofTree(storeKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	iProof, next, err := tree.GetRangeProof(version, start, end, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ops, err := c.withStoreProof(storeKey, version, proof.NewIAVLRangeCommitmentOp(iProof))
+	if err != nil {
+		return nil, nil, err
+	}
+	return ops, next, nil
+}
+
+func (c *CommitStore) getBatchProofTree(storeKey []byte) (BatchProofTree, error) {
+	tree, ok := c.multiTrees[conv.UnsafeBytesToStr(storeKey)]
+	if !ok {
+		return nil, fmt.Errorf("store %s not found", storeKey)
+	}
+	batchTree, ok := tree.(BatchProofTree)
+	if !ok {
+		return nil, fmt.Errorf("store %s does not support batch proofs", storeKey)
+	}
+	return batchTree, nil
+}
+
+// withStoreProof returns the given proof of the store tree, followed by the proof
+// of the store hash in the commit info of the version.
+func (c *CommitStore) withStoreProof(storeKey []byte, version uint64, commitOp proof.CommitmentOp) ([]proof.CommitmentOp, error) {
 	cInfo, err := c.metadata.GetCommitInfo(version)
 	if err != nil {
 		return nil, err
@@ -191,7 +249,6 @@ func (c *CommitStore) GetProof(storeKey []byte, version uint64, key []byte) ([]p
 	if cInfo == nil {
 		return nil, fmt.Errorf("commit info not found for version %d", version)
 	}
-	commitOp := proof.NewIAVLCommitmentOp(key, iProof)
 	_, storeCommitmentOp, err := cInfo.GetStoreProof(storeKey)
 	if err != nil {
 		return nil, err
@@ -243,6 +300,46 @@ func (c *CommitStore) PausePruning(pause bool) {
 
 // Snapshot implements snapshotstypes.CommitSnapshotter.
 func (c *CommitStore) Snapshot(version uint64, protoWriter protoio.Writer) error {
+	if err := c.validateSnapshotVersion(version); err != nil {
+		return err
+	}
+
+	// the stores are exported in the order of their keys, so the snapshot is
+	// identical across all nodes for a given version
+	for _, storeKey := range c.SnapshotStoreKeys() {
+		if err := c.exportStore(version, storeKey, protoWriter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SnapshotStoreKeys implements snapshots.ParallelCommitSnapshotter.
+func (c *CommitStore) SnapshotStoreKeys() []string {
+	storeKeys := make([]string, 0, len(c.multiTrees))
+	for storeKey := range c.multiTrees {
+		storeKeys = append(storeKeys, storeKey)
+	}
+	sort.Strings(storeKeys)
+
+	return storeKeys
+}
+
+// SnapshotStore implements snapshots.ParallelCommitSnapshotter.
+func (c *CommitStore) SnapshotStore(version uint64, storeKey string, protoWriter protoio.Writer) error {
+	if err := c.validateSnapshotVersion(version); err != nil {
+		return err
+	}
+	if _, ok := c.multiTrees[storeKey]; !ok {
+		return fmt.Errorf("store %s not found", storeKey)
+	}
+
+	return c.exportStore(version, storeKey, protoWriter)
+}
+
+// validateSnapshotVersion checks that a snapshot can be taken at the given version.
+func (c *CommitStore) validateSnapshotVersion(version uint64) error {
 	if version == 0 {
 		return errors.New("the snapshot version must be greater than 0")
 	}
@@ -255,46 +352,43 @@ func (c *CommitStore) Snapshot(version uint64, protoWriter protoio.Writer) error
 		return fmt.Errorf("the snapshot version %d is greater than the latest version %d", version, latestVersion)
 	}
 
-	for storeKey, tree := range c.multiTrees {
-		// TODO: check the parallelism of this loop
-		if err := func() error {
-			exporter, err := tree.Export(version)
-			if err != nil {
-				return fmt.Errorf("failed to export tree for version %d: %w", version, err)
-			}
-			defer exporter.Close()
+	return nil
+}
 
-			err = protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
-				Item: &snapshotstypes.SnapshotItem_Store{
-					Store: &snapshotstypes.SnapshotStoreItem{
-						Name: storeKey,
-					},
-				},
-			})
-			if err != nil {
-				return fmt.Errorf("failed to write store name: %w", err)
-			}
+// exportStore writes the store item of the given store followed by its exported
+// tree nodes.
+func (c *CommitStore) exportStore(version uint64, storeKey string, protoWriter protoio.Writer) error {
+	exporter, err := c.multiTrees[storeKey].Export(version)
+	if err != nil {
+		return fmt.Errorf("failed to export tree for version %d: %w", version, err)
+	}
+	defer exporter.Close()
 
-			for {
-				item, err := exporter.Next()
-				if errors.Is(err, ErrorExportDone) {
-					break
-				} else if err != nil {
-					return fmt.Errorf("failed to get the next export node: %w", err)
-				}
+	err = protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+		Item: &snapshotstypes.SnapshotItem_Store{
+			Store: &snapshotstypes.SnapshotStoreItem{
+				Name: storeKey,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write store name: %w", err)
+	}
 
-				if err = protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
-					Item: &snapshotstypes.SnapshotItem_IAVL{
-						IAVL: item,
-					},
-				}); err != nil {
-					return fmt.Errorf("failed to write iavl node: %w", err)
-				}
-			}
+	for {
+		item, err := exporter.Next()
+		if errors.Is(err, ErrorExportDone) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("failed to get the next export node: %w", err)
+		}
 
-			return nil
-		}(); err != nil {
-			return err
+		if err = protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+			Item: &snapshotstypes.SnapshotItem_IAVL{
+				IAVL: item,
+			},
+		}); err != nil {
+			return fmt.Errorf("failed to write iavl node: %w", err)
 		}
 	}
 
@@ -350,48 +444,274 @@ loop:
 			if importer == nil {
 				return snapshotstypes.SnapshotItem{}, errors.New("received IAVL node item before store item")
 			}
-			node := item.IAVL
-			if node.Height > int32(math.MaxInt8) {
-				return snapshotstypes.SnapshotItem{}, fmt.Errorf("node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
+			if err := importNode(importer, storeKey, item.IAVL, chStorage); err != nil {
+				return snapshotstypes.SnapshotItem{}, err
 			}
-			// Protobuf does not differentiate between []byte{} and nil, but fortunately IAVL does
-			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
-			if node.Key == nil {
-				node.Key = []byte{}
+		default:
+			break loop
+		}
+	}
+
+	if importer != nil {
+		if err := importer.Commit(); err != nil {
+			return snapshotstypes.SnapshotItem{}, fmt.Errorf("failed to commit importer: %w", err)
+		}
+	}
+
+	return snapshotItem, c.LoadVersion(version)
+}
+
+// RestoreStore implements snapshots.ParallelCommitSnapshotter. The store item of
+// the given store has already been read from the reader, which must only contain
+// its tree nodes. LoadVersion must be called once all the stores are restored.
+func (c *CommitStore) RestoreStore(
+	version uint64,
+	storeKey string,
+	protoReader protoio.Reader,
+	chStorage chan<- *corestore.StateChanges,
+) error {
+	tree := c.multiTrees[storeKey]
+	if tree == nil {
+		return fmt.Errorf("store %s not found", storeKey)
+	}
+
+	importer, err := tree.Import(version)
+	if err != nil {
+		return fmt.Errorf("failed to import tree for version %d: %w", version, err)
+	}
+	defer importer.Close()
+
+	for {
+		snapshotItem := snapshotstypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("invalid protobuf message: %w", err)
+		}
+
+		node := snapshotItem.GetIAVL()
+		if node == nil {
+			return fmt.Errorf("unexpected snapshot item %T in the stream of store %s", snapshotItem.Item, storeKey)
+		}
+		if err := importNode(importer, []byte(storeKey), node, chStorage); err != nil {
+			return err
+		}
+	}
+
+	if err := importer.Commit(); err != nil {
+		return fmt.Errorf("failed to commit importer: %w", err)
+	}
+
+	return nil
+}
+
+// importNode adds an exported node to the importer, passing it to the storage
+// channel if it is a leaf node.
+func importNode(
+	importer Importer,
+	storeKey []byte,
+	node *snapshotstypes.SnapshotIAVLItem,
+	chStorage chan<- *corestore.StateChanges,
+) error {
+	if node.Height > int32(math.MaxInt8) {
+		return fmt.Errorf("node height %v cannot exceed %v", node.Height, math.MaxInt8)
+	}
+	// Protobuf does not differentiate between []byte{} and nil, but fortunately IAVL does
+	// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+	if node.Key == nil {
+		node.Key = []byte{}
+	}
+	if node.Height == 0 {
+		if node.Value == nil {
+			node.Value = []byte{}
+		}
+
+		// If the node is a leaf node, it will be written to the storage.
+		chStorage <- &corestore.StateChanges{
+			Actor: storeKey,
+			StateChanges: []corestore.KVPair{
+				{
+					Key:   node.Key,
+					Value: node.Value,
+				},
+			},
+		}
+	}
+	if err := importer.Add(node); err != nil {
+		return fmt.Errorf("failed to add node to importer: %w", err)
+	}
+
+	return nil
+}
+
+// SnapshotChanges implements snapshots.DeltaCommitSnapshotter. It writes, for every
+// version after fromVersion up to toVersion, the changesets of the stores followed
+// by the commit hash of the version. Both versions must not be pruned.
+func (c *CommitStore) SnapshotChanges(fromVersion, toVersion uint64, protoWriter protoio.Writer) error {
+	if fromVersion >= toVersion {
+		return fmt.Errorf("the snapshot version %d must be greater than the base version %d", toVersion, fromVersion)
+	}
+	if err := c.validateSnapshotVersion(toVersion); err != nil {
+		return err
+	}
+
+	prevInfo, err := c.GetCommitInfo(fromVersion)
+	if err != nil {
+		return err
+	}
+	if prevInfo == nil {
+		return fmt.Errorf("commit info of the base version %d not found", fromVersion)
+	}
+
+	storeKeys := c.SnapshotStoreKeys()
+	for version := fromVersion + 1; version <= toVersion; version++ {
+		cInfo, err := c.GetCommitInfo(version)
+		if err != nil {
+			return err
+		}
+		if cInfo == nil {
+			return fmt.Errorf("commit info of version %d not found", version)
+		}
+
+		for _, storeKey := range storeKeys {
+			if internal.IsMemoryStoreKey(storeKey) {
+				continue
+			}
+			tree, ok := c.multiTrees[storeKey].(ChangesetTree)
+			if !ok {
+				return fmt.Errorf("store %s does not support changesets", storeKey)
+			}
+			// the changes are computed against the previous version, which must not be
+			// pruned unless the store is added by this version
+			if prevInfo.GetStoreCommitID([]byte(storeKey)).Version != 0 && !tree.VersionExists(version-1) {
+				return fmt.Errorf("version %d of store %s is pruned", version-1, storeKey)
 			}
-			if node.Height == 0 {
-				if node.Value == nil {
-					node.Value = []byte{}
-				}
 
-				// If the node is a leaf node, it will be written to the storage.
-				chStorage <- &corestore.StateChanges{
-					Actor: storeKey,
-					StateChanges: []corestore.KVPair{
-						{
-							Key:   node.Key,
-							Value: node.Value,
-						},
+			changes, err := tree.GetChangeset(version)
+			if err != nil {
+				return fmt.Errorf("failed to get the changeset of store %s: %w", storeKey, err)
+			}
+			if len(changes) == 0 {
+				continue
+			}
+
+			pairs := make([]*snapshotstypes.SnapshotChangesetPair, len(changes))
+			for i, change := range changes {
+				pairs[i] = &snapshotstypes.SnapshotChangesetPair{
+					Key:    change.Key,
+					Value:  change.Value,
+					Remove: change.Remove,
+				}
+			}
+			if err := protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+				Item: &snapshotstypes.SnapshotItem_Changeset{
+					Changeset: &snapshotstypes.SnapshotChangesetItem{
+						Store:   storeKey,
+						Version: version,
+						Pairs:   pairs,
 					},
+				},
+			}); err != nil {
+				return fmt.Errorf("failed to write changeset: %w", err)
+			}
+		}
+
+		if err := protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+			Item: &snapshotstypes.SnapshotItem_Commit{
+				Commit: &snapshotstypes.SnapshotCommitItem{
+					Version: version,
+					Hash:    cInfo.Hash(),
+				},
+			},
+		}); err != nil {
+			return fmt.Errorf("failed to write commit: %w", err)
+		}
+		prevInfo = cInfo
+	}
+
+	return nil
+}
+
+// RestoreChanges implements snapshots.DeltaCommitSnapshotter. The changesets are
+// committed as the versions following the latest one, and each version must match
+// the commit hash of the snapshot before it is passed to the apply function.
+func (c *CommitStore) RestoreChanges(
+	protoReader protoio.Reader,
+	apply func(version uint64, cs *corestore.Changeset) error,
+) (snapshotstypes.SnapshotItem, error) {
+	latestVersion, err := c.GetLatestVersion()
+	if err != nil {
+		return snapshotstypes.SnapshotItem{}, err
+	}
+
+	var snapshotItem snapshotstypes.SnapshotItem
+	version := latestVersion + 1
+	cs := corestore.NewChangeset()
+	for {
+		snapshotItem = snapshotstypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return snapshotstypes.SnapshotItem{}, fmt.Errorf("invalid protobuf message: %w", err)
+		}
+
+		switch item := snapshotItem.Item.(type) {
+		case *snapshotstypes.SnapshotItem_Changeset:
+			if item.Changeset.Version != version {
+				return snapshotstypes.SnapshotItem{}, fmt.Errorf("expected changeset of version %d, got %d", version, item.Changeset.Version)
+			}
+			if _, ok := c.multiTrees[item.Changeset.Store]; !ok {
+				return snapshotstypes.SnapshotItem{}, fmt.Errorf("store %s not found", item.Changeset.Store)
+			}
+			pairs := make(corestore.KVPairs, len(item.Changeset.Pairs))
+			for i, pair := range item.Changeset.Pairs {
+				pairs[i] = corestore.KVPair{Key: pair.Key, Value: pair.Value, Remove: pair.Remove}
+				// Protobuf does not differentiate between []byte{} and nil.
+				if !pair.Remove && pair.Value == nil {
+					pairs[i].Value = []byte{}
 				}
 			}
-			err := importer.Add(node)
+			cs.Changes = append(cs.Changes, corestore.StateChanges{
+				Actor:        []byte(item.Changeset.Store),
+				StateChanges: pairs,
+			})
+
+		case *snapshotstypes.SnapshotItem_Commit:
+			if item.Commit.Version != version {
+				return snapshotstypes.SnapshotItem{}, fmt.Errorf("expected commit of version %d, got %d", version, item.Commit.Version)
+			}
+			if err := c.WriteChangeset(cs); err != nil {
+				return snapshotstypes.SnapshotItem{}, fmt.Errorf("failed to write changeset of version %d: %w", version, err)
+			}
+			cInfo, err := c.Commit(version)
 			if err != nil {
-				return snapshotstypes.SnapshotItem{}, fmt.Errorf("failed to add node to importer: %w", err)
+				return snapshotstypes.SnapshotItem{}, fmt.Errorf("failed to commit version %d: %w", version, err)
+			}
+			if !bytes.Equal(cInfo.Hash(), item.Commit.Hash) {
+				return snapshotstypes.SnapshotItem{}, fmt.Errorf("commit hash mismatch at version %d: expected %X, got %X",
+					version, item.Commit.Hash, cInfo.Hash())
 			}
+			if err := apply(version, cs); err != nil {
+				return snapshotstypes.SnapshotItem{}, fmt.Errorf("failed to apply changeset of version %d: %w", version, err)
+			}
+			version++
+			cs = corestore.NewChangeset()
+
 		default:
-			break loop
+			if len(cs.Changes) > 0 {
+				return snapshotstypes.SnapshotItem{}, fmt.Errorf("the changes of version %d are not committed", version)
+			}
+			return snapshotItem, nil
 		}
 	}
 
-	if importer != nil {
-		if err := importer.Commit(); err != nil {
-			return snapshotstypes.SnapshotItem{}, fmt.Errorf("failed to commit importer: %w", err)
-		}
+	if len(cs.Changes) > 0 {
+		return snapshotstypes.SnapshotItem{}, fmt.Errorf("the changes of version %d are not committed", version)
 	}
 
-	return snapshotItem, c.LoadVersion(version)
+	return snapshotItem, nil
 }
 
 func (c *CommitStore) GetCommitInfo(version uint64) (*proof.CommitInfo, error) {
@@ -6,6 +6,8 @@ import (
 	"io"
 	"sync"
 
+	protoio "github.com/cosmos/gogoproto/io"
+	ics23 "github.com/cosmos/ics23/go"
 	"github.com/stretchr/testify/suite"
 
 	corelog "cosmossdk.io/core/log"
@@ -13,6 +15,7 @@ import (
 	coretesting "cosmossdk.io/core/testing"
 	"cosmossdk.io/store/v2"
 	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/proof"
 	"cosmossdk.io/store/v2/snapshots"
 	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
 )
@@ -20,8 +23,38 @@ import (
 const (
 	storeKey1 = "store1"
 	storeKey2 = "store2"
+	storeKey3 = "store3"
 )
 
+// leavesSnapshotter is a snapshots.StorageSnapshotter collecting the restored leaves.
+type leavesSnapshotter struct {
+	leaves map[string]string
+}
+
+func (l *leavesSnapshotter) Restore(version uint64, chStorage <-chan *corestore.StateChanges) error {
+	l.leaves = make(map[string]string)
+	for kv := range chStorage {
+		for _, pair := range kv.StateChanges {
+			l.leaves[fmt.Sprintf("%s_%s", kv.Actor, pair.Key)] = string(pair.Value)
+		}
+	}
+	return nil
+}
+
+func (l *leavesSnapshotter) ApplyChangeset(version uint64, cs *corestore.Changeset) error {
+	for _, kv := range cs.Changes {
+		for _, pair := range kv.StateChanges {
+			key := fmt.Sprintf("%s_%s", kv.Actor, pair.Key)
+			if pair.Remove {
+				delete(l.leaves, key)
+			} else {
+				l.leaves[key] = string(pair.Value)
+			}
+		}
+	}
+	return nil
+}
+
 // CommitStoreTestSuite is a test suite to be used for all tree backends.
 type CommitStoreTestSuite struct {
 	suite.Suite
@@ -124,6 +157,274 @@ func (s *CommitStoreTestSuite) TestStore_Snapshotter() {
 	}
 }
 
+func (s *CommitStoreTestSuite) TestStore_ParallelSnapshotter() {
+	storeKeys := []string{storeKey1, storeKey2, storeKey3}
+	commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, coretesting.NewNopLogger())
+	s.Require().NoError(err)
+
+	latestVersion := uint64(10)
+	kvCount := 10
+	for i := uint64(1); i <= latestVersion; i++ {
+		kvPairs := make(map[string]corestore.KVPairs)
+		for _, storeKey := range storeKeys {
+			kvPairs[storeKey] = corestore.KVPairs{}
+			for j := 0; j < kvCount; j++ {
+				key := []byte(fmt.Sprintf("key-%d-%d", i, j))
+				value := []byte(fmt.Sprintf("value-%s-%d-%d", storeKey, i, j))
+				kvPairs[storeKey] = append(kvPairs[storeKey], corestore.KVPair{Key: key, Value: value})
+			}
+		}
+		s.Require().NoError(commitStore.WriteChangeset(corestore.NewChangesetWithPairs(kvPairs)))
+
+		_, err = commitStore.Commit(i)
+		s.Require().NoError(err)
+	}
+	cInfo := commitStore.WorkingCommitInfo(latestVersion)
+
+	opts := snapshots.SnapshotOptions{Parallelism: 2}
+	snapshotStore, err := snapshots.NewStore(s.T().TempDir())
+	s.Require().NoError(err)
+	manager := snapshots.NewManager(snapshotStore, opts, commitStore, &leavesSnapshotter{}, nil, coretesting.NewNopLogger())
+	snapshot, err := manager.Create(latestVersion)
+	s.Require().NoError(err)
+	s.Require().Equal(snapshotstypes.ParallelFormat, snapshot.Format)
+	s.Require().Len(snapshot.Metadata.StreamChunks, len(storeKeys))
+
+	targetStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, coretesting.NewNopLogger())
+	s.Require().NoError(err)
+	leaves := &leavesSnapshotter{}
+	targetManager := snapshots.NewManager(snapshotStore, opts, targetStore, leaves, nil, coretesting.NewNopLogger())
+	s.Require().NoError(targetManager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format))
+
+	s.Require().Equal(len(storeKeys)*kvCount*int(latestVersion), len(leaves.leaves))
+	for _, storeKey := range storeKeys {
+		for i := 1; i <= int(latestVersion); i++ {
+			for j := 0; j < kvCount; j++ {
+				key := fmt.Sprintf("%s_key-%d-%d", storeKey, i, j)
+				s.Require().Equal(fmt.Sprintf("value-%s-%d-%d", storeKey, i, j), leaves.leaves[key])
+			}
+		}
+	}
+
+	// check the restored tree hashes
+	targetCommitInfo := targetStore.WorkingCommitInfo(latestVersion)
+	s.Require().Equal(cInfo.Hash(), targetCommitInfo.Hash())
+}
+
+func (s *CommitStoreTestSuite) TestStore_DeltaSnapshotter() {
+	storeKeys := []string{storeKey1, storeKey2}
+	commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, coretesting.NewNopLogger())
+	s.Require().NoError(err)
+
+	snapshotStore, err := snapshots.NewStore(s.T().TempDir())
+	s.Require().NoError(err)
+	manager := snapshots.NewManager(snapshotStore, snapshots.SnapshotOptions{}, commitStore, &leavesSnapshotter{}, nil, coretesting.NewNopLogger())
+
+	// every version overwrites a key, and removes the key written two versions before
+	latestVersion := uint64(10)
+	kvCount := 5
+	for i := uint64(1); i <= latestVersion; i++ {
+		kvPairs := make(map[string]corestore.KVPairs)
+		for _, storeKey := range storeKeys {
+			kvPairs[storeKey] = corestore.KVPairs{
+				{Key: []byte("key-updated"), Value: []byte(fmt.Sprintf("value-%s-%d", storeKey, i))},
+			}
+			for j := 0; j < kvCount; j++ {
+				key := []byte(fmt.Sprintf("key-%d-%d", i, j))
+				value := []byte(fmt.Sprintf("value-%s-%d-%d", storeKey, i, j))
+				kvPairs[storeKey] = append(kvPairs[storeKey], corestore.KVPair{Key: key, Value: value})
+			}
+			if i > 2 {
+				kvPairs[storeKey] = append(kvPairs[storeKey], corestore.KVPair{Key: []byte(fmt.Sprintf("key-%d-0", i-2)), Remove: true})
+			}
+		}
+		s.Require().NoError(commitStore.WriteChangeset(corestore.NewChangesetWithPairs(kvPairs)))
+
+		_, err = commitStore.Commit(i)
+		s.Require().NoError(err)
+
+		switch i {
+		case 4:
+			_, err := manager.Create(i)
+			s.Require().NoError(err)
+		case 7, latestVersion:
+			snapshot, err := manager.CreateDelta(i)
+			s.Require().NoError(err)
+			s.Require().Equal(snapshotstypes.DeltaFormat, snapshot.Format)
+		}
+	}
+	cInfo := commitStore.WorkingCommitInfo(latestVersion)
+
+	chain, err := snapshotStore.DeltaChain(latestVersion)
+	s.Require().NoError(err)
+	s.Require().Len(chain, 3)
+	s.Require().Equal(uint64(7), chain[2].Metadata.BaseHeight)
+
+	targetStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, coretesting.NewNopLogger())
+	s.Require().NoError(err)
+	leaves := &leavesSnapshotter{}
+	targetManager := snapshots.NewManager(snapshotStore, snapshots.SnapshotOptions{}, targetStore, leaves, nil, coretesting.NewNopLogger())
+	s.Require().NoError(targetManager.RestoreLocalSnapshot(latestVersion, snapshotstypes.DeltaFormat))
+
+	// the removed keys are not restored
+	s.Require().Equal(len(storeKeys)*(kvCount*int(latestVersion)-int(latestVersion-2)+1), len(leaves.leaves))
+	for _, storeKey := range storeKeys {
+		s.Require().Equal(fmt.Sprintf("value-%s-%d", storeKey, latestVersion), leaves.leaves[storeKey+"_key-updated"])
+		for i := 1; i <= int(latestVersion); i++ {
+			for j := 0; j < kvCount; j++ {
+				value, ok := leaves.leaves[fmt.Sprintf("%s_key-%d-%d", storeKey, i, j)]
+				if j == 0 && i <= int(latestVersion)-2 {
+					s.Require().False(ok)
+					continue
+				}
+				s.Require().Equal(fmt.Sprintf("value-%s-%d-%d", storeKey, i, j), value)
+			}
+		}
+	}
+
+	latest, err := targetStore.GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(latestVersion, latest)
+	targetCommitInfo, err := targetStore.GetCommitInfo(latestVersion)
+	s.Require().NoError(err)
+	s.Require().Equal(cInfo.Hash(), targetCommitInfo.Hash())
+
+	// the changes can't be snapshotted once the versions they span are pruned
+	s.Require().NoError(commitStore.Prune(8))
+	err = commitStore.SnapshotChanges(7, latestVersion, protoio.NewDelimitedWriter(io.Discard))
+	s.Require().Error(err)
+}
+
+func (s *CommitStoreTestSuite) TestStore_BatchProof() {
+	storeKeys := []string{storeKey1, storeKey2}
+	commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, coretesting.NewNopLogger())
+	s.Require().NoError(err)
+
+	// the even keys are written at version 1, the odd keys at version 2 and key-05 is
+	// removed at version 3
+	for version := uint64(1); version <= 3; version++ {
+		kvPairs := make(map[string]corestore.KVPairs)
+		for _, storeKey := range storeKeys {
+			kvPairs[storeKey] = corestore.KVPairs{}
+			for i := 0; i < 20 && version < 3; i++ {
+				if uint64(i%2) == version-1 {
+					key := []byte(fmt.Sprintf("key-%02d", i))
+					kvPairs[storeKey] = append(kvPairs[storeKey], corestore.KVPair{Key: key, Value: []byte(fmt.Sprintf("value-%d-%02d", version, i))})
+				}
+			}
+			if version == 3 {
+				kvPairs[storeKey] = append(kvPairs[storeKey], corestore.KVPair{Key: []byte("key-05"), Remove: true})
+			}
+		}
+		s.Require().NoError(commitStore.WriteChangeset(corestore.NewChangesetWithPairs(kvPairs)))
+		_, err = commitStore.Commit(version)
+		s.Require().NoError(err)
+	}
+
+	cInfo, err := commitStore.GetCommitInfo(3)
+	s.Require().NoError(err)
+	root := cInfo.Hash()
+
+	// batch proof of existing and absent keys
+	keys := [][]byte{[]byte("key-04"), []byte("key-05"), []byte("key-07"), []byte("key-20"), []byte("a")}
+	ops, err := commitStore.GetBatchProof([]byte(storeKey1), 3, keys)
+	s.Require().NoError(err)
+	s.Require().Len(ops, 2)
+	values, err := proof.VerifyBatchProof(ops, root, keys)
+	s.Require().NoError(err)
+	s.Require().Equal([][]byte{[]byte("value-1-04"), nil, []byte("value-2-07"), nil, nil}, values)
+
+	// the proof of the previous version proves key-05
+	prevInfo, err := commitStore.GetCommitInfo(2)
+	s.Require().NoError(err)
+	ops, err = commitStore.GetBatchProof([]byte(storeKey1), 2, keys[:2])
+	s.Require().NoError(err)
+	values, err = proof.VerifyBatchProof(ops, prevInfo.Hash(), keys[:2])
+	s.Require().NoError(err)
+	s.Require().Equal([][]byte{[]byte("value-1-04"), []byte("value-2-05")}, values)
+	_, err = proof.VerifyBatchProof(ops, root, keys[:2])
+	s.Require().Error(err)
+	// a key which is not in the batch is not proven
+	_, err = proof.VerifyBatchProof(ops, prevInfo.Hash(), [][]byte{[]byte("key-06")})
+	s.Require().Error(err)
+
+	// range proofs
+	tests := []struct {
+		start, end []byte
+		keys       []string
+	}{
+		{[]byte("key-1"), proof.PrefixEndBytes([]byte("key-1")), []string{"key-10", "key-11", "key-12", "key-13", "key-14", "key-15", "key-16", "key-17", "key-18", "key-19"}},
+		{[]byte("key-03"), []byte("key-08"), []string{"key-03", "key-04", "key-06", "key-07"}},
+		{[]byte("key-05"), []byte("key-06"), nil},
+		{[]byte("key-18"), nil, []string{"key-18", "key-19"}},
+		{[]byte("a"), []byte("b"), nil},
+		{[]byte("z"), nil, nil},
+		{nil, []byte("key-02"), []string{"key-00", "key-01"}},
+	}
+	for _, tc := range tests {
+		ops, next, err := commitStore.GetRangeProof([]byte(storeKey2), 3, tc.start, tc.end, 20)
+		s.Require().NoError(err)
+		s.Require().Nil(next)
+		pairs, err := proof.VerifyRangeProof(ops, root, tc.start, tc.end)
+		s.Require().NoError(err, "range [%s, %s)", tc.start, tc.end)
+		s.Require().Len(pairs, len(tc.keys))
+		for i, pair := range pairs {
+			s.Require().Equal(tc.keys[i], string(pair.Key))
+		}
+	}
+
+	// a range with more keys than the limit is proven page by page, each page
+	// ending before the first key of the next one
+	var (
+		start = []byte("key-")
+		end   = proof.PrefixEndBytes(start)
+		paged []string
+	)
+	for {
+		ops, next, err := commitStore.GetRangeProof([]byte(storeKey2), 3, start, end, 3)
+		s.Require().NoError(err)
+		pageEnd := end
+		if next != nil {
+			pageEnd = next
+		}
+		pairs, err := proof.VerifyRangeProof(ops, root, start, pageEnd)
+		s.Require().NoError(err, "range [%s, %s)", start, pageEnd)
+		s.Require().LessOrEqual(len(pairs), 3)
+		for _, pair := range pairs {
+			paged = append(paged, string(pair.Key))
+		}
+		if next == nil {
+			break
+		}
+		s.Require().Len(pairs, 3)
+		start = next
+	}
+	s.Require().Len(paged, 19)
+	s.Require().Equal("key-00", paged[0])
+	s.Require().Equal("key-19", paged[18])
+	s.Require().NotContains(paged, "key-05")
+	_, _, err = commitStore.GetRangeProof([]byte(storeKey2), 3, start, end, 0)
+	s.Require().Error(err)
+
+	// the range proof is incomplete without any of the keys of the range
+	ops, _, err = commitStore.GetRangeProof([]byte(storeKey2), 3, []byte("key-03"), []byte("key-08"), 20)
+	s.Require().NoError(err)
+	_, err = proof.VerifyRangeProof(ops, root, []byte("key-02"), []byte("key-08"))
+	s.Require().Error(err)
+	_, err = proof.VerifyRangeProof(ops, root, []byte("key-03"), []byte("key-09"))
+	s.Require().Error(err)
+	batch := ics23.Decompress(ops[0].Proof).GetBatch()
+	for i := range batch.Entries {
+		entries := append(append([]*ics23.BatchEntry{}, batch.Entries[:i]...), batch.Entries[i+1:]...)
+		incomplete, err := ics23.CombineProofs([]*ics23.CommitmentProof{{
+			Proof: &ics23.CommitmentProof_Batch{Batch: &ics23.BatchProof{Entries: entries}},
+		}})
+		s.Require().NoError(err)
+		_, err = proof.VerifyRangeProof([]proof.CommitmentOp{proof.NewIAVLRangeCommitmentOp(incomplete), ops[1]}, root, []byte("key-03"), []byte("key-08"))
+		s.Require().Error(err, "entry %d", i)
+	}
+}
+
 func (s *CommitStoreTestSuite) TestStore_Pruning() {
 	storeKeys := []string{storeKey1, storeKey2}
 	pruneOpts := store.NewPruningOptionWithCustom(10, 5)
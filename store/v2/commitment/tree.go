@@ -6,6 +6,7 @@ import (
 
 	ics23 "github.com/cosmos/ics23/go"
 
+	corestore "cosmossdk.io/core/store"
 	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
 )
 
@@ -42,6 +43,35 @@ type Tree interface {
 	io.Closer
 }
 
+// ChangesetTree is a Tree which can return the changes made by its committed
+// versions, which is required to take delta snapshots.
+type ChangesetTree interface {
+	Tree
+
+	// VersionExists returns true if the given version is committed and not pruned.
+	VersionExists(version uint64) bool
+
+	// GetChangeset returns the changes made by the given version, sorted by key.
+	GetChangeset(version uint64) (corestore.KVPairs, error)
+}
+
+// BatchProofTree is a Tree which can prove several keys, or all the keys of a
+// range, with a single ics23 batch proof.
+type BatchProofTree interface {
+	Tree
+
+	// GetBatchProof returns a batch proof of the existence or absence of the
+	// given keys at the given version.
+	GetBatchProof(version uint64, keys [][]byte) (*ics23.CommitmentProof, error)
+
+	// GetRangeProof returns a batch proof of the keys in the range [start, end)
+	// at the given version, along with the closest keys around the range, so the
+	// range is proven complete. A nil end is unbounded. At most limit keys are
+	// proven: if the range has more keys, the proven range is [start, next),
+	// next being the returned key of the rest of the range, nil otherwise.
+	GetRangeProof(version uint64, start, end []byte, limit int) (proof *ics23.CommitmentProof, next []byte, err error)
+}
+
 // Exporter is the interface that wraps the basic Export methods.
 type Exporter interface {
 	Next() (*snapshotstypes.SnapshotIAVLItem, error)
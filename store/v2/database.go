@@ -61,3 +61,17 @@ type Committer interface {
 	// only be called once and any call after may panic.
 	io.Closer
 }
+
+// BatchProver defines an API for proving several keys of a store, or all the keys
+// of a range, with a single proof. It is optionally implemented by a Committer.
+type BatchProver interface {
+	// GetBatchProof returns the proof of existence or non-existence of the given
+	// keys, verified by proof.VerifyBatchProof.
+	GetBatchProof(storeKey []byte, version uint64, keys [][]byte) ([]proof.CommitmentOp, error)
+
+	// GetRangeProof returns the proof of at most limit keys of the range
+	// [start, end), verified by proof.VerifyRangeProof. A nil end is unbounded.
+	// If the range has more than limit keys, the proven range is [start, next),
+	// next being the returned first key of the rest of the range, nil otherwise.
+	GetRangeProof(storeKey []byte, version uint64, start, end []byte, limit int) (ops []proof.CommitmentOp, next []byte, err error)
+}
@@ -45,3 +45,9 @@ func NewMetrics(labels [][]string) (Metrics, error) {
 func (m Metrics) MeasureSince(start time.Time, keys ...string) {
 	metrics.MeasureSinceWithLabels(keys, start.UTC(), m.Labels)
 }
+
+// SetGauge provides a wrapper functionality for emitting a gauge metric with
+// global labels (if any).
+func (m Metrics) SetGauge(val float32, keys ...string) {
+	metrics.SetGaugeWithLabels(keys, val, m.Labels)
+}
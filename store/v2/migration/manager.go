@@ -14,6 +14,7 @@ import (
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/store/v2/commitment"
 	"cosmossdk.io/store/v2/internal/encoding"
+	"cosmossdk.io/store/v2/metrics"
 	"cosmossdk.io/store/v2/snapshots"
 	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
 	"cosmossdk.io/store/v2/storage"
@@ -25,9 +26,22 @@ const (
 	// defaultStorageBufferSize is the default buffer size for the storage snapshotter.
 	defaultStorageBufferSize = 1024
 
+	// syncPollInterval is the interval at which the catch up polls for the next Changeset.
+	syncPollInterval = 100 * time.Millisecond
+	// progressLogInterval is the number of restored keys between progress logs.
+	progressLogInterval = 100_000
+
 	migrateChangesetKeyFmt = "m/cs_%x" // m/cs_<version>
+	migratedVersionKey     = "m/migrated_version"
+	restoringKey           = "m/restoring"
+	completedKey           = "m/completed"
 )
 
+// ErrInterruptedRestore is returned when the node stopped while the snapshot was being
+// restored into the store/v2 backends. Their data is incomplete and must be removed to
+// start the migration over.
+var ErrInterruptedRestore = errors.New("migration: the snapshot restore was interrupted, remove the store/v2 data to start over")
+
 // VersionedChangeset is a pair of version and Changeset.
 type VersionedChangeset struct {
 	Version   uint64
@@ -42,9 +56,17 @@ type Manager struct {
 	stateStorage    *storage.StorageStore
 	stateCommitment *commitment.CommitStore
 
+	// telemetry reflects a telemetry agent responsible for emitting the progress (if any)
+	telemetry *metrics.Metrics
+
+	// switchHeight is the height from which the RootStore switches over to the store/v2
+	// backends, zero meaning as soon as the migration has caught up.
+	switchHeight uint64
+
 	db              corestore.KVStoreWithBatch
-	mtx             sync.Mutex // mutex for migratedVersion
+	mtx             sync.Mutex // mutex for migratedVersion and err
 	migratedVersion uint64
+	err             error
 
 	chChangeset <-chan *VersionedChangeset
 	chDone      <-chan struct{}
@@ -63,24 +85,77 @@ func NewManager(db corestore.KVStoreWithBatch, sm *snapshots.Manager, ss *storag
 	}
 }
 
+// SetMetrics sets the telemetry agent the migration progress is reported to.
+func (m *Manager) SetMetrics(telemetry metrics.Metrics) {
+	m.telemetry = &telemetry
+}
+
+// SetSwitchHeight sets the height from which the RootStore switches over to the store/v2
+// backends. Until then, the node keeps serving from the original store while the
+// migration catches up in the background. The RootStore switches over at the first
+// height, from the switch height on, at which the migration has caught up.
+// By default, the RootStore switches over as soon as the migration has caught up.
+func (m *Manager) SetSwitchHeight(height uint64) {
+	m.switchHeight = height
+}
+
 // Start starts the whole migration process.
 // It migrates the whole state at the given version to the new store/v2 (both SC and SS).
 // It also catches up the Changesets which are committed while the migration is in progress.
-// `chChangeset` is the channel to receive the committed Changesets from the RootStore.
+// `chChangeset` is the channel to receive the committed Changesets from the RootStore, it
+// can be nil if they are persisted with PersistChangeset instead.
 // `chDone` is the channel to receive the done signal from the RootStore.
+//
+// If a previous migration was interrupted after the snapshot was restored, it resumes
+// catching up from the last migrated version instead.
 // NOTE: It should be called by the RootStore, running in the background.
-func (m *Manager) Start(version uint64, chChangeset <-chan *VersionedChangeset, chDone <-chan struct{}) error {
+func (m *Manager) Start(version uint64, chChangeset <-chan *VersionedChangeset, chDone <-chan struct{}) (err error) {
+	defer func() {
+		if err != nil {
+			m.mtx.Lock()
+			m.err = err
+			m.mtx.Unlock()
+		}
+	}()
+
 	m.chChangeset = chChangeset
 	m.chDone = chDone
 
-	go func() {
-		if err := m.writeChangeset(); err != nil {
-			m.logger.Error("failed to write changeset", "err", err)
+	if chChangeset != nil {
+		go func() {
+			if err := m.writeChangeset(); err != nil {
+				m.logger.Error("failed to write changeset", "err", err)
+			}
+		}()
+	}
+
+	migratedVersion, err := m.loadMigratedVersion()
+	if err != nil {
+		return err
+	}
+	restoring, err := m.db.Has([]byte(restoringKey))
+	if err != nil {
+		return fmt.Errorf("failed to get the migration state: %w", err)
+	}
+
+	switch {
+	case migratedVersion > 0:
+		m.logger.Info("resuming migration", "migrated_version", migratedVersion, "version", version)
+		if m.stateCommitment != nil {
+			// roll back a version which was committed to SC but not to SS
+			if err := m.stateCommitment.LoadVersion(migratedVersion); err != nil {
+				return fmt.Errorf("failed to load the migrated version %d: %w", migratedVersion, err)
+			}
 		}
-	}()
+		m.setMigratedVersion(migratedVersion)
 
-	if err := m.Migrate(version); err != nil {
-		return fmt.Errorf("failed to migrate state: %w", err)
+	case restoring:
+		return ErrInterruptedRestore
+
+	default:
+		if err := m.Migrate(version); err != nil {
+			return fmt.Errorf("failed to migrate state: %w", err)
+		}
 	}
 
 	return m.Sync()
@@ -93,6 +168,18 @@ func (m *Manager) GetStateCommitment() *commitment.CommitStore {
 
 // Migrate migrates the whole state at the given height to the new store/v2.
 func (m *Manager) Migrate(height uint64) error {
+	if m.telemetry != nil {
+		now := time.Now()
+		defer m.telemetry.MeasureSince(now, "migration", "restore")
+		m.telemetry.SetGauge(float32(height), "migration", "restore_version")
+	}
+
+	// mark the restore as started, so that an interrupted restore is detected
+	if err := m.db.Set([]byte(restoringKey), []byte{}); err != nil {
+		return fmt.Errorf("failed to set the migration state: %w", err)
+	}
+	m.logger.Info("restoring the snapshot of the original store", "version", height)
+
 	// create the migration stream and snapshot,
 	// which acts as protoio.Reader and snapshots.WriteCloser.
 	ms := NewMigrationStream(defaultChannelBufferSize)
@@ -101,7 +188,8 @@ func (m *Manager) Migrate(height uint64) error {
 		return err
 	}
 
-	// restore the snapshot
+	// restore the snapshot, relaying the restored keys to the storage to report the progress
+	chRestored := make(chan *corestore.StateChanges, defaultStorageBufferSize)
 	chStorage := make(chan *corestore.StateChanges, defaultStorageBufferSize)
 
 	eg := new(errgroup.Group)
@@ -110,8 +198,23 @@ func (m *Manager) Migrate(height uint64) error {
 	})
 	eg.Go(func() error {
 		defer close(chStorage)
+		restoredKeys := 0
+		for changes := range chRestored {
+			chStorage <- changes
+			restoredKeys += len(changes.StateChanges)
+			if restoredKeys%progressLogInterval < len(changes.StateChanges) {
+				m.logger.Info("migration progress", "version", height, "restored_keys", restoredKeys)
+				if m.telemetry != nil {
+					m.telemetry.SetGauge(float32(restoredKeys), "migration", "restored_keys")
+				}
+			}
+		}
+		return nil
+	})
+	eg.Go(func() error {
+		defer close(chRestored)
 		if m.stateCommitment != nil {
-			if _, err := m.stateCommitment.Restore(height, 0, ms, chStorage); err != nil {
+			if _, err := m.stateCommitment.Restore(height, 0, ms, chRestored); err != nil {
 				return err
 			}
 		} else { // there is no commitment migration, just consume the stream to restore the state storage
@@ -139,7 +242,7 @@ func (m *Manager) Migrate(height uint64) error {
 						if value == nil {
 							value = []byte{}
 						}
-						chStorage <- &corestore.StateChanges{
+						chRestored <- &corestore.StateChanges{
 							Actor: storeKey,
 							StateChanges: []corestore.KVPair{
 								{
@@ -161,47 +264,43 @@ func (m *Manager) Migrate(height uint64) error {
 		return err
 	}
 
-	m.mtx.Lock()
-	m.migratedVersion = height
-	m.mtx.Unlock()
+	if err := m.saveMigratedVersion(height, nil); err != nil {
+		return err
+	}
+	m.logger.Info("restored the snapshot of the original store", "version", height)
 
 	return nil
 }
 
-// writeChangeset writes the Changeset to the db.
+// writeChangeset writes the Changesets received from the RootStore to the db.
 func (m *Manager) writeChangeset() error {
 	for vc := range m.chChangeset {
-		cs := vc.Changeset
-		buf := make([]byte, 8)
-		binary.BigEndian.PutUint64(buf, vc.Version)
-		csKey := []byte(fmt.Sprintf(migrateChangesetKeyFmt, buf))
-		csBytes, err := encoding.MarshalChangeset(cs)
-		if err != nil {
-			return fmt.Errorf("failed to marshal changeset: %w", err)
+		if err := m.PersistChangeset(vc); err != nil {
+			return err
 		}
+	}
 
-		batch := m.db.NewBatch()
-		// Invoking this code in a closure so that defer is called immediately on return
-		// yet not in the for-loop which can leave resource lingering.
-		err = func() (err error) {
-			defer func() {
-				cErr := batch.Close()
-				if err == nil {
-					err = cErr
-				}
-			}()
+	return nil
+}
 
-			if err := batch.Set(csKey, csBytes); err != nil {
-				return fmt.Errorf("failed to write changeset to db.Batch: %w", err)
-			}
-			if err := batch.Write(); err != nil {
-				return fmt.Errorf("failed to write changeset to db: %w", err)
-			}
-			return nil
-		}()
-		if err != nil {
-			return err
-		}
+// PersistChangeset writes the Changeset committed to the original store at the given
+// version to the db, to be replayed against the store/v2 backends while catching up.
+// The RootStore calls it before committing the version to the original store, so that
+// the migration can resume from the db after a crash.
+func (m *Manager) PersistChangeset(vc *VersionedChangeset) error {
+	csBytes, err := encoding.MarshalChangeset(vc.Changeset)
+	if err != nil {
+		return fmt.Errorf("failed to marshal changeset: %w", err)
+	}
+
+	batch := m.db.NewBatch()
+	defer batch.Close()
+
+	if err := batch.Set(changesetKey(vc.Version), csBytes); err != nil {
+		return fmt.Errorf("failed to write changeset to db.Batch: %w", err)
+	}
+	if err := batch.Write(); err != nil {
+		return fmt.Errorf("failed to write changeset to db: %w", err)
 	}
 
 	return nil
@@ -215,6 +314,53 @@ func (m *Manager) GetMigratedVersion() uint64 {
 	return m.migratedVersion
 }
 
+func (m *Manager) setMigratedVersion(version uint64) {
+	m.mtx.Lock()
+	m.migratedVersion = version
+	m.mtx.Unlock()
+
+	if m.telemetry != nil {
+		m.telemetry.SetGauge(float32(version), "migration", "migrated_version")
+	}
+}
+
+// ShouldSwitch returns whether the RootStore must switch over to the store/v2 backends
+// before writing the version following the given latest committed version, that is whether
+// the migration has caught up with the latest version and the switch height (if any) is
+// reached. It does not wait for the migration: if it has not caught up yet, the RootStore
+// keeps committing to the original store and checks again at the next version.
+// An error is returned if the migration failed, once the switch height (if any) is reached.
+func (m *Manager) ShouldSwitch(latestVersion uint64) (bool, error) {
+	if m.switchHeight > 0 && latestVersion+1 < m.switchHeight {
+		return false, nil
+	}
+
+	m.mtx.Lock()
+	migratedVersion, err := m.migratedVersion, m.err
+	m.mtx.Unlock()
+	if m.switchHeight == 0 {
+		if err != nil {
+			return false, fmt.Errorf("failed to switch over: %w", err)
+		}
+		return migratedVersion == latestVersion, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to switch over at height %d: %w", m.switchHeight, err)
+	}
+	if migratedVersion != latestVersion {
+		m.logger.Info("migration has not caught up at the switch height, retrying at the next height", "migrated_version", migratedVersion, "version", latestVersion, "switch_height", m.switchHeight)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// IsCompleted returns whether a previous migration has already switched the RootStore
+// over to the store/v2 backends.
+func (m *Manager) IsCompleted() (bool, error) {
+	return m.db.Has([]byte(completedKey))
+}
+
 // Sync catches up the Changesets which are committed while the migration is in progress.
 // It should be called after the migration is done.
 func (m *Manager) Sync() error {
@@ -229,16 +375,14 @@ func (m *Manager) Sync() error {
 		case <-m.chDone:
 			return nil
 		default:
-			buf := make([]byte, 8)
-			binary.BigEndian.PutUint64(buf, version)
-			csKey := []byte(fmt.Sprintf(migrateChangesetKeyFmt, buf))
+			csKey := changesetKey(version)
 			csBytes, err := m.db.Get(csKey)
 			if err != nil {
 				return fmt.Errorf("failed to get changeset from db: %w", err)
 			}
 			if csBytes == nil {
 				// wait for the next changeset
-				time.Sleep(100 * time.Millisecond)
+				time.Sleep(syncPollInterval)
 				continue
 			}
 
@@ -258,9 +402,9 @@ func (m *Manager) Sync() error {
 				return fmt.Errorf("failed to write changeset to storage: %w", err)
 			}
 
-			m.mtx.Lock()
-			m.migratedVersion = version
-			m.mtx.Unlock()
+			if err := m.saveMigratedVersion(version, csKey); err != nil {
+				return err
+			}
 
 			version += 1
 		}
@@ -268,8 +412,12 @@ func (m *Manager) Sync() error {
 }
 
 // Close closes the manager. It should be called after the migration is done.
-// It will close the db and notify the snapshotsManager that the migration is done.
+// It records the migration as completed, closes the db and notifies the snapshotsManager
+// that the migration is done.
 func (m *Manager) Close() error {
+	if err := m.db.Set([]byte(completedKey), []byte{}); err != nil {
+		return fmt.Errorf("failed to set the migration state: %w", err)
+	}
 	if err := m.db.Close(); err != nil {
 		return fmt.Errorf("failed to close db: %w", err)
 	}
@@ -279,3 +427,51 @@ func (m *Manager) Close() error {
 
 	return nil
 }
+
+// loadMigratedVersion returns the migrated version persisted in the db, zero if the
+// snapshot was not restored yet.
+func (m *Manager) loadMigratedVersion() (uint64, error) {
+	bz, err := m.db.Get([]byte(migratedVersionKey))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the migrated version: %w", err)
+	}
+	if bz == nil {
+		return 0, nil
+	}
+
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+// saveMigratedVersion persists the migrated version, removing the replayed Changeset
+// (if any), and updates the in-memory migrated version.
+func (m *Manager) saveMigratedVersion(version uint64, csKey []byte) error {
+	batch := m.db.NewBatch()
+	defer batch.Close()
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, version)
+	if err := batch.Set([]byte(migratedVersionKey), bz); err != nil {
+		return fmt.Errorf("failed to write the migrated version to db.Batch: %w", err)
+	}
+	if err := batch.Delete([]byte(restoringKey)); err != nil {
+		return fmt.Errorf("failed to write the migration state to db.Batch: %w", err)
+	}
+	if csKey != nil {
+		if err := batch.Delete(csKey); err != nil {
+			return fmt.Errorf("failed to delete changeset from db.Batch: %w", err)
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return fmt.Errorf("failed to write the migrated version to db: %w", err)
+	}
+
+	m.setMigratedVersion(version)
+
+	return nil
+}
+
+func changesetKey(version uint64) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, version)
+	return []byte(fmt.Sprintf(migrateChangesetKeyFmt, buf))
+}
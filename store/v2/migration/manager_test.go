@@ -3,6 +3,7 @@ package migration
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -117,3 +118,94 @@ func TestMigrateState(t *testing.T) {
 		})
 	}
 }
+
+func TestMigrateResume(t *testing.T) {
+	m, orgCommitStore := setupMigrationManager(t, false)
+
+	// apply changeset
+	toVersion := uint64(10)
+	keyCount := 10
+	changesets := make(map[uint64]*corestore.Changeset)
+	for version := uint64(1); version <= toVersion+3; version++ {
+		cs := corestore.NewChangeset()
+		for _, storeKey := range storeKeys {
+			for i := 0; i < keyCount; i++ {
+				cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key-%d-%d", version, i)), []byte(fmt.Sprintf("value-%d-%d", version, i)), false)
+			}
+		}
+		changesets[version] = cs
+	}
+	for version := uint64(1); version <= toVersion; version++ {
+		require.NoError(t, orgCommitStore.WriteChangeset(changesets[version]))
+		_, err := orgCommitStore.Commit(version)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, m.Migrate(toVersion))
+
+	// the next changesets are committed to the original store while the node is down
+	for version := toVersion + 1; version <= toVersion+3; version++ {
+		require.NoError(t, m.PersistChangeset(&VersionedChangeset{Version: version, Changeset: changesets[version]}))
+	}
+
+	// restart the migration, it resumes from the migrated version instead of restoring a snapshot again
+	resumed := NewManager(m.db, m.snapshotsManager, m.stateStorage, m.stateCommitment, coretesting.NewNopLogger())
+	chDone := make(chan struct{})
+	chErr := make(chan error, 1)
+	go func() {
+		chErr <- resumed.Start(toVersion+3, nil, chDone)
+	}()
+	require.Eventually(t, func() bool {
+		return resumed.GetMigratedVersion() == toVersion+3
+	}, 5*time.Second, 10*time.Millisecond)
+	close(chDone)
+	require.NoError(t, <-chErr)
+
+	for version := uint64(1); version <= toVersion+3; version++ {
+		val, err := resumed.stateStorage.Get([]byte("store1"), toVersion+3, []byte(fmt.Sprintf("key-%d-0", version)))
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("value-%d-0", version)), val)
+	}
+
+	// the replayed changesets are removed
+	for version := toVersion + 1; version <= toVersion+3; version++ {
+		has, err := m.db.Has(changesetKey(version))
+		require.NoError(t, err)
+		require.False(t, has)
+	}
+
+	// closing the manager records the migration as completed
+	completed, err := resumed.IsCompleted()
+	require.NoError(t, err)
+	require.False(t, completed)
+	require.NoError(t, resumed.Close())
+	completed, err = NewManager(m.db, m.snapshotsManager, m.stateStorage, m.stateCommitment, coretesting.NewNopLogger()).IsCompleted()
+	require.NoError(t, err)
+	require.True(t, completed)
+}
+
+func TestMigrateInterruptedRestore(t *testing.T) {
+	m, _ := setupMigrationManager(t, true)
+
+	// the node stopped while restoring the snapshot
+	require.NoError(t, m.db.Set([]byte(restoringKey), []byte{}))
+
+	err := m.Start(1, nil, make(chan struct{}))
+	require.ErrorIs(t, err, ErrInterruptedRestore)
+}
+
+func TestShouldSwitchFailedMigration(t *testing.T) {
+	m, _ := setupMigrationManager(t, true)
+	m.migratedVersion = 1
+	m.err = fmt.Errorf("migration failed")
+
+	_, err := m.ShouldSwitch(1)
+	require.ErrorContains(t, err, "migration failed")
+
+	m.SetSwitchHeight(3)
+	switchOver, err := m.ShouldSwitch(1)
+	require.NoError(t, err)
+	require.False(t, switchOver)
+	_, err = m.ShouldSwitch(2)
+	require.ErrorContains(t, err, "migration failed")
+}
@@ -0,0 +1,61 @@
+package migration
+
+import (
+	"errors"
+
+	protoio "github.com/cosmos/gogoproto/io"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2/snapshots"
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+// StoreKVPair is a state change recorded by the listenkv stores of a store v1 rootmulti.Store,
+// it is implemented by the store v1 *types.StoreKVPair returned by the PopStateCache method.
+type StoreKVPair interface {
+	GetStoreKey() string
+	GetDelete() bool
+	GetKey() []byte
+	GetValue() []byte
+}
+
+// PersistStoreKVPairs persists the state changes committed to a store v1 rootmulti.Store at
+// the given version, to be replayed against the store/v2 backends while catching up.
+// It lets a node running on store v1 keep committing to its rootmulti.Store while it is
+// migrated in the background: it should be called with the state changes of every block,
+// for instance from a baseapp ABCIListener, the store listeners being enabled for all the
+// KV stores of the app.
+func PersistStoreKVPairs[P StoreKVPair](m *Manager, version uint64, pairs []P) error {
+	cs := corestore.NewChangeset()
+	for _, pair := range pairs {
+		cs.Add([]byte(pair.GetStoreKey()), pair.GetKey(), pair.GetValue(), pair.GetDelete())
+	}
+
+	return m.PersistChangeset(&VersionedChangeset{Version: version, Changeset: cs})
+}
+
+// Snapshotter writes a snapshot of the state of the original store at a height,
+// it is implemented by the store v1 rootmulti.Store, whose snapshot items can be
+// read as store/v2 snapshot items.
+type Snapshotter interface {
+	Snapshot(height uint64, protoWriter protoio.Writer) error
+}
+
+// v1CommitSnapshotter adapts the Snapshotter of a store v1 rootmulti.Store to a
+// snapshots.CommitSnapshotter, so that the snapshot restored by the migration is
+// streamed from the rootmulti.Store.
+type v1CommitSnapshotter struct {
+	Snapshotter
+}
+
+// NewV1CommitSnapshotter returns the snapshots.CommitSnapshotter of the snapshots.Manager
+// passed to NewManager, to migrate a node running on a store v1 rootmulti.Store.
+// It only snapshots the rootmulti.Store, restoring a snapshot is not supported.
+func NewV1CommitSnapshotter(s Snapshotter) snapshots.CommitSnapshotter {
+	return v1CommitSnapshotter{Snapshotter: s}
+}
+
+// Restore implements snapshots.CommitSnapshotter.
+func (v1CommitSnapshotter) Restore(uint64, uint32, protoio.Reader, chan<- *corestore.StateChanges) (snapshotstypes.SnapshotItem, error) {
+	return snapshotstypes.SnapshotItem{}, errors.New("migration: restoring a snapshot into a store v1 rootmulti.Store is not supported")
+}
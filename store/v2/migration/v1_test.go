@@ -0,0 +1,88 @@
+package migration
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	corestore "cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/store/v2/snapshots"
+)
+
+// testStoreKVPair mimics the store v1 StoreKVPair recorded by the listenkv stores.
+type testStoreKVPair struct {
+	storeKey   string
+	delete     bool
+	key, value []byte
+}
+
+func (p *testStoreKVPair) GetStoreKey() string { return p.storeKey }
+func (p *testStoreKVPair) GetDelete() bool     { return p.delete }
+func (p *testStoreKVPair) GetKey() []byte      { return p.key }
+func (p *testStoreKVPair) GetValue() []byte    { return p.value }
+
+func TestMigrateV1(t *testing.T) {
+	m, orgCommitStore := setupMigrationManager(t, false)
+
+	// the original store is snapshotted through the store v1 snapshotter adapter
+	snapshotsStore, err := snapshots.NewStore(t.TempDir())
+	require.NoError(t, err)
+	m.snapshotsManager = snapshots.NewManager(snapshotsStore, snapshots.NewSnapshotOptions(1500, 2), NewV1CommitSnapshotter(orgCommitStore), nil, nil, coretesting.NewNopLogger())
+
+	toVersion := uint64(10)
+	for version := uint64(1); version <= toVersion; version++ {
+		cs := corestore.NewChangeset()
+		for _, storeKey := range storeKeys {
+			cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key-%d", version)), []byte(fmt.Sprintf("value-%d", version)), false)
+		}
+		require.NoError(t, orgCommitStore.WriteChangeset(cs))
+		_, err := orgCommitStore.Commit(version)
+		require.NoError(t, err)
+	}
+	require.NoError(t, m.Migrate(toVersion))
+
+	// the state changes of the next block are recorded by the listeners of the original store
+	pairs := []*testStoreKVPair{
+		{storeKey: "store1", key: []byte("key-11"), value: []byte("value-11")},
+		{storeKey: "store1", key: []byte("key-1"), delete: true},
+	}
+	require.NoError(t, PersistStoreKVPairs(m, toVersion+1, pairs))
+
+	// the migration has not caught up at the switch height, the store does not wait for it
+	m.SetSwitchHeight(toVersion + 1)
+	switchOver, err := m.ShouldSwitch(toVersion + 1)
+	require.NoError(t, err)
+	require.False(t, switchOver)
+
+	chDone := make(chan struct{})
+	chErr := make(chan error, 1)
+	go func() {
+		chErr <- m.Start(toVersion+1, nil, chDone)
+	}()
+	require.Eventually(t, func() bool {
+		return m.GetMigratedVersion() == toVersion+1
+	}, 5*time.Second, 10*time.Millisecond)
+	close(chDone)
+	require.NoError(t, <-chErr)
+
+	switchOver, err = m.ShouldSwitch(toVersion + 1)
+	require.NoError(t, err)
+	require.True(t, switchOver)
+
+	val, err := m.stateStorage.Get([]byte("store1"), toVersion+1, []byte("key-11"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value-11"), val)
+	val, err = m.stateStorage.Get([]byte("store1"), toVersion+1, []byte("key-1"))
+	require.NoError(t, err)
+	require.Nil(t, val)
+	val, err = m.stateStorage.Get([]byte("store2"), toVersion+1, []byte("key-10"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value-10"), val)
+
+	// restoring into the original store is not supported
+	_, err = NewV1CommitSnapshotter(orgCommitStore).Restore(toVersion, 0, nil, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,269 @@
+package proof
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	ics23 "github.com/cosmos/ics23/go"
+
+	corestore "cosmossdk.io/core/store"
+	errors "cosmossdk.io/errors/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
+)
+
+// Proof operation types of the proofs of several keys of a store, made of a
+// single ics23 batch proof.
+const (
+	ProofOpIAVLBatchCommitment = "ics23:iavl-batch"
+	ProofOpIAVLRangeCommitment = "ics23:iavl-range"
+)
+
+// MaxBatchProofKeys is the maximum number of keys proven by a single batch or
+// range proof query, a range with more keys being proven page by page.
+const MaxBatchProofKeys = 1000
+
+// NewIAVLBatchCommitmentOp returns the CommitmentOp of a batch proof of the
+// existence or absence of several keys of an IAVL tree.
+func NewIAVLBatchCommitmentOp(proof *ics23.CommitmentProof) CommitmentOp {
+	return CommitmentOp{
+		Type:  ProofOpIAVLBatchCommitment,
+		Spec:  ics23.IavlSpec,
+		Proof: proof,
+	}
+}
+
+// NewIAVLRangeCommitmentOp returns the CommitmentOp of a batch proof of all the
+// keys of an IAVL tree in a range, along with the neighbors of the range.
+func NewIAVLRangeCommitmentOp(proof *ics23.CommitmentProof) CommitmentOp {
+	return CommitmentOp{
+		Type:  ProofOpIAVLRangeCommitment,
+		Spec:  ics23.IavlSpec,
+		Proof: proof,
+	}
+}
+
+// VerifyBatchProof verifies the proof of the given keys of a store up to the root
+// hash, and returns the proven values of the keys, a nil value proving the absence
+// of the key. The proof ops are the batch proof of the keys in the store tree,
+// followed by the proof of the store hash in the commit info.
+func VerifyBatchProof(ops []CommitmentOp, root []byte, keys [][]byte) ([][]byte, error) {
+	storeRoot, err := calculateStoreRoot(ops, ProofOpIAVLBatchCommitment)
+	if err != nil {
+		return nil, err
+	}
+
+	batchOp := ops[0]
+	existProofs := make(map[string]*ics23.ExistenceProof)
+	for _, entry := range ics23.Decompress(batchOp.Proof).GetBatch().GetEntries() {
+		if exist := entry.GetExist(); exist != nil {
+			existProofs[string(exist.Key)] = exist
+		}
+	}
+
+	values := make([][]byte, len(keys))
+	for i, key := range keys {
+		if exist, ok := existProofs[string(key)]; ok {
+			if !ics23.VerifyMembership(batchOp.Spec, storeRoot, batchOp.Proof, key, exist.Value) {
+				return nil, errors.Wrapf(storeerrors.ErrInvalidProof, "proof did not verify existence of key %s", key)
+			}
+			values[i] = exist.Value
+			continue
+		}
+		if !ics23.VerifyNonMembership(batchOp.Spec, storeRoot, batchOp.Proof, key) {
+			return nil, errors.Wrapf(storeerrors.ErrInvalidProof, "proof did not verify absence of key %s", key)
+		}
+	}
+
+	if err := verifyProofChain(ops[1:], storeRoot, root); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// VerifyRangeProof verifies the proof of the keys of a store in the range
+// [start, end) up to the root hash, and returns all the key/value pairs of the
+// range, sorted by key. A nil end is unbounded. The proof ops are the range proof
+// in the store tree, followed by the proof of the store hash in the commit info.
+//
+// The range is complete if the proven keys of the range, preceded by the closest
+// key before start and followed by the closest key from end, if any, are
+// neighbors in the store tree.
+func VerifyRangeProof(ops []CommitmentOp, root, start, end []byte) (corestore.KVPairs, error) {
+	storeRoot, err := calculateStoreRoot(ops, ProofOpIAVLRangeCommitment)
+	if err != nil {
+		return nil, err
+	}
+
+	// every existence proof of the batch, including the neighbors of the
+	// non-existence proofs, must be valid
+	rangeOp := ops[0]
+	existProofs := make(map[string]*ics23.ExistenceProof)
+	for _, entry := range ics23.Decompress(rangeOp.Proof).GetBatch().GetEntries() {
+		proofs := []*ics23.ExistenceProof{entry.GetExist()}
+		if nonexist := entry.GetNonexist(); nonexist != nil {
+			proofs = []*ics23.ExistenceProof{nonexist.Left, nonexist.Right}
+		}
+		for _, exist := range proofs {
+			if exist == nil {
+				continue
+			}
+			if err := exist.Verify(rangeOp.Spec, storeRoot, exist.Key, exist.Value); err != nil {
+				return nil, errors.Wrapf(storeerrors.ErrInvalidProof, "invalid existence proof of key %s: %v", exist.Key, err)
+			}
+			existProofs[string(exist.Key)] = exist
+		}
+	}
+	sorted := make([]*ics23.ExistenceProof, 0, len(existProofs))
+	for _, exist := range existProofs {
+		sorted = append(sorted, exist)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Key, sorted[j].Key) < 0
+	})
+
+	var (
+		left, right *ics23.ExistenceProof
+		pairs       corestore.KVPairs
+		chain       []*ics23.ExistenceProof
+	)
+	for _, exist := range sorted {
+		switch {
+		case bytes.Compare(exist.Key, start) < 0:
+			left = exist
+		case end == nil || bytes.Compare(exist.Key, end) < 0:
+			chain = append(chain, exist)
+			pairs = append(pairs, corestore.KVPair{Key: exist.Key, Value: exist.Value})
+		case right == nil:
+			right = exist
+		}
+	}
+	if left != nil {
+		chain = append([]*ics23.ExistenceProof{left}, chain...)
+	}
+	if right != nil {
+		chain = append(chain, right)
+	}
+	if len(chain) == 0 {
+		return nil, errors.Wrap(storeerrors.ErrInvalidProof, "proof does not contain any key")
+	}
+
+	spec := rangeOp.Spec.InnerSpec
+	if left == nil && !ics23.IsLeftMost(spec, chain[0].Path) {
+		return nil, errors.Wrapf(storeerrors.ErrInvalidProof, "proof did not verify the keys before key %s", chain[0].Key)
+	}
+	if right == nil && !ics23.IsRightMost(spec, chain[len(chain)-1].Path) {
+		return nil, errors.Wrapf(storeerrors.ErrInvalidProof, "proof did not verify the keys after key %s", chain[len(chain)-1].Key)
+	}
+	for i := 1; i < len(chain); i++ {
+		if len(chain[i-1].Path) == 0 || len(chain[i].Path) == 0 || !ics23.IsLeftNeighbor(spec, chain[i-1].Path, chain[i].Path) {
+			return nil, errors.Wrapf(storeerrors.ErrInvalidProof, "proof did not verify the keys between key %s and key %s",
+				chain[i-1].Key, chain[i].Key)
+		}
+	}
+
+	if err := verifyProofChain(ops[1:], storeRoot, root); err != nil {
+		return nil, err
+	}
+	return pairs, nil
+}
+
+// calculateStoreRoot checks the first proof op is a batch proof of the given
+// type, and returns the root hash of the store it proves the keys of.
+func calculateStoreRoot(ops []CommitmentOp, opType string) ([]byte, error) {
+	if len(ops) == 0 {
+		return nil, errors.Wrap(storeerrors.ErrInvalidProof, "proof is empty")
+	}
+	if ops[0].Type != opType {
+		return nil, errors.Wrapf(storeerrors.ErrInvalidProof, "expected proof op of type %s, got %s", opType, ops[0].Type)
+	}
+	if ops[0].Proof.GetBatch() == nil && ops[0].Proof.GetCompressed() == nil {
+		return nil, errors.Wrap(storeerrors.ErrInvalidProof, "proof is not a batch proof")
+	}
+
+	storeRoot, err := ops[0].Proof.Calculate()
+	if err != nil {
+		return nil, errors.Wrapf(storeerrors.ErrInvalidProof, "could not calculate root for proof: %v", err)
+	}
+	return storeRoot, nil
+}
+
+// verifyProofChain runs the given proof ops in order, each one proving the root
+// hash returned by the previous one, and checks the last root hash is root.
+func verifyProofChain(ops []CommitmentOp, subRoot, root []byte) error {
+	hash := subRoot
+	for _, op := range ops {
+		res, err := op.Run([][]byte{hash})
+		if err != nil {
+			return err
+		}
+		hash = res[0]
+	}
+	if !bytes.Equal(hash, root) {
+		return errors.Wrapf(storeerrors.ErrInvalidProof, "calculated root hash %X does not match %X", hash, root)
+	}
+	return nil
+}
+
+// PrefixEndBytes returns the end of the range of the keys with the given prefix,
+// to prove them with a range proof. It is nil, i.e. unbounded, if the prefix is
+// empty or only made of 0xFF bytes.
+func PrefixEndBytes(prefix []byte) []byte {
+	end := bytes.Clone(prefix)
+	for len(end) > 0 {
+		if end[len(end)-1] != 0xFF {
+			end[len(end)-1]++
+			return end
+		}
+		end = end[:len(end)-1]
+	}
+	return nil
+}
+
+// EncodeKeys encodes the keys of a batch proof query, each key being prefixed
+// by its uvarint length.
+func EncodeKeys(keys [][]byte) []byte {
+	var buf bytes.Buffer
+	for _, key := range keys {
+		buf.Write(binary.AppendUvarint(nil, uint64(len(key))))
+		buf.Write(key)
+	}
+	return buf.Bytes()
+}
+
+// DecodeKeys decodes the keys encoded by EncodeKeys.
+func DecodeKeys(bz []byte) ([][]byte, error) {
+	var keys [][]byte
+	for len(bz) > 0 {
+		n, size := binary.Uvarint(bz)
+		if size <= 0 || uint64(len(bz)-size) < n {
+			return nil, errors.Wrap(storeerrors.ErrInvalidRequest, "invalid encoded keys")
+		}
+		keys = append(keys, bz[size:size+int(n)])
+		bz = bz[size+int(n):]
+	}
+	return keys, nil
+}
+
+// EncodeRangeQuery encodes the query of a range proof of at most limit keys with
+// the given prefix, from the start key. The start key is the prefix itself for
+// the first page and the next key returned by the query for the following ones.
+func EncodeRangeQuery(prefix, start []byte, limit uint64) []byte {
+	return append(binary.AppendUvarint(nil, limit), EncodeKeys([][]byte{prefix, start})...)
+}
+
+// DecodeRangeQuery decodes the query encoded by EncodeRangeQuery.
+func DecodeRangeQuery(bz []byte) (prefix, start []byte, limit uint64, err error) {
+	limit, size := binary.Uvarint(bz)
+	if size <= 0 {
+		return nil, nil, 0, errors.Wrap(storeerrors.ErrInvalidRequest, "invalid encoded range query limit")
+	}
+	keys, err := DecodeKeys(bz[size:])
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if len(keys) != 2 {
+		return nil, nil, 0, errors.Wrapf(storeerrors.ErrInvalidRequest, "expected a prefix and a start key, got %d keys", len(keys))
+	}
+	return keys[0], keys[1], limit, nil
+}
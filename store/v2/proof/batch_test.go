@@ -0,0 +1,57 @@
+package proof
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrefixEndBytes(t *testing.T) {
+	tests := []struct {
+		prefix []byte
+		want   []byte
+	}{
+		{nil, nil},
+		{[]byte{}, nil},
+		{[]byte{0xFF}, nil},
+		{[]byte{0xFF, 0xFF}, nil},
+		{[]byte("key"), []byte("kez")},
+		{[]byte{0x01, 0xFF}, []byte{0x02}},
+		{[]byte{0x01, 0xFE, 0xFF}, []byte{0x01, 0xFF}},
+	}
+	for _, tc := range tests {
+		prefix := append([]byte(nil), tc.prefix...)
+		require.Equal(t, tc.want, PrefixEndBytes(tc.prefix), "prefix %X", tc.prefix)
+		// the prefix is not modified
+		require.Equal(t, prefix, append([]byte(nil), tc.prefix...))
+	}
+}
+
+func TestEncodeDecodeKeys(t *testing.T) {
+	keys := [][]byte{[]byte("key1"), {}, make([]byte, 300)}
+	decoded, err := DecodeKeys(EncodeKeys(keys))
+	require.NoError(t, err)
+	require.Equal(t, keys, decoded)
+
+	decoded, err = DecodeKeys(nil)
+	require.NoError(t, err)
+	require.Empty(t, decoded)
+
+	// the length prefix exceeds the remaining bytes
+	_, err = DecodeKeys([]byte{5, 'k', 'e', 'y'})
+	require.Error(t, err)
+}
+
+func TestEncodeDecodeRangeQuery(t *testing.T) {
+	prefix, start, limit, err := DecodeRangeQuery(EncodeRangeQuery([]byte("prefix"), []byte("prefix-key"), 300))
+	require.NoError(t, err)
+	require.Equal(t, []byte("prefix"), prefix)
+	require.Equal(t, []byte("prefix-key"), start)
+	require.Equal(t, uint64(300), limit)
+
+	_, _, _, err = DecodeRangeQuery(nil)
+	require.Error(t, err)
+	// a single key
+	_, _, _, err = DecodeRangeQuery(append([]byte{1}, EncodeKeys([][]byte{[]byte("prefix")})...))
+	require.Error(t, err)
+}
@@ -13,6 +13,7 @@ import (
 	"cosmossdk.io/store/v2/commitment/mem"
 	"cosmossdk.io/store/v2/db"
 	"cosmossdk.io/store/v2/internal"
+	"cosmossdk.io/store/v2/migration"
 	"cosmossdk.io/store/v2/pruning"
 	"cosmossdk.io/store/v2/storage"
 	"cosmossdk.io/store/v2/storage/pebbledb"
@@ -39,6 +40,8 @@ type Options struct {
 	SSPruningOption *store.PruningOption `mapstructure:"ss-pruning-option" toml:"ss-pruning-option" comment:"Pruning options for state storage"`
 	SCPruningOption *store.PruningOption `mapstructure:"sc-pruning-option" toml:"sc-pruning-option" comment:"Pruning options for state commitment"`
 	IavlConfig      *iavl.Config         `mapstructure:"iavl-config" toml:"iavl-config"`
+
+	MigrationSwitchHeight uint64 `mapstructure:"migration-switch-height" toml:"migration-switch-height" comment:"Height from which the node switches over to the store/v2 backends when migrating from store v1, 0 to switch over as soon as the migration has caught up"`
 }
 
 type FactoryOptions struct {
@@ -47,6 +50,10 @@ type FactoryOptions struct {
 	Options   Options
 	StoreKeys []string
 	SCRawDB   corestore.KVStoreWithBatch
+
+	// MigrationManager migrates the state of the created store to the store/v2
+	// backends, if set. Its switch height is set from Options.
+	MigrationManager *migration.Manager
 }
 
 func DefaultStoreOptions() Options {
@@ -147,5 +154,9 @@ func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
 
 	pm := pruning.NewManager(sc, ss, storeOpts.SCPruningOption, storeOpts.SSPruningOption)
 
-	return New(opts.Logger, ss, sc, pm, nil, nil)
+	if opts.MigrationManager != nil {
+		opts.MigrationManager.SetSwitchHeight(storeOpts.MigrationSwitchHeight)
+	}
+
+	return New(opts.Logger, ss, sc, pm, opts.MigrationManager, nil)
 }
@@ -26,7 +26,8 @@ var storeKeys = []string{"store1", "store2", "store3"}
 type MigrateStoreTestSuite struct {
 	suite.Suite
 
-	rootStore store.RootStore
+	rootStore        store.RootStore
+	migrationManager *migration.Manager
 }
 
 func TestMigrateStoreTestSuite(t *testing.T) {
@@ -76,11 +77,11 @@ func (s *MigrateStoreTestSuite) SetupTest() {
 	snapshotsStore, err := snapshots.NewStore(s.T().TempDir())
 	s.Require().NoError(err)
 	snapshotManager := snapshots.NewManager(snapshotsStore, snapshots.NewSnapshotOptions(1500, 2), orgSC, nil, nil, testLog)
-	migrationManager := migration.NewManager(dbm.NewMemDB(), snapshotManager, ss, sc, testLog)
+	s.migrationManager = migration.NewManager(dbm.NewMemDB(), snapshotManager, ss, sc, testLog)
 	pm := pruning.NewManager(sc, ss, nil, nil)
 
 	// assume no storage store, simulate the migration process
-	s.rootStore, err = New(testLog, ss, orgSC, pm, migrationManager, nil)
+	s.rootStore, err = New(testLog, ss, orgSC, pm, s.migrationManager, nil)
 	s.Require().NoError(err)
 }
 
@@ -161,3 +162,51 @@ func (s *MigrateStoreTestSuite) TestMigrateState() {
 	s.Require().NoError(err)
 	s.Require().Equal(latestVersion+10, version)
 }
+
+func (s *MigrateStoreTestSuite) TestMigrateSwitchHeight() {
+	originalLatestVersion := uint64(200)
+	switchHeight := originalLatestVersion + 5
+	s.migrationManager.SetSwitchHeight(switchHeight)
+
+	err := s.rootStore.LoadLatestVersion()
+	s.Require().NoError(err)
+
+	// the store keeps serving from the original store until the switch height, and
+	// switches over at the first height from the switch height on at which the
+	// migration has caught up
+	latestVersion := originalLatestVersion
+	for s.rootStore.(*Store).isMigrating {
+		latestVersion++
+		s.Require().Less(latestVersion, switchHeight+100, "the migration did not catch up")
+		cs := corestore.NewChangeset()
+		for _, storeKey := range storeKeys {
+			cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key-%d", latestVersion)), []byte(fmt.Sprintf("value-%d", latestVersion)), false)
+		}
+		_, err = s.rootStore.Commit(cs)
+		s.Require().NoError(err)
+		if latestVersion < switchHeight {
+			s.Require().True(s.rootStore.(*Store).isMigrating, "version %d", latestVersion)
+		}
+
+		// add some delay to simulate the consensus process
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// query against the migrated store
+	for version := uint64(1); version <= latestVersion; version++ {
+		key := fmt.Sprintf("key-%d-0", version)
+		value := fmt.Sprintf("value-%d-0", version)
+		if version > originalLatestVersion {
+			key = fmt.Sprintf("key-%d", version)
+			value = fmt.Sprintf("value-%d", version)
+		}
+		res, err := s.rootStore.Query([]byte("store1"), latestVersion, []byte(key), true)
+		s.Require().NoError(err)
+		s.Require().Equal([]byte(value), res.Value)
+	}
+
+	// the store is not migrated again once restarted
+	completed, err := s.migrationManager.IsCompleted()
+	s.Require().NoError(err)
+	s.Require().True(completed)
+}
@@ -0,0 +1,28 @@
+package root
+
+import (
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/proof"
+)
+
+// Prover defines an adapter around a RootStore that proves the values of the keys
+// of a store at a specific version. It implements the collections.Prover interface,
+// so that collections values can be read along with their proofs.
+type Prover struct {
+	rootStore store.RootStore
+}
+
+func NewProver(rs store.RootStore) *Prover {
+	return &Prover{rootStore: rs}
+}
+
+// GetWithProof returns the value of the key at the given version, along with its
+// proof up to the app hash of the version: the proof of the key in the store tree,
+// followed by the proof of the store hash in the commit info.
+func (p *Prover) GetWithProof(storeKey []byte, version uint64, key []byte) ([]byte, []proof.CommitmentOp, error) {
+	result, err := p.rootStore.Query(storeKey, version, key, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result.Value, result.ProofOps, nil
+}
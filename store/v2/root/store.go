@@ -52,8 +52,6 @@ type Store struct {
 	// Migration related fields
 	// migrationManager reflects the migration manager used to migrate state from v1 to v2
 	migrationManager *migration.Manager
-	// chChangeset reflects the channel used to send the changeset to the migration manager
-	chChangeset chan *migration.VersionedChangeset
 	// chDone reflects the channel used to signal the migration manager that the migration
 	// is done
 	chDone chan struct{}
@@ -72,6 +70,26 @@ func New(
 	mm *migration.Manager,
 	m metrics.StoreMetrics,
 ) (store.RootStore, error) {
+	// if a previous migration has already switched over, the store/v2 backends are used
+	if mm != nil {
+		completed, err := mm.IsCompleted()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the migration state: %w", err)
+		}
+		if completed {
+			if newStateCommitment := mm.GetStateCommitment(); newStateCommitment != nil {
+				if err := sc.Close(); err != nil {
+					return nil, fmt.Errorf("failed to close the old SC store: %w", err)
+				}
+				sc = newStateCommitment
+			}
+			if err := mm.Close(); err != nil {
+				return nil, fmt.Errorf("failed to close migration manager: %w", err)
+			}
+			mm = nil
+		}
+	}
+
 	return &Store{
 		logger:           logger,
 		initialVersion:   1,
@@ -100,6 +118,9 @@ func (s *Store) Close() (err error) {
 
 func (s *Store) SetMetrics(m metrics.Metrics) {
 	s.telemetry = m
+	if s.migrationManager != nil {
+		s.migrationManager.SetMetrics(m)
+	}
 }
 
 func (s *Store) SetInitialVersion(v uint64) error {
@@ -374,9 +395,6 @@ func (s *Store) Commit(cs *corestore.Changeset) ([]byte, error) {
 //
 // NOTE: This method should only be called once after loadVersion.
 func (s *Store) startMigration() {
-	// buffer at most 1 changeset, if the receiver is behind attempting to buffer
-	// more than 1 will block.
-	s.chChangeset = make(chan *migration.VersionedChangeset, 1)
 	// it is used to signal the migration manager that the migration is done
 	s.chDone = make(chan struct{})
 
@@ -386,7 +404,8 @@ func (s *Store) startMigration() {
 		version := s.lastCommitInfo.Version
 		s.logger.Info("starting migration", "version", version)
 		mtx.Unlock()
-		if err := s.migrationManager.Start(version, s.chChangeset, s.chDone); err != nil {
+		// the changesets are persisted synchronously by writeSC, see PersistChangeset
+		if err := s.migrationManager.Start(version, nil, s.chDone); err != nil {
 			s.logger.Error("failed to start migration", "err", err)
 		}
 	}()
@@ -400,14 +419,19 @@ func (s *Store) startMigration() {
 // tree, which allows us to retrieve the working hash of the SC tree. Finally,
 // we construct a *CommitInfo and set that as lastCommitInfo. Note, this should
 // only be called once per block!
-// If migration is in progress, the changeset is sent to the migration manager.
+// If migration is in progress, the changeset is persisted by the migration manager,
+// before being committed to the original store, unless the migration has caught up
+// and the store switches over to the migrated backends.
 func (s *Store) writeSC(cs *corestore.Changeset) error {
 	if s.isMigrating {
+		switchOver, err := s.migrationManager.ShouldSwitch(s.lastCommitInfo.Version)
+		if err != nil {
+			return err
+		}
 		// if the migration manager has already migrated to the version, close the
-		// channels and replace the state commitment
-		if s.migrationManager.GetMigratedVersion() == s.lastCommitInfo.Version {
+		// channel and replace the state commitment
+		if switchOver {
 			close(s.chDone)
-			close(s.chChangeset)
 			s.isMigrating = false
 			// close the old state commitment and replace it with the new one
 			if err := s.stateCommitment.Close(); err != nil {
@@ -422,7 +446,10 @@ func (s *Store) writeSC(cs *corestore.Changeset) error {
 			}
 			s.logger.Info("migration completed", "version", s.lastCommitInfo.Version)
 		} else {
-			s.chChangeset <- &migration.VersionedChangeset{Version: s.lastCommitInfo.Version + 1, Changeset: cs}
+			vc := &migration.VersionedChangeset{Version: s.lastCommitInfo.Version + 1, Changeset: cs}
+			if err := s.migrationManager.PersistChangeset(vc); err != nil {
+				return fmt.Errorf("failed to persist changeset for migration: %w", err)
+			}
 		}
 	}
 
@@ -231,6 +231,28 @@ func (s *RootStoreTestSuite) TestQueryProof() {
 	s.Require().Equal(expRoots[0], cInfo.Hash())
 }
 
+func (s *RootStoreTestSuite) TestProver() {
+	cs := corestore.NewChangeset()
+	cs.Add(testStoreKeyBytes, []byte("key1"), []byte("value1"), false)
+	_, err := s.rootStore.Commit(cs)
+	s.Require().NoError(err)
+
+	prover := NewProver(s.rootStore)
+	value, proofOps, err := prover.GetWithProof(testStoreKeyBytes, 1, []byte("key1"))
+	s.Require().NoError(err)
+	s.Require().Equal([]byte("value1"), value)
+	s.Require().Len(proofOps, 2)
+
+	// the proof chain goes up to the app hash
+	cInfo, err := s.rootStore.GetStateCommitment().GetCommitInfo(1)
+	s.Require().NoError(err)
+	roots, err := proofOps[0].Run([][]byte{value})
+	s.Require().NoError(err)
+	roots, err = proofOps[1].Run(roots)
+	s.Require().NoError(err)
+	s.Require().Equal(cInfo.Hash(), roots[0])
+}
+
 func (s *RootStoreTestSuite) TestLoadVersion() {
 	// write and commit a few changesets
 	for v := 1; v <= 5; v++ {
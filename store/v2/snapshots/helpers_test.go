@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
+	"sync"
 	"testing"
 	"time"
 
@@ -155,12 +157,180 @@ func (m *mockCommitSnapshotter) SupportedFormats() []uint32 {
 	return []uint32{snapshotstypes.CurrentFormat}
 }
 
+// mockParallelCommitSnapshotter snapshots each of its stores in a separate stream, the
+// items of a store are written as IAVL leaf nodes.
+type mockParallelCommitSnapshotter struct {
+	mtx    sync.Mutex
+	stores map[string][][]byte
+	loaded uint64
+}
+
+var _ snapshots.ParallelCommitSnapshotter = (*mockParallelCommitSnapshotter)(nil)
+
+func (m *mockParallelCommitSnapshotter) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	for _, storeKey := range m.SnapshotStoreKeys() {
+		if err := m.SnapshotStore(height, storeKey, protoWriter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockParallelCommitSnapshotter) Restore(
+	height uint64, format uint32, protoReader protoio.Reader, chStorage chan<- *corestore.StateChanges,
+) (snapshotstypes.SnapshotItem, error) {
+	panic("not implemented")
+}
+
+func (m *mockParallelCommitSnapshotter) SnapshotStoreKeys() []string {
+	storeKeys := make([]string, 0, len(m.stores))
+	for storeKey := range m.stores {
+		storeKeys = append(storeKeys, storeKey)
+	}
+	sort.Strings(storeKeys)
+	return storeKeys
+}
+
+func (m *mockParallelCommitSnapshotter) SnapshotStore(height uint64, storeKey string, protoWriter protoio.Writer) error {
+	err := protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+		Item: &snapshotstypes.SnapshotItem_Store{
+			Store: &snapshotstypes.SnapshotStoreItem{Name: storeKey},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	for _, item := range m.stores[storeKey] {
+		err := protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+			Item: &snapshotstypes.SnapshotItem_IAVL{
+				IAVL: &snapshotstypes.SnapshotIAVLItem{Key: item, Value: item},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockParallelCommitSnapshotter) RestoreStore(
+	height uint64, storeKey string, protoReader protoio.Reader, chStorage chan<- *corestore.StateChanges,
+) error {
+	items := [][]byte{}
+	for {
+		var item snapshotstypes.SnapshotItem
+		err := protoReader.ReadMsg(&item)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("invalid protobuf message: %w", err)
+		}
+		node := item.GetIAVL()
+		if node == nil {
+			return fmt.Errorf("unexpected snapshot item %T", item.Item)
+		}
+		items = append(items, node.Key)
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.stores == nil {
+		m.stores = make(map[string][][]byte)
+	}
+	m.stores[storeKey] = items
+	return nil
+}
+
+func (m *mockParallelCommitSnapshotter) LoadVersion(version uint64) error {
+	m.loaded = version
+	return nil
+}
+
+// mockDeltaCommitSnapshotter is a mockParallelCommitSnapshotter whose changes are the items
+// committed by each version, written to the "delta" store.
+type mockDeltaCommitSnapshotter struct {
+	mockParallelCommitSnapshotter
+	changes map[uint64][][]byte
+}
+
+var _ snapshots.DeltaCommitSnapshotter = (*mockDeltaCommitSnapshotter)(nil)
+
+func (m *mockDeltaCommitSnapshotter) SnapshotChanges(fromVersion, toVersion uint64, protoWriter protoio.Writer) error {
+	for version := fromVersion + 1; version <= toVersion; version++ {
+		pairs := make([]*snapshotstypes.SnapshotChangesetPair, 0, len(m.changes[version]))
+		for _, item := range m.changes[version] {
+			pairs = append(pairs, &snapshotstypes.SnapshotChangesetPair{Key: item, Value: item})
+		}
+		if len(pairs) > 0 {
+			err := protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+				Item: &snapshotstypes.SnapshotItem_Changeset{
+					Changeset: &snapshotstypes.SnapshotChangesetItem{Store: "delta", Version: version, Pairs: pairs},
+				},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		err := protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+			Item: &snapshotstypes.SnapshotItem_Commit{
+				Commit: &snapshotstypes.SnapshotCommitItem{Version: version},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockDeltaCommitSnapshotter) RestoreChanges(
+	protoReader protoio.Reader, apply func(version uint64, cs *corestore.Changeset) error,
+) (snapshotstypes.SnapshotItem, error) {
+	cs := corestore.NewChangeset()
+	for {
+		var item snapshotstypes.SnapshotItem
+		err := protoReader.ReadMsg(&item)
+		if errors.Is(err, io.EOF) {
+			return snapshotstypes.SnapshotItem{}, nil
+		} else if err != nil {
+			return snapshotstypes.SnapshotItem{}, fmt.Errorf("invalid protobuf message: %w", err)
+		}
+
+		switch {
+		case item.GetChangeset() != nil:
+			changeset := item.GetChangeset()
+			for _, pair := range changeset.Pairs {
+				cs.Add([]byte(changeset.Store), pair.Key, pair.Value, pair.Remove)
+				m.stores[changeset.Store] = append(m.stores[changeset.Store], pair.Key)
+			}
+		case item.GetCommit() != nil:
+			if err := apply(item.GetCommit().Version, cs); err != nil {
+				return snapshotstypes.SnapshotItem{}, err
+			}
+			cs = corestore.NewChangeset()
+		default:
+			return item, nil
+		}
+	}
+}
+
 type mockStorageSnapshotter struct{}
 
 func (m *mockStorageSnapshotter) Restore(version uint64, chStorage <-chan *corestore.StateChanges) error {
 	return nil
 }
 
+// mockDeltaStorageSnapshotter records the versions of the applied changesets.
+type mockDeltaStorageSnapshotter struct {
+	mockStorageSnapshotter
+	versions []uint64
+}
+
+func (m *mockDeltaStorageSnapshotter) ApplyChangeset(version uint64, cs *corestore.Changeset) error {
+	m.versions = append(m.versions, version)
+	return nil
+}
+
 type mockErrorCommitSnapshotter struct{}
 
 var _ snapshots.CommitSnapshotter = (*mockErrorCommitSnapshotter)(nil)
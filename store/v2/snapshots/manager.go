@@ -8,9 +8,13 @@ import (
 	"io"
 	"math"
 	"os"
+	"runtime"
 	"sort"
 	"sync"
 
+	protoio "github.com/cosmos/gogoproto/io"
+	"golang.org/x/sync/errgroup"
+
 	corelog "cosmossdk.io/core/log"
 	corestore "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors/v2"
@@ -185,6 +189,10 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	if snapshotter, ok := m.commitSnapshotter.(ParallelCommitSnapshotter); ok && m.opts.Parallelism > 0 {
+		return m.createParallelSnapshot(height, snapshotter)
+	}
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
 	go m.createSnapshot(height, ch)
@@ -192,9 +200,85 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 	return m.store.Save(height, types.CurrentFormat, ch)
 }
 
+// CreateDelta creates a delta snapshot on top of the latest snapshot and returns its metadata. It
+// contains the changes committed since the height of the latest snapshot, followed by the extensions.
+func (m *Manager) CreateDelta(height uint64) (*types.Snapshot, error) {
+	if m == nil {
+		return nil, errorsmod.Wrap(storeerrors.ErrLogic, "Snapshot Manager is nil")
+	}
+	snapshotter, ok := m.commitSnapshotter.(DeltaCommitSnapshotter)
+	if !ok {
+		return nil, errorsmod.Wrap(storeerrors.ErrLogic, "the commitment snapshotter does not support delta snapshots")
+	}
+
+	err := m.begin(opSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	defer m.end()
+
+	base, err := m.store.GetLatest()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to examine latest snapshot")
+	}
+	if base == nil {
+		return nil, errorsmod.Wrap(storeerrors.ErrLogic, "no snapshot to take a delta snapshot on top of")
+	}
+	if base.Height >= height {
+		return nil, errorsmod.Wrapf(storeerrors.ErrConflict,
+			"a more recent snapshot already exists at height %v", base.Height)
+	}
+
+	ch := make(chan io.ReadCloser)
+	go m.createStream(ch, func(protoWriter protoio.Writer) error {
+		if err := snapshotter.SnapshotChanges(base.Height, height, protoWriter); err != nil {
+			return err
+		}
+		return m.snapshotExtensions(height, protoWriter)
+	})
+
+	return m.store.saveDelta(height, base, ch)
+}
+
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
 // the produced chunks are written to the channel.
 func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
+	m.createStream(ch, func(protoWriter protoio.Writer) error {
+		if err := m.commitSnapshotter.Snapshot(height, protoWriter); err != nil {
+			return err
+		}
+		return m.snapshotExtensions(height, protoWriter)
+	})
+}
+
+// createParallelSnapshot takes a snapshot of the parallel format, made of one stream per store
+// followed by one stream for the extensions, if any. The streams are created concurrently.
+func (m *Manager) createParallelSnapshot(height uint64, snapshotter ParallelCommitSnapshotter) (*types.Snapshot, error) {
+	storeKeys := snapshotter.SnapshotStoreKeys()
+	streams := uint32(len(storeKeys))
+	if len(m.extensions) > 0 {
+		streams++
+	}
+
+	return m.store.SaveStreams(height, streams, int(m.opts.Parallelism), func(index uint32) <-chan io.ReadCloser {
+		ch := make(chan io.ReadCloser)
+		if int(index) < len(storeKeys) {
+			storeKey := storeKeys[index]
+			go m.createStream(ch, func(protoWriter protoio.Writer) error {
+				return snapshotter.SnapshotStore(height, storeKey, protoWriter)
+			})
+		} else {
+			go m.createStream(ch, func(protoWriter protoio.Writer) error {
+				return m.snapshotExtensions(height, protoWriter)
+			})
+		}
+		return ch
+	})
+}
+
+// createStream writes a snapshot stream with the given write function, the produced chunks are
+// written to the channel.
+func (m *Manager) createStream(ch chan<- io.ReadCloser, write func(protoWriter protoio.Writer) error) {
 	streamWriter := NewStreamWriter(ch)
 	if streamWriter == nil {
 		return
@@ -205,14 +289,17 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 		}
 	}()
 
-	if err := m.commitSnapshotter.Snapshot(height, streamWriter); err != nil {
+	if err := write(streamWriter); err != nil {
 		streamWriter.CloseWithError(err)
-		return
 	}
+}
+
+// snapshotExtensions writes the snapshots of the extensions, in the order of their names.
+func (m *Manager) snapshotExtensions(height uint64, protoWriter protoio.Writer) error {
 	for _, name := range m.sortedExtensionNames() {
 		extension := m.extensions[name]
 		// write extension metadata
-		err := streamWriter.WriteMsg(&types.SnapshotItem{
+		err := protoWriter.WriteMsg(&types.SnapshotItem{
 			Item: &types.SnapshotItem_Extension{
 				Extension: &types.SnapshotExtensionMeta{
 					Name:   name,
@@ -221,17 +308,16 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 			},
 		})
 		if err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
 		payloadWriter := func(payload []byte) error {
-			return types.WriteExtensionPayload(streamWriter, payload)
+			return types.WriteExtensionPayload(protoWriter, payload)
 		}
 		if err := extension.SnapshotExtension(height, payloadWriter); err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
 	}
+	return nil
 }
 
 // CreateMigration creates a migration snapshot and writes it to the given writer.
@@ -266,8 +352,20 @@ func (m *Manager) EndMigration(commitSnapshotter CommitSnapshotter) {
 }
 
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
+// Delta snapshots are not listed, since they can't be restored by state sync.
 func (m *Manager) List() ([]*types.Snapshot, error) {
-	return m.store.List()
+	snapshots, err := m.store.List()
+	if err != nil {
+		return nil, err
+	}
+
+	listed := make([]*types.Snapshot, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		if snapshot.Format != types.DeltaFormat {
+			listed = append(listed, snapshot)
+		}
+	}
+	return listed, nil
 }
 
 // LoadChunk loads a chunk into a byte slice, mirroring ABCI LoadChunk. It can be called
@@ -310,9 +408,14 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	if !types.IsSupportedFormat(snapshot.Format) {
 		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
+	if snapshot.Format == types.ParallelFormat {
+		if err := m.validateParallelSnapshot(snapshot); err != nil {
+			return err
+		}
+	}
 	if snapshot.Height == 0 {
 		return errorsmod.Wrap(storeerrors.ErrLogic, "cannot restore snapshot at height 0")
 	}
@@ -335,10 +438,13 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 		return errorsmod.Wrapf(err, "failed to create snapshot directory %q", dir)
 	}
 
-	chChunks := m.loadChunkStream(snapshot.Height, snapshot.Format, chChunkIDs)
-
 	go func() {
-		err := m.doRestoreSnapshot(snapshot, chChunks)
+		var err error
+		if snapshot.Format == types.ParallelFormat {
+			err = m.doRestoreParallelSnapshot(snapshot, chChunkIDs, true)
+		} else {
+			err = m.doRestoreSnapshot(snapshot, m.loadChunkStream(snapshot.Height, snapshot.Format, chChunkIDs), true)
+		}
 		chDone <- restoreDone{
 			complete: err == nil,
 			err:      err,
@@ -372,32 +478,117 @@ func (m *Manager) loadChunkStream(height uint64, format uint32, chunkIDs <-chan
 }
 
 // doRestoreSnapshot do the heavy work of snapshot restoration after preliminary checks on request have passed.
-func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
+// The extensions are skipped unless withExtensions is true.
+func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser, withExtensions bool) error {
 	dir := m.store.pathSnapshot(snapshot.Height, snapshot.Format)
 	if err := os.MkdirAll(dir, 0o750); err != nil {
 		return errorsmod.Wrapf(err, "failed to create snapshot directory %q", dir)
 	}
 
-	var nextItem types.SnapshotItem
 	streamReader, err := NewStreamReader(chChunks)
 	if err != nil {
 		return err
 	}
 	defer streamReader.Close()
 
-	// payloadReader reads an extension payload for extension snapshotter, it returns `io.EOF` at extension boundaries.
-	payloadReader := func() ([]byte, error) {
-		nextItem.Reset()
-		if err := streamReader.ReadMsg(&nextItem); err != nil {
-			return nil, err
+	// chStorage is the channel to pass the KV pairs to the storage snapshotter.
+	chStorage := make(chan *corestore.StateChanges, defaultStorageChannelBufferSize)
+
+	storageErrs := make(chan error, 1)
+	go func() {
+		defer close(storageErrs)
+		err := m.storageSnapshotter.Restore(snapshot.Height, chStorage)
+		if err != nil {
+			storageErrs <- err
 		}
-		payload := nextItem.GetExtensionPayload()
-		if payload == nil {
-			return nil, io.EOF
+	}()
+
+	nextItem, err := m.commitSnapshotter.Restore(snapshot.Height, snapshot.Format, streamReader, chStorage)
+	if err != nil {
+		return errorsmod.Wrap(err, "multistore restore")
+	}
+	close(chStorage)
+
+	if withExtensions {
+		if err := m.restoreExtensions(snapshot.Height, nextItem, streamReader); err != nil {
+			return err
 		}
-		return payload.Payload, nil
 	}
 
+	// wait for storage snapshotter to complete
+	if err := <-storageErrs; err != nil {
+		return errorsmod.Wrap(err, "storage snapshotter")
+	}
+
+	return nil
+}
+
+// validateParallelSnapshot checks the stream chunks of a snapshot of the parallel format.
+func (m *Manager) validateParallelSnapshot(snapshot types.Snapshot) error {
+	if _, ok := m.commitSnapshotter.(ParallelCommitSnapshotter); !ok {
+		return errorsmod.Wrapf(types.ErrUnknownFormat,
+			"snapshot format %v is not supported by the commitment snapshotter", snapshot.Format)
+	}
+	if len(snapshot.Metadata.StreamChunks) == 0 {
+		return errorsmod.Wrap(types.ErrInvalidMetadata, "no streams")
+	}
+	chunks := uint64(0)
+	for i, streamChunks := range snapshot.Metadata.StreamChunks {
+		if streamChunks == 0 {
+			return errorsmod.Wrapf(types.ErrInvalidMetadata, "stream %d has no chunks", i)
+		}
+		chunks += uint64(streamChunks)
+	}
+	if chunks != uint64(snapshot.Chunks) {
+		return errorsmod.Wrapf(types.ErrInvalidMetadata, "snapshot streams have %v chunks, but snapshot has %v chunks",
+			chunks, snapshot.Chunks)
+	}
+	return nil
+}
+
+// doRestoreParallelSnapshot restores a snapshot of the parallel format. The chunk IDs are
+// dispatched to the streams they belong to, and the store streams are restored concurrently.
+// The extensions stream, if any, is restored once all the stores are restored, unless withExtensions
+// is false.
+func (m *Manager) doRestoreParallelSnapshot(snapshot types.Snapshot, chChunkIDs <-chan uint32, withExtensions bool) error {
+	snapshotter, ok := m.commitSnapshotter.(ParallelCommitSnapshotter)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrUnknownFormat,
+			"snapshot format %v is not supported by the commitment snapshotter", snapshot.Format)
+	}
+
+	dir := m.store.pathSnapshot(snapshot.Height, snapshot.Format)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return errorsmod.Wrapf(err, "failed to create snapshot directory %q", dir)
+	}
+
+	// every stream gets a channel large enough for all its chunk IDs, so the dispatch never
+	// blocks on a stream which is not restored yet.
+	streamChunkIDs := make([]chan uint32, len(snapshot.Metadata.StreamChunks))
+	for i, streamChunks := range snapshot.Metadata.StreamChunks {
+		streamChunkIDs[i] = make(chan uint32, streamChunks)
+	}
+	go func() {
+		defer func() {
+			for _, ch := range streamChunkIDs {
+				close(ch)
+			}
+		}()
+
+		stream, streamEnd := 0, snapshot.Metadata.StreamChunks[0]
+		for chunkID := range chChunkIDs {
+			for chunkID >= streamEnd {
+				stream++
+				if stream == len(streamChunkIDs) {
+					m.logger.Error("unexpected snapshot chunk", "height", snapshot.Height, "chunk", chunkID)
+					return
+				}
+				streamEnd += snapshot.Metadata.StreamChunks[stream]
+			}
+			streamChunkIDs[stream] <- chunkID
+		}
+	}()
+
 	// chStorage is the channel to pass the KV pairs to the storage snapshotter.
 	chStorage := make(chan *corestore.StateChanges, defaultStorageChannelBufferSize)
 
@@ -410,11 +601,120 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		}
 	}()
 
-	nextItem, err = m.commitSnapshotter.Restore(snapshot.Height, snapshot.Format, streamReader, chStorage)
+	parallelism := int(m.opts.Parallelism)
+	if parallelism == 0 {
+		parallelism = runtime.GOMAXPROCS(0)
+	}
+
+	var (
+		extensionsItem   types.SnapshotItem
+		extensionsReader *StreamReader
+	)
+	g := new(errgroup.Group)
+	g.SetLimit(parallelism)
+	for i := range streamChunkIDs {
+		i := i
+		g.Go(func() error {
+			streamReader, err := NewStreamReader(m.loadChunkStream(snapshot.Height, snapshot.Format, streamChunkIDs[i]))
+			if err != nil {
+				return err
+			}
+
+			var item types.SnapshotItem
+			if err := streamReader.ReadMsg(&item); err != nil {
+				streamReader.Close()
+				return errorsmod.Wrapf(err, "stream %d", i)
+			}
+			if item.GetExtension() != nil && i == len(streamChunkIDs)-1 {
+				// the extensions are restored after the stores
+				extensionsItem, extensionsReader = item, streamReader
+				return nil
+			}
+			defer streamReader.Close()
+
+			store := item.GetStore()
+			if store == nil {
+				return errorsmod.Wrapf(storeerrors.ErrLogic, "unexpected snapshot item %T at the start of stream %d", item.Item, i)
+			}
+			if err := snapshotter.RestoreStore(snapshot.Height, store.Name, streamReader, chStorage); err != nil {
+				return errorsmod.Wrapf(err, "store %s restore", store.Name)
+			}
+			return nil
+		})
+	}
+	err := g.Wait()
+	close(chStorage)
+	if extensionsReader != nil {
+		defer extensionsReader.Close()
+	}
 	if err != nil {
 		return errorsmod.Wrap(err, "multistore restore")
 	}
-	close(chStorage)
+
+	if err := snapshotter.LoadVersion(snapshot.Height); err != nil {
+		return errorsmod.Wrap(err, "multistore restore")
+	}
+
+	if extensionsReader != nil && withExtensions {
+		if err := m.restoreExtensions(snapshot.Height, extensionsItem, extensionsReader); err != nil {
+			return err
+		}
+	}
+
+	// wait for storage snapshotter to complete
+	if err := <-storageErrs; err != nil {
+		return errorsmod.Wrap(err, "storage snapshotter")
+	}
+
+	return nil
+}
+
+// doRestoreDeltaSnapshot applies a delta snapshot on top of the restored state, committing its
+// changes version by version. The extensions are skipped unless withExtensions is true.
+func (m *Manager) doRestoreDeltaSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser, withExtensions bool) error {
+	streamReader, err := NewStreamReader(chChunks)
+	if err != nil {
+		return err
+	}
+	defer streamReader.Close()
+
+	snapshotter, ok := m.commitSnapshotter.(DeltaCommitSnapshotter)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrUnknownFormat,
+			"snapshot format %v is not supported by the commitment snapshotter", snapshot.Format)
+	}
+	storageSnapshotter, ok := m.storageSnapshotter.(DeltaStorageSnapshotter)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrUnknownFormat,
+			"snapshot format %v is not supported by the storage snapshotter", snapshot.Format)
+	}
+
+	nextItem, err := snapshotter.RestoreChanges(streamReader, storageSnapshotter.ApplyChangeset)
+	if err != nil {
+		return errorsmod.Wrap(err, "multistore restore")
+	}
+
+	if !withExtensions {
+		return nil
+	}
+	return m.restoreExtensions(snapshot.Height, nextItem, streamReader)
+}
+
+// restoreExtensions restores the extensions from the stream reader, starting with the given
+// item, until the end of the stream.
+func (m *Manager) restoreExtensions(height uint64, nextItem types.SnapshotItem, protoReader protoio.Reader) error {
+	// payloadReader reads an extension payload for extension snapshotter, it returns `io.EOF` at extension boundaries.
+	payloadReader := func() ([]byte, error) {
+		nextItem.Reset()
+		if err := protoReader.ReadMsg(&nextItem); err != nil {
+			return nil, err
+		}
+		payload := nextItem.GetExtensionPayload()
+		if payload == nil {
+			return nil, io.EOF
+		}
+		return payload.Payload, nil
+	}
 
 	for {
 		if nextItem.Item == nil {
@@ -433,7 +733,7 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 			return errorsmod.Wrapf(types.ErrUnknownFormat, "format %v for extension %s", metadata.Format, metadata.Name)
 		}
 
-		if err := extension.RestoreExtension(snapshot.Height, metadata.Format, payloadReader); err != nil {
+		if err := extension.RestoreExtension(height, metadata.Format, payloadReader); err != nil {
 			return errorsmod.Wrapf(err, "extension %s restore", metadata.Name)
 		}
 
@@ -442,11 +742,6 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		}
 	}
 
-	// wait for storage snapshotter to complete
-	if err := <-storageErrs; err != nil {
-		return errorsmod.Wrap(err, "storage snapshotter")
-	}
-
 	return nil
 }
 
@@ -514,27 +809,73 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	return false, nil
 }
 
-// RestoreLocalSnapshot restores app state from a local snapshot.
+// RestoreLocalSnapshot restores app state from a local snapshot. A delta snapshot is restored by
+// restoring the full snapshot it is based on, and applying the delta snapshots of its chain in order.
 func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
-	snapshot, ch, err := m.store.Load(height, format)
-	if err != nil {
-		return err
+	var chain []*types.Snapshot
+	if format == types.DeltaFormat {
+		var err error
+		chain, err = m.store.DeltaChain(height)
+		if err != nil {
+			return err
+		}
+	} else {
+		snapshot, err := m.store.Get(height, format)
+		if err != nil {
+			return err
+		}
+		if snapshot == nil {
+			return fmt.Errorf("snapshot doesn't exist, height: %d, format: %d", height, format)
+		}
+		chain = []*types.Snapshot{snapshot}
 	}
-
-	if snapshot == nil {
-		return fmt.Errorf("snapshot doesn't exist, height: %d, format: %d", height, format)
+	if chain[0].Format == types.ParallelFormat {
+		if err := m.validateParallelSnapshot(*chain[0]); err != nil {
+			return err
+		}
 	}
 
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
-	err = m.beginLocked(opRestore)
+	err := m.beginLocked(opRestore)
 	if err != nil {
 		return err
 	}
 	defer m.endLocked()
 
-	return m.doRestoreSnapshot(*snapshot, ch)
+	// the extensions are restored from the last snapshot of the chain only, since delta
+	// snapshots contain the full snapshots of the extensions
+	for i, snapshot := range chain {
+		if err := m.restoreLocalSnapshot(*snapshot, i == len(chain)-1); err != nil {
+			return errorsmod.Wrapf(err, "restore snapshot of height %d format %d", snapshot.Height, snapshot.Format)
+		}
+	}
+	return nil
+}
+
+// restoreLocalSnapshot restores a single local snapshot, with its extensions or not.
+func (m *Manager) restoreLocalSnapshot(snapshot types.Snapshot, withExtensions bool) error {
+	if snapshot.Format == types.ParallelFormat {
+		chChunkIDs := make(chan uint32, snapshot.Chunks)
+		for i := uint32(0); i < snapshot.Chunks; i++ {
+			chChunkIDs <- i
+		}
+		close(chChunkIDs)
+		return m.doRestoreParallelSnapshot(snapshot, chChunkIDs, withExtensions)
+	}
+
+	loaded, ch, err := m.store.Load(snapshot.Height, snapshot.Format)
+	if err != nil {
+		return err
+	}
+	if loaded == nil {
+		return fmt.Errorf("snapshot doesn't exist, height: %d, format: %d", snapshot.Height, snapshot.Format)
+	}
+	if snapshot.Format == types.DeltaFormat {
+		return m.doRestoreDeltaSnapshot(snapshot, ch, withExtensions)
+	}
+	return m.doRestoreSnapshot(snapshot, ch, withExtensions)
 }
 
 // sortedExtensionNames sort extension names for deterministic iteration.
@@ -565,6 +906,10 @@ func (m *Manager) SnapshotIfApplicable(height int64) {
 		return
 	}
 	if !m.shouldTakeSnapshot(height) {
+		if m.shouldTakeDeltaSnapshot(height) {
+			go m.deltaSnapshot(height)
+			return
+		}
 		m.logger.Debug("snapshot is skipped", "height", height)
 		return
 	}
@@ -577,6 +922,28 @@ func (m *Manager) shouldTakeSnapshot(height int64) bool {
 	return m.opts.Interval > 0 && uint64(height)%m.opts.Interval == 0
 }
 
+// shouldTakeDeltaSnapshot returns true if a delta snapshot should be taken at height.
+func (m *Manager) shouldTakeDeltaSnapshot(height int64) bool {
+	return m.opts.DeltaInterval > 0 && uint64(height)%m.opts.DeltaInterval == 0
+}
+
+func (m *Manager) deltaSnapshot(height int64) {
+	m.logger.Info("creating delta state snapshot", "height", height)
+
+	if height <= 0 {
+		m.logger.Error("snapshot height must be positive", "height", height)
+		return
+	}
+
+	snapshot, err := m.CreateDelta(uint64(height))
+	if err != nil {
+		m.logger.Error("failed to create delta state snapshot", "height", height, "err", err)
+		return
+	}
+
+	m.logger.Info("completed delta state snapshot", "height", height, "base_height", snapshot.Metadata.BaseHeight)
+}
+
 func (m *Manager) snapshot(height int64) {
 	m.logger.Info("creating state snapshot", "height", height)
 
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	coretesting "cosmossdk.io/core/testing"
+	storeerrors "cosmossdk.io/store/v2/errors"
 	"cosmossdk.io/store/v2/snapshots"
 	"cosmossdk.io/store/v2/snapshots/types"
 )
@@ -245,6 +246,167 @@ func TestManager_Restore(t *testing.T) {
 	}
 }
 
+func TestManager_TakeParallel_Restore(t *testing.T) {
+	store := setupStore(t)
+	source := &mockParallelCommitSnapshotter{
+		stores: map[string][][]byte{
+			"bank":    {{1, 2, 3}, {4, 5, 6}},
+			"acc":     {{7, 8, 9}},
+			"staking": {{10, 11}, {12}, {13, 14, 15}},
+		},
+	}
+	parallelOpts := snapshots.SnapshotOptions{Interval: 1500, KeepRecent: 2, Parallelism: 2}
+	manager := snapshots.NewManager(store, parallelOpts, source, &mockStorageSnapshotter{}, nil, coretesting.NewNopLogger())
+	require.NoError(t, manager.RegisterExtensions(newExtSnapshotter(10)))
+
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+	require.Equal(t, types.ParallelFormat, snapshot.Format)
+	// one stream per store and one for the extensions, made of a single chunk each
+	require.Equal(t, []uint32{1, 1, 1, 1}, snapshot.Metadata.StreamChunks)
+	require.Equal(t, uint32(4), snapshot.Chunks)
+	require.Equal(t, hash(snapshot.Metadata.ChunkHashes), snapshot.Hash)
+
+	chunks := make([][]byte, 0, snapshot.Chunks)
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		chunks = append(chunks, chunk)
+	}
+	require.Equal(t, snapshot.Metadata.ChunkHashes, checksums(chunks))
+
+	// the snapshot is identical when taken again
+	other := snapshots.NewManager(setupStore(t), parallelOpts, source, &mockStorageSnapshotter{}, nil, coretesting.NewNopLogger())
+	require.NoError(t, other.RegisterExtensions(newExtSnapshotter(10)))
+	otherSnapshot, err := other.Create(5)
+	require.NoError(t, err)
+	require.Equal(t, snapshot.Hash, otherSnapshot.Hash)
+
+	// the parallel format can't be restored by a commitment snapshotter which does not support it
+	restoring := snapshots.NewManager(setupStore(t), opts, &mockCommitSnapshotter{}, &mockStorageSnapshotter{}, nil, coretesting.NewNopLogger())
+	err = restoring.Restore(*snapshot)
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+
+	target := &mockParallelCommitSnapshotter{}
+	extSnapshotter := newExtSnapshotter(0)
+	restoring = snapshots.NewManager(setupStore(t), opts, target, &mockStorageSnapshotter{}, nil, coretesting.NewNopLogger())
+	require.NoError(t, restoring.RegisterExtensions(extSnapshotter))
+
+	// Restore errors on stream chunks which don't match the snapshot chunks
+	invalid := *snapshot
+	invalid.Metadata.StreamChunks = []uint32{1, 1, 1}
+	err = restoring.Restore(invalid)
+	require.ErrorIs(t, err, types.ErrInvalidMetadata)
+
+	require.NoError(t, restoring.Restore(*snapshot))
+	for i, chunk := range chunks {
+		done, err := restoring.RestoreChunk(chunk)
+		require.NoError(t, err)
+		require.Equal(t, i == len(chunks)-1, done)
+	}
+	require.Equal(t, source.stores, target.stores)
+	require.Equal(t, snapshot.Height, target.loaded)
+	require.Len(t, extSnapshotter.state, 10)
+
+	// the restored snapshot is saved and can be restored locally
+	target.stores, target.loaded, extSnapshotter.state = nil, 0, nil
+	require.NoError(t, restoring.RestoreLocalSnapshot(snapshot.Height, snapshot.Format))
+	require.Equal(t, source.stores, target.stores)
+	require.Equal(t, snapshot.Height, target.loaded)
+	require.Len(t, extSnapshotter.state, 10)
+}
+
+func TestManager_CreateDelta_Restore(t *testing.T) {
+	store, err := snapshots.NewStore(t.TempDir())
+	require.NoError(t, err)
+	source := &mockDeltaCommitSnapshotter{
+		mockParallelCommitSnapshotter: mockParallelCommitSnapshotter{
+			stores: map[string][][]byte{
+				"bank":  {{1, 2, 3}, {4, 5, 6}},
+				"delta": {{7, 8, 9}},
+			},
+		},
+		changes: map[uint64][][]byte{6: {{10}}, 7: {{11}, {12}}, 9: {{13}}},
+	}
+	deltaOpts := snapshots.SnapshotOptions{Interval: 1500, KeepRecent: 2, Parallelism: 2}
+	manager := snapshots.NewManager(store, deltaOpts, source, &mockStorageSnapshotter{}, nil, coretesting.NewNopLogger())
+	require.NoError(t, manager.RegisterExtensions(newExtSnapshotter(10)))
+
+	// a delta snapshot needs a snapshot to be based on
+	_, err = manager.CreateDelta(4)
+	require.Error(t, err)
+
+	base, err := manager.Create(5)
+	require.NoError(t, err)
+	_, err = manager.CreateDelta(5)
+	require.ErrorIs(t, err, storeerrors.ErrConflict)
+	delta, err := manager.CreateDelta(7)
+	require.NoError(t, err)
+	require.Equal(t, types.DeltaFormat, delta.Format)
+	require.Equal(t, types.Metadata{
+		ChunkHashes: delta.Metadata.ChunkHashes,
+		BaseHeight:  base.Height,
+		BaseFormat:  base.Format,
+		BaseHash:    base.Hash,
+	}, delta.Metadata)
+	delta, err = manager.CreateDelta(9)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), delta.Metadata.BaseHeight)
+	require.Equal(t, types.DeltaFormat, delta.Metadata.BaseFormat)
+
+	// delta snapshots are not offered to state sync
+	listed, err := manager.List()
+	require.NoError(t, err)
+	require.Equal(t, []*types.Snapshot{base}, listed)
+	err = manager.Restore(*delta)
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+
+	chain, err := store.DeltaChain(9)
+	require.NoError(t, err)
+	require.Len(t, chain, 3)
+	for i, height := range []uint64{5, 7, 9} {
+		require.Equal(t, height, chain[i].Height)
+	}
+
+	// the full snapshot is restored, then the changes of every delta snapshot, and the
+	// extensions of the last delta snapshot only
+	target := &mockDeltaCommitSnapshotter{}
+	storage := &mockDeltaStorageSnapshotter{}
+	extSnapshotter := newExtSnapshotter(0)
+	restoring := snapshots.NewManager(store, deltaOpts, target, storage, nil, coretesting.NewNopLogger())
+	require.NoError(t, restoring.RegisterExtensions(extSnapshotter))
+	require.NoError(t, restoring.RestoreLocalSnapshot(9, types.DeltaFormat))
+	require.Equal(t, map[string][][]byte{
+		"bank":  {{1, 2, 3}, {4, 5, 6}},
+		"delta": {{7, 8, 9}, {10}, {11}, {12}, {13}},
+	}, target.stores)
+	require.Equal(t, base.Height, target.loaded)
+	require.Equal(t, []uint64{6, 7, 8, 9}, storage.versions)
+	require.Len(t, extSnapshotter.state, 10)
+
+	// the delta snapshots are pruned with the snapshot they are based on
+	_, err = manager.Create(10)
+	require.NoError(t, err)
+	_, err = manager.CreateDelta(12)
+	require.NoError(t, err)
+	pruned, err := store.Prune(1)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, pruned)
+	snapshots, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+
+	// a delta snapshot can't be restored without the snapshot it is based on
+	require.NoError(t, store.Delete(10, types.ParallelFormat))
+	_, err = store.DeltaChain(12)
+	require.ErrorIs(t, err, types.ErrInvalidMetadata)
+	err = restoring.RestoreLocalSnapshot(12, types.DeltaFormat)
+	require.ErrorIs(t, err, types.ErrInvalidMetadata)
+	pruned, err = store.Prune(1)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, pruned)
+}
+
 func TestManager_TakeError(t *testing.T) {
 	snapshotter := &mockErrorCommitSnapshotter{}
 	store, err := snapshots.NewStore(t.TempDir())
@@ -4,10 +4,21 @@ package snapshots
 // heights are snapshotted for state sync.
 type SnapshotOptions struct {
 	// Interval defines at which heights the snapshot is taken.
-	Interval uint64
+	Interval uint64 `mapstructure:"interval" toml:"interval" comment:"Height interval at which snapshots are taken, 0 disables snapshots."`
 
 	// KeepRecent defines how many snapshots to keep in heights.
-	KeepRecent uint32
+	KeepRecent uint32 `mapstructure:"keep-recent" toml:"keep-recent" comment:"Number of recent snapshots to keep, 0 keeps all of them."`
+
+	// Parallelism defines how many stores are exported or imported concurrently.
+	// If it is greater than 0 and the commitment snapshotter supports it, snapshots
+	// are taken in the parallel format. Parallel snapshots are restored with this
+	// many concurrent imports, or GOMAXPROCS if it is 0.
+	Parallelism uint32 `mapstructure:"parallelism" toml:"parallelism" comment:"Number of stores exported and imported concurrently. Greater than 0, snapshots are taken in the parallel format."`
+
+	// DeltaInterval defines at which heights a delta snapshot is taken, on top of
+	// the latest snapshot, when no full snapshot is taken. The value of 0 disables
+	// delta snapshots. The versions since the latest full snapshot must not be pruned.
+	DeltaInterval uint64 `mapstructure:"delta-interval" toml:"delta-interval" comment:"Height interval at which delta snapshots are taken on top of the latest snapshot, 0 disables delta snapshots."`
 }
 
 func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
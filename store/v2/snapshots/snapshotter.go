@@ -17,12 +17,55 @@ type CommitSnapshotter interface {
 	Restore(version uint64, format uint32, protoReader protoio.Reader, chStorage chan<- *corestore.StateChanges) (types.SnapshotItem, error)
 }
 
+// ParallelCommitSnapshotter is a CommitSnapshotter which can snapshot and restore
+// each of its stores independently, so the snapshot streams of the stores can be
+// created and restored concurrently.
+type ParallelCommitSnapshotter interface {
+	CommitSnapshotter
+
+	// SnapshotStoreKeys returns the sorted keys of the stores to snapshot.
+	SnapshotStoreKeys() []string
+
+	// SnapshotStore writes a snapshot of the given store at the given version.
+	SnapshotStore(version uint64, storeKey string, protoWriter protoio.Writer) error
+
+	// RestoreStore restores the given store from the snapshot reader, whose store
+	// item has already been read, passing the restored KV pairs to the storage channel.
+	RestoreStore(version uint64, storeKey string, protoReader protoio.Reader, chStorage chan<- *corestore.StateChanges) error
+
+	// LoadVersion loads the given version once all the stores are restored.
+	LoadVersion(version uint64) error
+}
+
+// DeltaCommitSnapshotter is a CommitSnapshotter which can snapshot and restore the
+// changes committed between two versions, to take delta snapshots.
+type DeltaCommitSnapshotter interface {
+	CommitSnapshotter
+
+	// SnapshotChanges writes the changes of the versions after fromVersion up to toVersion.
+	SnapshotChanges(fromVersion, toVersion uint64, protoWriter protoio.Writer) error
+
+	// RestoreChanges commits the changes read from the snapshot reader as the versions
+	// following the latest one, passing the changeset of each committed version to
+	// the apply function. It returns the first item which is not a change.
+	RestoreChanges(protoReader protoio.Reader, apply func(version uint64, cs *corestore.Changeset) error) (types.SnapshotItem, error)
+}
+
 // StorageSnapshotter defines an API for restoring snapshots of the storage state.
 type StorageSnapshotter interface {
 	// Restore restores the storage state from the given channel.
 	Restore(version uint64, chStorage <-chan *corestore.StateChanges) error
 }
 
+// DeltaStorageSnapshotter is a StorageSnapshotter which can apply the changesets of
+// delta snapshots.
+type DeltaStorageSnapshotter interface {
+	StorageSnapshotter
+
+	// ApplyChangeset applies the changeset of the given version to the storage state.
+	ApplyChangeset(version uint64, cs *corestore.Changeset) error
+}
+
 // ExtensionPayloadReader read extension payloads,
 // it returns io.EOF when reached either end of stream or the extension boundaries.
 type ExtensionPayloadReader = func() ([]byte, error)
@@ -1,6 +1,8 @@
 package snapshots
 
 import (
+	"bytes"
+	"context"
 	"crypto/sha256"
 	"encoding/binary"
 	"fmt"
@@ -15,6 +17,7 @@ import (
 	"sync"
 
 	"github.com/cosmos/gogoproto/proto"
+	"golang.org/x/sync/errgroup"
 
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/errors/v2"
@@ -203,7 +206,8 @@ func (s *Store) loadChunkFile(height uint64, format, chunk uint32) (io.ReadClose
 	return os.Open(path)
 }
 
-// Prune removes old snapshots. The given number of most recent heights (regardless of format) are retained.
+// Prune removes old snapshots. The given number of most recent heights (regardless of format) of
+// full snapshots are retained, along with the delta snapshots chained to a retained snapshot.
 func (s *Store) Prune(retain uint32) (uint64, error) {
 	metadata, err := os.ReadDir(s.pathMetadataDir())
 	if err != nil {
@@ -213,11 +217,16 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 	pruned := uint64(0)
 	prunedHeights := make(map[uint64]bool)
 	skip := make(map[uint64]bool)
+	var deltaHeights []uint64
 	for i := len(metadata) - 1; i >= 0; i-- {
 		height, format, err := s.parseMetadataFilename(metadata[i].Name())
 		if err != nil {
 			return 0, err
 		}
+		if format == types.DeltaFormat {
+			deltaHeights = append(deltaHeights, height)
+			continue
+		}
 
 		if skip[height] || uint32(len(skip)) < retain {
 			skip[height] = true
@@ -230,10 +239,36 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 		pruned++
 		prunedHeights[height] = true
 	}
+	// A delta snapshot is retained as long as its base snapshot is, so the deltas are checked from
+	// the oldest one, whose base is either a full snapshot or a delta snapshot already checked.
+	for i := len(deltaHeights) - 1; i >= 0; i-- {
+		height := deltaHeights[i]
+		snapshot, err := s.Get(height, types.DeltaFormat)
+		if err != nil {
+			return 0, err
+		}
+		if snapshot == nil {
+			continue
+		}
+		base, err := s.Get(snapshot.Metadata.BaseHeight, snapshot.Metadata.BaseFormat)
+		if err != nil {
+			return 0, err
+		}
+		if base != nil && bytes.Equal(base.Hash, snapshot.Metadata.BaseHash) {
+			skip[height] = true
+			continue
+		}
+		err = s.Delete(height, types.DeltaFormat)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to prune snapshots")
+		}
+		pruned++
+		prunedHeights[height] = true
+	}
 	// Since Delete() deletes a specific format, while we want to prune a height, we clean up
 	// the height directory as well
 	for height, ok := range prunedHeights {
-		if ok {
+		if ok && !skip[height] {
 			err = os.Remove(s.pathHeight(height))
 			if err != nil {
 				return 0, errors.Wrapf(err, "failed to remove snapshot directory for height %v", height)
@@ -243,11 +278,69 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 	return pruned, nil
 }
 
+// DeltaChain returns the chain of snapshots leading to the delta snapshot at the given height,
+// starting with the full snapshot it is based on. It errors if a snapshot of the chain is missing
+// or does not match the base hash of the snapshot following it.
+func (s *Store) DeltaChain(height uint64) ([]*types.Snapshot, error) {
+	snapshot, err := s.Get(height, types.DeltaFormat)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("delta snapshot doesn't exist, height: %d", height)
+	}
+
+	chain := []*types.Snapshot{snapshot}
+	for snapshot.Format == types.DeltaFormat {
+		base, err := s.Get(snapshot.Metadata.BaseHeight, snapshot.Metadata.BaseFormat)
+		if err != nil {
+			return nil, err
+		}
+		if base == nil {
+			return nil, errors.Wrapf(types.ErrInvalidMetadata, "base snapshot of height %d format %d doesn't exist",
+				snapshot.Metadata.BaseHeight, snapshot.Metadata.BaseFormat)
+		}
+		if !bytes.Equal(base.Hash, snapshot.Metadata.BaseHash) {
+			return nil, errors.Wrapf(types.ErrInvalidMetadata, "base snapshot of height %d format %d has hash %X, expected %X",
+				base.Height, base.Format, base.Hash, snapshot.Metadata.BaseHash)
+		}
+		chain = append(chain, base)
+		snapshot = base
+	}
+
+	// reverse the chain so it starts with the full snapshot
+	for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+		chain[i], chain[j] = chain[j], chain[i]
+	}
+	return chain, nil
+}
+
 // Save saves a snapshot to disk, returning it.
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
 ) (*types.Snapshot, error) {
+	return s.save(&types.Snapshot{Height: height, Format: format}, chunks)
+}
+
+// saveDelta saves a delta snapshot based on the given snapshot to disk, returning it.
+func (s *Store) saveDelta(
+	height uint64, base *types.Snapshot, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	return s.save(&types.Snapshot{
+		Height: height,
+		Format: types.DeltaFormat,
+		Metadata: types.Metadata{
+			BaseHeight: base.Height,
+			BaseFormat: base.Format,
+			BaseHash:   base.Hash,
+		},
+	}, chunks)
+}
+
+// save saves the chunks of the given snapshot to disk, completing and returning it.
+func (s *Store) save(snapshot *types.Snapshot, chunks <-chan io.ReadCloser) (*types.Snapshot, error) {
 	defer DrainChunks(chunks)
+	height, format := snapshot.Height, snapshot.Format
 	if height == 0 {
 		return nil, errors.Wrap(storeerrors.ErrLogic, "snapshot height cannot be 0")
 	}
@@ -266,11 +359,6 @@ func (s *Store) Save(
 		s.mtx.Unlock()
 	}()
 
-	snapshot := &types.Snapshot{
-		Height: height,
-		Format: format,
-	}
-
 	// create height directory or do nothing
 	if err := os.MkdirAll(s.pathHeight(height), 0o750); err != nil {
 		return nil, errors.Wrapf(err, "failed to create snapshot directory for height %v", height)
@@ -294,13 +382,119 @@ func (s *Store) Save(
 	return snapshot, s.saveSnapshot(snapshot)
 }
 
+// SaveStreams saves a snapshot of the parallel format to disk, returning it. The snapshot is
+// made of the given number of streams, whose chunks are returned by the stream function, and at
+// most parallelism streams are started and saved concurrently. The chunks of each stream are
+// written to temporary files, and renamed in the order of the streams once all of them are saved.
+func (s *Store) SaveStreams(
+	height uint64, streams uint32, parallelism int, stream func(index uint32) <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	if height == 0 {
+		return nil, errors.Wrap(storeerrors.ErrLogic, "snapshot height cannot be 0")
+	}
+	if parallelism <= 0 {
+		return nil, errors.Wrap(storeerrors.ErrLogic, "snapshot parallelism must be greater than 0")
+	}
+
+	s.mtx.Lock()
+	saving := s.saving[height]
+	s.saving[height] = true
+	s.mtx.Unlock()
+	if saving {
+		return nil, errors.Wrapf(storeerrors.ErrConflict,
+			"a snapshot for height %v is already being saved", height)
+	}
+	defer func() {
+		s.mtx.Lock()
+		delete(s.saving, height)
+		s.mtx.Unlock()
+	}()
+
+	snapshot := &types.Snapshot{
+		Height: height,
+		Format: types.ParallelFormat,
+	}
+
+	// create height directory or do nothing
+	if err := os.MkdirAll(s.pathHeight(height), 0o750); err != nil {
+		return nil, errors.Wrapf(err, "failed to create snapshot directory for height %v", height)
+	}
+	// create format directory or fail (if for example the format directory already exists)
+	if err := os.Mkdir(s.pathSnapshot(height, snapshot.Format), 0o750); err != nil {
+		return nil, errors.Wrapf(err, "failed to create snapshot directory for height %v format %v", height, snapshot.Format)
+	}
+
+	streamHashes := make([][][]byte, streams)
+	g, ctx := errgroup.WithContext(context.Background())
+	g.SetLimit(parallelism)
+	for i := uint32(0); i < streams; i++ {
+		i := i
+		g.Go(func() error {
+			if ctx.Err() != nil {
+				// another stream failed, there is no need to start this one
+				return nil
+			}
+			chunks := stream(i)
+			defer DrainChunks(chunks)
+
+			chunkHasher := sha256.New()
+			for chunkBody := range chunks {
+				if ctx.Err() != nil {
+					_ = chunkBody.Close()
+					return nil
+				}
+				path := s.pathStreamChunk(height, i, uint32(len(streamHashes[i])))
+				if err := saveChunkFile(path, chunkBody, chunkHasher); err != nil {
+					return errors.Wrapf(err, "stream %d", i)
+				}
+				streamHashes[i] = append(streamHashes[i], chunkHasher.Sum(nil))
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	// the chunks are renamed in the order of the streams, and the snapshot hash is the hash of
+	// all the chunk hashes, in the same order
+	index := uint32(0)
+	snapshotHasher := sha256.New()
+	for i, hashes := range streamHashes {
+		for j, chunkHash := range hashes {
+			path := s.pathStreamChunk(height, uint32(i), uint32(j))
+			if err := os.Rename(path, s.PathChunk(height, snapshot.Format, index)); err != nil {
+				return nil, errors.Wrapf(err, "failed to rename snapshot chunk file %q", path)
+			}
+			snapshotHasher.Write(chunkHash)
+			snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, chunkHash)
+			index++
+		}
+		snapshot.Metadata.StreamChunks = append(snapshot.Metadata.StreamChunks, uint32(len(hashes)))
+	}
+	snapshot.Chunks = index
+	snapshot.Hash = snapshotHasher.Sum(nil)
+	return snapshot, s.saveSnapshot(snapshot)
+}
+
 // saveChunk saves the given chunkBody with the given index to its appropriate path on disk.
 // The hash of the chunk is appended to the snapshot's metadata,
 // and the overall snapshot hash is updated with the chunk content too.
 func (s *Store) saveChunk(chunkBody io.ReadCloser, index uint32, snapshot *types.Snapshot, chunkHasher, snapshotHasher hash.Hash) error {
+	path := s.PathChunk(snapshot.Height, snapshot.Format, index)
+	if err := saveChunkFile(path, chunkBody, chunkHasher, snapshotHasher); err != nil {
+		return errors.Wrapf(err, "chunk %d", index)
+	}
+
+	snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, chunkHasher.Sum(nil))
+	return nil
+}
+
+// saveChunkFile copies the given chunkBody to the file at the given path. The chunk hasher is reset
+// before the copy, and it is updated with the other hashers by the chunk content.
+func saveChunkFile(path string, chunkBody io.ReadCloser, chunkHasher hash.Hash, hashers ...io.Writer) error {
 	defer chunkBody.Close()
 
-	path := s.PathChunk(snapshot.Height, snapshot.Format, index)
 	chunkFile, err := os.Create(path)
 	if err != nil {
 		return errors.Wrapf(err, "failed to create snapshot chunk file %q", path)
@@ -308,19 +502,19 @@ func (s *Store) saveChunk(chunkBody io.ReadCloser, index uint32, snapshot *types
 	defer chunkFile.Close()
 
 	chunkHasher.Reset()
-	if _, err := io.Copy(io.MultiWriter(chunkFile, chunkHasher, snapshotHasher), chunkBody); err != nil {
-		return errors.Wrapf(err, "failed to generate snapshot chunk %d", index)
+	writers := append([]io.Writer{chunkFile, chunkHasher}, hashers...)
+	if _, err := io.Copy(io.MultiWriter(writers...), chunkBody); err != nil {
+		return errors.Wrap(err, "failed to generate snapshot chunk")
 	}
 
 	if err := chunkFile.Close(); err != nil {
-		return errors.Wrapf(err, "failed to close snapshot chunk file %d", index)
+		return errors.Wrap(err, "failed to close snapshot chunk file")
 	}
 
 	if err := chunkBody.Close(); err != nil {
-		return errors.Wrapf(err, "failed to close snapshot chunk body %d", index)
+		return errors.Wrap(err, "failed to close snapshot chunk body")
 	}
 
-	snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, chunkHasher.Sum(nil))
 	return nil
 }
 
@@ -353,6 +547,11 @@ func (s *Store) pathSnapshot(height uint64, format uint32) string {
 	return filepath.Join(s.pathHeight(height), strconv.FormatUint(uint64(format), 10))
 }
 
+// pathStreamChunk generates the temporary path of a chunk of a stream of a parallel snapshot.
+func (s *Store) pathStreamChunk(height uint64, stream, chunk uint32) string {
+	return filepath.Join(s.pathSnapshot(height, types.ParallelFormat), fmt.Sprintf("stream-%d-%d.tmp", stream, chunk))
+}
+
 func (s *Store) pathMetadataDir() string {
 	return filepath.Join(s.dir, "metadata")
 }
@@ -346,6 +346,59 @@ func TestStore_Save(t *testing.T) {
 	assert.Equal(t, []uint64{7, 7}, gotErrHeights)
 }
 
+func TestStore_SaveStreams(t *testing.T) {
+	t.Parallel()
+	store := setupStore(t)
+	streams := [][][]byte{{{1}, {2}}, {{3}}, {{4}, {5}, {6}}}
+	stream := func(index uint32) <-chan io.ReadCloser {
+		return makeChunks(streams[index])
+	}
+
+	// Saving a snapshot should work, the chunks are ordered by stream
+	snapshot, err := store.SaveStreams(4, uint32(len(streams)), 2, stream)
+	require.NoError(t, err)
+	chunks := [][]byte{{1}, {2}, {3}, {4}, {5}, {6}}
+	assert.Equal(t, &types.Snapshot{
+		Height: 4,
+		Format: types.ParallelFormat,
+		Chunks: 6,
+		Hash:   hash(checksums(chunks)),
+		Metadata: types.Metadata{
+			ChunkHashes:  checksums(chunks),
+			StreamChunks: []uint32{2, 1, 3},
+		},
+	}, snapshot)
+	loaded, chs, err := store.Load(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, loaded)
+	assert.Equal(t, chunks, readChunks(chs))
+
+	// Saving an existing snapshot should error
+	_, err = store.SaveStreams(4, uint32(len(streams)), 2, stream)
+	require.Error(t, err)
+
+	// Saving at height 0 or without parallelism should error
+	_, err = store.SaveStreams(0, uint32(len(streams)), 2, stream)
+	require.Error(t, err)
+	_, err = store.SaveStreams(5, uint32(len(streams)), 0, stream)
+	require.Error(t, err)
+
+	// Saving a snapshot should error if a chunk reader of any stream returns an error
+	someErr := errors.New("boom")
+	_, err = store.SaveStreams(6, 2, 2, func(index uint32) <-chan io.ReadCloser {
+		if index == 0 {
+			return makeChunks([][]byte{{1}})
+		}
+		pr, pw := io.Pipe()
+		_ = pw.CloseWithError(someErr)
+		ch := make(chan io.ReadCloser, 1)
+		ch <- pr
+		close(ch)
+		return ch
+	})
+	require.ErrorIs(t, err, someErr)
+}
+
 type ReadCloserMock struct{}
 
 func (r ReadCloserMock) Read(p []byte) (n int, err error) {
@@ -4,3 +4,20 @@ package types
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 3
+
+// ParallelFormat is the format of snapshots made of independent streams, one per store key
+// followed by one for the extensions, which are created and restored concurrently. The number
+// of chunks of each stream is recorded in the snapshot metadata, and the snapshot hash is the
+// hash of the concatenated chunk hashes, so it can be computed without ordering the streams.
+const ParallelFormat uint32 = 4
+
+// DeltaFormat is the format of delta snapshots, which only contain the changesets committed
+// since the snapshot they are based on, followed by the extensions. A delta snapshot can only be
+// restored locally, on top of the chain of snapshots leading to it from a full snapshot, so it is
+// not offered to state sync peers.
+const DeltaFormat uint32 = 5
+
+// IsSupportedFormat returns true if snapshots of the given format can be restored by state sync.
+func IsSupportedFormat(format uint32) bool {
+	return format == CurrentFormat || format == ParallelFormat
+}
@@ -103,6 +103,17 @@ func (m *Snapshot) GetMetadata() Metadata {
 // Metadata contains SDK-specific snapshot metadata.
 type Metadata struct {
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	// stream_chunks is the number of chunks of each stream of a snapshot in the parallel format,
+	// in the order of the chunks. It is empty for the other formats, made of a single stream.
+	StreamChunks []uint32 `protobuf:"varint,2,rep,packed,name=stream_chunks,json=streamChunks,proto3" json:"stream_chunks,omitempty"`
+	// base_height is the height of the snapshot a delta snapshot applies on top of, either a full
+	// snapshot or another delta snapshot. It is 0 for the other formats.
+	BaseHeight uint64 `protobuf:"varint,3,opt,name=base_height,json=baseHeight,proto3" json:"base_height,omitempty"`
+	// base_format is the format of the snapshot a delta snapshot applies on top of.
+	BaseFormat uint32 `protobuf:"varint,4,opt,name=base_format,json=baseFormat,proto3" json:"base_format,omitempty"`
+	// base_hash is the hash of the snapshot a delta snapshot applies on top of, which chains the
+	// delta snapshots to their full snapshot.
+	BaseHash []byte `protobuf:"bytes,5,opt,name=base_hash,json=baseHash,proto3" json:"base_hash,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -145,16 +156,45 @@ func (m *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (m *Metadata) GetStreamChunks() []uint32 {
+	if m != nil {
+		return m.StreamChunks
+	}
+	return nil
+}
+
+func (m *Metadata) GetBaseHeight() uint64 {
+	if m != nil {
+		return m.BaseHeight
+	}
+	return 0
+}
+
+func (m *Metadata) GetBaseFormat() uint32 {
+	if m != nil {
+		return m.BaseFormat
+	}
+	return 0
+}
+
+func (m *Metadata) GetBaseHash() []byte {
+	if m != nil {
+		return m.BaseHash
+	}
+	return nil
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 type SnapshotItem struct {
 	// item is the specific type of snapshot item.
 	//
 	// Types that are valid to be assigned to Item:
-	//
 	//	*SnapshotItem_Store
 	//	*SnapshotItem_IAVL
 	//	*SnapshotItem_Extension
 	//	*SnapshotItem_ExtensionPayload
+	//	*SnapshotItem_Changeset
+	//	*SnapshotItem_Commit
 	Item isSnapshotItem_Item `protobuf_oneof:"item"`
 }
 
@@ -209,11 +249,19 @@ type SnapshotItem_Extension struct {
 type SnapshotItem_ExtensionPayload struct {
 	ExtensionPayload *SnapshotExtensionPayload `protobuf:"bytes,4,opt,name=extension_payload,json=extensionPayload,proto3,oneof" json:"extension_payload,omitempty"`
 }
+type SnapshotItem_Changeset struct {
+	Changeset *SnapshotChangesetItem `protobuf:"bytes,5,opt,name=changeset,proto3,oneof" json:"changeset,omitempty"`
+}
+type SnapshotItem_Commit struct {
+	Commit *SnapshotCommitItem `protobuf:"bytes,6,opt,name=commit,proto3,oneof" json:"commit,omitempty"`
+}
 
 func (*SnapshotItem_Store) isSnapshotItem_Item()            {}
 func (*SnapshotItem_IAVL) isSnapshotItem_Item()             {}
 func (*SnapshotItem_Extension) isSnapshotItem_Item()        {}
 func (*SnapshotItem_ExtensionPayload) isSnapshotItem_Item() {}
+func (*SnapshotItem_Changeset) isSnapshotItem_Item()        {}
+func (*SnapshotItem_Commit) isSnapshotItem_Item()           {}
 
 func (m *SnapshotItem) GetItem() isSnapshotItem_Item {
 	if m != nil {
@@ -250,6 +298,20 @@ func (m *SnapshotItem) GetExtensionPayload() *SnapshotExtensionPayload {
 	return nil
 }
 
+func (m *SnapshotItem) GetChangeset() *SnapshotChangesetItem {
+	if x, ok := m.GetItem().(*SnapshotItem_Changeset); ok {
+		return x.Changeset
+	}
+	return nil
+}
+
+func (m *SnapshotItem) GetCommit() *SnapshotCommitItem {
+	if x, ok := m.GetItem().(*SnapshotItem_Commit); ok {
+		return x.Commit
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -257,6 +319,8 @@ func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 		(*SnapshotItem_IAVL)(nil),
 		(*SnapshotItem_Extension)(nil),
 		(*SnapshotItem_ExtensionPayload)(nil),
+		(*SnapshotItem_Changeset)(nil),
+		(*SnapshotItem_Commit)(nil),
 	}
 }
 
@@ -474,6 +538,183 @@ func (m *SnapshotExtensionPayload) GetPayload() []byte {
 	return nil
 }
 
+// SnapshotChangesetItem contains the changes made to a store by a version, in a delta snapshot.
+type SnapshotChangesetItem struct {
+	Store   string                   `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	Version uint64                   `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Pairs   []*SnapshotChangesetPair `protobuf:"bytes,3,rep,name=pairs,proto3" json:"pairs,omitempty"`
+}
+
+func (m *SnapshotChangesetItem) Reset()         { *m = SnapshotChangesetItem{} }
+func (m *SnapshotChangesetItem) String() string { return proto.CompactTextString(m) }
+func (*SnapshotChangesetItem) ProtoMessage()    {}
+func (*SnapshotChangesetItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6851f1463fcbb80c, []int{7}
+}
+func (m *SnapshotChangesetItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotChangesetItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotChangesetItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotChangesetItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotChangesetItem.Merge(m, src)
+}
+func (m *SnapshotChangesetItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotChangesetItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotChangesetItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotChangesetItem proto.InternalMessageInfo
+
+func (m *SnapshotChangesetItem) GetStore() string {
+	if m != nil {
+		return m.Store
+	}
+	return ""
+}
+
+func (m *SnapshotChangesetItem) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SnapshotChangesetItem) GetPairs() []*SnapshotChangesetPair {
+	if m != nil {
+		return m.Pairs
+	}
+	return nil
+}
+
+// SnapshotChangesetPair is a key changed by a version, in a delta snapshot.
+type SnapshotChangesetPair struct {
+	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// remove is true when the key is removed by the version.
+	Remove bool `protobuf:"varint,3,opt,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (m *SnapshotChangesetPair) Reset()         { *m = SnapshotChangesetPair{} }
+func (m *SnapshotChangesetPair) String() string { return proto.CompactTextString(m) }
+func (*SnapshotChangesetPair) ProtoMessage()    {}
+func (*SnapshotChangesetPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6851f1463fcbb80c, []int{8}
+}
+func (m *SnapshotChangesetPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotChangesetPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotChangesetPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotChangesetPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotChangesetPair.Merge(m, src)
+}
+func (m *SnapshotChangesetPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotChangesetPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotChangesetPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotChangesetPair proto.InternalMessageInfo
+
+func (m *SnapshotChangesetPair) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *SnapshotChangesetPair) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *SnapshotChangesetPair) GetRemove() bool {
+	if m != nil {
+		return m.Remove
+	}
+	return false
+}
+
+// SnapshotCommitItem ends the changes of a version in a delta snapshot, with the commit hash of
+// the version to verify the restored state against.
+type SnapshotCommitItem struct {
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Hash    []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *SnapshotCommitItem) Reset()         { *m = SnapshotCommitItem{} }
+func (m *SnapshotCommitItem) String() string { return proto.CompactTextString(m) }
+func (*SnapshotCommitItem) ProtoMessage()    {}
+func (*SnapshotCommitItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6851f1463fcbb80c, []int{9}
+}
+func (m *SnapshotCommitItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotCommitItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotCommitItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotCommitItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotCommitItem.Merge(m, src)
+}
+func (m *SnapshotCommitItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotCommitItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotCommitItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotCommitItem proto.InternalMessageInfo
+
+func (m *SnapshotCommitItem) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SnapshotCommitItem) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Snapshot)(nil), "cosmos.store.snapshots.v2.Snapshot")
 	proto.RegisterType((*Metadata)(nil), "cosmos.store.snapshots.v2.Metadata")
@@ -482,6 +723,9 @@ func init() {
 	proto.RegisterType((*SnapshotIAVLItem)(nil), "cosmos.store.snapshots.v2.SnapshotIAVLItem")
 	proto.RegisterType((*SnapshotExtensionMeta)(nil), "cosmos.store.snapshots.v2.SnapshotExtensionMeta")
 	proto.RegisterType((*SnapshotExtensionPayload)(nil), "cosmos.store.snapshots.v2.SnapshotExtensionPayload")
+	proto.RegisterType((*SnapshotChangesetItem)(nil), "cosmos.store.snapshots.v2.SnapshotChangesetItem")
+	proto.RegisterType((*SnapshotChangesetPair)(nil), "cosmos.store.snapshots.v2.SnapshotChangesetPair")
+	proto.RegisterType((*SnapshotCommitItem)(nil), "cosmos.store.snapshots.v2.SnapshotCommitItem")
 }
 
 func init() {
@@ -489,41 +733,52 @@ func init() {
 }
 
 var fileDescriptor_6851f1463fcbb80c = []byte{
-	// 535 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0x8e, 0xd7, 0xb4, 0x74, 0x4e, 0x10, 0x9d, 0x19, 0x28, 0xec, 0x90, 0x85, 0x70, 0x89, 0x04,
-	0x4d, 0xa7, 0x0c, 0x71, 0x40, 0xbb, 0x50, 0x31, 0xa9, 0x15, 0x20, 0x4d, 0x9e, 0x84, 0x10, 0x97,
-	0xca, 0x5b, 0x4d, 0x53, 0xb5, 0x89, 0xab, 0xda, 0x8b, 0xe8, 0x91, 0x37, 0xe0, 0x45, 0xb8, 0xf1,
-	0x10, 0x3b, 0x4e, 0x9c, 0x38, 0x4d, 0xa8, 0x7d, 0x05, 0x1e, 0x00, 0xd9, 0x4e, 0x02, 0xda, 0x52,
-	0xb4, 0xdd, 0xfe, 0xef, 0xf7, 0xf7, 0x7d, 0xf6, 0xff, 0xd9, 0x09, 0x0c, 0x4e, 0x19, 0x4f, 0x18,
-	0xef, 0x70, 0xc1, 0xe6, 0xb4, 0xc3, 0x53, 0x32, 0xe3, 0x31, 0x13, 0xbc, 0x93, 0x45, 0x25, 0x08,
-	0x67, 0x73, 0x26, 0x18, 0x7a, 0xa4, 0x99, 0xa1, 0x62, 0x86, 0x25, 0x33, 0xcc, 0xa2, 0x9d, 0xed,
-	0x11, 0x1b, 0x31, 0xc5, 0xea, 0xc8, 0x4a, 0x0b, 0x76, 0x72, 0xc1, 0x40, 0x2f, 0xe4, 0x6a, 0x05,
-	0xfc, 0x6f, 0x00, 0x36, 0x8f, 0x73, 0x07, 0xf4, 0x10, 0x36, 0x62, 0x3a, 0x1e, 0xc5, 0xc2, 0x01,
-	0x1e, 0x08, 0x4c, 0x9c, 0x23, 0xd9, 0xff, 0xc4, 0xe6, 0x09, 0x11, 0xce, 0x86, 0x07, 0x82, 0xbb,
-	0x38, 0x47, 0xb2, 0x7f, 0x1a, 0x9f, 0xa5, 0x13, 0xee, 0xd4, 0x74, 0x5f, 0x23, 0x84, 0xa0, 0x19,
-	0x13, 0x1e, 0x3b, 0xa6, 0x07, 0x02, 0x1b, 0xab, 0x1a, 0x1d, 0xc2, 0x66, 0x42, 0x05, 0x19, 0x12,
-	0x41, 0x9c, 0xba, 0x07, 0x02, 0x2b, 0x7a, 0x12, 0xae, 0x9d, 0x23, 0x7c, 0x97, 0x53, 0xbb, 0xe6,
-	0xf9, 0xe5, 0xae, 0x81, 0x4b, 0xa9, 0xdf, 0x86, 0xcd, 0x62, 0x0d, 0x3d, 0x86, 0xb6, 0xda, 0x70,
-	0x20, 0x37, 0xa0, 0xdc, 0x01, 0x5e, 0x2d, 0xb0, 0xb1, 0xa5, 0x7a, 0x3d, 0xd5, 0xf2, 0x7f, 0x6f,
-	0x40, 0xbb, 0x18, 0xaf, 0x2f, 0x68, 0x82, 0x5e, 0xc3, 0xba, 0xda, 0x4e, 0x4d, 0x68, 0x45, 0xcf,
-	0xfe, 0x73, 0x86, 0x42, 0x77, 0x2c, 0x97, 0xa4, 0xb8, 0x67, 0x60, 0x2d, 0x46, 0x6f, 0xa0, 0x39,
-	0x26, 0xd9, 0x54, 0xc5, 0x61, 0x45, 0x4f, 0x6f, 0x60, 0xd2, 0x7f, 0xf5, 0xfe, 0xad, 0xf4, 0xe8,
-	0x36, 0x97, 0x97, 0xbb, 0xa6, 0x44, 0x3d, 0x03, 0x2b, 0x13, 0x74, 0x04, 0x37, 0xe9, 0x67, 0x41,
-	0x53, 0x3e, 0x66, 0xa9, 0x0a, 0xd2, 0x8a, 0xf6, 0x6e, 0xe0, 0x78, 0x58, 0x68, 0x64, 0x1e, 0x3d,
-	0x03, 0xff, 0x35, 0x41, 0x27, 0x70, 0xab, 0x04, 0x83, 0x19, 0x59, 0x4c, 0x19, 0x19, 0xaa, 0xcb,
-	0xb0, 0xa2, 0xfd, 0xdb, 0x38, 0x1f, 0x69, 0x69, 0xcf, 0xc0, 0x2d, 0x7a, 0xa5, 0xf7, 0xf2, 0xfe,
-	0x8f, 0xef, 0xed, 0x7b, 0xda, 0xab, 0xcd, 0x87, 0x13, 0x6f, 0x2f, 0x7c, 0xfe, 0xa2, 0xdb, 0x80,
-	0xe6, 0x58, 0xd0, 0xc4, 0x3f, 0x80, 0x5b, 0xd7, 0xd2, 0x93, 0xaf, 0x22, 0x25, 0x89, 0x4e, 0x7e,
-	0x13, 0xab, 0xba, 0xd2, 0xc5, 0xff, 0x02, 0x60, 0xeb, 0x6a, 0x6e, 0xa8, 0x05, 0x6b, 0x13, 0xba,
-	0x50, 0x62, 0x1b, 0xcb, 0x12, 0x6d, 0xc3, 0x7a, 0x46, 0xa6, 0x67, 0x54, 0xdd, 0x82, 0x8d, 0x35,
-	0x40, 0x0e, 0xbc, 0x93, 0xd1, 0x79, 0x99, 0x65, 0x0d, 0x17, 0xf0, 0x9f, 0xd7, 0x2d, 0xa3, 0xa8,
-	0x17, 0xaf, 0xbb, 0xfa, 0x0c, 0x1f, 0xe0, 0x83, 0xca, 0xa0, 0xab, 0xa6, 0x58, 0xf7, 0x7d, 0x54,
-	0x3b, 0xf7, 0xa1, 0xb3, 0x2e, 0x68, 0x79, 0xf8, 0xe2, 0xba, 0xf4, 0xa0, 0x05, 0xac, 0x8e, 0xfb,
-	0xe0, 0x7c, 0xe9, 0x82, 0x8b, 0xa5, 0x0b, 0x7e, 0x2d, 0x5d, 0xf0, 0x75, 0xe5, 0x1a, 0x17, 0x2b,
-	0xd7, 0xf8, 0xb9, 0x72, 0x8d, 0x8f, 0xbe, 0xa6, 0xf2, 0xe1, 0x24, 0x1c, 0xb3, 0x6b, 0xbf, 0x14,
-	0xb1, 0x98, 0x51, 0x7e, 0xd2, 0x50, 0x7f, 0x80, 0xfd, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa0,
-	0xee, 0x29, 0xf5, 0x79, 0x04, 0x00, 0x00,
+	// 712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x41, 0x4f, 0x13, 0x41,
+	0x14, 0xde, 0x69, 0xb7, 0xb5, 0x7d, 0x2d, 0x11, 0x46, 0x20, 0x2b, 0x26, 0xa5, 0x2e, 0x97, 0x26,
+	0xca, 0x96, 0x14, 0xe3, 0xc1, 0x70, 0xb1, 0x08, 0x96, 0xa8, 0x09, 0x19, 0x12, 0x35, 0x5e, 0x9a,
+	0xa1, 0x1d, 0xbb, 0x9b, 0xb2, 0x9d, 0x66, 0x67, 0x68, 0xe4, 0xe8, 0x2f, 0xc0, 0x3f, 0xe2, 0xc1,
+	0xc4, 0x1f, 0xc1, 0x91, 0x78, 0xf2, 0x44, 0x4c, 0xf9, 0x23, 0x66, 0x66, 0x76, 0x5b, 0x84, 0xc5,
+	0x94, 0xdb, 0x7c, 0x6f, 0xde, 0xf7, 0xed, 0x7c, 0xef, 0xbd, 0x99, 0x85, 0x5a, 0x87, 0x8b, 0x90,
+	0x8b, 0xba, 0x90, 0x3c, 0x62, 0x75, 0x31, 0xa0, 0x43, 0xe1, 0x73, 0x29, 0xea, 0xa3, 0xc6, 0x04,
+	0x78, 0xc3, 0x88, 0x4b, 0x8e, 0x1f, 0x9a, 0x4c, 0x4f, 0x67, 0x7a, 0x93, 0x4c, 0x6f, 0xd4, 0x58,
+	0x59, 0xec, 0xf1, 0x1e, 0xd7, 0x59, 0x75, 0xb5, 0x32, 0x84, 0x95, 0x98, 0xd0, 0x36, 0x1b, 0x31,
+	0x5b, 0x03, 0xf7, 0x3b, 0x82, 0xc2, 0x41, 0xac, 0x80, 0x97, 0x21, 0xef, 0xb3, 0xa0, 0xe7, 0x4b,
+	0x07, 0x55, 0x51, 0xcd, 0x26, 0x31, 0x52, 0xf1, 0xcf, 0x3c, 0x0a, 0xa9, 0x74, 0x32, 0x55, 0x54,
+	0x9b, 0x23, 0x31, 0x52, 0xf1, 0x8e, 0x7f, 0x3c, 0xe8, 0x0b, 0x27, 0x6b, 0xe2, 0x06, 0x61, 0x0c,
+	0xb6, 0x4f, 0x85, 0xef, 0xd8, 0x55, 0x54, 0x2b, 0x13, 0xbd, 0xc6, 0x3b, 0x50, 0x08, 0x99, 0xa4,
+	0x5d, 0x2a, 0xa9, 0x93, 0xab, 0xa2, 0x5a, 0xa9, 0xb1, 0xe6, 0xdd, 0xea, 0xc3, 0x7b, 0x17, 0xa7,
+	0x36, 0xed, 0xb3, 0x8b, 0x55, 0x8b, 0x4c, 0xa8, 0xee, 0x0f, 0x04, 0x85, 0x64, 0x13, 0x3f, 0x86,
+	0xb2, 0xfe, 0x62, 0x5b, 0x7d, 0x81, 0x09, 0x07, 0x55, 0xb3, 0xb5, 0x32, 0x29, 0xe9, 0x58, 0x4b,
+	0x87, 0xf0, 0x1a, 0xcc, 0x09, 0x19, 0x31, 0x1a, 0xb6, 0xe3, 0x93, 0x66, 0xaa, 0xd9, 0xda, 0x1c,
+	0x29, 0x9b, 0xe0, 0xb6, 0x39, 0xef, 0x2a, 0x94, 0x0e, 0xa9, 0x60, 0xed, 0xd8, 0x7c, 0x56, 0x9b,
+	0x07, 0x15, 0x6a, 0x99, 0x02, 0x24, 0x09, 0x71, 0x15, 0x6c, 0xed, 0x56, 0x27, 0xec, 0x9a, 0x4a,
+	0x3c, 0x82, 0xa2, 0x51, 0x50, 0xb6, 0x73, 0xda, 0x76, 0x41, 0xf3, 0xa9, 0xf0, 0xdd, 0x53, 0x1b,
+	0xca, 0x49, 0x8d, 0xf7, 0x24, 0x0b, 0xf1, 0x2b, 0xc8, 0x69, 0xcf, 0xba, 0xcc, 0xa5, 0xc6, 0xd3,
+	0xff, 0x14, 0x22, 0xe1, 0x1d, 0xa8, 0x2d, 0x45, 0x6e, 0x59, 0xc4, 0x90, 0xf1, 0x1b, 0xb0, 0x03,
+	0x3a, 0x3a, 0xd2, 0x3d, 0x29, 0x35, 0x9e, 0xcc, 0x20, 0xb2, 0xf7, 0xf2, 0xfd, 0x5b, 0xa5, 0xd1,
+	0x2c, 0x8c, 0x2f, 0x56, 0x6d, 0x85, 0x5a, 0x16, 0xd1, 0x22, 0x78, 0x1f, 0x8a, 0xec, 0x8b, 0x64,
+	0x03, 0x11, 0xf0, 0x81, 0x2e, 0x40, 0xa9, 0xb1, 0x31, 0x83, 0xe2, 0x4e, 0xc2, 0x51, 0x3d, 0x69,
+	0x59, 0x64, 0x2a, 0x82, 0x0f, 0x61, 0x61, 0x02, 0xda, 0x43, 0x7a, 0x72, 0xc4, 0x69, 0x57, 0x57,
+	0xae, 0xd4, 0xd8, 0xbc, 0x8b, 0xf2, 0xbe, 0xa1, 0xb6, 0x2c, 0x32, 0xcf, 0xae, 0xc5, 0xd4, 0xa9,
+	0x3b, 0x3e, 0x1d, 0xf4, 0x98, 0x60, 0xd2, 0xc9, 0xcd, 0x7c, 0xea, 0xed, 0x84, 0x13, 0x17, 0x74,
+	0x2a, 0x82, 0x5f, 0x43, 0xbe, 0xc3, 0xc3, 0x30, 0x90, 0x4e, 0x5e, 0xcb, 0xad, 0xcf, 0x22, 0xa7,
+	0x09, 0xb1, 0x56, 0x4c, 0x7f, 0xf1, 0xe0, 0xd7, 0xcf, 0xf5, 0xfb, 0x86, 0xbb, 0x2e, 0xba, 0xfd,
+	0xea, 0x86, 0xf7, 0xec, 0x79, 0x33, 0x0f, 0x76, 0x20, 0x59, 0xe8, 0x6e, 0xc1, 0xc2, 0x8d, 0xc6,
+	0xaa, 0x5b, 0x33, 0xa0, 0xa1, 0x19, 0x8a, 0x22, 0xd1, 0xeb, 0x54, 0x15, 0xf7, 0x2b, 0x82, 0xf9,
+	0xeb, 0x2d, 0xc5, 0xf3, 0x90, 0xed, 0xb3, 0x13, 0x4d, 0x2e, 0x13, 0xb5, 0xc4, 0x8b, 0x90, 0x1b,
+	0xd1, 0xa3, 0x63, 0xa6, 0x07, 0xa4, 0x4c, 0x0c, 0xc0, 0x0e, 0xdc, 0x1b, 0xb1, 0x68, 0xd2, 0xe6,
+	0x2c, 0x49, 0xe0, 0x95, 0xdb, 0xaf, 0xba, 0x94, 0x4b, 0x6e, 0x7f, 0xfa, 0x19, 0x3e, 0xc2, 0x52,
+	0xea, 0x0c, 0xa4, 0xb9, 0xb8, 0xed, 0xfd, 0x48, 0x57, 0xde, 0x03, 0xe7, 0xb6, 0x19, 0x50, 0x87,
+	0x4f, 0x26, 0xc9, 0x18, 0x4d, 0x60, 0xba, 0xd4, 0x29, 0x82, 0xa5, 0xd4, 0x9e, 0xab, 0xda, 0x4c,
+	0x6f, 0x60, 0x31, 0xb9, 0x51, 0x57, 0x6a, 0x93, 0xd1, 0x6f, 0x40, 0x02, 0xf1, 0x2e, 0xe4, 0x86,
+	0x34, 0x88, 0xd4, 0x43, 0x97, 0xbd, 0xeb, 0x90, 0xed, 0xd3, 0x20, 0x22, 0x86, 0xee, 0x7e, 0x80,
+	0xa5, 0xd4, 0xfd, 0x99, 0xdb, 0xb7, 0x0c, 0xf9, 0x88, 0x85, 0x7c, 0xc4, 0x74, 0xf7, 0x0a, 0x24,
+	0x46, 0x6e, 0x13, 0xf0, 0xcd, 0x71, 0xbc, 0x6a, 0x08, 0xfd, 0x6b, 0x28, 0x79, 0xa2, 0x33, 0xd3,
+	0x27, 0xba, 0xb9, 0x75, 0x36, 0xae, 0xa0, 0xf3, 0x71, 0x05, 0xfd, 0x19, 0x57, 0xd0, 0xb7, 0xcb,
+	0x8a, 0x75, 0x7e, 0x59, 0xb1, 0x7e, 0x5f, 0x56, 0xac, 0x4f, 0xae, 0xb1, 0x2b, 0xba, 0x7d, 0x2f,
+	0xe0, 0x37, 0xfe, 0x50, 0xf2, 0x64, 0xc8, 0xc4, 0x61, 0x5e, 0xff, 0x50, 0x36, 0xff, 0x0e, 0x00,
+	0x19, 0xb2, 0xad, 0xaa, 0xc8, 0x06, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -601,6 +856,41 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BaseHash) > 0 {
+		i -= len(m.BaseHash)
+		copy(dAtA[i:], m.BaseHash)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.BaseHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BaseFormat != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.BaseFormat))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BaseHeight != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.BaseHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.StreamChunks) > 0 {
+		dAtA3 := make([]byte, len(m.StreamChunks)*10)
+		var j2 int
+		for _, num := range m.StreamChunks {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintSnapshot(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ChunkHashes) > 0 {
 		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ChunkHashes[iNdEx])
@@ -729,6 +1019,48 @@ func (m *SnapshotItem_ExtensionPayload) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *SnapshotItem_Changeset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotItem_Changeset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Changeset != nil {
+		{
+			size, err := m.Changeset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSnapshot(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SnapshotItem_Commit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotItem_Commit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSnapshot(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
 func (m *SnapshotStoreItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -871,52 +1203,200 @@ func (m *SnapshotExtensionPayload) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSnapshot(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSnapshot(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SnapshotChangesetItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Snapshot) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SnapshotChangesetItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotChangesetItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovSnapshot(uint64(m.Height))
-	}
-	if m.Format != 0 {
-		n += 1 + sovSnapshot(uint64(m.Format))
+	if len(m.Pairs) > 0 {
+		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSnapshot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.Chunks != 0 {
-		n += 1 + sovSnapshot(uint64(m.Chunks))
+	if m.Version != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovSnapshot(uint64(l))
+	if len(m.Store) > 0 {
+		i -= len(m.Store)
+		copy(dAtA[i:], m.Store)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Store)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovSnapshot(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Metadata) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SnapshotChangesetPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotChangesetPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotChangesetPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ChunkHashes) > 0 {
-		for _, b := range m.ChunkHashes {
-			l = len(b)
-			n += 1 + l + sovSnapshot(uint64(l))
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotCommitItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotCommitItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotCommitItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSnapshot(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSnapshot(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Snapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovSnapshot(uint64(m.Height))
+	}
+	if m.Format != 0 {
+		n += 1 + sovSnapshot(uint64(m.Format))
+	}
+	if m.Chunks != 0 {
+		n += 1 + sovSnapshot(uint64(m.Chunks))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovSnapshot(uint64(l))
+	return n
+}
+
+func (m *Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChunkHashes) > 0 {
+		for _, b := range m.ChunkHashes {
+			l = len(b)
+			n += 1 + l + sovSnapshot(uint64(l))
+		}
+	}
+	if len(m.StreamChunks) > 0 {
+		l = 0
+		for _, e := range m.StreamChunks {
+			l += sovSnapshot(uint64(e))
+		}
+		n += 1 + sovSnapshot(uint64(l)) + l
+	}
+	if m.BaseHeight != 0 {
+		n += 1 + sovSnapshot(uint64(m.BaseHeight))
+	}
+	if m.BaseFormat != 0 {
+		n += 1 + sovSnapshot(uint64(m.BaseFormat))
+	}
+	l = len(m.BaseHash)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
 	}
 	return n
 }
@@ -981,6 +1461,30 @@ func (m *SnapshotItem_ExtensionPayload) Size() (n int) {
 	}
 	return n
 }
+func (m *SnapshotItem_Changeset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Changeset != nil {
+		l = m.Changeset.Size()
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
+func (m *SnapshotItem_Commit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
 func (m *SnapshotStoreItem) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1046,6 +1550,64 @@ func (m *SnapshotExtensionPayload) Size() (n int) {
 	return n
 }
 
+func (m *SnapshotChangesetItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Store)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovSnapshot(uint64(m.Version))
+	}
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovSnapshot(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SnapshotChangesetPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	if m.Remove {
+		n += 2
+	}
+	return n
+}
+
+func (m *SnapshotCommitItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovSnapshot(uint64(m.Version))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
+
 func sovSnapshot(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1287,6 +1849,154 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			m.ChunkHashes = append(m.ChunkHashes, make([]byte, postIndex-iNdEx))
 			copy(m.ChunkHashes[len(m.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSnapshot
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.StreamChunks = append(m.StreamChunks, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSnapshot
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSnapshot
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSnapshot
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.StreamChunks) == 0 {
+					m.StreamChunks = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSnapshot
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.StreamChunks = append(m.StreamChunks, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamChunks", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseHeight", wireType)
+			}
+			m.BaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFormat", wireType)
+			}
+			m.BaseFormat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFormat |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseHash = append(m.BaseHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BaseHash == nil {
+				m.BaseHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -1477,16 +2187,86 @@ func (m *SnapshotItem) Unmarshal(dAtA []byte) error {
 			}
 			m.Item = &SnapshotItem_ExtensionPayload{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSnapshot(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSnapshot
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changeset", wireType)
 			}
-			if (iNdEx + skippy) > l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SnapshotChangesetItem{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &SnapshotItem_Changeset{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SnapshotCommitItem{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &SnapshotItem_Commit{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -1921,6 +2701,382 @@ func (m *SnapshotExtensionPayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SnapshotChangesetItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotChangesetItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotChangesetItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Store = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, &SnapshotChangesetPair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotChangesetPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotChangesetPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotChangesetPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remove = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotCommitItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotCommitItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotCommitItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSnapshot(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
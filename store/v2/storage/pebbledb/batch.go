@@ -41,8 +41,17 @@ func (b *Batch) Size() int {
 	return b.batch.Len()
 }
 
+// Reset starts a new batch for the same version, as Write closes the underlying
+// PebbleDB batch.
 func (b *Batch) Reset() error {
-	b.batch.Reset()
+	var versionBz [VersionSize]byte
+	binary.LittleEndian.PutUint64(versionBz[:], b.version)
+
+	b.batch = b.storage.NewBatch()
+	if err := b.batch.Set([]byte(latestVersionKey), versionBz[:], nil); err != nil {
+		return fmt.Errorf("failed to write PebbleDB batch: %w", err)
+	}
+
 	return nil
 }
 
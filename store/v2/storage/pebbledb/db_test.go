@@ -1,11 +1,14 @@
 package pebbledb
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/store/v2"
 	"cosmossdk.io/store/v2/storage"
 )
 
@@ -26,3 +29,60 @@ func TestStorageTestSuite(t *testing.T) {
 
 	suite.Run(t, s)
 }
+
+func TestBatchResetAfterWrite(t *testing.T) {
+	db, err := New(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	storeKey := []byte("store1")
+	batch, err := db.NewBatch(1)
+	require.NoError(t, err)
+	require.NoError(t, batch.Set(storeKey, []byte("key1"), []byte("val1")))
+	require.NoError(t, batch.Write())
+
+	// the batch can be reused for the same version once written
+	require.NoError(t, batch.Reset())
+	require.NoError(t, batch.Set(storeKey, []byte("key2"), []byte("val2")))
+	require.NoError(t, batch.Write())
+
+	for _, key := range []string{"key1", "key2"} {
+		ok, err := db.Has(storeKey, 1, []byte(key))
+		require.NoError(t, err)
+		require.True(t, ok, key)
+	}
+
+	latestVersion, err := db.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), latestVersion)
+}
+
+func TestIteratorSkipsTombstonedFirstKey(t *testing.T) {
+	db, err := New(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	storeKey := []byte("store1")
+	write := func(version uint64, f func(b store.Batch) error) {
+		batch, err := db.NewBatch(version)
+		require.NoError(t, err)
+		require.NoError(t, f(batch))
+		require.NoError(t, batch.Write())
+	}
+	write(1, func(b store.Batch) error {
+		return errors.Join(b.Set(storeKey, []byte("a"), []byte("a1")), b.Set(storeKey, []byte("b"), []byte("b1")))
+	})
+	write(2, func(b store.Batch) error { return b.Delete(storeKey, []byte("a")) })
+	write(5, func(b store.Batch) error { return b.Set(storeKey, []byte("a"), []byte("a5")) })
+
+	// "a" is deleted at version 3, the iterator starts at "b"
+	itr, err := db.Iterator(storeKey, 3, nil, nil)
+	require.NoError(t, err)
+	defer itr.Close()
+
+	require.True(t, itr.Valid())
+	require.Equal(t, []byte("b"), itr.Key())
+	require.Equal(t, []byte("b1"), itr.Value())
+	itr.Next()
+	require.False(t, itr.Valid())
+}
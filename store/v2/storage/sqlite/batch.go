@@ -22,23 +22,19 @@ type batchOp struct {
 	key, value []byte
 }
 
+// Batch buffers the operations of a version, which are written in a single SQL
+// transaction on Write, so that the single writer connection is only held for
+// the duration of the write.
 type Batch struct {
 	db      *sql.DB
-	tx      *sql.Tx
 	ops     []batchOp
 	size    int
 	version uint64
 }
 
 func NewBatch(db *sql.DB, version uint64) (*Batch, error) {
-	tx, err := db.Begin()
-	if err != nil {
-		return nil, fmt.Errorf("failed to create SQL transaction: %w", err)
-	}
-
 	return &Batch{
 		db:      db,
-		tx:      tx,
 		ops:     make([]batchOp, 0),
 		version: version,
 	}, nil
@@ -53,12 +49,6 @@ func (b *Batch) Reset() error {
 	b.ops = make([]batchOp, 0)
 	b.size = 0
 
-	tx, err := b.db.Begin()
-	if err != nil {
-		return err
-	}
-
-	b.tx = tx
 	return nil
 }
 
@@ -74,29 +64,53 @@ func (b *Batch) Delete(storeKey, key []byte) error {
 	return nil
 }
 
-func (b *Batch) Write() error {
-	_, err := b.tx.Exec(reservedUpsertStmt, reservedStoreKey, keyLatestHeight, b.version, 0, b.version)
+func (b *Batch) Write() (err error) {
+	tx, err := b.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to create SQL transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	_, err = tx.Exec(reservedUpsertStmt, reservedStoreKey, keyLatestHeight, b.version, 0, b.version)
 	if err != nil {
 		return fmt.Errorf("failed to exec SQL statement: %w", err)
 	}
 
+	// the statements are prepared once per batch, as restoring a snapshot writes
+	// large batches of sets
+	setStmt, err := tx.Prepare(upsertStmt)
+	if err != nil {
+		return fmt.Errorf("failed to prepare SQL statement: %w", err)
+	}
+	defer setStmt.Close()
+
+	deleteStmt, err := tx.Prepare(delStmt)
+	if err != nil {
+		return fmt.Errorf("failed to prepare SQL statement: %w", err)
+	}
+	defer deleteStmt.Close()
+
 	for _, op := range b.ops {
 		switch op.action {
 		case batchActionSet:
-			_, err := b.tx.Exec(upsertStmt, op.storeKey, op.key, op.value, b.version, op.value)
+			_, err = setStmt.Exec(op.storeKey, op.key, op.value, b.version, op.value)
 			if err != nil {
 				return fmt.Errorf("failed to exec SQL statement: %w", err)
 			}
 
 		case batchActionDel:
-			_, err := b.tx.Exec(delStmt, b.version, op.storeKey, op.key, b.version)
+			_, err = deleteStmt.Exec(b.version, op.storeKey, op.key, b.version)
 			if err != nil {
 				return fmt.Errorf("failed to exec SQL statement: %w", err)
 			}
 		}
 	}
 
-	if err := b.tx.Commit(); err != nil {
+	if err = tx.Commit(); err != nil {
 		return fmt.Errorf("failed to write SQL transaction: %w", err)
 	}
 
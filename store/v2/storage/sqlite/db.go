@@ -17,8 +17,16 @@ import (
 )
 
 const (
-	driverName       = "sqlite3"
-	dbName           = "ss.db?cache=shared&mode=rwc&_journal_mode=WAL"
+	driverName = "sqlite3"
+	dbName     = "ss.db"
+	// writerOpts opens the database in WAL mode, so that readers never block the
+	// writer and the writer never blocks readers. Write transactions take the
+	// write lock upfront, avoiding lock upgrades failing with SQLITE_BUSY.
+	writerOpts = "?mode=rwc&_journal_mode=WAL&_synchronous=NORMAL&_busy_timeout=5000&_txlock=immediate"
+	// readerOpts opens read-only connections, which read concurrently from the
+	// latest committed snapshot of the WAL.
+	readerOpts = "?mode=ro&_busy_timeout=5000"
+
 	reservedStoreKey = "_RESERVED_"
 	keyLatestHeight  = "latest_height"
 	keyPruneHeight   = "prune_height"
@@ -46,7 +54,11 @@ const (
 var _ storage.Database = (*Database)(nil)
 
 type Database struct {
+	// storage is the single connection all writes go through, as SQLite allows
+	// a single writer at a time.
 	storage *sql.DB
+	// reader is the pool of read-only connections used by queries and iterators.
+	reader *sql.DB
 
 	// earliestVersion defines the earliest version set in the database, which is
 	// only updated when the database is pruned.
@@ -54,10 +66,12 @@ type Database struct {
 }
 
 func New(dataDir string) (*Database, error) {
-	storage, err := sql.Open(driverName, filepath.Join(dataDir, dbName))
+	path := filepath.Join(dataDir, dbName)
+	storage, err := sql.Open(driverName, path+writerOpts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open sqlite DB: %w", err)
 	}
+	storage.SetMaxOpenConns(1)
 
 	stmt := `
 	CREATE TABLE IF NOT EXISTS state_storage (
@@ -74,23 +88,42 @@ func New(dataDir string) (*Database, error) {
 	`
 	_, err = storage.Exec(stmt)
 	if err != nil {
+		_ = storage.Close()
 		return nil, fmt.Errorf("failed to exec SQL statement: %w", err)
 	}
 
-	pruneHeight, err := getPruneHeight(storage)
+	// the reader is opened once the database file exists, as read-only
+	// connections cannot create it
+	reader, err := sql.Open(driverName, path+readerOpts)
 	if err != nil {
+		_ = storage.Close()
+		return nil, fmt.Errorf("failed to open sqlite DB: %w", err)
+	}
+
+	pruneHeight, err := getPruneHeight(reader)
+	if err != nil {
+		_ = reader.Close()
+		_ = storage.Close()
 		return nil, fmt.Errorf("failed to get prune height: %w", err)
 	}
 
+	// versions <= the prune height are pruned, see Prune
+	earliestVersion := pruneHeight
+	if pruneHeight > 0 {
+		earliestVersion = pruneHeight + 1
+	}
+
 	return &Database{
 		storage:         storage,
-		earliestVersion: pruneHeight,
+		reader:          reader,
+		earliestVersion: earliestVersion,
 	}, nil
 }
 
 func (db *Database) Close() error {
-	err := db.storage.Close()
+	err := errors.Join(db.reader.Close(), db.storage.Close())
 	db.storage = nil
+	db.reader = nil
 	return err
 }
 
@@ -99,7 +132,7 @@ func (db *Database) NewBatch(version uint64) (store.Batch, error) {
 }
 
 func (db *Database) GetLatestVersion() (uint64, error) {
-	stmt, err := db.storage.Prepare("SELECT value FROM state_storage WHERE store_key = ? AND key = ?")
+	stmt, err := db.reader.Prepare("SELECT value FROM state_storage WHERE store_key = ? AND key = ?")
 	if err != nil {
 		return 0, fmt.Errorf("failed to prepare SQL statement: %w", err)
 	}
@@ -142,7 +175,7 @@ func (db *Database) Get(storeKey []byte, targetVersion uint64, key []byte) ([]by
 		return nil, storeerrors.ErrVersionPruned{EarliestVersion: db.earliestVersion, RequestedVersion: targetVersion}
 	}
 
-	stmt, err := db.storage.Prepare(`
+	stmt, err := db.reader.Prepare(`
 	SELECT value, tombstone FROM state_storage
 	WHERE store_key = ? AND key = ? AND version <= ?
 	ORDER BY version DESC LIMIT 1;
@@ -180,7 +213,9 @@ func (db *Database) Get(storeKey []byte, targetVersion uint64, key []byte) ([]by
 // above the prune version. This is analogous to RocksDB full_history_ts_low.
 //
 // We perform the prune by deleting all versions of a key, excluding reserved keys,
-// that are <= the given version, except for the latest version of the key.
+// that are <= the given version, except for the latest version of the key. The
+// latest version is deleted as well if it was tombstoned at or below the given
+// version, as the key is then deleted for all queries above the prune version.
 func (db *Database) Prune(version uint64) error {
 	tx, err := db.storage.Begin()
 	if err != nil {
@@ -198,12 +233,24 @@ func (db *Database) Prune(version uint64) error {
 
 	_, err = tx.Exec(pruneStmt, version, reservedStoreKey)
 	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to exec SQL statement: %w", err)
+	}
+
+	pruneTombstonesStmt := `DELETE FROM state_storage
+	WHERE tombstone > 0 AND tombstone <= ? AND store_key != ?;
+	`
+
+	_, err = tx.Exec(pruneTombstonesStmt, version, reservedStoreKey)
+	if err != nil {
+		_ = tx.Rollback()
 		return fmt.Errorf("failed to exec SQL statement: %w", err)
 	}
 
 	// set the prune height so we can return <nil> for queries below this height
 	_, err = tx.Exec(reservedUpsertStmt, reservedStoreKey, keyPruneHeight, version, 0, version)
 	if err != nil {
+		_ = tx.Rollback()
 		return fmt.Errorf("failed to exec SQL statement: %w", err)
 	}
 
@@ -241,7 +288,7 @@ func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end
 }
 
 func (db *Database) PrintRowsDebug() {
-	stmt, err := db.storage.Prepare("SELECT store_key, key, value, version, tombstone FROM state_storage")
+	stmt, err := db.reader.Prepare("SELECT store_key, key, value, version, tombstone FROM state_storage")
 	if err != nil {
 		panic(fmt.Errorf("failed to prepare SQL statement: %w", err))
 	}
@@ -252,6 +299,7 @@ func (db *Database) PrintRowsDebug() {
 	if err != nil {
 		panic(fmt.Errorf("failed to execute SQL query: %w", err))
 	}
+	defer rows.Close()
 
 	var sb strings.Builder
 	for rows.Next() {
@@ -198,3 +198,43 @@ func TestParallelWriteAndPruning(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, []byte(fmt.Sprintf("val-%d-%03d", version-1, 0)), val)
 }
+
+func TestIteratorDuringWrites(t *testing.T) {
+	db, err := New(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	batch, err := db.NewBatch(1)
+	require.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, batch.Set(storeKey1, []byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("val%03d-1", i))))
+	}
+	require.NoError(t, batch.Write())
+
+	// an open iterator must not block writes, which must not block reads
+	iter, err := db.Iterator(storeKey1, 1, nil, nil)
+	require.NoError(t, err)
+	defer iter.Close()
+
+	for v := uint64(2); v <= 5; v++ {
+		batch, err := db.NewBatch(v)
+		require.NoError(t, err)
+		for i := 0; i < 100; i++ {
+			require.NoError(t, batch.Set(storeKey1, []byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("val%03d-%d", i, v))))
+		}
+		require.NoError(t, batch.Write())
+
+		val, err := db.Get(storeKey1, v, []byte("key000"))
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("val000-%d", v)), val)
+	}
+
+	// the iterator keeps reading the version it was opened at
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		require.Equal(t, []byte(fmt.Sprintf("val%03d-1", count)), iter.Value())
+		count++
+	}
+	require.NoError(t, iter.Error())
+	require.Equal(t, 100, count)
+}
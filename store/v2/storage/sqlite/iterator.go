@@ -3,6 +3,7 @@ package sqlite
 import (
 	"bytes"
 	"database/sql"
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
@@ -31,42 +32,40 @@ func newIterator(db *Database, storeKey []byte, targetVersion uint64, start, end
 	}
 
 	var (
-		keyClause = []string{"store_key = ?", "version <= ?"}
-		queryArgs []any
+		keyClause = []string{"store_key = ?"}
+		queryArgs = []any{storeKey}
 	)
 
-	switch {
-	case len(start) > 0 && len(end) > 0:
-		keyClause = append(keyClause, "key >= ?", "key < ?")
-		queryArgs = []any{storeKey, targetVersion, start, end, targetVersion}
-
-	case len(start) > 0 && len(end) == 0:
+	if len(start) > 0 {
 		keyClause = append(keyClause, "key >= ?")
-		queryArgs = []any{storeKey, targetVersion, start, targetVersion}
-
-	case len(start) == 0 && len(end) > 0:
+		queryArgs = append(queryArgs, start)
+	}
+	if len(end) > 0 {
 		keyClause = append(keyClause, "key < ?")
-		queryArgs = []any{storeKey, targetVersion, end, targetVersion}
-
-	default:
-		queryArgs = []any{storeKey, targetVersion, targetVersion}
+		queryArgs = append(queryArgs, end)
 	}
+	queryArgs = append(queryArgs, targetVersion, targetVersion)
 
 	orderBy := "ASC"
 	if reverse {
 		orderBy = "DESC"
 	}
 
+	// The latest version of each key is selected with a correlated subquery, so
+	// that SQLite walks the (store_key, key, version) index in key order, in either
+	// direction, and streams the rows without sorting the whole domain first. This
+	// keeps reverse iteration as cheap as forward iteration.
+	//
 	// Note, this is not susceptible to SQL injection because placeholders are used
 	// for parts of the query outside the store's direct control.
-	stmt, err := db.storage.Prepare(fmt.Sprintf(`
-	SELECT x.key, x.value
-	FROM (
-		SELECT key, value, version, tombstone,
-			row_number() OVER (PARTITION BY key ORDER BY version DESC) AS _rn
-			FROM state_storage WHERE %s
-		) x
-	WHERE x._rn = 1 AND (x.tombstone = 0 OR x.tombstone > ?) ORDER BY x.key %s;
+	stmt, err := db.reader.Prepare(fmt.Sprintf(`
+	SELECT s.key, s.value
+	FROM state_storage s
+	WHERE %s AND s.version = (
+		SELECT max(t.version) FROM state_storage t
+		WHERE t.store_key = s.store_key AND t.key = s.key AND t.version <= ?
+	) AND (s.tombstone = 0 OR s.tombstone > ?)
+	ORDER BY s.key %s;
 	`, strings.Join(keyClause, " AND "), orderBy))
 	if err != nil {
 		return nil, fmt.Errorf("failed to prepare SQL statement: %w", err)
@@ -100,8 +99,11 @@ func newIterator(db *Database, storeKey []byte, targetVersion uint64, start, end
 }
 
 func (itr *iterator) Close() (err error) {
+	if itr.rows != nil {
+		err = itr.rows.Close()
+	}
 	if itr.statement != nil {
-		err = itr.statement.Close()
+		err = errors.Join(err, itr.statement.Close())
 	}
 
 	itr.valid = false
@@ -154,6 +156,9 @@ func (itr *iterator) Next() {
 }
 
 func (itr *iterator) Error() error {
+	if itr.rows == nil {
+		return itr.err
+	}
 	if err := itr.rows.Err(); err != nil {
 		return err
 	}
@@ -182,5 +182,25 @@ func BenchmarkIterate(b *testing.B) {
 				require.NoError(b, itr.Error())
 			}
 		})
+
+		b.Run(fmt.Sprintf("backend_%s_reverse", ty), func(b *testing.B) {
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+
+				itr, err := db.ReverseIterator(storeKey1, 1, keys[0], nil)
+				require.NoError(b, err)
+
+				b.StartTimer()
+
+				for ; itr.Valid(); itr.Next() {
+					_ = itr.Key()
+					_ = itr.Value()
+				}
+
+				require.NoError(b, itr.Error())
+			}
+		})
 	}
 }
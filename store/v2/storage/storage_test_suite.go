@@ -640,6 +640,126 @@ func (s *StorageTestSuite) TestDatabase_Prune_KeepRecent() {
 	s.Require().Equal([]byte("val200"), bz)
 }
 
+func (s *StorageTestSuite) TestDatabase_Prune_Tombstones() {
+	if slices.Contains(s.SkipTests, s.T().Name()) {
+		s.T().SkipNow()
+	}
+
+	db, err := s.NewDB(s.T().TempDir())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	key := []byte("key")
+
+	// write a key, delete it and write it again
+	s.Require().NoError(db.ApplyChangeset(1, corestore.NewChangesetWithPairs(
+		map[string]corestore.KVPairs{storeKey1: {{Key: key, Value: []byte("val001")}}},
+	)))
+	s.Require().NoError(db.ApplyChangeset(2, corestore.NewChangesetWithPairs(
+		map[string]corestore.KVPairs{storeKey1: {{Key: key, Remove: true}}},
+	)))
+	s.Require().NoError(db.ApplyChangeset(5, corestore.NewChangesetWithPairs(
+		map[string]corestore.KVPairs{storeKey1: {{Key: key, Value: []byte("val005")}}},
+	)))
+
+	// prune above the deletion, the key stays deleted until it is written again
+	s.Require().NoError(db.Prune(3))
+
+	bz, err := db.Get(storeKey1Bytes, 4, key)
+	s.Require().NoError(err)
+	s.Require().Nil(bz)
+
+	itr, err := db.Iterator(storeKey1Bytes, 4, nil, nil)
+	s.Require().NoError(err)
+	s.Require().False(itr.Valid())
+	s.Require().NoError(itr.Close())
+
+	bz, err = db.Get(storeKey1Bytes, 5, key)
+	s.Require().NoError(err)
+	s.Require().Equal([]byte("val005"), bz)
+}
+
+func (s *StorageTestSuite) TestDatabase_Prune_Restart() {
+	if slices.Contains(s.SkipTests, s.T().Name()) {
+		s.T().SkipNow()
+	}
+
+	dir := s.T().TempDir()
+	db, err := s.NewDB(dir)
+	s.Require().NoError(err)
+
+	for v := uint64(1); v <= 10; v++ {
+		s.Require().NoError(db.ApplyChangeset(v, corestore.NewChangesetWithPairs(
+			map[string]corestore.KVPairs{storeKey1: {{Key: []byte("key"), Value: []byte(fmt.Sprintf("val%03d", v))}}},
+		)))
+	}
+	s.Require().NoError(db.Prune(5))
+	s.Require().NoError(db.Close())
+
+	// the pruned versions stay pruned once the database is reopened
+	db, err = s.NewDB(dir)
+	s.Require().NoError(err)
+	defer db.Close()
+
+	bz, err := db.Get(storeKey1Bytes, 5, []byte("key"))
+	s.Require().Error(err)
+	s.Require().Nil(bz)
+
+	bz, err = db.Get(storeKey1Bytes, 6, []byte("key"))
+	s.Require().NoError(err)
+	s.Require().Equal([]byte("val006"), bz)
+}
+
+func (s *StorageTestSuite) TestDatabase_Restore() {
+	if slices.Contains(s.SkipTests, s.T().Name()) {
+		s.T().SkipNow()
+	}
+
+	db, err := s.NewDB(s.T().TempDir())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	// enough keys to span several batches
+	numKeys := 2 * defaultBatchBufferSize / 16
+	chStorage := make(chan *corestore.StateChanges, 1)
+	go func() {
+		defer close(chStorage)
+		pairs := make(corestore.KVPairs, 0, numKeys)
+		for i := 0; i < numKeys; i++ {
+			pairs = append(pairs, corestore.KVPair{Key: []byte(fmt.Sprintf("key%06d", i)), Value: []byte(fmt.Sprintf("val%06d", i))})
+		}
+		chStorage <- &corestore.StateChanges{Actor: storeKey1Bytes, StateChanges: pairs}
+	}()
+	s.Require().NoError(db.Restore(10, chStorage))
+
+	latestVersion, err := db.GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(10), latestVersion)
+
+	itr, err := db.Iterator(storeKey1Bytes, 10, nil, nil)
+	s.Require().NoError(err)
+	defer itr.Close()
+
+	count := 0
+	for ; itr.Valid(); itr.Next() {
+		s.Require().Equal([]byte(fmt.Sprintf("key%06d", count)), itr.Key())
+		s.Require().Equal([]byte(fmt.Sprintf("val%06d", count)), itr.Value())
+		count++
+	}
+	s.Require().NoError(itr.Error())
+	s.Require().Equal(numKeys, count)
+
+	// the restored state is not visible below the snapshot version
+	bz, err := db.Get(storeKey1Bytes, 9, []byte("key000000"))
+	s.Require().NoError(err)
+	s.Require().Nil(bz)
+
+	// restoring at or below the latest version fails
+	chStorage = make(chan *corestore.StateChanges)
+	close(chStorage)
+	s.Require().Error(db.Restore(10, chStorage))
+}
+
 func DBApplyChangeset(
 	t *testing.T,
 	db store.VersionedDatabase,
@@ -77,6 +77,8 @@ func initFixture(t *testing.T) *fixture {
 	router := baseapp.NewMsgServiceRouter()
 	queryRouter := baseapp.NewGRPCQueryRouter()
 
+	authority := authtypes.NewModuleAddress("gov")
+
 	handler := directHandler{}
 	account := baseaccount.NewAccount("base", signing.NewHandlerMap(handler))
 	accountsKeeper, err := accounts.NewKeeper(
@@ -84,12 +86,11 @@ func initFixture(t *testing.T) *fixture {
 		runtime.NewEnvironment(runtime.NewKVStoreService(keys[accounts.StoreKey]), log.NewNopLogger(), runtime.EnvWithQueryRouterService(queryRouter), runtime.EnvWithMsgRouterService(router)),
 		addresscodec.NewBech32Codec("cosmos"),
 		cdc.InterfaceRegistry(),
+		authority.String(),
 		account,
 	)
 	assert.NilError(t, err)
 
-	authority := authtypes.NewModuleAddress("gov")
-
 	authKeeper := authkeeper.NewAccountKeeper(
 		runtime.NewEnvironment(runtime.NewKVStoreService(keys[authtypes.StoreKey]), log.NewNopLogger()),
 		cdc,
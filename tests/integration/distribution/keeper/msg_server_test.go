@@ -89,6 +89,7 @@ func initFixture(t *testing.T) *fixture {
 		pooltypes.ModuleName:               {},
 		pooltypes.StreamAccount:            {},
 		pooltypes.ProtocolPoolDistrAccount: {},
+		pooltypes.PaymentStreamAccount:     {},
 		distrtypes.ModuleName:              {authtypes.Minter},
 		stakingtypes.BondedPoolName:        {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName:     {authtypes.Burner, authtypes.Staking},
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"gotest.tools/v3/assert"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
@@ -102,6 +103,18 @@ func TestImportExportQueues(t *testing.T) {
 	assert.Assert(t, proposal1.Status == v1.StatusDepositPeriod)
 	assert.Assert(t, proposal2.Status == v1.StatusVotingPeriod)
 
+	err = s1.GovKeeper.SetTallyStrategyParams(ctx, keeper.TallyStrategyQuadratic, `{"threshold":"0.6"}`)
+	assert.NilError(t, err)
+
+	voter, err := s1.AccountKeeper.AddressCodec().BytesToString(addrs[0])
+	assert.NilError(t, err)
+	ballot := v1.Ballot{ProposalId: proposalID1, Voter: voter, Options: []v1.VoteOption{v1.OptionTwo, v1.OptionOne}}
+	err = s1.GovKeeper.Ballots.Set(ctx, collections.Join(proposalID1, addrs[0]), ballot)
+	assert.NilError(t, err)
+	ballotTally := v1.BallotTallyResult{VotingMode: v1.MultipleChoiceVotingMode_MULTIPLE_CHOICE_VOTING_MODE_APPROVAL, Winner: v1.OptionTwo}
+	err = s1.GovKeeper.BallotTallyResults.Set(ctx, proposalID1, ballotTally)
+	assert.NilError(t, err)
+
 	authGenState, err := s1.AccountKeeper.ExportGenesis(ctx)
 	require.NoError(t, err)
 	bankGenState, err := s1.BankKeeper.ExportGenesis(ctx)
@@ -173,6 +186,17 @@ func TestImportExportQueues(t *testing.T) {
 	assert.Assert(t, proposal1.Status == v1.StatusDepositPeriod)
 	assert.Assert(t, proposal2.Status == v1.StatusVotingPeriod)
 
+	tallyStrategyParams, err := s2.GovKeeper.TallyStrategyParams.Get(ctx2, keeper.TallyStrategyQuadratic)
+	assert.NilError(t, err)
+	assert.Equal(t, `{"threshold":"0.6"}`, tallyStrategyParams)
+
+	importedBallot, err := s2.GovKeeper.Ballots.Get(ctx2, collections.Join(proposalID1, addrs[0]))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, ballot, importedBallot)
+	importedBallotTally, err := s2.GovKeeper.BallotTallyResults.Get(ctx2, proposalID1)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, ballotTally, importedBallotTally)
+
 	macc := s2.GovKeeper.GetGovernanceAccount(ctx2)
 	assert.DeepEqual(t, sdk.Coins(params.MinDeposit), s2.BankKeeper.GetAllBalances(ctx2, macc.GetAddress()))
 
@@ -70,6 +70,7 @@ func initFixture(tb testing.TB) *fixture {
 		pooltypes.ModuleName:               {},
 		pooltypes.StreamAccount:            {},
 		pooltypes.ProtocolPoolDistrAccount: {},
+		pooltypes.PaymentStreamAccount:     {},
 		minttypes.ModuleName:               {authtypes.Minter},
 		stakingtypes.BondedPoolName:        {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName:     {authtypes.Burner, authtypes.Staking},
@@ -87,6 +87,7 @@ func defaultConfig() *Config {
 			"vesting",
 			testutil.CircuitModuleName,
 			testutil.ProtocolPoolModuleName,
+			testutil.AccountsModuleName,
 		},
 		InitGenesisOrder: []string{
 			testutil.AccountsModuleName,
@@ -166,6 +167,7 @@ func AuthModule() ModuleOption {
 					{Account: testutil.ProtocolPoolModuleName},
 					{Account: "stream_acc"},
 					{Account: "protocolpool_distr"},
+					{Account: "payment_stream_acc"},
 				},
 			}),
 		}
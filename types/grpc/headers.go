@@ -3,4 +3,9 @@ package grpc
 const (
 	// GRPCBlockHeightHeader is the gRPC header for block height.
 	GRPCBlockHeightHeader = "x-cosmos-block-height"
+	// GRPCQueryProveHeader is the gRPC header set by the clients of a query requesting the proofs of its response.
+	GRPCQueryProveHeader = "x-cosmos-query-prove"
+	// GRPCQueryProofHeader is the binary gRPC header in which a query returns the proofs of its response,
+	// each value is a protobuf encoded cometbft ProofOps proving the values read from one store up to the app hash.
+	GRPCQueryProofHeader = "x-cosmos-query-proof-bin"
 )
@@ -2,6 +2,7 @@ package accounts
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"github.com/cosmos/gogoproto/types"
@@ -11,6 +12,8 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/x/accounts/accountstd"
 	"cosmossdk.io/x/accounts/internal/implementation"
+
+	addresstypes "github.com/cosmos/cosmos-sdk/types/address"
 )
 
 var _ implementation.Account = (*TestAccount)(nil)
@@ -115,3 +118,56 @@ func (t TestAccount) RegisterQueryHandlers(builder *implementation.QueryBuilder)
 		return &types.UInt64Value{Value: v}, nil
 	})
 }
+
+var _ implementation.MigratableAccount = (*TestAccountV2)(nil)
+
+func NewTestAccountV2(d accountstd.Dependencies) (*TestAccountV2, error) {
+	return &TestAccountV2{
+		Counter: collections.NewSequence(d.SchemaBuilder, collections.NewPrefix(0), "counter"),
+		Offset:  collections.NewItem(d.SchemaBuilder, collections.NewPrefix(1), "offset", collections.Uint64Value),
+	}, nil
+}
+
+// TestAccountV2 is a newer version of TestAccount which accounts of type "test" can be migrated to.
+type TestAccountV2 struct {
+	Counter collections.Sequence
+	Offset  collections.Item[uint64]
+}
+
+func (t TestAccountV2) RegisterInitHandler(builder *implementation.InitBuilder) {
+	implementation.RegisterInitHandler(builder, func(_ context.Context, _ *types.Empty) (*types.Empty, error) {
+		return &types.Empty{}, nil
+	})
+}
+
+func (t TestAccountV2) RegisterExecuteHandlers(builder *implementation.ExecuteBuilder) {
+	implementation.RegisterExecuteHandler(builder, func(_ context.Context, _ *types.Empty) (*types.Empty, error) {
+		return &types.Empty{}, nil
+	})
+}
+
+func (t TestAccountV2) RegisterQueryHandlers(builder *implementation.QueryBuilder) {
+	// genesis testing; returns the counter of the account, plus the offset set during migration.
+	implementation.RegisterQueryHandler(builder, func(ctx context.Context, _ *types.DoubleValue) (*types.UInt64Value, error) {
+		v, err := t.Counter.Peek(ctx)
+		if err != nil {
+			return nil, err
+		}
+		offset, err := t.Offset.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &types.UInt64Value{Value: v + offset}, nil
+	})
+}
+
+func (t TestAccountV2) RegisterMigrationHandlers(builder *implementation.MigrationBuilder) {
+	// the counter is kept as is, the offset is set from the migration request.
+	implementation.RegisterMigrationHandler(builder, "test", func(ctx context.Context, req *types.UInt64Value) (*types.Empty, error) {
+		sender := string(implementation.Sender(ctx))
+		if sender != "sender" && sender != string(addresstypes.Module("accounts")) {
+			return nil, fmt.Errorf("unauthorized sender %s", sender)
+		}
+		return &types.Empty{}, t.Offset.Set(ctx, req.Value)
+	})
+}
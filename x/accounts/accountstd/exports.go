@@ -26,6 +26,13 @@ type QueryBuilder = implementation.QueryBuilder
 // InitBuilder is the exported type of InitBuilder.
 type InitBuilder = implementation.InitBuilder
 
+// MigrationBuilder is the exported type of MigrationBuilder.
+type MigrationBuilder = implementation.MigrationBuilder
+
+// MigratableInterface is the exported interface of an Account which accounts of other
+// account types can be migrated to.
+type MigratableInterface = implementation.MigratableAccount
+
 // AccountCreatorFunc is the exported type of AccountCreatorFunc.
 type AccountCreatorFunc = implementation.AccountCreatorFunc
 
@@ -55,6 +62,15 @@ func RegisterInitHandler[
 	implementation.RegisterInitHandler(router, handler)
 }
 
+// RegisterMigrationHandler registers a handler migrating accounts of the given account type
+// to a smart account that uses protobuf.
+func RegisterMigrationHandler[
+	Req any, ProtoReq implementation.ProtoMsgG[Req], Resp any, ProtoResp implementation.ProtoMsgG[Resp],
+](router *MigrationBuilder, fromAccountType string, handler func(ctx context.Context, req ProtoReq) (ProtoResp, error),
+) {
+	implementation.RegisterMigrationHandler(router, fromAccountType, handler)
+}
+
 // AddAccount is a helper function to add a smart account to the list of smart accounts.
 func AddAccount[A Interface](name string, constructor func(deps Dependencies) (A, error)) AccountCreatorFunc {
 	return func(deps implementation.Dependencies) (string, implementation.Account, error) {
@@ -24,7 +24,7 @@ func TxCmd(name string) *cobra.Command {
 		RunE:               client.ValidateCmd,
 		DisableFlagParsing: true,
 	}
-	cmd.AddCommand(GetTxInitCmd(), GetExecuteCmd())
+	cmd.AddCommand(GetTxInitCmd(), GetExecuteCmd(), GetMigrateCmd())
 	return cmd
 }
 
@@ -35,7 +35,7 @@ func QueryCmd(name string) *cobra.Command {
 		RunE:               client.ValidateCmd,
 		DisableFlagParsing: true,
 	}
-	cmd.AddCommand(GetQueryAccountCmd())
+	cmd.AddCommand(GetQueryAccountCmd(), GetQueryAccountImplementationCmd())
 	return cmd
 }
 
@@ -130,6 +130,59 @@ func GetExecuteCmd() *cobra.Command {
 	return cmd
 }
 
+func GetMigrateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate [account-address] [account-type] [json-message]",
+		Short: "Migrate an account to another account type",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			// the migration message depends on the account type the account
+			// is migrated from.
+			queryClient := v1.NewQueryClient(clientCtx)
+			accType, err := queryClient.AccountType(cmd.Context(), &v1.AccountTypeRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			schema, err := queryClient.Schema(cmd.Context(), &v1.SchemaRequest{
+				AccountType: args[1],
+			})
+			if err != nil {
+				return err
+			}
+			msgSchema, ok := schema.MigrationHandlers[accType.AccountType]
+			if !ok {
+				return fmt.Errorf("account type %s cannot migrate accounts from %s", args[1], accType.AccountType)
+			}
+
+			msgBytes, err := encodeJSONToProto(msgSchema.Request, args[2])
+			if err != nil {
+				return err
+			}
+			msg := v1.MsgMigrateAccount{
+				Sender:      sender,
+				Target:      args[0],
+				AccountType: args[1],
+				Message:     msgBytes,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetQueryAccountCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "query [account-address] [query-request-type-url] [json-message]",
@@ -164,6 +217,31 @@ func GetQueryAccountCmd() *cobra.Command {
 	return cmd
 }
 
+func GetQueryAccountImplementationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "implementation [account-address]",
+		Short: "Query the account type of an account and the history of its migrations",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := v1.NewQueryClient(clientCtx)
+			res, err := queryClient.AccountImplementation(cmd.Context(), &v1.AccountImplementationRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func getSchemaForAccount(clientCtx client.Context, addr string) (*v1.SchemaResponse, error) {
 	queryClient := v1.NewQueryClient(clientCtx)
 	accType, err := queryClient.AccountType(clientCtx.CmdContext, &v1.AccountTypeRequest{
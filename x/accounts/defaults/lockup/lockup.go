@@ -72,6 +72,9 @@ type BaseLockup struct {
 	headerService    header.Service
 	// lockup end time.
 	EndTime collections.Item[time.Time]
+	// previousAccountTypes are the account types of the previous versions of the account
+	// type, whose accounts can be migrated to it, see WithPreviousVersions.
+	previousAccountTypes []string
 }
 
 func (bva *BaseLockup) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupAccount) (
@@ -0,0 +1,69 @@
+package lockup
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
+)
+
+// Compile-time type assertions
+var (
+	_ accountstd.MigratableInterface = (*ContinuousLockingAccount)(nil)
+	_ accountstd.MigratableInterface = (*DelayedLockingAccount)(nil)
+	_ accountstd.MigratableInterface = (*PeriodicLockingAccount)(nil)
+	_ accountstd.MigratableInterface = (*PermanentLockingAccount)(nil)
+)
+
+// lockupAccount is implemented by the lockup account types, which embed a BaseLockup.
+type lockupAccount interface {
+	accountstd.Interface
+	baseLockup() *BaseLockup
+}
+
+// WithPreviousVersions returns the constructor of a new version of a lockup account type, which
+// accepts the migration of the accounts of its previous versions, registered under the given
+// account types. The migration keeps the state of the account, so the previous versions must share
+// the state layout of the new one. For example, a fixed periodic locking account is registered with:
+//
+//	accountstd.AddAccount("periodic-locking-account-v2", lockup.WithPreviousVersions(lockup.NewPeriodicLockingAccount, lockup.PERIODIC_LOCKING_ACCOUNT))
+func WithPreviousVersions[A lockupAccount](
+	constructor func(d accountstd.Dependencies) (A, error),
+	previousAccountTypes ...string,
+) func(d accountstd.Dependencies) (A, error) {
+	return func(d accountstd.Dependencies) (A, error) {
+		acc, err := constructor(d)
+		if err != nil {
+			return acc, err
+		}
+		acc.baseLockup().previousAccountTypes = previousAccountTypes
+		return acc, nil
+	}
+}
+
+func (bva *BaseLockup) baseLockup() *BaseLockup { return bva }
+
+// MigrateFromPreviousVersion migrates a lockup account from a previous version of its account
+// type. The state of the account is kept as is. Only the owner of the account, or governance
+// through the accounts module, can migrate it.
+func (bva *BaseLockup) MigrateFromPreviousVersion(ctx context.Context, _ *lockuptypes.MsgMigrateLockupAccount) (
+	*lockuptypes.MsgMigrateLockupAccountResponse, error,
+) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		owner, err := bva.Owner.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !accountstd.HasSender(ctx, owner) {
+			return nil, errors.New("sender is not the owner of this lockup account")
+		}
+	}
+	return &lockuptypes.MsgMigrateLockupAccountResponse{}, nil
+}
+
+func (bva BaseLockup) RegisterMigrationHandlers(builder *accountstd.MigrationBuilder) {
+	for _, accountType := range bva.previousAccountTypes {
+		accountstd.RegisterMigrationHandler(builder, accountType, bva.MigrateFromPreviousVersion)
+	}
+}
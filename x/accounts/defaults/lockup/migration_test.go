@@ -0,0 +1,57 @@
+package lockup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+func TestWithPreviousVersions(t *testing.T) {
+	_, ss := newMockContext(t)
+
+	acc, err := NewPeriodicLockingAccount(makeMockDependencies(ss))
+	require.NoError(t, err)
+	require.Empty(t, acc.previousAccountTypes)
+
+	acc, err = WithPreviousVersions(NewPeriodicLockingAccount, PERIODIC_LOCKING_ACCOUNT)(makeMockDependencies(ss))
+	require.NoError(t, err)
+	require.Equal(t, []string{PERIODIC_LOCKING_ACCOUNT}, acc.previousAccountTypes)
+}
+
+func TestMigrateFromPreviousVersion(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Now(),
+	})
+	acc := setupDelayedAccount(t, sdkCtx, ss)
+
+	// the account state is shared by the versions of the account type
+	newAcc, err := WithPreviousVersions(NewDelayedLockingAccount, DELAYED_LOCKING_ACCOUNT)(makeMockDependencies(ss))
+	require.NoError(t, err)
+
+	_, err = newAcc.MigrateFromPreviousVersion(accountstd.SetSender(sdkCtx, []byte("someone")), &lockuptypes.MsgMigrateLockupAccount{})
+	require.ErrorContains(t, err, "sender is not the owner")
+
+	_, err = newAcc.MigrateFromPreviousVersion(accountstd.SetSender(sdkCtx, []byte("owner")), &lockuptypes.MsgMigrateLockupAccount{})
+	require.NoError(t, err)
+
+	// governance migrates accounts through the accounts module
+	_, err = newAcc.MigrateFromPreviousVersion(accountstd.SetSender(sdkCtx, address.Module("accounts")), &lockuptypes.MsgMigrateLockupAccount{})
+	require.NoError(t, err)
+
+	info, err := newAcc.QueryVestingAccountInfo(sdkCtx, &lockuptypes.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	oldInfo, err := acc.QueryVestingAccountInfo(sdkCtx, &lockuptypes.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, oldInfo, info)
+	require.Equal(t, "owner", info.Owner)
+}
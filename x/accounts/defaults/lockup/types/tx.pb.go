@@ -237,6 +237,81 @@ func (m *MsgInitPeriodicLockingAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgInitPeriodicLockingAccountResponse proto.InternalMessageInfo
 
+// MsgMigrateLockupAccount defines a message that enables the owner of a lockup account
+// to migrate it from a previous version of its account type, keeping its state.
+type MsgMigrateLockupAccount struct {
+}
+
+func (m *MsgMigrateLockupAccount) Reset()         { *m = MsgMigrateLockupAccount{} }
+func (m *MsgMigrateLockupAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateLockupAccount) ProtoMessage()    {}
+func (*MsgMigrateLockupAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5f39108a4d67f92, []int{4}
+}
+func (m *MsgMigrateLockupAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateLockupAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateLockupAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateLockupAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateLockupAccount.Merge(m, src)
+}
+func (m *MsgMigrateLockupAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateLockupAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateLockupAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateLockupAccount proto.InternalMessageInfo
+
+// MsgMigrateLockupAccountResponse defines the response for MsgMigrateLockupAccount.
+type MsgMigrateLockupAccountResponse struct {
+}
+
+func (m *MsgMigrateLockupAccountResponse) Reset()         { *m = MsgMigrateLockupAccountResponse{} }
+func (m *MsgMigrateLockupAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateLockupAccountResponse) ProtoMessage()    {}
+func (*MsgMigrateLockupAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5f39108a4d67f92, []int{5}
+}
+func (m *MsgMigrateLockupAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateLockupAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateLockupAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateLockupAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateLockupAccountResponse.Merge(m, src)
+}
+func (m *MsgMigrateLockupAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateLockupAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateLockupAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateLockupAccountResponse proto.InternalMessageInfo
+
 // MsgDelegate defines a message that enable lockup account to execute delegate message
 type MsgDelegate struct {
 	// sender is the owner of the lockup account
@@ -249,7 +324,7 @@ func (m *MsgDelegate) Reset()         { *m = MsgDelegate{} }
 func (m *MsgDelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegate) ProtoMessage()    {}
 func (*MsgDelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5f39108a4d67f92, []int{4}
+	return fileDescriptor_e5f39108a4d67f92, []int{6}
 }
 func (m *MsgDelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,7 +364,7 @@ func (m *MsgUndelegate) Reset()         { *m = MsgUndelegate{} }
 func (m *MsgUndelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgUndelegate) ProtoMessage()    {}
 func (*MsgUndelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5f39108a4d67f92, []int{5}
+	return fileDescriptor_e5f39108a4d67f92, []int{7}
 }
 func (m *MsgUndelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,7 +403,7 @@ func (m *MsgWithdrawReward) Reset()         { *m = MsgWithdrawReward{} }
 func (m *MsgWithdrawReward) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawReward) ProtoMessage()    {}
 func (*MsgWithdrawReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5f39108a4d67f92, []int{6}
+	return fileDescriptor_e5f39108a4d67f92, []int{8}
 }
 func (m *MsgWithdrawReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -368,7 +443,7 @@ func (m *MsgSend) Reset()         { *m = MsgSend{} }
 func (m *MsgSend) String() string { return proto.CompactTextString(m) }
 func (*MsgSend) ProtoMessage()    {}
 func (*MsgSend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5f39108a4d67f92, []int{7}
+	return fileDescriptor_e5f39108a4d67f92, []int{9}
 }
 func (m *MsgSend) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,7 +481,7 @@ func (m *MsgExecuteMessagesResponse) Reset()         { *m = MsgExecuteMessagesRe
 func (m *MsgExecuteMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteMessagesResponse) ProtoMessage()    {}
 func (*MsgExecuteMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5f39108a4d67f92, []int{8}
+	return fileDescriptor_e5f39108a4d67f92, []int{10}
 }
 func (m *MsgExecuteMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -454,7 +529,7 @@ func (m *MsgWithdraw) Reset()         { *m = MsgWithdraw{} }
 func (m *MsgWithdraw) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdraw) ProtoMessage()    {}
 func (*MsgWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5f39108a4d67f92, []int{9}
+	return fileDescriptor_e5f39108a4d67f92, []int{11}
 }
 func (m *MsgWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,7 +568,7 @@ func (m *MsgWithdrawResponse) Reset()         { *m = MsgWithdrawResponse{} }
 func (m *MsgWithdrawResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawResponse) ProtoMessage()    {}
 func (*MsgWithdrawResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5f39108a4d67f92, []int{10}
+	return fileDescriptor_e5f39108a4d67f92, []int{12}
 }
 func (m *MsgWithdrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -541,6 +616,8 @@ func init() {
 	proto.RegisterType((*MsgInitLockupAccountResponse)(nil), "cosmos.accounts.defaults.lockup.MsgInitLockupAccountResponse")
 	proto.RegisterType((*MsgInitPeriodicLockingAccount)(nil), "cosmos.accounts.defaults.lockup.MsgInitPeriodicLockingAccount")
 	proto.RegisterType((*MsgInitPeriodicLockingAccountResponse)(nil), "cosmos.accounts.defaults.lockup.MsgInitPeriodicLockingAccountResponse")
+	proto.RegisterType((*MsgMigrateLockupAccount)(nil), "cosmos.accounts.defaults.lockup.MsgMigrateLockupAccount")
+	proto.RegisterType((*MsgMigrateLockupAccountResponse)(nil), "cosmos.accounts.defaults.lockup.MsgMigrateLockupAccountResponse")
 	proto.RegisterType((*MsgDelegate)(nil), "cosmos.accounts.defaults.lockup.MsgDelegate")
 	proto.RegisterType((*MsgUndelegate)(nil), "cosmos.accounts.defaults.lockup.MsgUndelegate")
 	proto.RegisterType((*MsgWithdrawReward)(nil), "cosmos.accounts.defaults.lockup.MsgWithdrawReward")
@@ -555,58 +632,59 @@ func init() {
 }
 
 var fileDescriptor_e5f39108a4d67f92 = []byte{
-	// 816 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcf, 0x4f, 0x1b, 0x47,
-	0x14, 0xf6, 0xda, 0xaa, 0xc1, 0x43, 0x81, 0xb2, 0x58, 0xc5, 0x58, 0x65, 0x97, 0x5a, 0x42, 0x58,
-	0x56, 0xbd, 0x5b, 0x68, 0xa5, 0x56, 0x56, 0x2f, 0xb8, 0xf4, 0x97, 0x54, 0x57, 0xc8, 0xf4, 0x87,
-	0xd4, 0x1c, 0xac, 0xf1, 0xee, 0x30, 0xac, 0xf0, 0xce, 0x58, 0x3b, 0x63, 0x1b, 0xdf, 0xa2, 0x28,
-	0x87, 0x88, 0x13, 0xe7, 0x9c, 0x38, 0x45, 0x11, 0x27, 0x47, 0xe2, 0x8f, 0xe0, 0x88, 0x38, 0x71,
-	0x0a, 0x91, 0x89, 0x64, 0xfe, 0x8c, 0x68, 0x77, 0x66, 0x89, 0x0d, 0x04, 0x1c, 0x1f, 0x12, 0x29,
-	0x17, 0xef, 0xcc, 0xbc, 0xef, 0xbd, 0xf7, 0xbd, 0x6f, 0xde, 0xdb, 0x35, 0xc8, 0x5a, 0x94, 0xb9,
-	0x94, 0x99, 0xd0, 0xb2, 0x68, 0x83, 0x70, 0x66, 0xda, 0x68, 0x0b, 0x36, 0x6a, 0x9c, 0x99, 0x35,
-	0x6a, 0xed, 0x34, 0xea, 0x26, 0xdf, 0x35, 0xea, 0x1e, 0xe5, 0x54, 0xd5, 0x05, 0xd2, 0x08, 0x91,
-	0x46, 0x88, 0x34, 0x04, 0x32, 0x3d, 0x03, 0x5d, 0x87, 0x50, 0x33, 0xf8, 0x15, 0x3e, 0x69, 0x4d,
-	0x46, 0xaf, 0x42, 0x86, 0xcc, 0xe6, 0x4a, 0x15, 0x71, 0xb8, 0x62, 0x5a, 0xd4, 0x21, 0xd2, 0xfe,
-	0xcd, 0x7d, 0xd9, 0xc5, 0x43, 0xa2, 0xe7, 0x24, 0xda, 0x65, 0xd8, 0x6c, 0xae, 0xf8, 0x0f, 0x69,
-	0x98, 0x17, 0x86, 0x4a, 0xb0, 0x33, 0x25, 0x4f, 0x61, 0x4a, 0x62, 0x8a, 0xa9, 0x38, 0xf7, 0x57,
-	0xa1, 0x03, 0xa6, 0x14, 0xd7, 0x90, 0x19, 0xec, 0xaa, 0x8d, 0x2d, 0x13, 0x92, 0xb6, 0x34, 0xe9,
-	0xd7, 0x4d, 0xdc, 0x71, 0x11, 0xe3, 0xd0, 0x95, 0x2c, 0x32, 0x0f, 0xa3, 0x20, 0x59, 0x62, 0xf8,
-	0x0f, 0xe2, 0xf0, 0x3f, 0x03, 0x76, 0x6b, 0x82, 0xbc, 0x6a, 0x80, 0xcf, 0x68, 0x8b, 0x20, 0x2f,
-	0xa5, 0x2c, 0x2a, 0xd9, 0x44, 0x31, 0x75, 0x7a, 0x94, 0x4f, 0x4a, 0x2e, 0x6b, 0xb6, 0xed, 0x21,
-	0xc6, 0x36, 0xb9, 0xe7, 0x10, 0x5c, 0x16, 0x30, 0x75, 0x1d, 0x8c, 0x23, 0x62, 0x57, 0xfc, 0xf8,
-	0xa9, 0xe8, 0xa2, 0x92, 0x9d, 0x58, 0x4d, 0x1b, 0x22, 0xb9, 0x11, 0x26, 0x37, 0xfe, 0x0e, 0x93,
-	0x17, 0x27, 0x8f, 0x5f, 0xea, 0x91, 0xfd, 0x73, 0x5d, 0x79, 0xde, 0xeb, 0xe4, 0x94, 0xf2, 0x18,
-	0x22, 0xb6, 0x6f, 0x54, 0x7f, 0x07, 0x80, 0x71, 0xe8, 0x71, 0x11, 0x27, 0xf6, 0xbe, 0x71, 0x12,
-	0x81, 0xb3, 0x6f, 0x2e, 0x64, 0x2f, 0x0f, 0x74, 0x65, 0xaf, 0xd7, 0xc9, 0xc9, 0x9b, 0xce, 0x33,
-	0x7b, 0xc7, 0xbc, 0xad, 0xd2, 0x8c, 0x06, 0xbe, 0xba, 0xed, 0xbc, 0x8c, 0x58, 0x9d, 0x12, 0x86,
-	0x32, 0xcf, 0xa2, 0x60, 0x41, 0x02, 0x36, 0x90, 0xe7, 0x50, 0xdb, 0xb1, 0x7c, 0xa0, 0x43, 0xf0,
-	0xa8, 0x5a, 0x0d, 0x56, 0x19, 0x1d, 0xbd, 0x4a, 0xf5, 0x01, 0x98, 0xae, 0x09, 0x2e, 0x95, 0x7a,
-	0xc0, 0x8d, 0xa5, 0x62, 0x8b, 0xb1, 0xec, 0xc4, 0xea, 0xb2, 0x71, 0x4f, 0x83, 0x1b, 0xa2, 0x96,
-	0x62, 0xc2, 0x8f, 0x2d, 0xe2, 0x4e, 0xc9, 0x50, 0xc2, 0xc2, 0x0a, 0xc6, 0xe5, 0x81, 0x1e, 0xf1,
-	0x25, 0x5c, 0xba, 0x29, 0xa1, 0xc0, 0x0c, 0x0a, 0xb9, 0x0c, 0x96, 0xee, 0xd4, 0xe9, 0x4a, 0xd1,
-	0xae, 0x02, 0x26, 0x4a, 0x0c, 0xaf, 0xa3, 0x1a, 0xc2, 0x90, 0x23, 0xf5, 0x5b, 0x10, 0x67, 0x88,
-	0xd8, 0x43, 0x08, 0x28, 0x71, 0xea, 0x5f, 0x60, 0xa6, 0x09, 0x6b, 0x8e, 0x0d, 0x39, 0xf5, 0x2a,
-	0x50, 0x40, 0x02, 0x21, 0x13, 0xc5, 0xaf, 0x4f, 0x8f, 0xf2, 0x0b, 0xd2, 0xf9, 0xdf, 0x10, 0x33,
-	0x18, 0xe5, 0x8b, 0xe6, 0xb5, 0x73, 0xf5, 0x27, 0x10, 0x87, 0xae, 0xcf, 0x51, 0xf6, 0xdc, 0x7c,
-	0x28, 0x9f, 0x3f, 0xeb, 0x86, 0x9c, 0x75, 0xe3, 0x67, 0xea, 0x90, 0x7e, 0xc1, 0xa4, 0x4f, 0x61,
-	0xf6, 0xc9, 0x81, 0x1e, 0xf1, 0xc5, 0x7a, 0xd4, 0xeb, 0xe4, 0x24, 0xc5, 0xcc, 0x6b, 0x05, 0x4c,
-	0x96, 0x18, 0xfe, 0x87, 0xd8, 0x9f, 0x74, 0x99, 0x87, 0x0a, 0x98, 0x29, 0x31, 0xfc, 0x9f, 0xc3,
-	0xb7, 0x6d, 0x0f, 0xb6, 0xca, 0xa8, 0x05, 0x3d, 0xfb, 0xe3, 0x97, 0x7a, 0x3b, 0xd9, 0xc7, 0x51,
-	0x30, 0x56, 0x62, 0x78, 0x13, 0x91, 0x51, 0x28, 0xfe, 0x00, 0x00, 0xa7, 0xd7, 0xb8, 0xbd, 0xdb,
-	0x2b, 0xc1, 0x69, 0x28, 0x7b, 0xbb, 0x4f, 0xf6, 0xd8, 0xdd, 0xb2, 0xff, 0xea, 0xcb, 0x7e, 0x78,
-	0xae, 0x67, 0xb1, 0xc3, 0xb7, 0x1b, 0x55, 0xc3, 0xa2, 0xae, 0xfc, 0x04, 0x98, 0x7d, 0x43, 0xc8,
-	0xdb, 0x75, 0xc4, 0x02, 0x07, 0xf6, 0xb4, 0xd7, 0xc9, 0x7d, 0xee, 0x37, 0x98, 0xd5, 0xae, 0xf8,
-	0xdf, 0x22, 0x36, 0xc4, 0x9d, 0x6d, 0x80, 0x74, 0x89, 0xe1, 0x5f, 0x76, 0x91, 0xd5, 0xe0, 0xa8,
-	0x84, 0x18, 0x83, 0x18, 0xb1, 0x70, 0x3a, 0xd5, 0x55, 0x90, 0xf0, 0xe4, 0x9a, 0xa5, 0x94, 0x80,
-	0x70, 0xf2, 0xc6, 0xcb, 0x69, 0x8d, 0xb4, 0xcb, 0x6f, 0x61, 0x99, 0x17, 0x62, 0xa2, 0xc3, 0x2e,
-	0x50, 0x7f, 0x04, 0xa0, 0x25, 0xd7, 0x43, 0x08, 0xdc, 0x87, 0x1d, 0x5d, 0xe4, 0x2f, 0x41, 0xdc,
-	0x46, 0x84, 0xba, 0xe2, 0x0d, 0x98, 0x28, 0xcb, 0x5d, 0x61, 0xae, 0x5f, 0x81, 0xbe, 0x4c, 0x99,
-	0x33, 0x05, 0xcc, 0x0e, 0x74, 0xae, 0xac, 0xff, 0x7b, 0x30, 0xee, 0x21, 0x0b, 0x39, 0xcd, 0x21,
-	0x98, 0x5f, 0x21, 0xd5, 0x3d, 0x05, 0x4c, 0x0b, 0xcd, 0x2b, 0xf2, 0xcc, 0x4e, 0x45, 0x3f, 0xd4,
-	0x6d, 0x4f, 0x89, 0xcc, 0x65, 0x99, 0xb8, 0xf8, 0xdb, 0x71, 0x57, 0x53, 0x4e, 0xba, 0x9a, 0xf2,
-	0xaa, 0xab, 0x29, 0xfb, 0x17, 0x5a, 0xe4, 0xe4, 0x42, 0x8b, 0x9c, 0x5d, 0x68, 0x91, 0xff, 0xf3,
-	0x22, 0x2e, 0xb3, 0x77, 0x0c, 0x87, 0x9a, 0xbb, 0x77, 0xfc, 0x53, 0xf2, 0x93, 0x56, 0xe3, 0xc1,
-	0x85, 0x7f, 0xf7, 0x26, 0x00, 0x00, 0xff, 0xff, 0x4e, 0xb6, 0xac, 0x3d, 0x59, 0x09, 0x00, 0x00,
+	// 829 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4b, 0x4f, 0xeb, 0x46,
+	0x14, 0x8e, 0x13, 0x35, 0x90, 0xa1, 0x40, 0x31, 0x51, 0x09, 0x51, 0xb1, 0x21, 0x12, 0x22, 0x8a,
+	0x1a, 0xbb, 0xd0, 0x4a, 0xad, 0xa2, 0x6e, 0x48, 0xe9, 0x4b, 0xaa, 0x2b, 0x14, 0xfa, 0x90, 0xda,
+	0x45, 0x34, 0xb1, 0x87, 0xc1, 0x22, 0x9e, 0x89, 0x3c, 0x93, 0x84, 0xec, 0xaa, 0xaa, 0x8b, 0x8a,
+	0x15, 0xeb, 0xae, 0x58, 0x55, 0x15, 0xab, 0x54, 0xe2, 0x47, 0xb0, 0x44, 0xac, 0x58, 0x95, 0x2a,
+	0x54, 0x0a, 0x3f, 0xa3, 0xb2, 0x67, 0xcc, 0x4d, 0x78, 0xe6, 0x66, 0x71, 0xaf, 0x74, 0x37, 0xb1,
+	0x67, 0xce, 0x77, 0xbe, 0xf3, 0x9d, 0x6f, 0x1e, 0x0e, 0xc8, 0xdb, 0x94, 0x79, 0x94, 0x99, 0xd0,
+	0xb6, 0x69, 0x93, 0x70, 0x66, 0x3a, 0x68, 0x17, 0x36, 0xeb, 0x9c, 0x99, 0x75, 0x6a, 0xef, 0x37,
+	0x1b, 0x26, 0x3f, 0x30, 0x1a, 0x3e, 0xe5, 0x54, 0xd5, 0x05, 0xd2, 0x88, 0x90, 0x46, 0x84, 0x34,
+	0x04, 0x32, 0x3b, 0x07, 0x3d, 0x97, 0x50, 0x33, 0xfc, 0x15, 0x39, 0x59, 0x4d, 0xb2, 0xd7, 0x20,
+	0x43, 0x66, 0x6b, 0xbd, 0x86, 0x38, 0x5c, 0x37, 0x6d, 0xea, 0x12, 0x19, 0x7f, 0xff, 0xb9, 0xea,
+	0xe2, 0x21, 0xd1, 0x0b, 0x12, 0xed, 0x31, 0x6c, 0xb6, 0xd6, 0x83, 0x87, 0x0c, 0x2c, 0x8a, 0x40,
+	0x35, 0x1c, 0x99, 0x52, 0xa7, 0x08, 0xa5, 0x31, 0xc5, 0x54, 0xcc, 0x07, 0x6f, 0x51, 0x02, 0xa6,
+	0x14, 0xd7, 0x91, 0x19, 0x8e, 0x6a, 0xcd, 0x5d, 0x13, 0x92, 0x8e, 0x0c, 0xe9, 0x77, 0x43, 0xdc,
+	0xf5, 0x10, 0xe3, 0xd0, 0x93, 0x2a, 0x72, 0xbf, 0xc4, 0x41, 0xda, 0x62, 0xf8, 0x6b, 0xe2, 0xf2,
+	0x6f, 0x42, 0x75, 0x9b, 0x42, 0xbc, 0x6a, 0x80, 0xb7, 0x68, 0x9b, 0x20, 0x3f, 0xa3, 0x2c, 0x2b,
+	0xf9, 0x54, 0x39, 0x73, 0x71, 0x5a, 0x4c, 0x4b, 0x2d, 0x9b, 0x8e, 0xe3, 0x23, 0xc6, 0x76, 0xb8,
+	0xef, 0x12, 0x5c, 0x11, 0x30, 0x75, 0x0b, 0x4c, 0x22, 0xe2, 0x54, 0x03, 0xfe, 0x4c, 0x7c, 0x59,
+	0xc9, 0x4f, 0x6d, 0x64, 0x0d, 0x51, 0xdc, 0x88, 0x8a, 0x1b, 0xdf, 0x45, 0xc5, 0xcb, 0xd3, 0x67,
+	0xff, 0xe8, 0xb1, 0xa3, 0x2b, 0x5d, 0xf9, 0xab, 0xdf, 0x2d, 0x28, 0x95, 0x09, 0x44, 0x9c, 0x20,
+	0xa8, 0x7e, 0x05, 0x00, 0xe3, 0xd0, 0xe7, 0x82, 0x27, 0xf1, 0xb2, 0x3c, 0xa9, 0x30, 0x39, 0x08,
+	0x97, 0xf2, 0x37, 0xc7, 0xba, 0x72, 0xd8, 0xef, 0x16, 0xe4, 0x4a, 0x17, 0x99, 0xb3, 0x6f, 0x3e,
+	0xd4, 0x69, 0x4e, 0x03, 0xef, 0x3d, 0x34, 0x5f, 0x41, 0xac, 0x41, 0x09, 0x43, 0xb9, 0x3f, 0xe3,
+	0x60, 0x49, 0x02, 0xb6, 0x91, 0xef, 0x52, 0xc7, 0xb5, 0x03, 0xa0, 0x4b, 0xf0, 0xb8, 0x5e, 0x0d,
+	0x77, 0x19, 0x1f, 0xbf, 0x4b, 0xf5, 0x67, 0x30, 0x5b, 0x17, 0x5a, 0xaa, 0x8d, 0x50, 0x1b, 0xcb,
+	0x24, 0x96, 0x13, 0xf9, 0xa9, 0x8d, 0x35, 0xe3, 0x99, 0x0d, 0x6e, 0x88, 0x5e, 0xca, 0xa9, 0x80,
+	0x5b, 0xf0, 0xce, 0x48, 0x2a, 0x11, 0x61, 0x25, 0xe3, 0xe6, 0x58, 0x8f, 0x05, 0x16, 0xae, 0xde,
+	0xb7, 0x50, 0x60, 0x86, 0x8d, 0x5c, 0x03, 0xab, 0x4f, 0xfa, 0x74, 0xeb, 0xe8, 0x22, 0x58, 0xb0,
+	0x18, 0xb6, 0x5c, 0xec, 0x43, 0x8e, 0x86, 0x39, 0x56, 0x80, 0xfe, 0x48, 0xe8, 0x36, 0xbb, 0xa7,
+	0x80, 0x29, 0x8b, 0xe1, 0x2d, 0x54, 0x47, 0x18, 0x72, 0xa4, 0x7e, 0x00, 0x92, 0x0c, 0x11, 0x67,
+	0x04, 0xfb, 0x25, 0x4e, 0xfd, 0x16, 0xcc, 0xb5, 0x60, 0xdd, 0x75, 0x20, 0xa7, 0x7e, 0x15, 0x0a,
+	0x48, 0xb8, 0x0c, 0xa9, 0xf2, 0xca, 0xc5, 0x69, 0x71, 0x49, 0x26, 0xff, 0x10, 0x61, 0x86, 0x59,
+	0xde, 0x69, 0xdd, 0x99, 0x57, 0x3f, 0x05, 0x49, 0xe8, 0x05, 0x1a, 0xe5, 0x8e, 0x5d, 0x8c, 0xcc,
+	0x0f, 0x6e, 0x0a, 0x43, 0xde, 0x14, 0xc6, 0x67, 0xd4, 0x25, 0x83, 0x76, 0xcb, 0x9c, 0xd2, 0xfc,
+	0xef, 0xc7, 0x7a, 0x2c, 0xb0, 0xfa, 0xd7, 0x7e, 0xb7, 0x20, 0x25, 0xe6, 0xfe, 0x53, 0xc0, 0xb4,
+	0xc5, 0xf0, 0xf7, 0xc4, 0x79, 0xa3, 0xdb, 0x3c, 0x51, 0xc0, 0x9c, 0xc5, 0xf0, 0x8f, 0x2e, 0xdf,
+	0x73, 0x7c, 0xd8, 0xae, 0xa0, 0x36, 0xf4, 0x9d, 0xd7, 0xdf, 0xea, 0xc3, 0x62, 0x7f, 0x8b, 0x83,
+	0x09, 0x8b, 0xe1, 0x1d, 0x44, 0xc6, 0x91, 0xf8, 0x31, 0x00, 0x9c, 0xde, 0xd1, 0xf6, 0x78, 0x56,
+	0x8a, 0xd3, 0xc8, 0xf6, 0xce, 0x80, 0xed, 0x89, 0xa7, 0x6d, 0xff, 0x22, 0xb0, 0xfd, 0xe4, 0x4a,
+	0xcf, 0x63, 0x97, 0xef, 0x35, 0x6b, 0x86, 0x4d, 0x3d, 0xf9, 0x01, 0x31, 0x07, 0x8e, 0x30, 0xef,
+	0x34, 0x10, 0x0b, 0x13, 0xd8, 0x1f, 0xfd, 0x6e, 0xe1, 0xed, 0x60, 0x83, 0xd9, 0x9d, 0x6a, 0xf0,
+	0x25, 0x63, 0x23, 0xac, 0xd9, 0x36, 0xc8, 0x5a, 0x0c, 0x7f, 0x7e, 0x80, 0xec, 0x26, 0x47, 0x16,
+	0x62, 0x0c, 0x62, 0xc4, 0xa2, 0xd3, 0xa9, 0x6e, 0x80, 0x94, 0x2f, 0xdf, 0x59, 0x46, 0x09, 0x05,
+	0xa7, 0xef, 0x5d, 0x6d, 0x9b, 0xa4, 0x53, 0x79, 0x01, 0xcb, 0xfd, 0x2d, 0x4e, 0x74, 0xb4, 0x0b,
+	0xd4, 0x4f, 0x00, 0x68, 0xcb, 0xf7, 0x11, 0x0c, 0x1e, 0xc0, 0x8e, 0x6f, 0xf2, 0xbb, 0x20, 0xe9,
+	0x20, 0x42, 0x3d, 0x71, 0x7f, 0xa6, 0x2a, 0x72, 0x54, 0x5a, 0x18, 0x74, 0x60, 0xa0, 0x52, 0xee,
+	0x52, 0x01, 0xf3, 0x43, 0x3b, 0x57, 0xf6, 0xff, 0x11, 0x98, 0xf4, 0x91, 0x8d, 0xdc, 0xd6, 0x08,
+	0xca, 0x6f, 0x91, 0xea, 0xa1, 0x02, 0x66, 0x85, 0xe7, 0x55, 0x39, 0xe7, 0x64, 0xe2, 0xaf, 0x6a,
+	0xb5, 0x67, 0x44, 0xe5, 0x8a, 0x2c, 0x5c, 0xfe, 0xf2, 0xac, 0xa7, 0x29, 0xe7, 0x3d, 0x4d, 0xf9,
+	0xb7, 0xa7, 0x29, 0x47, 0xd7, 0x5a, 0xec, 0xfc, 0x5a, 0x8b, 0x5d, 0x5e, 0x6b, 0xb1, 0x9f, 0x8a,
+	0x82, 0x97, 0x39, 0xfb, 0x86, 0x4b, 0xcd, 0x83, 0x27, 0xfe, 0x67, 0x05, 0x45, 0x6b, 0xc9, 0x70,
+	0xc1, 0x3f, 0xfc, 0x7f, 0x00, 0xe3, 0x40, 0xdd, 0x36, 0x97, 0x09, 0x00, 0x00,
 }
 
 func (this *MsgInitLockupAccount) Equal(that interface{}) bool {
@@ -783,6 +861,52 @@ func (m *MsgInitPeriodicLockingAccountResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgMigrateLockupAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateLockupAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateLockupAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateLockupAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateLockupAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateLockupAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgDelegate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1159,6 +1283,24 @@ func (m *MsgInitPeriodicLockingAccountResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgMigrateLockupAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateLockupAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgDelegate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1697,6 +1839,106 @@ func (m *MsgInitPeriodicLockingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgMigrateLockupAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateLockupAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateLockupAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateLockupAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateLockupAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateLockupAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,333 @@
+package passkey
+
+import (
+	"context"
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	secp256k1api "cosmossdk.io/api/cosmos/crypto/secp256k1"
+	secp256r1api "cosmossdk.io/api/cosmos/crypto/secp256r1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/passkey/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var (
+	CredentialPrefix  = collections.NewPrefix(0)
+	SequencePrefix    = collections.NewPrefix(1)
+	SessionKeysPrefix = collections.NewPrefix(2)
+)
+
+// Authentication methods of a passkey account.
+const (
+	AuthMethodWebAuthn   = "webauthn"
+	AuthMethodSessionKey = "session_key"
+)
+
+func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		return name, Account{
+			Credential: collections.NewItem(deps.SchemaBuilder, CredentialPrefix, "credential", codec.CollValue[v1.Credential](deps.LegacyStateCodec)),
+			Sequence:   collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+			SessionKeys: collections.NewMap(deps.SchemaBuilder, SessionKeysPrefix, "session_keys", collections.BytesKey,
+				codec.CollValue[v1.SessionKey](deps.LegacyStateCodec)),
+			addrCodec:       deps.AddressCodec,
+			signingHandlers: handlerMap,
+			hs:              deps.Environment.HeaderService,
+		}, nil
+	}
+}
+
+// Account implements an account authenticated by a WebAuthn credential (passkey),
+// which can delegate signing to session keys scoped to some message types, with a
+// spend limit and an expiration.
+type Account struct {
+	Credential  collections.Item[v1.Credential]
+	Sequence    collections.Sequence
+	SessionKeys collections.Map[[]byte, v1.SessionKey]
+
+	addrCodec address.Codec
+	hs        header.Service
+
+	signingHandlers *signing.HandlerMap
+}
+
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	return &v1.MsgInitResponse{}, a.verifyAndSetCredential(ctx, msg.Credential)
+}
+
+func (a Account) SwapCredential(ctx context.Context, msg *v1.MsgSwapCredential) (*v1.MsgSwapCredentialResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	return &v1.MsgSwapCredentialResponse{}, a.verifyAndSetCredential(ctx, msg.NewCredential)
+}
+
+func (a Account) verifyAndSetCredential(ctx context.Context, credential v1.Credential) error {
+	x, _ := elliptic.UnmarshalCompressed(elliptic.P256(), credential.PubKey)
+	if x == nil {
+		return errors.New("invalid credential public key: must be a compressed secp256r1 public key")
+	}
+	if credential.RpId == "" {
+		return errors.New("credential relying party ID cannot be empty")
+	}
+	return a.Credential.Set(ctx, credential)
+}
+
+func (a Account) AddSessionKey(ctx context.Context, msg *v1.MsgAddSessionKey) (*v1.MsgAddSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	if err := validateSessionKey(msg.SessionKey, a.hs.HeaderInfo(ctx).Time); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgAddSessionKeyResponse{}, a.SessionKeys.Set(ctx, msg.SessionKey.PubKey, msg.SessionKey)
+}
+
+func (a Account) RevokeSessionKey(ctx context.Context, msg *v1.MsgRevokeSessionKey) (*v1.MsgRevokeSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	has, err := a.SessionKeys.Has(ctx, msg.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("session key %X not found", msg.PubKey)
+	}
+
+	return &v1.MsgRevokeSessionKeyResponse{}, a.SessionKeys.Remove(ctx, msg.PubKey)
+}
+
+// Authenticate implements the authentication flow of an abstracted passkey account.
+// The signature is either a WebAuthn assertion of the credential, or the signature
+// of a session key, in which case the messages and the spending of the transaction
+// are checked against the restrictions of the session key.
+func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	sig := new(v1.Signature)
+	if err := sig.Unmarshal(msg.Tx.Signatures[msg.SignerIndex]); err != nil {
+		return nil, fmt.Errorf("unable to decode signature: %w", err)
+	}
+
+	var (
+		pubKey     *anypb.Any
+		credential v1.Credential
+		sessionKey v1.SessionKey
+		err        error
+	)
+	switch sum := sig.Sum.(type) {
+	case *v1.Signature_Webauthn:
+		credential, err = a.Credential.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pubKey, err = newAny(&secp256r1api.PubKey{Key: credential.PubKey})
+		if err != nil {
+			return nil, err
+		}
+	case *v1.Signature_SessionKey:
+		sessionKey, err = a.SessionKeys.Get(ctx, sum.SessionKey.PubKey)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return nil, fmt.Errorf("session key %X not found", sum.SessionKey.PubKey)
+			}
+			return nil, err
+		}
+		pubKey, err = newAny(&secp256k1api.PubKey{Key: sessionKey.PubKey})
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported signature type %T", sig.Sum)
+	}
+
+	signerData, err := a.computeSignerData(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	txData, err := a.getTxData(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	gotSeq := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].Sequence
+	if gotSeq != signerData.Sequence {
+		return nil, fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, gotSeq)
+	}
+
+	signMode, err := parseSignMode(msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].ModeInfo)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse sign mode: %w", err)
+	}
+
+	signBytes, err := a.signingHandlers.GetSignBytes(ctx, signMode, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	switch sum := sig.Sum.(type) {
+	case *v1.Signature_Webauthn:
+		if err := verifyWebAuthn(credential, sum.Webauthn, signBytes); err != nil {
+			return nil, err
+		}
+	case *v1.Signature_SessionKey:
+		if err := a.useSessionKey(ctx, sessionKey, sum.SessionKey.Signature, signBytes, signerData.Address, msg); err != nil {
+			return nil, err
+		}
+	}
+
+	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
+}
+
+// newAny packs the public key of the signer in an Any, the way the SDK does.
+func newAny(pubKey proto.Message) (*anypb.Any, error) {
+	bz, err := proto.Marshal(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return &anypb.Any{
+		TypeUrl: "/" + string(proto.MessageName(pubKey)),
+		Value:   bz,
+	}, nil
+}
+
+func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
+	single, ok := info.Sum.(*tx.ModeInfo_Single_)
+	if !ok {
+		return 0, fmt.Errorf("only sign mode single accepted got: %v", info.Sum)
+	}
+	return signingv1beta1.SignMode(single.Single.Mode), nil
+}
+
+// computeSignerData will populate signer data and also increase the sequence.
+func (a Account) computeSignerData(ctx context.Context, pubKey *anypb.Any) (signing.SignerData, error) {
+	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+	chainID := a.hs.HeaderInfo(ctx).ChainID
+
+	wantSequence, err := a.Sequence.Next(ctx)
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	accNum, err := a.getNumber(ctx, addrStr)
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	return signing.SignerData{
+		Address:       addrStr,
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      wantSequence,
+		PubKey:        pubKey,
+	}, nil
+}
+
+func (a Account) getNumber(ctx context.Context, addrStr string) (uint64, error) {
+	accNum, err := accountstd.QueryModule[accountsv1.AccountNumberResponse](ctx, &accountsv1.AccountNumberRequest{Address: addrStr})
+	if err != nil {
+		return 0, err
+	}
+
+	return accNum.Number, nil
+}
+
+func (a Account) getTxData(msg *aa_interface_v1.MsgAuthenticate) (signing.TxData, error) {
+	txBody := new(txv1beta1.TxBody)
+	err := proto.Unmarshal(msg.RawTx.BodyBytes, txBody)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+
+	authInfo := new(txv1beta1.AuthInfo)
+	err = proto.Unmarshal(msg.RawTx.AuthInfoBytes, authInfo)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+
+	return signing.TxData{
+		Body:                       txBody,
+		AuthInfo:                   authInfo,
+		BodyBytes:                  msg.RawTx.BodyBytes,
+		AuthInfoBytes:              msg.RawTx.AuthInfoBytes,
+		BodyHasUnknownNonCriticals: false, // NOTE: amino signing must be disabled.
+	}, nil
+}
+
+func (a Account) QueryCredential(ctx context.Context, _ *v1.QueryCredential) (*v1.QueryCredentialResponse, error) {
+	credential, err := a.Credential.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryCredentialResponse{Credential: credential}, nil
+}
+
+func (a Account) QuerySessionKeys(ctx context.Context, _ *v1.QuerySessionKeys) (*v1.QuerySessionKeysResponse, error) {
+	iter, err := a.SessionKeys.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	sessionKeys, err := iter.Values()
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySessionKeysResponse{SessionKeys: sessionKeys}, nil
+}
+
+func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
+	seq, err := a.Sequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySequenceResponse{Sequence: seq}, nil
+}
+
+func (a Account) QueryAuthenticationMethods(context.Context, *aa_interface_v1.QueryAuthenticationMethods) (*aa_interface_v1.QueryAuthenticationMethodsResponse, error) {
+	return &aa_interface_v1.QueryAuthenticationMethodsResponse{
+		AuthenticationMethods: []string{AuthMethodWebAuthn, AuthMethodSessionKey},
+	}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.SwapCredential)
+	accountstd.RegisterExecuteHandler(builder, a.AddSessionKey)
+	accountstd.RegisterExecuteHandler(builder, a.RevokeSessionKey)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QueryCredential)
+	accountstd.RegisterQueryHandler(builder, a.QuerySessionKeys)
+	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+	accountstd.RegisterQueryHandler(builder, a.QueryAuthenticationMethods) // account abstraction
+}
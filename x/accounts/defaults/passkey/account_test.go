@@ -0,0 +1,441 @@
+package passkey
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/passkey/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const (
+	testRPID   = "example.com"
+	testOrigin = "https://example.com"
+	selfAddr   = "mock_passkey_account"
+)
+
+func setupPasskeyAccount(t *testing.T, ss store.KVStoreService) Account {
+	t.Helper()
+	deps := makeMockDependencies(ss)
+	handler := directHandler{}
+
+	createAccFn := NewAccount("passkey", signing.NewHandlerMap(handler))
+	_, acc, err := createAccFn(deps)
+	passkeyAcc := acc.(Account)
+	require.NoError(t, err)
+
+	return passkeyAcc
+}
+
+func newCredential(t *testing.T) (*ecdsa.PrivateKey, v1.Credential) {
+	t.Helper()
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return privKey, v1.Credential{
+		PubKey: elliptic.MarshalCompressed(elliptic.P256(), privKey.X, privKey.Y),
+		RpId:   testRPID,
+		Origin: testOrigin,
+	}
+}
+
+// webAuthnSign returns the WebAuthn assertion an authenticator would make for the
+// given sign bytes.
+func webAuthnSign(t *testing.T, privKey *ecdsa.PrivateKey, rpID, origin string, signBytes []byte) *v1.WebAuthnSignature {
+	t.Helper()
+	challenge := sha256.Sum256(signBytes)
+	clientDataJSON, err := json.Marshal(clientData{
+		Type:      webAuthnGetType,
+		Challenge: base64.RawURLEncoding.EncodeToString(challenge[:]),
+		Origin:    origin,
+	})
+	require.NoError(t, err)
+
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	authData := append(rpIDHash[:], flagUserPresent, 0, 0, 0, 1)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(authData, clientDataHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, privKey, digest[:])
+	require.NoError(t, err)
+
+	return &v1.WebAuthnSignature{
+		AuthenticatorData: authData,
+		ClientDataJson:    clientDataJSON,
+		Signature:         sig,
+	}
+}
+
+// newTx returns an unsigned transaction of the account with the given messages
+// and fees, along with its sign bytes.
+func newTx(t *testing.T, sequence uint64, fee sdk.Coins, msgs ...*codectypes.Any) (*tx.Tx, []byte) {
+	t.Helper()
+	transaction := &tx.Tx{
+		Body: &tx.TxBody{Messages: msgs},
+		AuthInfo: &tx.AuthInfo{
+			SignerInfos: []*tx.SignerInfo{
+				{
+					ModeInfo: &tx.ModeInfo{
+						Sum: &tx.ModeInfo_Single_{
+							Single: &tx.ModeInfo_Single{
+								Mode: 1,
+							},
+						},
+					},
+					Sequence: sequence,
+				},
+			},
+			Fee: &tx.Fee{Amount: fee},
+		},
+	}
+
+	bodyBytes, err := transaction.Body.Marshal()
+	require.NoError(t, err)
+	authInfoBytes, err := transaction.AuthInfo.Marshal()
+	require.NoError(t, err)
+
+	txDoc := tx.SignDoc{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		ChainId:       "test",
+		AccountNumber: 1,
+	}
+	signBytes, err := txDoc.Marshal()
+	require.NoError(t, err)
+
+	return transaction, signBytes
+}
+
+func authenticate(t *testing.T, ctx context.Context, acc Account, transaction *tx.Tx, sig *v1.Signature) error {
+	t.Helper()
+	sigBytes, err := sig.Marshal()
+	require.NoError(t, err)
+	transaction.Signatures = [][]byte{sigBytes}
+
+	bodyBytes, err := transaction.Body.Marshal()
+	require.NoError(t, err)
+	authInfoBytes, err := transaction.AuthInfo.Marshal()
+	require.NoError(t, err)
+
+	_, err = acc.Authenticate(ctx, &aa_interface_v1.MsgAuthenticate{
+		RawTx: &tx.TxRaw{
+			BodyBytes:     bodyBytes,
+			AuthInfoBytes: authInfoBytes,
+			Signatures:    transaction.Signatures,
+		},
+		Tx:          transaction,
+		SignerIndex: 0,
+	})
+	return err
+}
+
+// peekSequence returns the sequence the next transaction of the account must have,
+// the failed authentications of the tests increasing it as their state is not reverted.
+func peekSequence(t *testing.T, ctx context.Context, acc Account) uint64 {
+	t.Helper()
+	res, err := acc.QuerySequence(ctx, &v1.QuerySequence{})
+	require.NoError(t, err)
+	return res.Sequence
+}
+
+func msgSend(t *testing.T, from string, amount sdk.Coins) *codectypes.Any {
+	t.Helper()
+	msg, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{FromAddress: from, ToAddress: "recipient", Amount: amount})
+	require.NoError(t, err)
+	return msg
+}
+
+func TestInit(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupPasskeyAccount(t, ss)
+	_, credential := newCredential(t)
+
+	testcases := []struct {
+		name     string
+		msg      *v1.MsgInit
+		isExpErr bool
+	}{
+		{
+			"valid init",
+			&v1.MsgInit{Credential: credential},
+			false,
+		},
+		{
+			"invalid pubkey",
+			&v1.MsgInit{Credential: v1.Credential{PubKey: []byte("invalid_pk"), RpId: testRPID}},
+			true,
+		},
+		{
+			"empty relying party ID",
+			&v1.MsgInit{Credential: v1.Credential{PubKey: credential.PubKey}},
+			true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := acc.Init(ctx, tc.msg)
+			if tc.isExpErr {
+				require.NotNil(t, err, tc.name)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSwapCredential(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupPasskeyAccount(t, ss)
+	_, credential := newCredential(t)
+	_, err := acc.Init(ctx, &v1.MsgInit{Credential: credential})
+	require.NoError(t, err)
+
+	_, newCred := newCredential(t)
+	_, err = acc.SwapCredential(accountstd.SetSender(ctx, []byte("sender")), &v1.MsgSwapCredential{NewCredential: newCred})
+	require.Equal(t, errors.New("unauthorized"), err)
+
+	_, err = acc.SwapCredential(accountstd.SetSender(ctx, []byte(selfAddr)), &v1.MsgSwapCredential{NewCredential: newCred})
+	require.NoError(t, err)
+
+	res, err := acc.QueryCredential(ctx, &v1.QueryCredential{})
+	require.NoError(t, err)
+	require.Equal(t, newCred, res.Credential)
+}
+
+func TestAuthenticateWebAuthn(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupPasskeyAccount(t, ss)
+	privKey, credential := newCredential(t)
+	_, err := acc.Init(ctx, &v1.MsgInit{Credential: credential})
+	require.NoError(t, err)
+
+	_, err = acc.Authenticate(ctx, &aa_interface_v1.MsgAuthenticate{})
+	require.Equal(t, errors.New("unauthorized: only accounts module is allowed to call this"), err)
+
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+
+	transaction, signBytes := newTx(t, 0, nil)
+	sig := &v1.Signature{Sum: &v1.Signature_Webauthn{Webauthn: webAuthnSign(t, privKey, testRPID, testOrigin, signBytes)}}
+	require.NoError(t, authenticate(t, ctx, acc, transaction, sig))
+
+	seq, err := acc.QuerySequence(ctx, &v1.QuerySequence{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), seq.Sequence)
+
+	testcases := []struct {
+		name   string
+		sign   func(signBytes []byte) *v1.WebAuthnSignature
+		expErr string
+	}{
+		{
+			"wrong relying party",
+			func(signBytes []byte) *v1.WebAuthnSignature {
+				return webAuthnSign(t, privKey, "evil.com", testOrigin, signBytes)
+			},
+			"relying party ID does not match",
+		},
+		{
+			"wrong origin",
+			func(signBytes []byte) *v1.WebAuthnSignature {
+				return webAuthnSign(t, privKey, testRPID, "https://evil.com", signBytes)
+			},
+			"invalid client data origin",
+		},
+		{
+			"wrong challenge",
+			func(signBytes []byte) *v1.WebAuthnSignature {
+				return webAuthnSign(t, privKey, testRPID, testOrigin, []byte("other tx"))
+			},
+			"challenge does not match",
+		},
+		{
+			"user not present",
+			func(signBytes []byte) *v1.WebAuthnSignature {
+				sig := webAuthnSign(t, privKey, testRPID, testOrigin, signBytes)
+				sig.AuthenticatorData[32] = 0
+				return sig
+			},
+			"user present flag not set",
+		},
+		{
+			"wrong key",
+			func(signBytes []byte) *v1.WebAuthnSignature {
+				otherKey, _ := newCredential(t)
+				return webAuthnSign(t, otherKey, testRPID, testOrigin, signBytes)
+			},
+			"signature verification failed",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			transaction, signBytes := newTx(t, peekSequence(t, ctx, acc), nil)
+			sig := &v1.Signature{Sum: &v1.Signature_Webauthn{Webauthn: tc.sign(signBytes)}}
+			err := authenticate(t, ctx, acc, transaction, sig)
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestSessionKeys(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupPasskeyAccount(t, ss)
+	_, credential := newCredential(t)
+	_, err := acc.Init(ctx, &v1.MsgInit{Credential: credential})
+	require.NoError(t, err)
+
+	now := testTime
+	sessionPrivKey := secp256k1.GenPrivKey()
+	sessionKey := v1.SessionKey{
+		PubKey:          sessionPrivKey.PubKey().Bytes(),
+		AllowedMessages: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+		SpendLimit:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		ExpiresAt:       now.Add(time.Hour),
+	}
+
+	selfCtx := accountstd.SetSender(ctx, []byte(selfAddr))
+	_, err = acc.AddSessionKey(accountstd.SetSender(ctx, []byte("sender")), &v1.MsgAddSessionKey{SessionKey: sessionKey})
+	require.Equal(t, errors.New("unauthorized"), err)
+
+	expired := sessionKey
+	expired.ExpiresAt = now
+	_, err = acc.AddSessionKey(selfCtx, &v1.MsgAddSessionKey{SessionKey: expired})
+	require.ErrorContains(t, err, "expiration must be in the future")
+
+	noMsgs := sessionKey
+	noMsgs.AllowedMessages = nil
+	_, err = acc.AddSessionKey(selfCtx, &v1.MsgAddSessionKey{SessionKey: noMsgs})
+	require.ErrorContains(t, err, "at least one message type")
+
+	// only the messages whose spending is accounted, or which do not spend, can be allowed
+	for _, msgTypeURL := range []string{
+		sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+		"/cosmos.authz.v1beta1.MsgGrant",
+		"/cosmos.feegrant.v1beta1.MsgGrantAllowance",
+		"/cosmos.staking.v1beta1.MsgDelegate",
+	} {
+		unaccounted := sessionKey
+		unaccounted.AllowedMessages = []string{sdk.MsgTypeURL(&banktypes.MsgSend{}), msgTypeURL}
+		_, err = acc.AddSessionKey(selfCtx, &v1.MsgAddSessionKey{SessionKey: unaccounted})
+		require.ErrorContains(t, err, "cannot be accounted", msgTypeURL)
+	}
+	vote := sessionKey
+	vote.AllowedMessages = []string{"/cosmos.gov.v1.MsgVote"}
+	vote.SpendLimit = nil
+	_, err = acc.AddSessionKey(selfCtx, &v1.MsgAddSessionKey{SessionKey: vote})
+	require.NoError(t, err)
+	_, err = acc.RevokeSessionKey(selfCtx, &v1.MsgRevokeSessionKey{PubKey: vote.PubKey})
+	require.NoError(t, err)
+
+	_, err = acc.AddSessionKey(selfCtx, &v1.MsgAddSessionKey{SessionKey: sessionKey})
+	require.NoError(t, err)
+
+	keys, err := acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Len(t, keys.SessionKeys, 1)
+	require.Equal(t, sessionKey.PubKey, keys.SessionKeys[0].PubKey)
+
+	_, err = acc.RevokeSessionKey(selfCtx, &v1.MsgRevokeSessionKey{PubKey: sessionKey.PubKey})
+	require.NoError(t, err)
+	_, err = acc.RevokeSessionKey(selfCtx, &v1.MsgRevokeSessionKey{PubKey: sessionKey.PubKey})
+	require.ErrorContains(t, err, "not found")
+
+	keys, err = acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Empty(t, keys.SessionKeys)
+}
+
+func TestAuthenticateSessionKey(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupPasskeyAccount(t, ss)
+	_, credential := newCredential(t)
+	_, err := acc.Init(ctx, &v1.MsgInit{Credential: credential})
+	require.NoError(t, err)
+
+	now := testTime
+	sessionPrivKey := secp256k1.GenPrivKey()
+	_, err = acc.AddSessionKey(accountstd.SetSender(ctx, []byte(selfAddr)), &v1.MsgAddSessionKey{SessionKey: v1.SessionKey{
+		PubKey:          sessionPrivKey.PubKey().Bytes(),
+		AllowedMessages: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+		SpendLimit:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		ExpiresAt:       now.Add(time.Hour),
+	}})
+	require.NoError(t, err)
+
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+	sessionSign := func(privKey *secp256k1.PrivKey, signBytes []byte) *v1.Signature {
+		sig, err := privKey.Sign(signBytes)
+		require.NoError(t, err)
+		return &v1.Signature{Sum: &v1.Signature_SessionKey{SessionKey: &v1.SessionKeySignature{
+			PubKey:    privKey.PubKey().Bytes(),
+			Signature: sig,
+		}}}
+	}
+
+	// spend 60stake with the message and 10stake of fees
+	transaction, signBytes := newTx(t, 0, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		msgSend(t, selfAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 60))))
+	require.NoError(t, authenticate(t, ctx, acc, transaction, sessionSign(sessionPrivKey, signBytes)))
+
+	keys, err := acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), keys.SessionKeys[0].SpendLimit)
+
+	// spending more than the remaining limit fails
+	transaction, signBytes = newTx(t, peekSequence(t, ctx, acc), nil, msgSend(t, selfAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 31))))
+	err = authenticate(t, ctx, acc, transaction, sessionSign(sessionPrivKey, signBytes))
+	require.ErrorContains(t, err, "spend limit exceeded")
+
+	// spending another denom fails
+	transaction, signBytes = newTx(t, peekSequence(t, ctx, acc), nil, msgSend(t, selfAddr, sdk.NewCoins(sdk.NewInt64Coin("atom", 1))))
+	err = authenticate(t, ctx, acc, transaction, sessionSign(sessionPrivKey, signBytes))
+	require.ErrorContains(t, err, "spend limit exceeded")
+
+	// messages not allowed for the session key fail
+	otherMsg, err := codectypes.NewAnyWithValue(&banktypes.MsgMultiSend{})
+	require.NoError(t, err)
+	transaction, signBytes = newTx(t, peekSequence(t, ctx, acc), nil, otherMsg)
+	err = authenticate(t, ctx, acc, transaction, sessionSign(sessionPrivKey, signBytes))
+	require.ErrorContains(t, err, "is not allowed for session key")
+
+	// unknown session keys fail
+	transaction, signBytes = newTx(t, peekSequence(t, ctx, acc), nil)
+	err = authenticate(t, ctx, acc, transaction, sessionSign(secp256k1.GenPrivKey(), signBytes))
+	require.ErrorContains(t, err, "not found")
+
+	// a signature of another key fails
+	sig := sessionSign(secp256k1.GenPrivKey(), signBytes)
+	sig.GetSessionKey().PubKey = sessionPrivKey.PubKey().Bytes()
+	err = authenticate(t, ctx, acc, transaction, sig)
+	require.Equal(t, errors.New("signature verification failed"), err)
+
+	// sending coins of other accounts does not count in the spending
+	transaction, signBytes = newTx(t, peekSequence(t, ctx, acc), nil, msgSend(t, "other", sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+	require.NoError(t, authenticate(t, ctx, acc, transaction, sessionSign(sessionPrivKey, signBytes)))
+
+	// expired session keys fail
+	acc.hs = headerService{time: now.Add(time.Hour)}
+	transaction, signBytes = newTx(t, peekSequence(t, ctx, acc), nil)
+	err = authenticate(t, ctx, acc, transaction, sessionSign(sessionPrivKey, signBytes))
+	require.ErrorContains(t, err, "expired")
+}
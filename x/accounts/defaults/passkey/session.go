@@ -0,0 +1,124 @@
+package passkey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"google.golang.org/protobuf/proto"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	distributionv1beta1 "cosmossdk.io/api/cosmos/distribution/v1beta1"
+	govv1 "cosmossdk.io/api/cosmos/gov/v1"
+	"cosmossdk.io/math"
+	v1 "cosmossdk.io/x/accounts/defaults/passkey/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// msgSendTypeURL is the type URL of the bank MsgSend, whose amount is accounted
+	// in the spending of the session keys.
+	msgSendTypeURL = typeURL(&bankv1beta1.MsgSend{})
+
+	// zeroSpendMsgTypeURLs are the type URLs of the messages which do not move coins
+	// out of the account, nor grant rights over them, and can therefore be allowed to
+	// a session key along with the bank MsgSend.
+	zeroSpendMsgTypeURLs = []string{
+		typeURL(&govv1.MsgVote{}),
+		typeURL(&govv1.MsgVoteWeighted{}),
+		typeURL(&distributionv1beta1.MsgWithdrawDelegatorReward{}),
+	}
+)
+
+func typeURL(msg proto.Message) string {
+	return "/" + string(proto.MessageName(msg))
+}
+
+// validateSessionKey checks the session key is a valid secp256k1 key, which can
+// sign some messages and is not already expired. The allowed messages must either
+// be the bank MsgSend, whose spending is accounted, or not spend from the account.
+func validateSessionKey(sessionKey v1.SessionKey, now time.Time) error {
+	if _, err := dcrd_secp256k1.ParsePubKey(sessionKey.PubKey); err != nil {
+		return fmt.Errorf("invalid session key public key: %w", err)
+	}
+	if len(sessionKey.AllowedMessages) == 0 {
+		return errors.New("session key must allow at least one message type")
+	}
+	for _, msgTypeURL := range sessionKey.AllowedMessages {
+		if msgTypeURL != msgSendTypeURL && !slices.Contains(zeroSpendMsgTypeURLs, msgTypeURL) {
+			return fmt.Errorf("session key cannot allow message %s, whose spending cannot be accounted", msgTypeURL)
+		}
+	}
+	if err := sessionKey.SpendLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid session key spend limit: %w", err)
+	}
+	if !sessionKey.ExpiresAt.After(now) {
+		return errors.New("session key expiration must be in the future")
+	}
+	return nil
+}
+
+// useSessionKey verifies the signature of the session key, and checks the
+// transaction against its restrictions: the session key must not be expired,
+// every message must be allowed, and the coins spent by the account must be
+// within the spend limit, which is decreased by the amount spent.
+func (a Account) useSessionKey(
+	ctx context.Context, sessionKey v1.SessionKey, signature, signBytes []byte, self string, msg *aa_interface_v1.MsgAuthenticate,
+) error {
+	if !a.hs.HeaderInfo(ctx).Time.Before(sessionKey.ExpiresAt) {
+		return fmt.Errorf("session key %X expired", sessionKey.PubKey)
+	}
+
+	pubKey := secp256k1.PubKey{Key: sessionKey.PubKey}
+	if !pubKey.VerifySignature(signBytes, signature) {
+		return errors.New("signature verification failed")
+	}
+
+	spent := sdk.NewCoins()
+	for _, anyMsg := range msg.Tx.Body.Messages {
+		if !slices.Contains(sessionKey.AllowedMessages, anyMsg.TypeUrl) {
+			return fmt.Errorf("message %s is not allowed for session key %X", anyMsg.TypeUrl, sessionKey.PubKey)
+		}
+		if anyMsg.TypeUrl != msgSendTypeURL {
+			continue
+		}
+		msgSend := new(bankv1beta1.MsgSend)
+		if err := proto.Unmarshal(anyMsg.Value, msgSend); err != nil {
+			return err
+		}
+		if msgSend.FromAddress != self {
+			continue
+		}
+		for _, coin := range msgSend.Amount {
+			amount, ok := math.NewIntFromString(coin.Amount)
+			if !ok {
+				return fmt.Errorf("invalid amount %s", coin.Amount)
+			}
+			spent = spent.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	// the fees are paid by the fee payer if set, by the first signer otherwise.
+	if fee := msg.Tx.AuthInfo.Fee; fee != nil {
+		if fee.Payer == self || (fee.Payer == "" && msg.SignerIndex == 0) {
+			spent = spent.Add(fee.Amount...)
+		}
+	}
+
+	remaining, hasNeg := sessionKey.SpendLimit.SafeSub(spent...)
+	if hasNeg {
+		return fmt.Errorf("session key %X spend limit exceeded: spending %s, limit %s", sessionKey.PubKey, spent, sessionKey.SpendLimit)
+	}
+	if spent.IsZero() {
+		return nil
+	}
+
+	sessionKey.SpendLimit = remaining
+	return a.SessionKeys.Set(ctx, sessionKey.PubKey, sessionKey)
+}
@@ -0,0 +1,81 @@
+package passkey
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	v1 "cosmossdk.io/x/accounts/defaults/passkey/v1"
+)
+
+const (
+	// webAuthnGetType is the type of the client data of WebAuthn assertions.
+	webAuthnGetType = "webauthn.get"
+	// authDataMinLength is the length of the authenticator data without the
+	// attested credential data and the extensions: the relying party ID hash, the
+	// flags and the signature counter.
+	authDataMinLength = 32 + 1 + 4
+	// flagUserPresent is the flag of the authenticator data set when the user was
+	// present during the assertion.
+	flagUserPresent = 0x01
+)
+
+// clientData is the subset of the client data of a WebAuthn assertion which is
+// verified.
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// verifyWebAuthn verifies the WebAuthn assertion of the credential over the sign
+// bytes of a transaction, following the assertion verification of the WebAuthn
+// specification: the challenge must be the base64url encoded SHA-256 hash of the
+// sign bytes, and the assertion must be made for the relying party of the
+// credential with the user present.
+func verifyWebAuthn(credential v1.Credential, sig *v1.WebAuthnSignature, signBytes []byte) error {
+	var cd clientData
+	if err := json.Unmarshal(sig.ClientDataJson, &cd); err != nil {
+		return fmt.Errorf("invalid client data: %w", err)
+	}
+	if cd.Type != webAuthnGetType {
+		return fmt.Errorf("invalid client data type, wanted: %s, got: %s", webAuthnGetType, cd.Type)
+	}
+	challenge := sha256.Sum256(signBytes)
+	if cd.Challenge != base64.RawURLEncoding.EncodeToString(challenge[:]) {
+		return errors.New("client data challenge does not match the transaction")
+	}
+	if credential.Origin != "" && cd.Origin != credential.Origin {
+		return fmt.Errorf("invalid client data origin, wanted: %s, got: %s", credential.Origin, cd.Origin)
+	}
+
+	authData := sig.AuthenticatorData
+	if len(authData) < authDataMinLength {
+		return fmt.Errorf("authenticator data too short: %d bytes", len(authData))
+	}
+	rpIDHash := sha256.Sum256([]byte(credential.RpId))
+	if !bytes.Equal(authData[:32], rpIDHash[:]) {
+		return errors.New("authenticator data relying party ID does not match the credential")
+	}
+	if authData[32]&flagUserPresent == 0 {
+		return errors.New("authenticator data user present flag not set")
+	}
+
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), credential.PubKey)
+	if x == nil {
+		return errors.New("invalid credential public key")
+	}
+	pubKey := &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+
+	clientDataHash := sha256.Sum256(sig.ClientDataJson)
+	digest := sha256.Sum256(append(bytes.Clone(authData), clientDataHash[:]...))
+	if !ecdsa.VerifyASN1(pubKey, digest[:], sig.Signature) {
+		return errors.New("signature verification failed")
+	}
+	return nil
+}
@@ -0,0 +1,305 @@
+package paymaster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/paymaster/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var (
+	OwnerPrefix   = collections.NewPrefix(0)
+	ConfigPrefix  = collections.NewPrefix(1)
+	BudgetsPrefix = collections.NewPrefix(2)
+)
+
+var (
+	// ErrAccountNotAllowed is returned when the paymaster does not pay the fees of an account.
+	ErrAccountNotAllowed = errors.New("account not allowed")
+	// ErrMessageNotAllowed is returned when the paymaster does not pay the fees of a message.
+	ErrMessageNotAllowed = errors.New("message not allowed")
+	// ErrBudgetExceeded is returned when the fees exceed the budget of an account.
+	ErrBudgetExceeded = errors.New("budget exceeded")
+)
+
+func NewAccount(name string) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		return name, Account{
+			Owner:  collections.NewItem(deps.SchemaBuilder, OwnerPrefix, "owner", collections.BytesValue),
+			Config: collections.NewItem(deps.SchemaBuilder, ConfigPrefix, "config", codec.CollValue[v1.Config](deps.LegacyStateCodec)),
+			Budgets: collections.NewMap(deps.SchemaBuilder, BudgetsPrefix, "budgets", collections.BytesKey,
+				codec.CollValue[v1.Budget](deps.LegacyStateCodec)),
+			addrCodec:    deps.AddressCodec,
+			eventService: deps.Environment.EventService,
+		}, nil
+	}
+}
+
+// Account implements a paymaster, which pays the fees of the transactions setting it
+// as fee granter, for the allowed accounts and messages and within the budget of
+// each account.
+type Account struct {
+	Owner   collections.Item[[]byte]
+	Config  collections.Item[v1.Config]
+	Budgets collections.Map[[]byte, v1.Budget]
+
+	addrCodec    address.Codec
+	eventService event.Service
+}
+
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	owner, err := a.addrCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, fmt.Errorf("invalid owner address: %w", err)
+	}
+	if err := a.Owner.Set(ctx, owner); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgInitResponse{}, a.setConfig(ctx, msg.Config)
+}
+
+func (a Account) UpdateConfig(ctx context.Context, msg *v1.MsgUpdateConfig) (*v1.MsgUpdateConfigResponse, error) {
+	if err := a.checkOwner(ctx); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUpdateConfigResponse{}, a.setConfig(ctx, msg.Config)
+}
+
+func (a Account) SetBudget(ctx context.Context, msg *v1.MsgSetBudget) (*v1.MsgSetBudgetResponse, error) {
+	if err := a.checkOwner(ctx); err != nil {
+		return nil, err
+	}
+
+	account, err := a.addrCodec.StringToBytes(msg.Account)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account address: %w", err)
+	}
+	if err := msg.Budget.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid budget: %w", err)
+	}
+
+	if msg.Budget.IsZero() {
+		err = a.Budgets.Remove(ctx, account)
+	} else {
+		err = a.Budgets.Set(ctx, account, v1.Budget{Account: msg.Account, Remaining: msg.Budget})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("budget_set",
+		event.NewAttribute("account", msg.Account),
+		event.NewAttribute("budget", msg.Budget.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgSetBudgetResponse{}, nil
+}
+
+func (a Account) ExecuteMessages(ctx context.Context, msg *v1.MsgExecuteMessages) (*v1.MsgExecuteMessagesResponse, error) {
+	if err := a.checkOwner(ctx); err != nil {
+		return nil, err
+	}
+
+	responses, err := accountstd.ExecModuleAnys(ctx, msg.Messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgExecuteMessagesResponse{Responses: responses}, nil
+}
+
+// PayFee accepts to pay the fees of a transaction if the fee payer and the messages
+// of the transaction are allowed, and the fees are within the budget of the fee payer,
+// which is then decreased by the fees.
+func (a Account) PayFee(ctx context.Context, msg *aa_interface_v1.MsgPayFee) (*aa_interface_v1.MsgPayFeeResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(config.AllowedAccounts) != 0 && !slices.Contains(config.AllowedAccounts, msg.FeePayer) {
+		return nil, fmt.Errorf("%w: %s", ErrAccountNotAllowed, msg.FeePayer)
+	}
+
+	if msg.Tx == nil || msg.Tx.Body == nil {
+		return nil, errors.New("missing tx body")
+	}
+	if len(config.AllowedMessages) != 0 {
+		for _, anyMsg := range msg.Tx.Body.Messages {
+			if !slices.Contains(config.AllowedMessages, anyMsg.TypeUrl) {
+				return nil, fmt.Errorf("%w: %s", ErrMessageNotAllowed, anyMsg.TypeUrl)
+			}
+		}
+	}
+
+	feePayer, err := a.addrCodec.StringToBytes(msg.FeePayer)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fee payer address: %w", err)
+	}
+	budget, err := a.getBudget(ctx, feePayer, msg.FeePayer, config)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining, hasNeg := budget.Remaining.SafeSub(msg.Fee...)
+	if hasNeg {
+		return nil, fmt.Errorf("%w: fees %s, remaining budget %s", ErrBudgetExceeded, msg.Fee, budget.Remaining)
+	}
+	budget.Remaining = remaining
+	if err := a.Budgets.Set(ctx, feePayer, budget); err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("fee_sponsored",
+		event.NewAttribute("fee_payer", msg.FeePayer),
+		event.NewAttribute("bundler", msg.Bundler),
+		event.NewAttribute("fee", msg.Fee.String()),
+		event.NewAttribute("remaining_budget", remaining.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &aa_interface_v1.MsgPayFeeResponse{}, nil
+}
+
+func (a Account) QueryConfig(ctx context.Context, _ *v1.QueryConfig) (*v1.QueryConfigResponse, error) {
+	owner, err := a.Owner.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ownerAddr, err := a.addrCodec.BytesToString(owner)
+	if err != nil {
+		return nil, err
+	}
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryConfigResponse{Owner: ownerAddr, Config: config}, nil
+}
+
+func (a Account) QueryBudget(ctx context.Context, req *v1.QueryBudget) (*v1.QueryBudgetResponse, error) {
+	account, err := a.addrCodec.StringToBytes(req.Account)
+	if err != nil {
+		return nil, fmt.Errorf("invalid account address: %w", err)
+	}
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	budget, err := a.getBudget(ctx, account, req.Account, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryBudgetResponse{Budget: budget}, nil
+}
+
+func (a Account) QueryBudgets(ctx context.Context, _ *v1.QueryBudgets) (*v1.QueryBudgetsResponse, error) {
+	var budgets []v1.Budget
+	err := a.Budgets.Walk(ctx, nil, func(_ []byte, budget v1.Budget) (bool, error) {
+		budgets = append(budgets, budget)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryBudgetsResponse{Budgets: budgets}, nil
+}
+
+// getBudget returns the budget of the account, the default budget if none is set.
+func (a Account) getBudget(ctx context.Context, account []byte, accountAddr string, config v1.Config) (v1.Budget, error) {
+	budget, err := a.Budgets.Get(ctx, account)
+	switch {
+	case err == nil:
+		return budget, nil
+	case errors.Is(err, collections.ErrNotFound):
+		return v1.Budget{Account: accountAddr, Remaining: config.DefaultBudget}, nil
+	default:
+		return v1.Budget{}, err
+	}
+}
+
+func (a Account) checkOwner(ctx context.Context) error {
+	owner, err := a.Owner.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !accountstd.HasSender(ctx, owner) {
+		return errors.New("unauthorized: sender is not the owner")
+	}
+	return nil
+}
+
+func (a Account) setConfig(ctx context.Context, config v1.Config) error {
+	if err := validateConfig(a.addrCodec, config); err != nil {
+		return err
+	}
+	if err := a.Config.Set(ctx, config); err != nil {
+		return err
+	}
+
+	return a.eventService.EventManager(ctx).EmitKV("paymaster_config_updated",
+		event.NewAttribute("allowed_accounts", fmt.Sprintf("%v", config.AllowedAccounts)),
+		event.NewAttribute("allowed_messages", fmt.Sprintf("%v", config.AllowedMessages)),
+		event.NewAttribute("default_budget", config.DefaultBudget.String()),
+	)
+}
+
+func validateConfig(addrCodec address.Codec, config v1.Config) error {
+	seen := make(map[string]struct{}, len(config.AllowedAccounts))
+	for _, account := range config.AllowedAccounts {
+		if _, err := addrCodec.StringToBytes(account); err != nil {
+			return fmt.Errorf("invalid allowed account address %s: %w", account, err)
+		}
+		if _, ok := seen[account]; ok {
+			return fmt.Errorf("duplicate allowed account %s", account)
+		}
+		seen[account] = struct{}{}
+	}
+	for _, typeURL := range config.AllowedMessages {
+		if typeURL == "" {
+			return errors.New("allowed message type URL cannot be empty")
+		}
+	}
+	if err := config.DefaultBudget.Validate(); err != nil {
+		return fmt.Errorf("invalid default budget: %w", err)
+	}
+	return nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.UpdateConfig)
+	accountstd.RegisterExecuteHandler(builder, a.SetBudget)
+	accountstd.RegisterExecuteHandler(builder, a.ExecuteMessages)
+	accountstd.RegisterExecuteHandler(builder, a.PayFee)
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QueryConfig)
+	accountstd.RegisterQueryHandler(builder, a.QueryBudget)
+	accountstd.RegisterQueryHandler(builder, a.QueryBudgets)
+}
@@ -0,0 +1,191 @@
+package paymaster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/paymaster/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const (
+	owner      = "owner"
+	msgSendURL = "/cosmos.bank.v1beta1.MsgSend"
+)
+
+func setupPaymasterAccount(t *testing.T, ss store.KVStoreService) Account {
+	t.Helper()
+	deps := makeMockDependencies(ss)
+
+	_, acc, err := NewAccount("paymaster")(deps)
+	require.NoError(t, err)
+
+	return acc.(Account)
+}
+
+func asSender(ctx context.Context, sender string) context.Context {
+	return accountstd.SetSender(ctx, []byte(sender))
+}
+
+func asAccountsModule(ctx context.Context) context.Context {
+	return accountstd.SetSender(ctx, address.Module("accounts"))
+}
+
+func payFee(feePayer string, fee sdk.Coins, msgTypeURLs ...string) *aa_interface_v1.MsgPayFee {
+	msgs := make([]*codectypes.Any, len(msgTypeURLs))
+	for i, typeURL := range msgTypeURLs {
+		msgs[i] = &codectypes.Any{TypeUrl: typeURL}
+	}
+	return &aa_interface_v1.MsgPayFee{
+		Bundler:  feePayer,
+		FeePayer: feePayer,
+		Fee:      fee,
+		RawTx:    &tx.TxRaw{},
+		Tx:       &tx.Tx{Body: &tx.TxBody{Messages: msgs}},
+	}
+}
+
+func TestInit(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupPaymasterAccount(t, ss)
+
+	testcases := []struct {
+		name   string
+		msg    *v1.MsgInit
+		expErr string
+	}{
+		{
+			"valid init",
+			&v1.MsgInit{Owner: owner, Config: v1.Config{AllowedAccounts: []string{"user1"}, DefaultBudget: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))}},
+			"",
+		},
+		{
+			"duplicate allowed account",
+			&v1.MsgInit{Owner: owner, Config: v1.Config{AllowedAccounts: []string{"user1", "user1"}}},
+			"duplicate allowed account",
+		},
+		{
+			"empty allowed message",
+			&v1.MsgInit{Owner: owner, Config: v1.Config{AllowedMessages: []string{""}}},
+			"allowed message type URL cannot be empty",
+		},
+		{
+			"invalid default budget",
+			&v1.MsgInit{Owner: owner, Config: v1.Config{DefaultBudget: sdk.Coins{sdk.NewInt64Coin("stake", 0)}}},
+			"invalid default budget",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := acc.Init(ctx, tc.msg)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPayFee(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupPaymasterAccount(t, ss)
+	_, err := acc.Init(ctx, &v1.MsgInit{Owner: owner, Config: v1.Config{
+		AllowedAccounts: []string{"user1", "user2"},
+		AllowedMessages: []string{msgSendURL},
+		DefaultBudget:   sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	}})
+	require.NoError(t, err)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 6))
+
+	// only the accounts module can ask the paymaster to pay fees
+	_, err = acc.PayFee(asSender(ctx, "user1"), payFee("user1", fee, msgSendURL))
+	require.ErrorContains(t, err, "unauthorized")
+
+	ctx = asAccountsModule(ctx)
+	_, err = acc.PayFee(ctx, payFee("user3", fee, msgSendURL))
+	require.ErrorIs(t, err, ErrAccountNotAllowed)
+	_, err = acc.PayFee(ctx, payFee("user1", fee, msgSendURL, "/cosmos.staking.v1beta1.MsgDelegate"))
+	require.ErrorIs(t, err, ErrMessageNotAllowed)
+
+	// the fees are deducted from the default budget
+	_, err = acc.PayFee(ctx, payFee("user1", fee, msgSendURL))
+	require.NoError(t, err)
+	budget, err := acc.QueryBudget(ctx, &v1.QueryBudget{Account: "user1"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 4)), budget.Budget.Remaining)
+
+	_, err = acc.PayFee(ctx, payFee("user1", fee, msgSendURL))
+	require.ErrorIs(t, err, ErrBudgetExceeded)
+
+	// the budget of the other accounts is untouched
+	budget, err = acc.QueryBudget(ctx, &v1.QueryBudget{Account: "user2"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), budget.Budget.Remaining)
+	_, err = acc.PayFee(ctx, payFee("user2", fee, msgSendURL))
+	require.NoError(t, err)
+
+	budgets, err := acc.QueryBudgets(ctx, &v1.QueryBudgets{})
+	require.NoError(t, err)
+	require.Len(t, budgets.Budgets, 2)
+}
+
+func TestSetBudget(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupPaymasterAccount(t, ss)
+	_, err := acc.Init(ctx, &v1.MsgInit{Owner: owner, Config: v1.Config{
+		DefaultBudget: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	}})
+	require.NoError(t, err)
+	budget := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	_, err = acc.SetBudget(asSender(ctx, "user1"), &v1.MsgSetBudget{Account: "user1", Budget: budget})
+	require.EqualError(t, err, "unauthorized: sender is not the owner")
+	_, err = acc.SetBudget(asSender(ctx, owner), &v1.MsgSetBudget{Account: "user1", Budget: budget})
+	require.NoError(t, err)
+
+	_, err = acc.PayFee(asAccountsModule(ctx), payFee("user1", sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), msgSendURL))
+	require.NoError(t, err)
+	res, err := acc.QueryBudget(ctx, &v1.QueryBudget{Account: "user1"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), res.Budget.Remaining)
+
+	// an empty budget falls back to the default budget
+	_, err = acc.SetBudget(asSender(ctx, owner), &v1.MsgSetBudget{Account: "user1"})
+	require.NoError(t, err)
+	res, err = acc.QueryBudget(ctx, &v1.QueryBudget{Account: "user1"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), res.Budget.Remaining)
+}
+
+func TestUpdateConfig(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupPaymasterAccount(t, ss)
+	_, err := acc.Init(ctx, &v1.MsgInit{Owner: owner, Config: v1.Config{AllowedAccounts: []string{"user1"}}})
+	require.NoError(t, err)
+
+	newConfig := v1.Config{AllowedMessages: []string{msgSendURL}}
+	_, err = acc.UpdateConfig(asSender(ctx, "user1"), &v1.MsgUpdateConfig{Config: newConfig})
+	require.EqualError(t, err, "unauthorized: sender is not the owner")
+	_, err = acc.UpdateConfig(asSender(ctx, owner), &v1.MsgUpdateConfig{Config: newConfig})
+	require.NoError(t, err)
+
+	res, err := acc.QueryConfig(ctx, &v1.QueryConfig{})
+	require.NoError(t, err)
+	require.Equal(t, owner, res.Owner)
+	require.Equal(t, newConfig, res.Config)
+
+	// without a default budget, the paymaster only pays for the accounts with a budget
+	_, err = acc.PayFee(asAccountsModule(ctx), payFee("user2", sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), msgSendURL))
+	require.ErrorIs(t, err, ErrBudgetExceeded)
+}
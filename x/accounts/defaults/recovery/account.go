@@ -0,0 +1,418 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	v1 "cosmossdk.io/x/accounts/defaults/recovery/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+// the prefixes follow the ones of the base account, whose state is shared.
+var (
+	ConfigPrefix           = collections.NewPrefix(2)
+	RecoveriesPrefix       = collections.NewPrefix(3)
+	RecoverySequencePrefix = collections.NewPrefix(4)
+)
+
+const (
+	// MinRecoveryDelay is the minimum delay of the recoveries, which leaves the owner
+	// the time to cancel a recovery it did not ask for.
+	MinRecoveryDelay = time.Hour
+	// MaxPendingRecoveriesPerGuardian is the maximum number of pending recoveries
+	// initiated by a guardian, which bounds the pending recoveries of the account.
+	MaxPendingRecoveriesPerGuardian = 1
+)
+
+// Compile-time type assertions
+var (
+	_ accountstd.Interface           = Account{}
+	_ accountstd.MigratableInterface = Account{}
+)
+
+// NewAccount returns the creator of the recoverable account. The base accounts of the
+// given account types, registered with base.NewAccount, can be migrated to it by their
+// owner with MsgMigrateFromBase.
+func NewAccount(name string, handlerMap *signing.HandlerMap, baseAccountTypes ...string) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		_, baseAcc, err := base.NewAccount(name, handlerMap)(deps)
+		if err != nil {
+			return "", nil, err
+		}
+		return name, Account{
+			Account:          baseAcc.(base.Account),
+			Config:           collections.NewItem(deps.SchemaBuilder, ConfigPrefix, "recovery_config", codec.CollValue[v1.RecoveryConfig](deps.LegacyStateCodec)),
+			Recoveries:       collections.NewMap(deps.SchemaBuilder, RecoveriesPrefix, "recoveries", collections.Uint64Key, codec.CollValue[v1.Recovery](deps.LegacyStateCodec)),
+			RecoverySequence: collections.NewSequence(deps.SchemaBuilder, RecoverySequencePrefix, "recovery_sequence"),
+			baseAccountTypes: baseAccountTypes,
+			addrCodec:        deps.AddressCodec,
+			headerService:    deps.Environment.HeaderService,
+			eventService:     deps.Environment.EventService,
+		}, nil
+	}
+}
+
+// Account implements a base account whose pubkey can be rotated by a set of
+// guardians when the owner lost its key. A recovery approved by the threshold
+// of guardians can only be executed after a delay, during which the owner can
+// cancel it.
+type Account struct {
+	base.Account
+
+	Config           collections.Item[v1.RecoveryConfig]
+	Recoveries       collections.Map[uint64, v1.Recovery]
+	RecoverySequence collections.Sequence
+
+	baseAccountTypes []string
+
+	addrCodec     address.Codec
+	headerService header.Service
+	eventService  event.Service
+}
+
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if err := a.validateConfig(msg.Config); err != nil {
+		return nil, err
+	}
+	if _, err := a.Account.Init(ctx, &basev1.MsgInit{PubKey: msg.PubKey}); err != nil {
+		return nil, err
+	}
+	return &v1.MsgInitResponse{}, a.Config.Set(ctx, msg.Config)
+}
+
+func (a Account) validateConfig(config v1.RecoveryConfig) error {
+	if len(config.Guardians) == 0 {
+		return errors.New("guardians must be specified")
+	}
+	guardians := make(map[string]struct{}, len(config.Guardians)) // to check for duplicates
+	for _, guardian := range config.Guardians {
+		if _, err := a.addrCodec.StringToBytes(guardian); err != nil {
+			return fmt.Errorf("invalid guardian address %s: %w", guardian, err)
+		}
+		if _, ok := guardians[guardian]; ok {
+			return fmt.Errorf("duplicate guardian address %s", guardian)
+		}
+		guardians[guardian] = struct{}{}
+	}
+	if config.Threshold == 0 || int(config.Threshold) > len(config.Guardians) {
+		return fmt.Errorf("threshold must be between 1 and the number of guardians, got %d", config.Threshold)
+	}
+	if config.Delay < MinRecoveryDelay {
+		return fmt.Errorf("delay must be at least %s, got %s", MinRecoveryDelay, config.Delay)
+	}
+	return nil
+}
+
+// MigrateFromBase migrates a base account to a recoverable account. The pubkey and the
+// sequence of the base account are kept, as the recoverable account shares its state.
+// Only the owner can migrate it.
+func (a Account) MigrateFromBase(ctx context.Context, msg *v1.MsgMigrateFromBase) (*v1.MsgMigrateFromBaseResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+	if err := a.validateConfig(msg.Config); err != nil {
+		return nil, err
+	}
+	has, err := a.PubKey.Has(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errors.New("base account has no pubkey")
+	}
+	if err := a.Config.Set(ctx, msg.Config); err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("recovery_config_updated",
+		event.NewAttribute("threshold", fmt.Sprint(msg.Config.Threshold)),
+		event.NewAttribute("guardians", fmt.Sprint(len(msg.Config.Guardians))),
+		event.NewAttribute("delay", msg.Config.Delay.String()),
+	); err != nil {
+		return nil, err
+	}
+	return &v1.MsgMigrateFromBaseResponse{}, nil
+}
+
+// UpdateRecoveryConfig updates the recovery config of the account, cancelling the
+// pending recoveries. Only the owner can update it.
+func (a Account) UpdateRecoveryConfig(ctx context.Context, msg *v1.MsgUpdateRecoveryConfig) (*v1.MsgUpdateRecoveryConfigResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+	if err := a.validateConfig(msg.Config); err != nil {
+		return nil, err
+	}
+	if err := a.Config.Set(ctx, msg.Config); err != nil {
+		return nil, err
+	}
+	if err := a.Recoveries.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("recovery_config_updated",
+		event.NewAttribute("threshold", fmt.Sprint(msg.Config.Threshold)),
+		event.NewAttribute("guardians", fmt.Sprint(len(msg.Config.Guardians))),
+		event.NewAttribute("delay", msg.Config.Delay.String()),
+	); err != nil {
+		return nil, err
+	}
+	return &v1.MsgUpdateRecoveryConfigResponse{}, nil
+}
+
+// InitiateRecovery creates a recovery rotating the pubkey of the account, approved
+// by the guardian initiating it, which must not have too many pending recoveries.
+func (a Account) InitiateRecovery(ctx context.Context, msg *v1.MsgInitiateRecovery) (*v1.MsgInitiateRecoveryResponse, error) {
+	config, guardian, err := a.senderGuardian(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dcrd_secp256k1.ParsePubKey(msg.NewPubKey); err != nil {
+		return nil, err
+	}
+	initiated, err := a.countInitiatedRecoveries(ctx, guardian)
+	if err != nil {
+		return nil, err
+	}
+	if initiated >= MaxPendingRecoveriesPerGuardian {
+		return nil, fmt.Errorf("guardian %s cannot initiate more than %d pending recoveries", guardian, MaxPendingRecoveriesPerGuardian)
+	}
+
+	id, err := a.RecoverySequence.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	recovery := v1.Recovery{
+		Id:        id,
+		NewPubKey: msg.NewPubKey,
+		Initiator: guardian,
+		Approvals: []string{guardian},
+	}
+	a.checkApproved(ctx, config, &recovery)
+	if err := a.Recoveries.Set(ctx, id, recovery); err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("recovery_initiated",
+		event.NewAttribute("recovery_id", fmt.Sprint(id)),
+		event.NewAttribute("initiator", guardian),
+		event.NewAttribute("new_pub_key", fmt.Sprintf("%X", msg.NewPubKey)),
+	); err != nil {
+		return nil, err
+	}
+	return &v1.MsgInitiateRecoveryResponse{RecoveryId: id}, nil
+}
+
+// ApproveRecovery adds the approval of a guardian to a pending recovery.
+func (a Account) ApproveRecovery(ctx context.Context, msg *v1.MsgApproveRecovery) (*v1.MsgApproveRecoveryResponse, error) {
+	config, guardian, err := a.senderGuardian(ctx)
+	if err != nil {
+		return nil, err
+	}
+	recovery, err := a.getRecovery(ctx, msg.RecoveryId)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(recovery.Approvals, guardian) {
+		return nil, fmt.Errorf("guardian %s already approved recovery %d", guardian, msg.RecoveryId)
+	}
+
+	recovery.Approvals = append(recovery.Approvals, guardian)
+	a.checkApproved(ctx, config, &recovery)
+	if err := a.Recoveries.Set(ctx, recovery.Id, recovery); err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("recovery_approved",
+		event.NewAttribute("recovery_id", fmt.Sprint(recovery.Id)),
+		event.NewAttribute("guardian", guardian),
+		event.NewAttribute("approvals", fmt.Sprint(len(recovery.Approvals))),
+		event.NewAttribute("executable_at", formatTime(recovery.ExecutableAt)),
+	); err != nil {
+		return nil, err
+	}
+	return &v1.MsgApproveRecoveryResponse{}, nil
+}
+
+// CancelRecovery removes a pending recovery. Only the owner can cancel it, which
+// is how it vetoes the recoveries it did not ask for.
+func (a Account) CancelRecovery(ctx context.Context, msg *v1.MsgCancelRecovery) (*v1.MsgCancelRecoveryResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+	if _, err := a.getRecovery(ctx, msg.RecoveryId); err != nil {
+		return nil, err
+	}
+	if err := a.Recoveries.Remove(ctx, msg.RecoveryId); err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("recovery_cancelled",
+		event.NewAttribute("recovery_id", fmt.Sprint(msg.RecoveryId)),
+	); err != nil {
+		return nil, err
+	}
+	return &v1.MsgCancelRecoveryResponse{}, nil
+}
+
+// ExecuteRecovery rotates the pubkey of the account to the one of an approved
+// recovery whose delay elapsed, and removes the pending recoveries.
+func (a Account) ExecuteRecovery(ctx context.Context, msg *v1.MsgExecuteRecovery) (*v1.MsgExecuteRecoveryResponse, error) {
+	_, guardian, err := a.senderGuardian(ctx)
+	if err != nil {
+		return nil, err
+	}
+	recovery, err := a.getRecovery(ctx, msg.RecoveryId)
+	if err != nil {
+		return nil, err
+	}
+	if recovery.ExecutableAt == nil {
+		return nil, fmt.Errorf("recovery %d is not approved by enough guardians", recovery.Id)
+	}
+	if now := a.headerService.HeaderInfo(ctx).Time; now.Before(*recovery.ExecutableAt) {
+		return nil, fmt.Errorf("recovery %d cannot be executed before %s", recovery.Id, recovery.ExecutableAt)
+	}
+
+	if err := a.PubKey.Set(ctx, secp256k1.PubKey{Key: recovery.NewPubKey}); err != nil {
+		return nil, err
+	}
+	if err := a.Recoveries.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("recovery_executed",
+		event.NewAttribute("recovery_id", fmt.Sprint(recovery.Id)),
+		event.NewAttribute("executor", guardian),
+		event.NewAttribute("new_pub_key", fmt.Sprintf("%X", recovery.NewPubKey)),
+	); err != nil {
+		return nil, err
+	}
+	return &v1.MsgExecuteRecoveryResponse{}, nil
+}
+
+// senderGuardian returns the recovery config of the account and the address of the
+// sender, which must be one of the guardians.
+func (a Account) senderGuardian(ctx context.Context) (v1.RecoveryConfig, string, error) {
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return v1.RecoveryConfig{}, "", err
+	}
+	sender, err := a.addrCodec.BytesToString(accountstd.Sender(ctx))
+	if err != nil {
+		return v1.RecoveryConfig{}, "", err
+	}
+	if !slices.Contains(config.Guardians, sender) {
+		return v1.RecoveryConfig{}, "", errors.New("unauthorized: sender is not a guardian")
+	}
+	return config, sender, nil
+}
+
+func (a Account) getRecovery(ctx context.Context, id uint64) (v1.Recovery, error) {
+	recovery, err := a.Recoveries.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return v1.Recovery{}, fmt.Errorf("recovery %d not found", id)
+		}
+		return v1.Recovery{}, err
+	}
+	return recovery, nil
+}
+
+// countInitiatedRecoveries returns the number of pending recoveries initiated by the
+// guardian, the pending recoveries being bounded by the number of guardians.
+func (a Account) countInitiatedRecoveries(ctx context.Context, guardian string) (int, error) {
+	count := 0
+	err := a.Recoveries.Walk(ctx, nil, func(_ uint64, recovery v1.Recovery) (bool, error) {
+		if recovery.Initiator == guardian {
+			count++
+		}
+		return false, nil
+	})
+	return count, err
+}
+
+// checkApproved starts the delay of the recovery once it reaches the threshold of
+// approvals.
+func (a Account) checkApproved(ctx context.Context, config v1.RecoveryConfig, recovery *v1.Recovery) {
+	if recovery.ExecutableAt != nil || len(recovery.Approvals) < int(config.Threshold) {
+		return
+	}
+	executableAt := a.headerService.HeaderInfo(ctx).Time.Add(config.Delay)
+	recovery.ExecutableAt = &executableAt
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.String()
+}
+
+func (a Account) QueryRecoveryConfig(ctx context.Context, _ *v1.QueryRecoveryConfig) (*v1.QueryRecoveryConfigResponse, error) {
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryRecoveryConfigResponse{Config: config}, nil
+}
+
+func (a Account) QueryRecovery(ctx context.Context, msg *v1.QueryRecovery) (*v1.QueryRecoveryResponse, error) {
+	recovery, err := a.getRecovery(ctx, msg.RecoveryId)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryRecoveryResponse{Recovery: recovery}, nil
+}
+
+func (a Account) QueryPendingRecoveries(ctx context.Context, _ *v1.QueryPendingRecoveries) (*v1.QueryPendingRecoveriesResponse, error) {
+	iter, err := a.Recoveries.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	recoveries, err := iter.Values()
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryPendingRecoveriesResponse{Recoveries: recoveries}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	a.Account.RegisterExecuteHandlers(builder)
+	accountstd.RegisterExecuteHandler(builder, a.UpdateRecoveryConfig)
+	accountstd.RegisterExecuteHandler(builder, a.InitiateRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.ApproveRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.CancelRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.ExecuteRecovery)
+}
+
+func (a Account) RegisterMigrationHandlers(builder *accountstd.MigrationBuilder) {
+	for _, baseAccountType := range a.baseAccountTypes {
+		accountstd.RegisterMigrationHandler(builder, baseAccountType, a.MigrateFromBase)
+	}
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	a.Account.RegisterQueryHandlers(builder)
+	accountstd.RegisterQueryHandler(builder, a.QueryRecoveryConfig)
+	accountstd.RegisterQueryHandler(builder, a.QueryRecovery)
+	accountstd.RegisterQueryHandler(builder, a.QueryPendingRecoveries)
+}
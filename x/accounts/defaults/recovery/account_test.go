@@ -0,0 +1,265 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	v1 "cosmossdk.io/x/accounts/defaults/recovery/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+const selfAddr = "mock_recovery_account"
+
+func setupRecoveryAccount(t *testing.T, ss store.KVStoreService) Account {
+	t.Helper()
+	deps := makeMockDependencies(ss)
+	handler := directHandler{}
+
+	createAccFn := NewAccount("recovery", signing.NewHandlerMap(handler))
+	_, acc, err := createAccFn(deps)
+	recoveryAcc := acc.(Account)
+	require.NoError(t, err)
+
+	return recoveryAcc
+}
+
+func testConfig() v1.RecoveryConfig {
+	return v1.RecoveryConfig{
+		Guardians: []string{"guardian1", "guardian2", "guardian3"},
+		Threshold: 2,
+		Delay:     24 * time.Hour,
+	}
+}
+
+func asSender(ctx context.Context, sender string) context.Context {
+	return accountstd.SetSender(ctx, []byte(sender))
+}
+
+func TestInit(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupRecoveryAccount(t, ss)
+	pubKey := secp256k1.GenPrivKey().PubKey().Bytes()
+
+	testcases := []struct {
+		name   string
+		msg    *v1.MsgInit
+		expErr string
+	}{
+		{
+			"valid init",
+			&v1.MsgInit{PubKey: pubKey, Config: testConfig()},
+			"",
+		},
+		{
+			"invalid pubkey",
+			&v1.MsgInit{PubKey: []byte("invalid_pk"), Config: testConfig()},
+			"malformed public key",
+		},
+		{
+			"no guardians",
+			&v1.MsgInit{PubKey: pubKey, Config: v1.RecoveryConfig{Threshold: 1}},
+			"guardians must be specified",
+		},
+		{
+			"duplicate guardians",
+			&v1.MsgInit{PubKey: pubKey, Config: v1.RecoveryConfig{Guardians: []string{"guardian1", "guardian1"}, Threshold: 1}},
+			"duplicate guardian",
+		},
+		{
+			"zero threshold",
+			&v1.MsgInit{PubKey: pubKey, Config: v1.RecoveryConfig{Guardians: []string{"guardian1"}}},
+			"threshold must be between",
+		},
+		{
+			"threshold above guardians",
+			&v1.MsgInit{PubKey: pubKey, Config: v1.RecoveryConfig{Guardians: []string{"guardian1"}, Threshold: 2}},
+			"threshold must be between",
+		},
+		{
+			"negative delay",
+			&v1.MsgInit{PubKey: pubKey, Config: v1.RecoveryConfig{Guardians: []string{"guardian1"}, Threshold: 1, Delay: -time.Second}},
+			"delay must be at least",
+		},
+		{
+			"zero delay",
+			&v1.MsgInit{PubKey: pubKey, Config: v1.RecoveryConfig{Guardians: []string{"guardian1"}, Threshold: 1}},
+			"delay must be at least",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := acc.Init(ctx, tc.msg)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRecovery(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupRecoveryAccount(t, ss)
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: secp256k1.GenPrivKey().PubKey().Bytes(), Config: testConfig()})
+	require.NoError(t, err)
+	newPubKey := secp256k1.GenPrivKey().PubKey().Bytes()
+
+	// only guardians can initiate a recovery
+	_, err = acc.InitiateRecovery(asSender(ctx, "sender"), &v1.MsgInitiateRecovery{NewPubKey: newPubKey})
+	require.EqualError(t, err, "unauthorized: sender is not a guardian")
+	_, err = acc.InitiateRecovery(asSender(ctx, "guardian1"), &v1.MsgInitiateRecovery{NewPubKey: []byte("invalid_pk")})
+	require.Error(t, err)
+
+	res, err := acc.InitiateRecovery(asSender(ctx, "guardian1"), &v1.MsgInitiateRecovery{NewPubKey: newPubKey})
+	require.NoError(t, err)
+	id := res.RecoveryId
+
+	// the recovery cannot be executed until approved by the threshold of guardians
+	_, err = acc.ExecuteRecovery(asSender(ctx, "guardian1"), &v1.MsgExecuteRecovery{RecoveryId: id})
+	require.ErrorContains(t, err, "not approved by enough guardians")
+	_, err = acc.ApproveRecovery(asSender(ctx, "guardian1"), &v1.MsgApproveRecovery{RecoveryId: id})
+	require.ErrorContains(t, err, "already approved")
+
+	_, err = acc.ApproveRecovery(asSender(ctx, "guardian2"), &v1.MsgApproveRecovery{RecoveryId: id})
+	require.NoError(t, err)
+
+	recovery, err := acc.QueryRecovery(ctx, &v1.QueryRecovery{RecoveryId: id})
+	require.NoError(t, err)
+	require.Equal(t, []string{"guardian1", "guardian2"}, recovery.Recovery.Approvals)
+	require.Equal(t, testTime.Add(24*time.Hour), *recovery.Recovery.ExecutableAt)
+
+	// the recovery cannot be executed before the delay elapsed
+	_, err = acc.ExecuteRecovery(asSender(ctx, "guardian3"), &v1.MsgExecuteRecovery{RecoveryId: id})
+	require.ErrorContains(t, err, "cannot be executed before")
+
+	// a guardian cannot initiate another recovery while its recovery is pending
+	_, err = acc.InitiateRecovery(asSender(ctx, "guardian1"), &v1.MsgInitiateRecovery{NewPubKey: secp256k1.GenPrivKey().PubKey().Bytes()})
+	require.ErrorContains(t, err, "cannot initiate more than 1 pending recoveries")
+
+	// a concurrent recovery is cancelled by the execution
+	_, err = acc.InitiateRecovery(asSender(ctx, "guardian3"), &v1.MsgInitiateRecovery{NewPubKey: secp256k1.GenPrivKey().PubKey().Bytes()})
+	require.NoError(t, err)
+	pending, err := acc.QueryPendingRecoveries(ctx, &v1.QueryPendingRecoveries{})
+	require.NoError(t, err)
+	require.Len(t, pending.Recoveries, 2)
+
+	acc.headerService = headerService{time: testTime.Add(24 * time.Hour)}
+	_, err = acc.ExecuteRecovery(asSender(ctx, "sender"), &v1.MsgExecuteRecovery{RecoveryId: id})
+	require.EqualError(t, err, "unauthorized: sender is not a guardian")
+	_, err = acc.ExecuteRecovery(asSender(ctx, "guardian3"), &v1.MsgExecuteRecovery{RecoveryId: id})
+	require.NoError(t, err)
+
+	pubKey, err := acc.PubKey.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, newPubKey, pubKey.Key)
+
+	pending, err = acc.QueryPendingRecoveries(ctx, &v1.QueryPendingRecoveries{})
+	require.NoError(t, err)
+	require.Empty(t, pending.Recoveries)
+}
+
+func TestCancelRecovery(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupRecoveryAccount(t, ss)
+	pubKey := secp256k1.GenPrivKey().PubKey().Bytes()
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: pubKey, Config: testConfig()})
+	require.NoError(t, err)
+
+	res, err := acc.InitiateRecovery(asSender(ctx, "guardian1"), &v1.MsgInitiateRecovery{NewPubKey: secp256k1.GenPrivKey().PubKey().Bytes()})
+	require.NoError(t, err)
+	_, err = acc.ApproveRecovery(asSender(ctx, "guardian2"), &v1.MsgApproveRecovery{RecoveryId: res.RecoveryId})
+	require.NoError(t, err)
+
+	// only the owner can veto a recovery
+	_, err = acc.CancelRecovery(asSender(ctx, "guardian1"), &v1.MsgCancelRecovery{RecoveryId: res.RecoveryId})
+	require.Equal(t, errors.New("unauthorized"), err)
+	_, err = acc.CancelRecovery(asSender(ctx, selfAddr), &v1.MsgCancelRecovery{RecoveryId: res.RecoveryId})
+	require.NoError(t, err)
+	_, err = acc.CancelRecovery(asSender(ctx, selfAddr), &v1.MsgCancelRecovery{RecoveryId: res.RecoveryId})
+	require.ErrorContains(t, err, "not found")
+
+	acc.headerService = headerService{time: testTime.Add(24 * time.Hour)}
+	_, err = acc.ExecuteRecovery(asSender(ctx, "guardian1"), &v1.MsgExecuteRecovery{RecoveryId: res.RecoveryId})
+	require.ErrorContains(t, err, "not found")
+
+	gotPubKey, err := acc.PubKey.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, pubKey, gotPubKey.Key)
+}
+
+func TestMigrateFromBase(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	_, baseAcc, err := base.NewAccount("base", signing.NewHandlerMap(directHandler{}))(makeMockDependencies(ss))
+	require.NoError(t, err)
+	pubKey := secp256k1.GenPrivKey().PubKey().Bytes()
+	_, err = baseAcc.(base.Account).Init(ctx, &basev1.MsgInit{PubKey: pubKey})
+	require.NoError(t, err)
+
+	acc := setupRecoveryAccount(t, ss)
+	_, err = acc.MigrateFromBase(asSender(ctx, "guardian1"), &v1.MsgMigrateFromBase{Config: testConfig()})
+	require.Equal(t, errors.New("unauthorized"), err)
+	_, err = acc.MigrateFromBase(asSender(ctx, selfAddr), &v1.MsgMigrateFromBase{Config: v1.RecoveryConfig{Threshold: 1}})
+	require.ErrorContains(t, err, "guardians must be specified")
+	_, err = acc.MigrateFromBase(asSender(ctx, selfAddr), &v1.MsgMigrateFromBase{Config: testConfig()})
+	require.NoError(t, err)
+
+	// the pubkey of the base account is kept
+	gotPubKey, err := acc.PubKey.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, pubKey, gotPubKey.Key)
+	config, err := acc.QueryRecoveryConfig(ctx, &v1.QueryRecoveryConfig{})
+	require.NoError(t, err)
+	require.Equal(t, testConfig(), config.Config)
+
+	// an uninitialized account cannot be migrated
+	ctx, ss = newMockContext(t)
+	acc = setupRecoveryAccount(t, ss)
+	_, err = acc.MigrateFromBase(asSender(ctx, selfAddr), &v1.MsgMigrateFromBase{Config: testConfig()})
+	require.ErrorContains(t, err, "no pubkey")
+}
+
+func TestUpdateRecoveryConfig(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupRecoveryAccount(t, ss)
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: secp256k1.GenPrivKey().PubKey().Bytes(), Config: testConfig()})
+	require.NoError(t, err)
+
+	_, err = acc.InitiateRecovery(asSender(ctx, "guardian1"), &v1.MsgInitiateRecovery{NewPubKey: secp256k1.GenPrivKey().PubKey().Bytes()})
+	require.NoError(t, err)
+
+	newConfig := v1.RecoveryConfig{Guardians: []string{"guardian4"}, Threshold: 1, Delay: time.Hour}
+	_, err = acc.UpdateRecoveryConfig(asSender(ctx, "guardian1"), &v1.MsgUpdateRecoveryConfig{Config: newConfig})
+	require.Equal(t, errors.New("unauthorized"), err)
+	_, err = acc.UpdateRecoveryConfig(asSender(ctx, selfAddr), &v1.MsgUpdateRecoveryConfig{Config: newConfig})
+	require.NoError(t, err)
+
+	config, err := acc.QueryRecoveryConfig(ctx, &v1.QueryRecoveryConfig{})
+	require.NoError(t, err)
+	require.Equal(t, newConfig, config.Config)
+
+	// the pending recoveries are cancelled and the former guardians lose their rights
+	pending, err := acc.QueryPendingRecoveries(ctx, &v1.QueryPendingRecoveries{})
+	require.NoError(t, err)
+	require.Empty(t, pending.Recoveries)
+	_, err = acc.InitiateRecovery(asSender(ctx, "guardian1"), &v1.MsgInitiateRecovery{NewPubKey: secp256k1.GenPrivKey().PubKey().Bytes()})
+	require.EqualError(t, err, "unauthorized: sender is not a guardian")
+
+	// a single approval is enough with the new threshold
+	res, err := acc.InitiateRecovery(asSender(ctx, "guardian4"), &v1.MsgInitiateRecovery{NewPubKey: secp256k1.GenPrivKey().PubKey().Bytes()})
+	require.NoError(t, err)
+	recovery, err := acc.QueryRecovery(ctx, &v1.QueryRecovery{RecoveryId: res.RecoveryId})
+	require.NoError(t, err)
+	require.Equal(t, testTime.Add(time.Hour), *recovery.Recovery.ExecutableAt)
+}
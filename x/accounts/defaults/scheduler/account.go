@@ -0,0 +1,405 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	epochsv1beta1 "cosmossdk.io/api/cosmos/epochs/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/scheduler/v1"
+	scheduler_interface_v1 "cosmossdk.io/x/accounts/interfaces/scheduler/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var (
+	OwnerPrefix          = collections.NewPrefix(0)
+	IntentSequencePrefix = collections.NewPrefix(1)
+	IntentsPrefix        = collections.NewPrefix(2)
+	ResultsPrefix        = collections.NewPrefix(3)
+)
+
+const (
+	// MaxPendingIntents is the maximum number of pending intents of an account.
+	MaxPendingIntents = 100
+	// MaxIntentGasLimit is the maximum gas limit of an intent.
+	MaxIntentGasLimit = 5_000_000
+)
+
+// ErrIntentNotFound is returned when an intent is not pending on the account.
+var ErrIntentNotFound = errors.New("intent not found")
+
+func NewAccount(name string) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		return name, Account{
+			Owner:          collections.NewItem(deps.SchemaBuilder, OwnerPrefix, "owner", collections.BytesValue),
+			IntentSequence: collections.NewSequence(deps.SchemaBuilder, IntentSequencePrefix, "intent_sequence"),
+			Intents: collections.NewMap(deps.SchemaBuilder, IntentsPrefix, "intents", collections.Uint64Key,
+				codec.CollValue[v1.Intent](deps.LegacyStateCodec)),
+			Results: collections.NewMap(deps.SchemaBuilder, ResultsPrefix, "results", collections.Uint64Key,
+				codec.CollValue[v1.IntentResult](deps.LegacyStateCodec)),
+			addrCodec:     deps.AddressCodec,
+			headerService: deps.Environment.HeaderService,
+			eventService:  deps.Environment.EventService,
+		}, nil
+	}
+}
+
+// Account implements a scheduler, which executes messages on its own behalf once
+// a trigger holds, such as a block height, a block time or an epoch tick. The
+// messages are executed by the accounts module at the end of the block, and the
+// outcome of each intent is kept in the account state.
+type Account struct {
+	Owner          collections.Item[[]byte]
+	IntentSequence collections.Sequence
+	Intents        collections.Map[uint64, v1.Intent]
+	Results        collections.Map[uint64, v1.IntentResult]
+
+	addrCodec     address.Codec
+	headerService header.Service
+	eventService  event.Service
+}
+
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	owner, err := a.addrCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, fmt.Errorf("invalid owner address: %w", err)
+	}
+
+	return &v1.MsgInitResponse{}, a.Owner.Set(ctx, owner)
+}
+
+func (a Account) ScheduleIntent(ctx context.Context, msg *v1.MsgScheduleIntent) (*v1.MsgScheduleIntentResponse, error) {
+	if err := a.checkOwner(ctx); err != nil {
+		return nil, err
+	}
+	if err := a.validateIntent(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	pending, err := a.countIntents(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if pending >= MaxPendingIntents {
+		return nil, fmt.Errorf("too many pending intents, maximum is %d", MaxPendingIntents)
+	}
+
+	id, err := a.IntentSequence.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	intent := v1.Intent{
+		Id:       id,
+		Trigger:  msg.Trigger,
+		Messages: msg.Messages,
+		GasLimit: msg.GasLimit,
+		Deadline: msg.Deadline,
+	}
+	if err := a.Intents.Set(ctx, id, intent); err != nil {
+		return nil, err
+	}
+
+	// have the accounts module collect the due intents of the account at the end of the blocks.
+	self, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := accountstd.ExecModule[accountsv1.MsgScheduleAccountResponse](ctx, &accountsv1.MsgScheduleAccount{Account: self}); err != nil {
+		return nil, fmt.Errorf("unable to schedule account: %w", err)
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("intent_scheduled",
+		event.NewAttribute("id", strconv.FormatUint(id, 10)),
+		event.NewAttribute("trigger", intent.Trigger.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgScheduleIntentResponse{Id: id}, nil
+}
+
+func (a Account) CancelIntent(ctx context.Context, msg *v1.MsgCancelIntent) (*v1.MsgCancelIntentResponse, error) {
+	if err := a.checkOwner(ctx); err != nil {
+		return nil, err
+	}
+
+	has, err := a.Intents.Has(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("%w: %d", ErrIntentNotFound, msg.Id)
+	}
+
+	return &v1.MsgCancelIntentResponse{}, a.closeIntent(ctx, v1.IntentResult{Id: msg.Id, Status: v1.IntentStatus_INTENT_STATUS_CANCELLED})
+}
+
+func (a Account) ExecuteMessages(ctx context.Context, msg *v1.MsgExecuteMessages) (*v1.MsgExecuteMessagesResponse, error) {
+	if err := a.checkOwner(ctx); err != nil {
+		return nil, err
+	}
+
+	responses, err := accountstd.ExecModuleAnys(ctx, msg.Messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgExecuteMessagesResponse{Responses: responses}, nil
+}
+
+// CollectDueIntents hands the intents whose trigger holds over to the accounts module,
+// in the order they were scheduled, as long as the sum of their gas limits fits in the
+// provided maximum. The intents whose deadline passed are expired.
+func (a Account) CollectDueIntents(ctx context.Context, msg *scheduler_interface_v1.MsgCollectDueIntents) (*scheduler_interface_v1.MsgCollectDueIntentsResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	var (
+		intents       []v1.Intent
+		expired, due  []uint64
+		pending, used uint64
+		full          bool
+	)
+	now := a.headerService.HeaderInfo(ctx).Time
+	err := a.Intents.Walk(ctx, nil, func(id uint64, intent v1.Intent) (bool, error) {
+		if intent.Deadline != nil && !now.Before(*intent.Deadline) {
+			expired = append(expired, id)
+			return false, nil
+		}
+		// once an intent does not fit, the next ones wait as well to preserve ordering.
+		if !full && a.triggerHolds(ctx, intent.Trigger) {
+			if used+intent.GasLimit <= msg.MaxGas {
+				used += intent.GasLimit
+				intents = append(intents, intent)
+				due = append(due, id)
+				return false, nil
+			}
+			full = true
+		}
+		pending++
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range expired {
+		if err := a.closeIntent(ctx, v1.IntentResult{Id: id, Status: v1.IntentStatus_INTENT_STATUS_EXPIRED}); err != nil {
+			return nil, err
+		}
+	}
+	resp := &scheduler_interface_v1.MsgCollectDueIntentsResponse{Pending: pending}
+	for i, id := range due {
+		if err := a.Intents.Remove(ctx, id); err != nil {
+			return nil, err
+		}
+		resp.Intents = append(resp.Intents, scheduler_interface_v1.DueIntent{
+			Id:       id,
+			Messages: intents[i].Messages,
+			GasLimit: intents[i].GasLimit,
+		})
+	}
+
+	return resp, nil
+}
+
+// ReportIntentResult records the outcome of the execution of a collected intent.
+func (a Account) ReportIntentResult(ctx context.Context, msg *scheduler_interface_v1.MsgReportIntentResult) (*scheduler_interface_v1.MsgReportIntentResultResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	result := v1.IntentResult{
+		Id:        msg.Id,
+		Status:    v1.IntentStatus_INTENT_STATUS_EXECUTED,
+		GasUsed:   msg.GasUsed,
+		Responses: msg.Responses,
+	}
+	if msg.Error != "" {
+		result.Status = v1.IntentStatus_INTENT_STATUS_FAILED
+		result.Error = msg.Error
+		result.Responses = nil
+	}
+
+	return &scheduler_interface_v1.MsgReportIntentResultResponse{}, a.closeIntent(ctx, result)
+}
+
+func (a Account) QueryOwner(ctx context.Context, _ *v1.QueryOwner) (*v1.QueryOwnerResponse, error) {
+	owner, err := a.Owner.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ownerAddr, err := a.addrCodec.BytesToString(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryOwnerResponse{Owner: ownerAddr}, nil
+}
+
+func (a Account) QueryIntents(ctx context.Context, _ *v1.QueryIntents) (*v1.QueryIntentsResponse, error) {
+	var intents []v1.Intent
+	err := a.Intents.Walk(ctx, nil, func(_ uint64, intent v1.Intent) (bool, error) {
+		intents = append(intents, intent)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryIntentsResponse{Intents: intents}, nil
+}
+
+func (a Account) QueryIntentResult(ctx context.Context, req *v1.QueryIntentResult) (*v1.QueryIntentResultResponse, error) {
+	result, err := a.Results.Get(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryIntentResultResponse{Result: result}, nil
+}
+
+func (a Account) QueryIntentResults(ctx context.Context, _ *v1.QueryIntentResults) (*v1.QueryIntentResultsResponse, error) {
+	var results []v1.IntentResult
+	err := a.Results.Walk(ctx, nil, func(_ uint64, result v1.IntentResult) (bool, error) {
+		results = append(results, result)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryIntentResultsResponse{Results: results}, nil
+}
+
+// triggerHolds returns if the trigger of an intent holds in the current block. An
+// epoch trigger does not hold if the epoch cannot be queried.
+func (a Account) triggerHolds(ctx context.Context, trigger v1.Trigger) bool {
+	headerInfo := a.headerService.HeaderInfo(ctx)
+	switch {
+	case trigger.Height != 0:
+		return headerInfo.Height >= trigger.Height
+	case trigger.Time != nil:
+		return !headerInfo.Time.Before(*trigger.Time)
+	case trigger.Epoch != nil:
+		epoch, err := a.currentEpoch(ctx, trigger.Epoch.Identifier)
+		return err == nil && epoch >= trigger.Epoch.Number
+	default:
+		return false
+	}
+}
+
+func (a Account) currentEpoch(ctx context.Context, identifier string) (int64, error) {
+	resp, err := accountstd.QueryModule[epochsv1beta1.QueryCurrentEpochResponse](ctx, &epochsv1beta1.QueryCurrentEpochRequest{
+		Identifier: identifier,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.CurrentEpoch, nil
+}
+
+// closeIntent removes an intent from the pending intents and records its result.
+func (a Account) closeIntent(ctx context.Context, result v1.IntentResult) error {
+	headerInfo := a.headerService.HeaderInfo(ctx)
+	result.Height = headerInfo.Height
+	result.Time = headerInfo.Time
+
+	if err := a.Intents.Remove(ctx, result.Id); err != nil {
+		return err
+	}
+	if err := a.Results.Set(ctx, result.Id, result); err != nil {
+		return err
+	}
+
+	return a.eventService.EventManager(ctx).EmitKV("intent_closed",
+		event.NewAttribute("id", strconv.FormatUint(result.Id, 10)),
+		event.NewAttribute("status", result.Status.String()),
+		event.NewAttribute("error", result.Error),
+	)
+}
+
+func (a Account) countIntents(ctx context.Context) (uint64, error) {
+	var count uint64
+	err := a.Intents.Walk(ctx, nil, func(uint64, v1.Intent) (bool, error) {
+		count++
+		return false, nil
+	})
+	return count, err
+}
+
+func (a Account) checkOwner(ctx context.Context) error {
+	owner, err := a.Owner.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !accountstd.HasSender(ctx, owner) {
+		return errors.New("unauthorized: sender is not the owner")
+	}
+	return nil
+}
+
+func (a Account) validateIntent(ctx context.Context, msg *v1.MsgScheduleIntent) error {
+	if len(msg.Messages) == 0 {
+		return errors.New("intent must have at least one message")
+	}
+	if msg.GasLimit == 0 || msg.GasLimit > MaxIntentGasLimit {
+		return fmt.Errorf("gas limit must be between 1 and %d", MaxIntentGasLimit)
+	}
+	if msg.Deadline != nil && !msg.Deadline.After(a.headerService.HeaderInfo(ctx).Time) {
+		return errors.New("deadline must be in the future")
+	}
+
+	trigger := msg.Trigger
+	set := 0
+	if trigger.Height != 0 {
+		if trigger.Height < 0 {
+			return errors.New("trigger height must be positive")
+		}
+		set++
+	}
+	if trigger.Time != nil {
+		set++
+	}
+	if trigger.Epoch != nil {
+		if trigger.Epoch.Identifier == "" {
+			return errors.New("trigger epoch identifier cannot be empty")
+		}
+		if _, err := a.currentEpoch(ctx, trigger.Epoch.Identifier); err != nil {
+			return fmt.Errorf("unknown epoch %s: %w", trigger.Epoch.Identifier, err)
+		}
+		set++
+	}
+	if set != 1 {
+		return errors.New("trigger must have exactly one condition set")
+	}
+	return nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.ScheduleIntent)
+	accountstd.RegisterExecuteHandler(builder, a.CancelIntent)
+	accountstd.RegisterExecuteHandler(builder, a.ExecuteMessages)
+	accountstd.RegisterExecuteHandler(builder, a.CollectDueIntents)
+	accountstd.RegisterExecuteHandler(builder, a.ReportIntentResult)
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QueryOwner)
+	accountstd.RegisterQueryHandler(builder, a.QueryIntents)
+	accountstd.RegisterQueryHandler(builder, a.QueryIntentResult)
+	accountstd.RegisterQueryHandler(builder, a.QueryIntentResults)
+}
@@ -0,0 +1,247 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/scheduler/v1"
+	scheduler_interface_v1 "cosmossdk.io/x/accounts/interfaces/scheduler/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const owner = "owner"
+
+func setupSchedulerAccount(t *testing.T, ctx context.Context, ss store.KVStoreService, info *header.Info) Account {
+	t.Helper()
+	deps := makeMockDependencies(ss, headerService{info: info})
+
+	_, acc, err := NewAccount("scheduler")(deps)
+	require.NoError(t, err)
+
+	_, err = acc.(Account).Init(ctx, &v1.MsgInit{Owner: owner})
+	require.NoError(t, err)
+	return acc.(Account)
+}
+
+func asSender(ctx context.Context, sender string) context.Context {
+	return accountstd.SetSender(ctx, []byte(sender))
+}
+
+func asAccountsModule(ctx context.Context) context.Context {
+	return accountstd.SetSender(ctx, address.Module("accounts"))
+}
+
+func scheduleIntent(trigger v1.Trigger, gasLimit uint64) *v1.MsgScheduleIntent {
+	return &v1.MsgScheduleIntent{
+		Trigger:  trigger,
+		Messages: []*codectypes.Any{{TypeUrl: "/cosmos.bank.v1beta1.MsgSend"}},
+		GasLimit: gasLimit,
+	}
+}
+
+func TestScheduleIntent(t *testing.T) {
+	ctx, ss := newMockContext(t, epochs{"day": 1})
+	info := &header.Info{Height: 1, Time: testTime}
+	acc := setupSchedulerAccount(t, ctx, ss, info)
+	past, future := testTime.Add(-time.Hour), testTime.Add(time.Hour)
+
+	testcases := []struct {
+		name   string
+		msg    *v1.MsgScheduleIntent
+		expErr string
+	}{
+		{
+			"height trigger",
+			scheduleIntent(v1.Trigger{Height: 10}, 100),
+			"",
+		},
+		{
+			"time trigger with deadline",
+			&v1.MsgScheduleIntent{
+				Trigger:  v1.Trigger{Time: &future},
+				Messages: []*codectypes.Any{{TypeUrl: "/cosmos.bank.v1beta1.MsgSend"}},
+				GasLimit: 100,
+				Deadline: &future,
+			},
+			"",
+		},
+		{
+			"epoch trigger",
+			scheduleIntent(v1.Trigger{Epoch: &v1.EpochTrigger{Identifier: "day", Number: 2}}, 100),
+			"",
+		},
+		{
+			"unknown epoch",
+			scheduleIntent(v1.Trigger{Epoch: &v1.EpochTrigger{Identifier: "week", Number: 2}}, 100),
+			"unknown epoch week",
+		},
+		{
+			"no condition",
+			scheduleIntent(v1.Trigger{}, 100),
+			"exactly one condition",
+		},
+		{
+			"multiple conditions",
+			scheduleIntent(v1.Trigger{Height: 10, Time: &future}, 100),
+			"exactly one condition",
+		},
+		{
+			"no messages",
+			&v1.MsgScheduleIntent{Trigger: v1.Trigger{Height: 10}, GasLimit: 100},
+			"at least one message",
+		},
+		{
+			"zero gas limit",
+			scheduleIntent(v1.Trigger{Height: 10}, 0),
+			"gas limit must be between",
+		},
+		{
+			"gas limit too high",
+			scheduleIntent(v1.Trigger{Height: 10}, MaxIntentGasLimit+1),
+			"gas limit must be between",
+		},
+		{
+			"past deadline",
+			&v1.MsgScheduleIntent{
+				Trigger:  v1.Trigger{Height: 10},
+				Messages: []*codectypes.Any{{TypeUrl: "/cosmos.bank.v1beta1.MsgSend"}},
+				GasLimit: 100,
+				Deadline: &past,
+			},
+			"deadline must be in the future",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := acc.ScheduleIntent(asSender(ctx, owner), tc.msg)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	_, err := acc.ScheduleIntent(asSender(ctx, "user"), scheduleIntent(v1.Trigger{Height: 10}, 100))
+	require.EqualError(t, err, "unauthorized: sender is not the owner")
+
+	intents, err := acc.QueryIntents(ctx, &v1.QueryIntents{})
+	require.NoError(t, err)
+	require.Len(t, intents.Intents, 3)
+}
+
+func TestCollectDueIntents(t *testing.T) {
+	currentEpochs := epochs{"day": 1}
+	ctx, ss := newMockContext(t, currentEpochs)
+	info := &header.Info{Height: 1, Time: testTime}
+	acc := setupSchedulerAccount(t, ctx, ss, info)
+	ownerCtx := asSender(ctx, owner)
+	later, deadline := testTime.Add(time.Hour), testTime.Add(2*time.Hour)
+
+	// 0: at height 10, 1: after an hour, 2: on day 2, 3: at height 100 unless the deadline passed
+	_, err := acc.ScheduleIntent(ownerCtx, scheduleIntent(v1.Trigger{Height: 10}, 300))
+	require.NoError(t, err)
+	_, err = acc.ScheduleIntent(ownerCtx, scheduleIntent(v1.Trigger{Time: &later}, 300))
+	require.NoError(t, err)
+	_, err = acc.ScheduleIntent(ownerCtx, scheduleIntent(v1.Trigger{Epoch: &v1.EpochTrigger{Identifier: "day", Number: 2}}, 300))
+	require.NoError(t, err)
+	expiring := scheduleIntent(v1.Trigger{Height: 100}, 300)
+	expiring.Deadline = &deadline
+	_, err = acc.ScheduleIntent(ownerCtx, expiring)
+	require.NoError(t, err)
+
+	// only the accounts module collects the intents
+	_, err = acc.CollectDueIntents(ownerCtx, &scheduler_interface_v1.MsgCollectDueIntents{MaxGas: 1000})
+	require.ErrorContains(t, err, "unauthorized")
+
+	moduleCtx := asAccountsModule(ctx)
+	resp, err := acc.CollectDueIntents(moduleCtx, &scheduler_interface_v1.MsgCollectDueIntents{MaxGas: 1000})
+	require.NoError(t, err)
+	require.Empty(t, resp.Intents)
+	require.Equal(t, uint64(4), resp.Pending)
+
+	// the height and time triggers hold, but only one fits in the gas
+	info.Height, info.Time = 10, later
+	resp, err = acc.CollectDueIntents(moduleCtx, &scheduler_interface_v1.MsgCollectDueIntents{MaxGas: 500})
+	require.NoError(t, err)
+	require.Len(t, resp.Intents, 1)
+	require.Equal(t, uint64(0), resp.Intents[0].Id)
+	require.Equal(t, uint64(3), resp.Pending)
+
+	resp, err = acc.CollectDueIntents(moduleCtx, &scheduler_interface_v1.MsgCollectDueIntents{MaxGas: 500})
+	require.NoError(t, err)
+	require.Len(t, resp.Intents, 1)
+	require.Equal(t, uint64(1), resp.Intents[0].Id)
+	require.Equal(t, uint64(2), resp.Pending)
+
+	// the results are reported by the accounts module
+	_, err = acc.ReportIntentResult(ownerCtx, &scheduler_interface_v1.MsgReportIntentResult{Id: 0})
+	require.ErrorContains(t, err, "unauthorized")
+	_, err = acc.ReportIntentResult(moduleCtx, &scheduler_interface_v1.MsgReportIntentResult{Id: 0, GasUsed: 120})
+	require.NoError(t, err)
+	_, err = acc.ReportIntentResult(moduleCtx, &scheduler_interface_v1.MsgReportIntentResult{Id: 1, GasUsed: 300, Error: "out of gas"})
+	require.NoError(t, err)
+
+	result, err := acc.QueryIntentResult(ctx, &v1.QueryIntentResult{Id: 0})
+	require.NoError(t, err)
+	require.Equal(t, v1.IntentStatus_INTENT_STATUS_EXECUTED, result.Result.Status)
+	require.Equal(t, uint64(120), result.Result.GasUsed)
+	require.Equal(t, int64(10), result.Result.Height)
+	result, err = acc.QueryIntentResult(ctx, &v1.QueryIntentResult{Id: 1})
+	require.NoError(t, err)
+	require.Equal(t, v1.IntentStatus_INTENT_STATUS_FAILED, result.Result.Status)
+	require.Equal(t, "out of gas", result.Result.Error)
+
+	// the deadline passes, then the epoch ticks
+	info.Height, info.Time = 11, deadline
+	resp, err = acc.CollectDueIntents(moduleCtx, &scheduler_interface_v1.MsgCollectDueIntents{MaxGas: 500})
+	require.NoError(t, err)
+	require.Empty(t, resp.Intents)
+	require.Equal(t, uint64(1), resp.Pending)
+	result, err = acc.QueryIntentResult(ctx, &v1.QueryIntentResult{Id: 3})
+	require.NoError(t, err)
+	require.Equal(t, v1.IntentStatus_INTENT_STATUS_EXPIRED, result.Result.Status)
+
+	currentEpochs["day"] = 2
+	resp, err = acc.CollectDueIntents(moduleCtx, &scheduler_interface_v1.MsgCollectDueIntents{MaxGas: 500})
+	require.NoError(t, err)
+	require.Len(t, resp.Intents, 1)
+	require.Equal(t, uint64(2), resp.Intents[0].Id)
+	require.Equal(t, uint64(0), resp.Pending)
+
+	results, err := acc.QueryIntentResults(ctx, &v1.QueryIntentResults{})
+	require.NoError(t, err)
+	require.Len(t, results.Results, 3)
+}
+
+func TestCancelIntent(t *testing.T) {
+	ctx, ss := newMockContext(t, epochs{})
+	info := &header.Info{Height: 1, Time: testTime}
+	acc := setupSchedulerAccount(t, ctx, ss, info)
+
+	resp, err := acc.ScheduleIntent(asSender(ctx, owner), scheduleIntent(v1.Trigger{Height: 10}, 100))
+	require.NoError(t, err)
+
+	_, err = acc.CancelIntent(asSender(ctx, "user"), &v1.MsgCancelIntent{Id: resp.Id})
+	require.EqualError(t, err, "unauthorized: sender is not the owner")
+	_, err = acc.CancelIntent(asSender(ctx, owner), &v1.MsgCancelIntent{Id: resp.Id})
+	require.NoError(t, err)
+	_, err = acc.CancelIntent(asSender(ctx, owner), &v1.MsgCancelIntent{Id: resp.Id})
+	require.ErrorIs(t, err, ErrIntentNotFound)
+
+	_, err = acc.Intents.Get(ctx, resp.Id)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	result, err := acc.QueryIntentResult(ctx, &v1.QueryIntentResult{Id: resp.Id})
+	require.NoError(t, err)
+	require.Equal(t, v1.IntentStatus_INTENT_STATUS_CANCELLED, result.Result.Status)
+}
@@ -13,10 +13,15 @@ import (
 	baseaccount "cosmossdk.io/x/accounts/defaults/base"
 	"cosmossdk.io/x/accounts/defaults/lockup"
 	"cosmossdk.io/x/accounts/defaults/multisig"
+	passkeyaccount "cosmossdk.io/x/accounts/defaults/passkey"
+	paymasteraccount "cosmossdk.io/x/accounts/defaults/paymaster"
+	recoveryaccount "cosmossdk.io/x/accounts/defaults/recovery"
+	scheduleraccount "cosmossdk.io/x/accounts/defaults/scheduler"
 	"cosmossdk.io/x/tx/signing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	addresstypes "github.com/cosmos/cosmos-sdk/types/address"
 )
 
 var _ depinject.OnePerModuleType = AppModule{}
@@ -34,6 +39,7 @@ func init() {
 type ModuleInputs struct {
 	depinject.In
 
+	Config       *modulev1.Module
 	Cdc          codec.Codec
 	Environment  appmodule.Environment
 	AddressCodec address.Codec
@@ -65,8 +71,26 @@ func (s directHandler) GetSignBytes(_ context.Context, _ signing.SignerData, _ s
 func ProvideModule(in ModuleInputs) ModuleOutputs {
 	handler := directHandler{}
 	account := baseaccount.NewAccount("base", signing.NewHandlerMap(handler))
+	passkeyAccount := passkeyaccount.NewAccount("passkey", signing.NewHandlerMap(handler))
+	recoveryAccount := recoveryaccount.NewAccount("recovery", signing.NewHandlerMap(handler), "base")
+
+	// default to governance authority if not provided
+	authority := addresstypes.Module("gov")
+	if in.Config.Authority != "" {
+		var err error
+		if authority, err = in.AddressCodec.StringToBytes(in.Config.Authority); err != nil {
+			authority = addresstypes.Module(in.Config.Authority)
+		}
+	}
+	authorityAddr, err := in.AddressCodec.BytesToString(authority)
+	if err != nil {
+		panic(err)
+	}
+
 	accountskeeper, err := NewKeeper(
-		in.Cdc, in.Environment, in.AddressCodec, in.Registry, account,
+		in.Cdc, in.Environment, in.AddressCodec, in.Registry, authorityAddr, account, passkeyAccount, recoveryAccount,
+		paymasteraccount.NewAccount("paymaster"),
+		scheduleraccount.NewAccount("scheduler"),
 		accountstd.AddAccount(lockup.CONTINUOUS_LOCKING_ACCOUNT, lockup.NewContinuousLockingAccount),
 		accountstd.AddAccount(lockup.PERIODIC_LOCKING_ACCOUNT, lockup.NewPeriodicLockingAccount),
 		accountstd.AddAccount(lockup.DELAYED_LOCKING_ACCOUNT, lockup.NewDelayedLockingAccount),
@@ -2,6 +2,7 @@ package accounts
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
@@ -9,9 +10,13 @@ import (
 )
 
 func (k Keeper) ExportState(ctx context.Context) (*v1.GenesisState, error) {
-	genState := &v1.GenesisState{}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genState := &v1.GenesisState{Params: params}
 
-	err := k.AccountsByType.Walk(ctx, nil, func(accAddr []byte, accType string) (stop bool, err error) {
+	err = k.AccountsByType.Walk(ctx, nil, func(accAddr []byte, accType string) (stop bool, err error) {
 		accNum, err := k.AccountByNumber.Get(ctx, accAddr)
 		if err != nil {
 			return true, err
@@ -52,12 +57,27 @@ func (k Keeper) exportAccount(ctx context.Context, addr []byte, accType string,
 	if err != nil {
 		return nil, err
 	}
+	migrations, err := k.AccountMigrations.Get(ctx, addr)
+	switch {
+	case err == nil:
+		account.Migrations = migrations.Migrations
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
 	return account, nil
 }
 
 func (k Keeper) ImportState(ctx context.Context, genState *v1.GenesisState) error {
+	err := genState.Params.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+	err = k.Params.Set(ctx, genState.Params)
+	if err != nil {
+		return err
+	}
+
 	lastAccountNumber := uint64(0)
-	var err error
 	// import accounts
 	for _, acc := range genState.Accounts {
 		err = k.importAccount(ctx, acc)
@@ -94,7 +114,7 @@ func (k Keeper) ImportState(ctx context.Context, genState *v1.GenesisState) erro
 
 func (k Keeper) importAccount(ctx context.Context, acc *v1.GenesisAccount) error {
 	// Check if the account type exists in the registered accounts
-	_, ok := k.accounts[acc.AccountType]
+	impl, ok := k.accounts[acc.AccountType]
 	if !ok {
 		// If the account type does not exist, return an error
 		return fmt.Errorf("account type %s not found in the registered accounts", acc.AccountType)
@@ -118,5 +138,11 @@ func (k Keeper) importAccount(ctx context.Context, acc *v1.GenesisAccount) error
 			return err
 		}
 	}
-	return nil
+	if len(acc.Migrations) != 0 {
+		err = k.AccountMigrations.Set(ctx, addrBytes, v1.AccountMigrations{Migrations: acc.Migrations})
+		if err != nil {
+			return err
+		}
+	}
+	return k.maybeScheduleAccount(ctx, addrBytes, impl)
 }
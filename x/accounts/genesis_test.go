@@ -1,11 +1,13 @@
 package accounts
 
 import (
+	"context"
 	"testing"
 
 	"github.com/cosmos/gogoproto/types"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/x/accounts/accountstd"
 	"cosmossdk.io/x/accounts/internal/implementation"
 	v1 "cosmossdk.io/x/accounts/v1"
 )
@@ -127,3 +129,33 @@ func TestImportAccountError(t *testing.T) {
 	// Assert that the error message contains the expected substring
 	require.Contains(t, err.Error(), "account type non-existent-type not found in the registered accounts")
 }
+
+func TestGenesis_AccountMigrations(t *testing.T) {
+	newTestKeeper := func() (Keeper, context.Context) {
+		return newKeeper(t, accountstd.AddAccount("test", NewTestAccount), accountstd.AddAccount("test_v2", NewTestAccountV2))
+	}
+	k, ctx := newTestKeeper()
+
+	_, addr, err := k.Init(ctx, "test", []byte("sender"), &types.Empty{}, nil)
+	require.NoError(t, err)
+	_, err = k.MigrateAccount(ctx, addr, []byte("sender"), "test_v2", &types.UInt64Value{Value: 3})
+	require.NoError(t, err)
+
+	state, err := k.ExportState(ctx)
+	require.NoError(t, err)
+	require.Len(t, state.Accounts, 1)
+	require.Equal(t, "test_v2", state.Accounts[0].AccountType)
+	require.Len(t, state.Accounts[0].Migrations, 1)
+
+	k, ctx = newTestKeeper()
+	err = k.ImportState(ctx, state)
+	require.NoError(t, err)
+
+	migrations, err := k.AccountMigrations.Get(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, state.Accounts[0].Migrations, migrations.Migrations)
+
+	resp, err := k.Query(ctx, addr, &types.DoubleValue{})
+	require.NoError(t, err)
+	require.Equal(t, &types.UInt64Value{Value: 3}, resp)
+}
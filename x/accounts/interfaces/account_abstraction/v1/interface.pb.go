@@ -5,7 +5,11 @@ package v1
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	tx "github.com/cosmos/cosmos-sdk/types/tx"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -140,6 +144,133 @@ func (m *MsgAuthenticateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAuthenticateResponse proto.InternalMessageInfo
 
+// MsgPayFee is a message that an x/account paymaster implementer must handle to
+// accept paying the fees of a transaction, when it is set as the fee granter of the
+// transaction. Once accepted, the fees are deducted from the paymaster account.
+// Always ensure the caller is the Accounts module.
+type MsgPayFee struct {
+	// bundler defines the address of the bundler that sent the operation.
+	// NOTE: in case the operation was sent directly by the user, this field will reflect
+	// the user address.
+	Bundler string `protobuf:"bytes,1,opt,name=bundler,proto3" json:"bundler,omitempty"`
+	// fee_payer defines the address of the account whose fees are paid, the first
+	// signer of the tx unless the fee payer is set.
+	FeePayer string `protobuf:"bytes,2,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// fee defines the fees to pay.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// raw_tx defines the raw version of the tx.
+	RawTx *tx.TxRaw `protobuf:"bytes,4,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+	// tx defines the decoded version of the tx, coming from raw_tx.
+	Tx *tx.Tx `protobuf:"bytes,5,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (m *MsgPayFee) Reset()         { *m = MsgPayFee{} }
+func (m *MsgPayFee) String() string { return proto.CompactTextString(m) }
+func (*MsgPayFee) ProtoMessage()    {}
+func (*MsgPayFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56b360422260e9d1, []int{2}
+}
+func (m *MsgPayFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPayFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPayFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPayFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPayFee.Merge(m, src)
+}
+func (m *MsgPayFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPayFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPayFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPayFee proto.InternalMessageInfo
+
+func (m *MsgPayFee) GetBundler() string {
+	if m != nil {
+		return m.Bundler
+	}
+	return ""
+}
+
+func (m *MsgPayFee) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
+
+func (m *MsgPayFee) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *MsgPayFee) GetRawTx() *tx.TxRaw {
+	if m != nil {
+		return m.RawTx
+	}
+	return nil
+}
+
+func (m *MsgPayFee) GetTx() *tx.Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+// MsgPayFeeResponse is the response to MsgPayFee.
+// The paymaster either refuses or accepts to pay the fees, this is why
+// there are no auxiliary fields to the response.
+type MsgPayFeeResponse struct {
+}
+
+func (m *MsgPayFeeResponse) Reset()         { *m = MsgPayFeeResponse{} }
+func (m *MsgPayFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPayFeeResponse) ProtoMessage()    {}
+func (*MsgPayFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56b360422260e9d1, []int{3}
+}
+func (m *MsgPayFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPayFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPayFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPayFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPayFeeResponse.Merge(m, src)
+}
+func (m *MsgPayFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPayFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPayFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPayFeeResponse proto.InternalMessageInfo
+
 // QueryAuthenticationMethods is a query that an x/account account abstraction implementer
 // must handle to return the authentication methods that the account supports.
 type QueryAuthenticationMethods struct {
@@ -149,7 +280,7 @@ func (m *QueryAuthenticationMethods) Reset()         { *m = QueryAuthenticationM
 func (m *QueryAuthenticationMethods) String() string { return proto.CompactTextString(m) }
 func (*QueryAuthenticationMethods) ProtoMessage()    {}
 func (*QueryAuthenticationMethods) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56b360422260e9d1, []int{2}
+	return fileDescriptor_56b360422260e9d1, []int{4}
 }
 func (m *QueryAuthenticationMethods) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -188,7 +319,7 @@ func (m *QueryAuthenticationMethodsResponse) Reset()         { *m = QueryAuthent
 func (m *QueryAuthenticationMethodsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAuthenticationMethodsResponse) ProtoMessage()    {}
 func (*QueryAuthenticationMethodsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56b360422260e9d1, []int{3}
+	return fileDescriptor_56b360422260e9d1, []int{5}
 }
 func (m *QueryAuthenticationMethodsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,6 +358,8 @@ func (m *QueryAuthenticationMethodsResponse) GetAuthenticationMethods() []string
 func init() {
 	proto.RegisterType((*MsgAuthenticate)(nil), "cosmos.accounts.interfaces.account_abstraction.v1.MsgAuthenticate")
 	proto.RegisterType((*MsgAuthenticateResponse)(nil), "cosmos.accounts.interfaces.account_abstraction.v1.MsgAuthenticateResponse")
+	proto.RegisterType((*MsgPayFee)(nil), "cosmos.accounts.interfaces.account_abstraction.v1.MsgPayFee")
+	proto.RegisterType((*MsgPayFeeResponse)(nil), "cosmos.accounts.interfaces.account_abstraction.v1.MsgPayFeeResponse")
 	proto.RegisterType((*QueryAuthenticationMethods)(nil), "cosmos.accounts.interfaces.account_abstraction.v1.QueryAuthenticationMethods")
 	proto.RegisterType((*QueryAuthenticationMethodsResponse)(nil), "cosmos.accounts.interfaces.account_abstraction.v1.QueryAuthenticationMethodsResponse")
 }
@@ -236,29 +369,39 @@ func init() {
 }
 
 var fileDescriptor_56b360422260e9d1 = []byte{
-	// 338 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xb1, 0x4e, 0xeb, 0x30,
-	0x14, 0x86, 0xeb, 0xf6, 0xde, 0xa2, 0xba, 0x20, 0xa4, 0x48, 0x85, 0x50, 0xa1, 0x28, 0x44, 0x42,
-	0xca, 0x64, 0x2b, 0x20, 0x06, 0xc6, 0xb2, 0x31, 0x74, 0x20, 0x74, 0x82, 0x21, 0x72, 0x12, 0xd3,
-	0x5a, 0x50, 0xbb, 0xb2, 0x4f, 0x5a, 0xf3, 0x16, 0x3c, 0x05, 0xcf, 0xc2, 0xd8, 0x91, 0x11, 0xb5,
-	0x2f, 0x82, 0x68, 0x5a, 0x0a, 0xa8, 0x0c, 0x8c, 0xfe, 0xcf, 0xe7, 0xcf, 0xc7, 0xfa, 0x71, 0x27,
-	0x53, 0x66, 0xa8, 0x0c, 0x65, 0x59, 0xa6, 0x0a, 0x09, 0x86, 0x0a, 0x09, 0x5c, 0xdf, 0xb1, 0x8c,
-	0x7f, 0x66, 0x09, 0x4b, 0x0d, 0x68, 0x96, 0x81, 0x50, 0x92, 0x8e, 0xa3, 0x35, 0x41, 0x46, 0x5a,
-	0x81, 0x72, 0xa2, 0x52, 0x41, 0x56, 0x0a, 0xb2, 0x56, 0x90, 0x0d, 0x0a, 0x32, 0x8e, 0xda, 0xed,
-	0xe5, 0xab, 0x60, 0xe9, 0x38, 0x4a, 0x39, 0xb0, 0x88, 0x82, 0x2d, 0x75, 0xc1, 0x33, 0xc2, 0xbb,
-	0x5d, 0xd3, 0xef, 0x14, 0x30, 0xe0, 0x12, 0x44, 0xc6, 0x80, 0x3b, 0x2e, 0xde, 0x4a, 0x0b, 0x99,
-	0x3f, 0x70, 0xed, 0x22, 0x1f, 0x85, 0x8d, 0x78, 0x75, 0x74, 0x28, 0xae, 0x6b, 0x36, 0x49, 0xc0,
-	0xba, 0x55, 0x1f, 0x85, 0xcd, 0x13, 0x97, 0x2c, 0xb7, 0x01, 0x4b, 0x96, 0x6a, 0xd2, 0xb3, 0x31,
-	0x9b, 0xc4, 0xff, 0x35, 0x9b, 0xf4, 0xac, 0x73, 0x8c, 0xab, 0x60, 0xdd, 0xda, 0x02, 0x6e, 0x6d,
-	0x86, 0xab, 0x60, 0x9d, 0x23, 0xbc, 0x6d, 0x44, 0x5f, 0x72, 0x9d, 0x08, 0x99, 0x73, 0xeb, 0xfe,
-	0xf3, 0x51, 0xb8, 0x13, 0x37, 0xcb, 0xec, 0xf2, 0x23, 0x0a, 0x0e, 0xf0, 0xfe, 0x8f, 0x3d, 0x63,
-	0x6e, 0x46, 0x4a, 0x1a, 0x1e, 0x1c, 0xe2, 0xf6, 0x55, 0xc1, 0xf5, 0xe3, 0x97, 0xa1, 0x50, 0xb2,
-	0xcb, 0x61, 0xa0, 0x72, 0x13, 0xdc, 0xe2, 0xe0, 0xf7, 0xe9, 0xca, 0xe1, 0x9c, 0xe1, 0x3d, 0xf6,
-	0x0d, 0x48, 0x86, 0x25, 0xe1, 0x22, 0xbf, 0x16, 0x36, 0xe2, 0x16, 0xdb, 0x74, 0xfd, 0xe2, 0xfa,
-	0x65, 0xe6, 0xa1, 0xe9, 0xcc, 0x43, 0x6f, 0x33, 0x0f, 0x3d, 0xcd, 0xbd, 0xca, 0x74, 0xee, 0x55,
-	0x5e, 0xe7, 0x5e, 0xe5, 0xe6, 0xbc, 0xfc, 0xac, 0xc9, 0xef, 0x89, 0x50, 0xd4, 0xfe, 0xa1, 0xf2,
-	0xb4, 0xbe, 0xa8, 0xe6, 0xf4, 0x3d, 0x00, 0x00, 0xff, 0xff, 0xb2, 0x1c, 0x3d, 0x0e, 0x2e, 0x02,
-	0x00, 0x00,
+	// 506 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4f, 0x6f, 0xd3, 0x30,
+	0x1c, 0xad, 0xdb, 0x6d, 0x50, 0x6f, 0x08, 0x2d, 0x30, 0xc8, 0x0a, 0xca, 0x4a, 0x24, 0xa4, 0x68,
+	0x12, 0xb6, 0x3a, 0xc4, 0x81, 0xe3, 0x86, 0x34, 0x89, 0x43, 0xa5, 0x11, 0x76, 0x82, 0x43, 0xe4,
+	0xa4, 0xbf, 0xa6, 0xd6, 0x56, 0xbb, 0x8a, 0xdd, 0xd6, 0xf9, 0x14, 0x70, 0xe6, 0x03, 0x20, 0xc4,
+	0x69, 0x1f, 0x63, 0xc7, 0x1d, 0x39, 0x01, 0x6a, 0x0f, 0xfb, 0x1a, 0x28, 0x7f, 0x9a, 0x0d, 0x54,
+	0x40, 0xbb, 0x24, 0xf6, 0xef, 0x3d, 0xbf, 0xdf, 0xfb, 0xbd, 0xc4, 0x78, 0x3f, 0x92, 0x6a, 0x28,
+	0x15, 0x65, 0x51, 0x24, 0xc7, 0x42, 0x2b, 0xca, 0x85, 0x86, 0xa4, 0xcf, 0x22, 0xa8, 0x6a, 0x01,
+	0x0b, 0x95, 0x4e, 0x58, 0xa4, 0xb9, 0x14, 0x74, 0xd2, 0xb9, 0x62, 0x90, 0x51, 0x22, 0xb5, 0xb4,
+	0x3a, 0x85, 0x04, 0x59, 0x48, 0x90, 0x2b, 0x09, 0xb2, 0x44, 0x82, 0x4c, 0x3a, 0xad, 0x4d, 0x36,
+	0xe4, 0x42, 0xd2, 0xfc, 0x59, 0xa8, 0xb4, 0x9c, 0xd2, 0x48, 0xc8, 0x14, 0xd0, 0x49, 0x27, 0x04,
+	0xcd, 0x3a, 0x34, 0x92, 0x5c, 0x94, 0x78, 0xab, 0xc4, 0xb5, 0xa9, 0x50, 0x6d, 0x4a, 0xec, 0x7e,
+	0x2c, 0x63, 0x99, 0x2f, 0x69, 0xb6, 0x2a, 0xaa, 0xee, 0x67, 0x84, 0xef, 0x76, 0x55, 0xbc, 0x3f,
+	0xd6, 0x03, 0x10, 0x9a, 0x47, 0x4c, 0x83, 0x65, 0xe3, 0x5b, 0xe1, 0x58, 0xf4, 0x4e, 0x21, 0xb1,
+	0x51, 0x1b, 0x79, 0x4d, 0x7f, 0xb1, 0xb5, 0x28, 0x5e, 0x4b, 0xd8, 0x34, 0xd0, 0xc6, 0xae, 0xb7,
+	0x91, 0xb7, 0xbe, 0x67, 0x93, 0x72, 0x2c, 0x6d, 0x48, 0xd9, 0x90, 0x1c, 0x1b, 0x9f, 0x4d, 0xfd,
+	0xd5, 0x84, 0x4d, 0x8f, 0x8d, 0xf5, 0x14, 0xd7, 0xb5, 0xb1, 0x1b, 0x39, 0x79, 0x6b, 0x39, 0xb9,
+	0xae, 0x8d, 0xf5, 0x04, 0x6f, 0x28, 0x1e, 0x0b, 0x48, 0x02, 0x2e, 0x7a, 0x60, 0xec, 0x95, 0x36,
+	0xf2, 0xee, 0xf8, 0xeb, 0x45, 0xed, 0x75, 0x56, 0x72, 0xb7, 0xf1, 0xc3, 0x3f, 0x7c, 0xfa, 0xa0,
+	0x46, 0x52, 0x28, 0x70, 0x3f, 0xd4, 0x71, 0xb3, 0xab, 0xe2, 0x23, 0x96, 0x1e, 0xc2, 0xbf, 0xdc,
+	0x3f, 0xc2, 0xcd, 0x3e, 0x40, 0x30, 0x62, 0x29, 0x24, 0xf9, 0x00, 0x4d, 0xff, 0x76, 0x1f, 0xe0,
+	0x28, 0xdb, 0x5b, 0x0a, 0x37, 0xfa, 0x00, 0x76, 0xa3, 0xdd, 0xf0, 0xd6, 0xf7, 0xb6, 0x17, 0x56,
+	0xb3, 0xa0, 0x2b, 0xb3, 0xaf, 0x24, 0x17, 0x07, 0x87, 0xe7, 0xdf, 0x77, 0x6a, 0x5f, 0x7f, 0xec,
+	0x78, 0x31, 0xd7, 0x83, 0x71, 0x48, 0x22, 0x39, 0xa4, 0x65, 0xea, 0xc5, 0xeb, 0x99, 0xea, 0x9d,
+	0x50, 0x9d, 0x8e, 0x40, 0xe5, 0x07, 0xd4, 0xa7, 0xcb, 0xb3, 0xdd, 0x8d, 0x53, 0x88, 0x59, 0x94,
+	0x06, 0xd9, 0xa7, 0x52, 0x5f, 0x2e, 0xcf, 0x76, 0x91, 0x9f, 0x75, 0xbb, 0x96, 0xe7, 0xca, 0x4d,
+	0xf2, 0x5c, 0xfd, 0x4f, 0x9e, 0xee, 0x3d, 0xbc, 0x59, 0x05, 0x52, 0xc5, 0xf4, 0x18, 0xb7, 0xde,
+	0x8c, 0x21, 0x49, 0xaf, 0x65, 0xc8, 0xa5, 0xe8, 0x82, 0x1e, 0xc8, 0x9e, 0x72, 0xdf, 0x63, 0xf7,
+	0xef, 0xe8, 0x42, 0xc3, 0x7a, 0x81, 0x1f, 0xb0, 0xdf, 0x08, 0xc1, 0xb0, 0x60, 0xd8, 0xa8, 0xdd,
+	0xf0, 0x9a, 0xfe, 0x16, 0x5b, 0x76, 0xfc, 0xe0, 0xed, 0xf9, 0xcc, 0x41, 0x17, 0x33, 0x07, 0xfd,
+	0x9c, 0x39, 0xe8, 0xe3, 0xdc, 0xa9, 0x5d, 0xcc, 0x9d, 0xda, 0xb7, 0xb9, 0x53, 0x7b, 0xf7, 0xb2,
+	0x98, 0x41, 0xf5, 0x4e, 0x08, 0x97, 0xd4, 0xdc, 0xe0, 0x8a, 0x85, 0x6b, 0xf9, 0x1f, 0xfc, 0xfc,
+	0xd7, 0x00, 0x0f, 0xbb, 0x3d, 0xa0, 0x9e, 0x03, 0x00, 0x00,
 }
 
 func (m *MsgAuthenticate) Marshal() (dAtA []byte, err error) {
@@ -343,6 +486,104 @@ func (m *MsgAuthenticateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgPayFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInterface(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RawTx != nil {
+		{
+			size, err := m.RawTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInterface(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterface(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintInterface(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bundler) > 0 {
+		i -= len(m.Bundler)
+		copy(dAtA[i:], m.Bundler)
+		i = encodeVarintInterface(dAtA, i, uint64(len(m.Bundler)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPayFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryAuthenticationMethods) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -442,6 +683,46 @@ func (m *MsgAuthenticateResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgPayFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bundler)
+	if l > 0 {
+		n += 1 + l + sovInterface(uint64(l))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovInterface(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovInterface(uint64(l))
+		}
+	}
+	if m.RawTx != nil {
+		l = m.RawTx.Size()
+		n += 1 + l + sovInterface(uint64(l))
+	}
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovInterface(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPayFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *QueryAuthenticationMethods) Size() (n int) {
 	if m == nil {
 		return 0
@@ -695,6 +976,276 @@ func (m *MsgAuthenticateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPayFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterface
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundler", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterface
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bundler = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterface
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterface
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterface
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RawTx == nil {
+				m.RawTx = &tx.TxRaw{}
+			}
+			if err := m.RawTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterface
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &tx.Tx{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterface(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterface
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterface(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryAuthenticationMethods) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,1230 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/accounts/interfaces/scheduler/v1/interface.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	any "github.com/cosmos/gogoproto/types/any"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DueIntent defines an intent whose trigger holds, and whose messages are to be
+// executed on behalf of the account.
+type DueIntent struct {
+	// id defines the identifier of the intent in the account.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// messages defines the messages to execute, in order.
+	Messages []*any.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	// gas_limit defines the maximum gas the messages can consume.
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *DueIntent) Reset()         { *m = DueIntent{} }
+func (m *DueIntent) String() string { return proto.CompactTextString(m) }
+func (*DueIntent) ProtoMessage()    {}
+func (*DueIntent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_178ce7db877b6ef7, []int{0}
+}
+func (m *DueIntent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DueIntent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DueIntent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DueIntent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DueIntent.Merge(m, src)
+}
+func (m *DueIntent) XXX_Size() int {
+	return m.Size()
+}
+func (m *DueIntent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DueIntent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DueIntent proto.InternalMessageInfo
+
+func (m *DueIntent) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DueIntent) GetMessages() []*any.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *DueIntent) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+// MsgCollectDueIntents is a message that an x/account scheduler implementer must
+// handle to hand over the intents whose trigger holds to the accounts module, which
+// executes their messages on behalf of the account at the end of the block.
+// The returned intents are no longer pending. Always ensure the caller is the Accounts module.
+type MsgCollectDueIntents struct {
+	// max_gas defines the maximum sum of the gas limits of the returned intents.
+	MaxGas uint64 `protobuf:"varint,1,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty"`
+}
+
+func (m *MsgCollectDueIntents) Reset()         { *m = MsgCollectDueIntents{} }
+func (m *MsgCollectDueIntents) String() string { return proto.CompactTextString(m) }
+func (*MsgCollectDueIntents) ProtoMessage()    {}
+func (*MsgCollectDueIntents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_178ce7db877b6ef7, []int{1}
+}
+func (m *MsgCollectDueIntents) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCollectDueIntents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCollectDueIntents.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCollectDueIntents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCollectDueIntents.Merge(m, src)
+}
+func (m *MsgCollectDueIntents) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCollectDueIntents) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCollectDueIntents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCollectDueIntents proto.InternalMessageInfo
+
+func (m *MsgCollectDueIntents) GetMaxGas() uint64 {
+	if m != nil {
+		return m.MaxGas
+	}
+	return 0
+}
+
+// MsgCollectDueIntentsResponse is the response to MsgCollectDueIntents.
+type MsgCollectDueIntentsResponse struct {
+	// intents are the due intents, in the order their messages must be executed.
+	Intents []DueIntent `protobuf:"bytes,1,rep,name=intents,proto3" json:"intents"`
+	// pending defines the number of intents still pending on the account, the
+	// accounts module stops collecting the intents of the account once zero.
+	Pending uint64 `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (m *MsgCollectDueIntentsResponse) Reset()         { *m = MsgCollectDueIntentsResponse{} }
+func (m *MsgCollectDueIntentsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCollectDueIntentsResponse) ProtoMessage()    {}
+func (*MsgCollectDueIntentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_178ce7db877b6ef7, []int{2}
+}
+func (m *MsgCollectDueIntentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCollectDueIntentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCollectDueIntentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCollectDueIntentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCollectDueIntentsResponse.Merge(m, src)
+}
+func (m *MsgCollectDueIntentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCollectDueIntentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCollectDueIntentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCollectDueIntentsResponse proto.InternalMessageInfo
+
+func (m *MsgCollectDueIntentsResponse) GetIntents() []DueIntent {
+	if m != nil {
+		return m.Intents
+	}
+	return nil
+}
+
+func (m *MsgCollectDueIntentsResponse) GetPending() uint64 {
+	if m != nil {
+		return m.Pending
+	}
+	return 0
+}
+
+// MsgReportIntentResult is a message that an x/account scheduler implementer must
+// handle to record the result of the execution of a collected intent.
+// Always ensure the caller is the Accounts module.
+type MsgReportIntentResult struct {
+	// id defines the identifier of the intent in the account.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// error defines the error returned by the execution of the messages, in which
+	// case none of the messages was applied. Empty if they were executed.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// gas_used defines the gas consumed by the execution of the messages.
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// responses are the responses of the executed messages.
+	Responses []*any.Any `protobuf:"bytes,4,rep,name=responses,proto3" json:"responses,omitempty"`
+}
+
+func (m *MsgReportIntentResult) Reset()         { *m = MsgReportIntentResult{} }
+func (m *MsgReportIntentResult) String() string { return proto.CompactTextString(m) }
+func (*MsgReportIntentResult) ProtoMessage()    {}
+func (*MsgReportIntentResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_178ce7db877b6ef7, []int{3}
+}
+func (m *MsgReportIntentResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportIntentResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportIntentResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReportIntentResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportIntentResult.Merge(m, src)
+}
+func (m *MsgReportIntentResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportIntentResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportIntentResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportIntentResult proto.InternalMessageInfo
+
+func (m *MsgReportIntentResult) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgReportIntentResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *MsgReportIntentResult) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *MsgReportIntentResult) GetResponses() []*any.Any {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+// MsgReportIntentResultResponse is the response to MsgReportIntentResult.
+// This is empty.
+type MsgReportIntentResultResponse struct {
+}
+
+func (m *MsgReportIntentResultResponse) Reset()         { *m = MsgReportIntentResultResponse{} }
+func (m *MsgReportIntentResultResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReportIntentResultResponse) ProtoMessage()    {}
+func (*MsgReportIntentResultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_178ce7db877b6ef7, []int{4}
+}
+func (m *MsgReportIntentResultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportIntentResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportIntentResultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReportIntentResultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportIntentResultResponse.Merge(m, src)
+}
+func (m *MsgReportIntentResultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportIntentResultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportIntentResultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportIntentResultResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*DueIntent)(nil), "cosmos.accounts.interfaces.scheduler.v1.DueIntent")
+	proto.RegisterType((*MsgCollectDueIntents)(nil), "cosmos.accounts.interfaces.scheduler.v1.MsgCollectDueIntents")
+	proto.RegisterType((*MsgCollectDueIntentsResponse)(nil), "cosmos.accounts.interfaces.scheduler.v1.MsgCollectDueIntentsResponse")
+	proto.RegisterType((*MsgReportIntentResult)(nil), "cosmos.accounts.interfaces.scheduler.v1.MsgReportIntentResult")
+	proto.RegisterType((*MsgReportIntentResultResponse)(nil), "cosmos.accounts.interfaces.scheduler.v1.MsgReportIntentResultResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/accounts/interfaces/scheduler/v1/interface.proto", fileDescriptor_178ce7db877b6ef7)
+}
+
+var fileDescriptor_178ce7db877b6ef7 = []byte{
+	// 405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xb1, 0x6f, 0xd3, 0x40,
+	0x14, 0xc6, 0xe3, 0x34, 0x34, 0xcd, 0x21, 0x31, 0x9c, 0x8c, 0x70, 0x0b, 0xb8, 0x95, 0x17, 0x3a,
+	0xdd, 0xd1, 0x30, 0x30, 0x53, 0x90, 0x50, 0x25, 0xba, 0x9c, 0xc4, 0xc2, 0x12, 0x5d, 0xed, 0xd7,
+	0xe3, 0xc0, 0xbe, 0xb3, 0xee, 0x9d, 0xab, 0xf4, 0x7f, 0x60, 0xe0, 0xcf, 0xea, 0xd8, 0x91, 0x09,
+	0xa1, 0xe4, 0x1f, 0x41, 0xf6, 0xc5, 0xce, 0x40, 0x04, 0x6c, 0xfe, 0x9e, 0xdf, 0xef, 0x7b, 0x9f,
+	0x3f, 0x99, 0xbc, 0xce, 0x2d, 0x56, 0x16, 0xb9, 0xcc, 0x73, 0xdb, 0x18, 0x8f, 0x5c, 0x1b, 0x0f,
+	0xee, 0x5a, 0xe6, 0x80, 0x1c, 0xf3, 0xcf, 0x50, 0x34, 0x25, 0x38, 0x7e, 0x73, 0xb6, 0x9d, 0xb3,
+	0xda, 0x59, 0x6f, 0xe9, 0x8b, 0x00, 0xb2, 0x1e, 0x64, 0x5b, 0x90, 0x0d, 0x20, 0xbb, 0x39, 0x3b,
+	0x8a, 0x95, 0x55, 0xb6, 0x63, 0x78, 0xfb, 0x14, 0xf0, 0xa3, 0x43, 0x65, 0xad, 0x2a, 0x81, 0x77,
+	0xea, 0xaa, 0xb9, 0xe6, 0xd2, 0xdc, 0x86, 0x57, 0xd9, 0x17, 0x32, 0x7b, 0xd7, 0xc0, 0x85, 0xf1,
+	0x60, 0x3c, 0x7d, 0x44, 0xc6, 0xba, 0x48, 0xa2, 0x93, 0xe8, 0x74, 0x22, 0xc6, 0xba, 0xa0, 0x2f,
+	0xc9, 0x41, 0x05, 0x88, 0x52, 0x01, 0x26, 0xe3, 0x93, 0xbd, 0xd3, 0x87, 0xf3, 0x98, 0x05, 0x2b,
+	0xd6, 0x5b, 0xb1, 0x37, 0xe6, 0x56, 0x0c, 0x5b, 0xf4, 0x29, 0x99, 0x29, 0x89, 0x8b, 0x52, 0x57,
+	0xda, 0x27, 0x7b, 0x9d, 0xd1, 0x81, 0x92, 0xf8, 0xa1, 0xd5, 0x19, 0x27, 0xf1, 0x25, 0xaa, 0xb7,
+	0xb6, 0x2c, 0x21, 0xf7, 0xc3, 0x55, 0xa4, 0x4f, 0xc8, 0xb4, 0x92, 0xcb, 0x85, 0x92, 0xb8, 0xb9,
+	0xbd, 0x5f, 0xc9, 0xe5, 0x7b, 0x89, 0xd9, 0xb7, 0x88, 0x3c, 0xdb, 0x45, 0x08, 0xc0, 0xda, 0x1a,
+	0x04, 0x2a, 0xc8, 0x54, 0x87, 0x51, 0x12, 0x75, 0xf9, 0xe6, 0xec, 0x3f, 0x9b, 0x62, 0x83, 0xdb,
+	0xf9, 0xe4, 0xee, 0xe7, 0xf1, 0x48, 0xf4, 0x46, 0x34, 0x21, 0xd3, 0x1a, 0x4c, 0xa1, 0x8d, 0x4a,
+	0xc6, 0x5d, 0x9a, 0x5e, 0xb6, 0x71, 0x1e, 0x5f, 0xa2, 0x12, 0x50, 0x5b, 0xe7, 0x03, 0x2c, 0x00,
+	0x9b, 0xf2, 0xcf, 0xe2, 0x62, 0xf2, 0x00, 0x9c, 0xb3, 0xae, 0x73, 0x98, 0x89, 0x20, 0xe8, 0x21,
+	0x69, 0xbb, 0x58, 0x34, 0x08, 0xc5, 0xa6, 0x9b, 0xa9, 0x92, 0xf8, 0x11, 0xa1, 0xa0, 0x73, 0x32,
+	0x73, 0x9b, 0x8f, 0xc2, 0x64, 0xf2, 0x97, 0xaa, 0xb7, 0x6b, 0xd9, 0x31, 0x79, 0xbe, 0x33, 0x4d,
+	0xdf, 0xce, 0xf9, 0xc5, 0xdd, 0x2a, 0x8d, 0xee, 0x57, 0x69, 0xf4, 0x6b, 0x95, 0x46, 0xdf, 0xd7,
+	0xe9, 0xe8, 0x7e, 0x9d, 0x8e, 0x7e, 0xac, 0xd3, 0xd1, 0x27, 0x1e, 0x5a, 0xc2, 0xe2, 0x2b, 0xd3,
+	0x96, 0x2f, 0xff, 0xf9, 0x43, 0x5e, 0xed, 0x77, 0x21, 0x5e, 0xfd, 0x1e, 0x00, 0x51, 0xca, 0x4c,
+	0xc3, 0xc2, 0x02, 0x00, 0x00,
+}
+
+func (m *DueIntent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DueIntent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DueIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintInterface(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterface(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintInterface(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCollectDueIntents) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCollectDueIntents) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCollectDueIntents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGas != 0 {
+		i = encodeVarintInterface(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCollectDueIntentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCollectDueIntentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCollectDueIntentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pending != 0 {
+		i = encodeVarintInterface(dAtA, i, uint64(m.Pending))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Intents) > 0 {
+		for iNdEx := len(m.Intents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Intents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterface(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportIntentResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportIntentResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportIntentResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterface(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintInterface(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintInterface(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintInterface(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportIntentResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportIntentResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportIntentResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInterface(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInterface(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DueIntent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovInterface(uint64(m.Id))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovInterface(uint64(l))
+		}
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovInterface(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *MsgCollectDueIntents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxGas != 0 {
+		n += 1 + sovInterface(uint64(m.MaxGas))
+	}
+	return n
+}
+
+func (m *MsgCollectDueIntentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Intents) > 0 {
+		for _, e := range m.Intents {
+			l = e.Size()
+			n += 1 + l + sovInterface(uint64(l))
+		}
+	}
+	if m.Pending != 0 {
+		n += 1 + sovInterface(uint64(m.Pending))
+	}
+	return n
+}
+
+func (m *MsgReportIntentResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovInterface(uint64(m.Id))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovInterface(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovInterface(uint64(m.GasUsed))
+	}
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovInterface(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgReportIntentResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovInterface(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInterface(x uint64) (n int) {
+	return sovInterface(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DueIntent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterface
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DueIntent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DueIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterface
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &any.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterface(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCollectDueIntents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterface
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCollectDueIntents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCollectDueIntents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterface(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCollectDueIntentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterface
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCollectDueIntentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCollectDueIntentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterface
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Intents = append(m.Intents, DueIntent{})
+			if err := m.Intents[len(m.Intents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			m.Pending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pending |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterface(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReportIntentResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterface
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReportIntentResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReportIntentResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterface
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterface
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, &any.Any{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterface(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReportIntentResultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterface
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReportIntentResultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReportIntentResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterface(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInterface(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInterface
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInterface
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInterface
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInterface
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInterface        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInterface          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInterface = fmt.Errorf("proto: unexpected end of group")
+)
@@ -10,6 +10,8 @@ var (
 	errNoInitHandler    = errors.New("no init handler")
 	errNoExecuteHandler = errors.New("account does not accept messages")
 	errInvalidMessage   = errors.New("invalid message")
+	// ErrNoMigrationHandler is returned when an account type cannot migrate accounts from another account type.
+	ErrNoMigrationHandler = errors.New("no migration handler")
 )
 
 // NewInitBuilder creates a new InitBuilder instance.
@@ -100,6 +102,42 @@ func (r *QueryBuilder) makeHandler() (func(ctx context.Context, queryRequest Pro
 	return r.er.makeHandler()
 }
 
+// NewMigrationBuilder creates a new MigrationBuilder instance.
+func NewMigrationBuilder() *MigrationBuilder {
+	return &MigrationBuilder{
+		handlers:       make(map[string]func(ctx context.Context, migrateRequest ProtoMsg) (migrateResponse ProtoMsg, err error)),
+		handlersSchema: make(map[string]HandlerSchema),
+	}
+}
+
+// MigrationBuilder defines a smart account's migration router, it will be used to map the account
+// type an account is migrated from to the handler function migrating its state.
+type MigrationBuilder struct {
+	// handlers is a map of handler functions, by the account type they migrate accounts from.
+	handlers map[string]func(ctx context.Context, migrateRequest ProtoMsg) (migrateResponse ProtoMsg, err error)
+
+	// handlersSchema is a map of schemas for the messages that will be passed to the handler functions
+	// and the messages that will be returned by the handler functions, by account type.
+	handlersSchema map[string]HandlerSchema
+
+	// err is the error that occurred before building the handler function.
+	err error
+}
+
+func (r *MigrationBuilder) makeHandler() (func(ctx context.Context, fromAccountType string, migrateRequest ProtoMsg) (migrateResponse ProtoMsg, err error), error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return func(ctx context.Context, fromAccountType string, migrateRequest ProtoMsg) (migrateResponse ProtoMsg, err error) {
+		handler, ok := r.handlers[fromAccountType]
+		if !ok {
+			return nil, fmt.Errorf("%w: from account type %s", ErrNoMigrationHandler, fromAccountType)
+		}
+		return handler(ctx, migrateRequest)
+	}, nil
+}
+
 // IsRoutingError returns true if the error is a routing error,
 // which typically occurs when a message cannot be matched to a handler.
 func IsRoutingError(err error) bool {
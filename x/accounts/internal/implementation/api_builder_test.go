@@ -37,3 +37,35 @@ func TestEmptyQueryExecuteHandler(t *testing.T) {
 	_, err = eh(ctx, &types.StringValue{})
 	require.ErrorIs(t, err, errNoExecuteHandler)
 }
+
+func TestMigrationBuilder(t *testing.T) {
+	t.Run("double registration", func(t *testing.T) {
+		mr := NewMigrationBuilder()
+		RegisterMigrationHandler(mr, "v1", func(_ context.Context, req *types.StringValue) (*types.StringValue, error) {
+			return nil, nil
+		})
+		RegisterMigrationHandler(mr, "v1", func(_ context.Context, req *types.BytesValue) (*types.StringValue, error) {
+			return nil, nil
+		})
+
+		_, err := mr.makeHandler()
+		require.ErrorContains(t, err, "already registered")
+	})
+
+	t.Run("no migration handler", func(t *testing.T) {
+		mr := NewMigrationBuilder()
+		RegisterMigrationHandler(mr, "v1", func(_ context.Context, req *types.StringValue) (*types.StringValue, error) {
+			return req, nil
+		})
+
+		mh, err := mr.makeHandler()
+		require.NoError(t, err)
+
+		resp, err := mh(context.Background(), "v1", &types.StringValue{Value: "a"})
+		require.NoError(t, err)
+		require.Equal(t, &types.StringValue{Value: "a"}, resp)
+
+		_, err = mh(context.Background(), "v0", &types.StringValue{})
+		require.ErrorIs(t, err, ErrNoMigrationHandler)
+	})
+}
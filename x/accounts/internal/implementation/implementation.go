@@ -55,6 +55,9 @@ func MakeAccountsMap(
 		if err != nil {
 			return nil, fmt.Errorf("failed to create implementation for account %s: %w", name, err)
 		}
+		if impl.HasMigration(name) {
+			return nil, fmt.Errorf("account %s cannot migrate accounts from itself", name)
+		}
 		accountsMap[name] = impl
 	}
 
@@ -87,19 +90,31 @@ func newImplementation(schemaBuilder *collections.SchemaBuilder, account Account
 		return Implementation{}, err
 	}
 
+	// make migration handler, if the account accepts migrations
+	mr := NewMigrationBuilder()
+	if migratable, ok := account.(MigratableAccount); ok {
+		migratable.RegisterMigrationHandlers(mr)
+	}
+	migrationHandler, err := mr.makeHandler()
+	if err != nil {
+		return Implementation{}, err
+	}
+
 	// build schema
 	schema, err := schemaBuilder.Build()
 	if err != nil {
 		return Implementation{}, err
 	}
 	return Implementation{
-		Init:                  initHandler,
-		Execute:               executeHandler,
-		Query:                 queryHandler,
-		CollectionsSchema:     schema,
-		InitHandlerSchema:     ir.schema,
-		QueryHandlersSchema:   qr.er.handlersSchema,
-		ExecuteHandlersSchema: er.handlersSchema,
+		Init:                    initHandler,
+		Execute:                 executeHandler,
+		Query:                   queryHandler,
+		Migrate:                 migrationHandler,
+		CollectionsSchema:       schema,
+		InitHandlerSchema:       ir.schema,
+		QueryHandlersSchema:     qr.er.handlersSchema,
+		ExecuteHandlersSchema:   er.handlersSchema,
+		MigrationHandlersSchema: mr.handlersSchema,
 	}, nil
 }
 
@@ -112,6 +127,8 @@ type Implementation struct {
 	Execute func(ctx context.Context, msg ProtoMsg) (resp ProtoMsg, err error)
 	// Query defines the query handler for the smart account.
 	Query func(ctx context.Context, msg ProtoMsg) (resp ProtoMsg, err error)
+	// Migrate defines the migration handler for accounts of the given account type migrated to the smart account.
+	Migrate func(ctx context.Context, fromAccountType string, msg ProtoMsg) (resp ProtoMsg, err error)
 	// CollectionsSchema represents the state schema.
 	CollectionsSchema collections.Schema
 	// InitHandlerSchema represents the init handler schema.
@@ -120,6 +137,8 @@ type Implementation struct {
 	QueryHandlersSchema map[string]HandlerSchema
 	// ExecuteHandlersSchema is the schema of the execute handlers.
 	ExecuteHandlersSchema map[string]HandlerSchema
+	// MigrationHandlersSchema is the schema of the migration handlers, by account type.
+	MigrationHandlersSchema map[string]HandlerSchema
 }
 
 // HasExec returns true if the account can execute the given msg.
@@ -128,6 +147,12 @@ func (i Implementation) HasExec(m ProtoMsg) bool {
 	return ok
 }
 
+// HasMigration returns true if the account can migrate accounts of the given account type.
+func (i Implementation) HasMigration(fromAccountType string) bool {
+	_, ok := i.MigrationHandlersSchema[fromAccountType]
+	return ok
+}
+
 // HasQuery returns true if the account can execute the given request.
 func (i Implementation) HasQuery(m ProtoMsg) bool {
 	_, ok := i.QueryHandlersSchema[MessageName(m)]
@@ -15,3 +15,15 @@ type Account interface {
 	// might also decide to not register any query handler.
 	RegisterQueryHandlers(builder *QueryBuilder)
 }
+
+// MigratableAccount defines a smart account which existing accounts of other account types
+// can be migrated to.
+type MigratableAccount interface {
+	Account
+
+	// RegisterMigrationHandlers allows the smart account to register migration handlers, by account
+	// type it can migrate accounts from, using the provided MigrationBuilder. A handler is called
+	// with the state of the migrated account, which it must adapt to the smart account state, and
+	// is responsible for authorizing the sender.
+	RegisterMigrationHandlers(builder *MigrationBuilder)
+}
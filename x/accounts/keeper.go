@@ -19,6 +19,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	addresstypes "github.com/cosmos/cosmos-sdk/types/address"
 )
 
 var (
@@ -34,6 +35,14 @@ var (
 	AccountNumberKey = collections.NewPrefix(1)
 	// AccountByNumber is the key for the accounts by number.
 	AccountByNumber = collections.NewPrefix(2)
+	// AccountMigrationsPrefix is the prefix for the account migrations.
+	AccountMigrationsPrefix = collections.NewPrefix(3)
+	// ScheduledAccountsPrefix is the prefix for the scheduled accounts.
+	ScheduledAccountsPrefix = collections.NewPrefix(4)
+	// ScheduledAccountsCursorPrefix is the prefix for the scheduled account from which intents are collected next.
+	ScheduledAccountsCursorPrefix = collections.NewPrefix(5)
+	// ParamsPrefix is the prefix for the module parameters.
+	ParamsPrefix = collections.NewPrefix(6)
 )
 
 type InterfaceRegistry interface {
@@ -46,19 +55,30 @@ func NewKeeper(
 	env appmodule.Environment,
 	addressCodec address.Codec,
 	ir InterfaceRegistry,
+	authority string,
 	accounts ...accountstd.AccountCreatorFunc,
 ) (Keeper, error) {
+	authorityAddr, err := addressCodec.StringToBytes(authority)
+	if err != nil {
+		return Keeper{}, fmt.Errorf("invalid authority address: %w", err)
+	}
+
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	keeper := Keeper{
-		Environment:      env,
-		codec:            cdc,
-		addressCodec:     addressCodec,
-		makeSendCoinsMsg: defaultCoinsTransferMsgFunc(addressCodec),
-		Schema:           collections.Schema{},
-		AccountNumber:    collections.NewSequence(sb, AccountNumberKey, "account_number"),
-		AccountsByType:   collections.NewMap(sb, AccountTypeKeyPrefix, "accounts_by_type", collections.BytesKey, collections.StringValue),
-		AccountByNumber:  collections.NewMap(sb, AccountByNumber, "account_by_number", collections.BytesKey, collections.Uint64Value),
-		AccountsState:    collections.NewMap(sb, implementation.AccountStatePrefix, "accounts_state", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), collections.BytesValue),
+		Environment:             env,
+		codec:                   cdc,
+		addressCodec:            addressCodec,
+		authority:               authorityAddr,
+		makeSendCoinsMsg:        defaultCoinsTransferMsgFunc(addressCodec),
+		Schema:                  collections.Schema{},
+		AccountNumber:           collections.NewSequence(sb, AccountNumberKey, "account_number"),
+		AccountsByType:          collections.NewMap(sb, AccountTypeKeyPrefix, "accounts_by_type", collections.BytesKey, collections.StringValue),
+		AccountByNumber:         collections.NewMap(sb, AccountByNumber, "account_by_number", collections.BytesKey, collections.Uint64Value),
+		AccountMigrations:       collections.NewMap(sb, AccountMigrationsPrefix, "account_migrations", collections.BytesKey, codec.CollValue[v1.AccountMigrations](cdc)),
+		ScheduledAccounts:       collections.NewKeySet(sb, ScheduledAccountsPrefix, "scheduled_accounts", collections.BytesKey),
+		ScheduledAccountsCursor: collections.NewItem(sb, ScheduledAccountsCursorPrefix, "scheduled_accounts_cursor", collections.BytesValue),
+		Params:                  collections.NewItem(sb, ParamsPrefix, "params", codec.CollValue[v1.Params](cdc)),
+		AccountsState:           collections.NewMap(sb, implementation.AccountStatePrefix, "accounts_state", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), collections.BytesValue),
 	}
 
 	schema, err := sb.Build()
@@ -79,6 +99,7 @@ type Keeper struct {
 
 	addressCodec     address.Codec
 	codec            codec.Codec
+	authority        []byte
 	makeSendCoinsMsg coinsTransferMsgFunc
 
 	accounts map[string]implementation.Implementation
@@ -91,6 +112,14 @@ type Keeper struct {
 	AccountsByType collections.Map[[]byte, string]
 	// AccountByNumber maps account number to their address.
 	AccountByNumber collections.Map[[]byte, uint64]
+	// AccountMigrations maps account address to the migrations of the account to other implementations.
+	AccountMigrations collections.Map[[]byte, v1.AccountMigrations]
+	// ScheduledAccounts keeps track of the scheduler accounts which may have pending intents.
+	ScheduledAccounts collections.KeySet[[]byte]
+	// ScheduledAccountsCursor is the scheduled account from which intents are collected in the next block.
+	ScheduledAccountsCursor collections.Item[[]byte]
+	// Params are the parameters of the module.
+	Params collections.Item[v1.Params]
 
 	// AccountsState keeps track of the state of each account.
 	// NOTE: this is only used for genesis import and export.
@@ -228,6 +257,81 @@ func (k Keeper) MigrateLegacyAccount(
 	return k.init(ctx, accType, addr, accNum, addr, msg, nil)
 }
 
+// MigrateAccount migrates the given account to another account type, running the migration handler
+// of the account type over the account state. The migration handler is responsible for authorizing
+// the sender, unless the sender is the authority of the module, in which case the handler is called
+// with the accounts module as sender.
+func (k Keeper) MigrateAccount(
+	ctx context.Context,
+	accountAddr []byte,
+	sender []byte,
+	toAccountType string,
+	migrateRequest implementation.ProtoMsg,
+) (implementation.ProtoMsg, error) {
+	fromAccountType, err := k.AccountsByType.Get(ctx, accountAddr)
+	if err != nil {
+		return nil, err
+	}
+	if fromAccountType == toAccountType {
+		return nil, fmt.Errorf("account is already of type %s", toAccountType)
+	}
+
+	impl, ok := k.accounts[toAccountType]
+	if !ok {
+		return nil, fmt.Errorf("%w: not found %s", errAccountTypeNotFound, toAccountType)
+	}
+	if !impl.HasMigration(fromAccountType) {
+		return nil, fmt.Errorf("%w: account type %s cannot migrate accounts from %s", implementation.ErrNoMigrationHandler, toAccountType, fromAccountType)
+	}
+
+	accountNum, err := k.AccountByNumber.Get(ctx, accountAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	handlerSender := sender
+	if bytes.Equal(sender, k.authority) {
+		handlerSender = addresstypes.Module("accounts")
+	}
+
+	// make the context and migrate the account state, which is kept under the same account number.
+	accountCtx := k.makeAccountContext(ctx, accountNum, accountAddr, handlerSender, nil, false)
+	resp, err := impl.Migrate(accountCtx, fromAccountType, migrateRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.AccountsByType.Set(ctx, accountAddr, toAccountType); err != nil {
+		return nil, err
+	}
+	if err := k.maybeScheduleAccount(ctx, accountAddr, impl); err != nil {
+		return nil, err
+	}
+	return resp, k.addAccountMigration(ctx, accountAddr, fromAccountType, toAccountType, sender)
+}
+
+// addAccountMigration records the migration of the account to the history of its migrations.
+func (k Keeper) addAccountMigration(ctx context.Context, accountAddr []byte, fromAccountType, toAccountType string, sender []byte) error {
+	senderAddr, err := k.addressCodec.BytesToString(sender)
+	if err != nil {
+		return err
+	}
+	migrations, err := k.AccountMigrations.Get(ctx, accountAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	headerInfo := k.HeaderService.HeaderInfo(ctx)
+	migrations.Migrations = append(migrations.Migrations, v1.AccountMigration{
+		FromAccountType: fromAccountType,
+		ToAccountType:   toAccountType,
+		Sender:          senderAddr,
+		Height:          headerInfo.Height,
+		Time:            headerInfo.Time,
+	})
+	return k.AccountMigrations.Set(ctx, accountAddr, migrations)
+}
+
 // Execute executes a state transition on the given account.
 func (k Keeper) Execute(
 	ctx context.Context,
@@ -342,7 +446,6 @@ func (k Keeper) makeAccountContext(ctx context.Context, accountNumber uint64, ac
 
 // sendAnyMessages it a helper function that executes untyped codectypes.Any messages
 // The messages must all belong to a module.
-// nolint: unused // TODO: remove nolint when we bring back bundler payments
 func (k Keeper) sendAnyMessages(ctx context.Context, sender []byte, anyMessages []*implementation.Any) ([]*implementation.Any, error) {
 	anyResponses := make([]*implementation.Any, len(anyMessages))
 	for i := range anyMessages {
@@ -454,5 +557,9 @@ func registerToInterfaceRegistry(ir InterfaceRegistry, accMap map[string]impleme
 		for _, query := range acc.QueryHandlersSchema {
 			ir.RegisterImplementations(msgInterfaceType, query.RequestSchema.New(), query.ResponseSchema.New())
 		}
+		// register migration
+		for _, migration := range acc.MigrationHandlersSchema {
+			ir.RegisterImplementations(msgInterfaceType, migration.RequestSchema.New(), migration.ResponseSchema.New())
+		}
 	}
 }
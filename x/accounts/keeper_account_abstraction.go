@@ -7,7 +7,9 @@ import (
 
 	"cosmossdk.io/collections"
 	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/accounts/internal/implementation"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
@@ -19,10 +21,24 @@ var (
 	ErrBundlerPayment = errors.New("bundler payment failed")
 	// ErrExecution is returned when the execution fails.
 	ErrExecution = errors.New("execution failed")
+	// ErrPaymasterPayment is returned when the paymaster refuses to pay the fees.
+	ErrPaymasterPayment = errors.New("paymaster payment failed")
 )
 
 // IsAbstractedAccount returns if the provided address is an abstracted account or not.
 func (k Keeper) IsAbstractedAccount(ctx context.Context, addr []byte) (bool, error) {
+	return k.accountHandlesExec(ctx, addr, &aa_interface_v1.MsgAuthenticate{})
+}
+
+// IsPaymasterAccount returns if the provided address is a paymaster account, which
+// can pay the fees of the transactions of other accounts, or not.
+func (k Keeper) IsPaymasterAccount(ctx context.Context, addr []byte) (bool, error) {
+	return k.accountHandlesExec(ctx, addr, &aa_interface_v1.MsgPayFee{})
+}
+
+// accountHandlesExec returns if the provided address is an account which handles
+// the given execute message.
+func (k Keeper) accountHandlesExec(ctx context.Context, addr []byte, msg implementation.ProtoMsg) (bool, error) {
 	accType, err := k.AccountsByType.Get(ctx, addr)
 	switch {
 	case errors.Is(err, collections.ErrNotFound):
@@ -35,7 +51,7 @@ func (k Keeper) IsAbstractedAccount(ctx context.Context, addr []byte) (bool, err
 	if !ok {
 		return false, fmt.Errorf("%w: %s", errAccountTypeNotFound, accType)
 	}
-	return impl.HasExec(&aa_interface_v1.MsgAuthenticate{}), nil
+	return impl.HasExec(msg), nil
 }
 
 func (k Keeper) AuthenticateAccount(ctx context.Context, signer []byte, bundler string, rawTx *tx.TxRaw, protoTx *tx.Tx, signIndex uint32) error {
@@ -51,3 +67,21 @@ func (k Keeper) AuthenticateAccount(ctx context.Context, signer []byte, bundler
 	}
 	return nil
 }
+
+// PayFee asks the paymaster account to pay the fees of the transaction of the fee
+// payer, it returns an error if the paymaster refuses to. The fees are not deducted
+// from the paymaster, which is left to the caller.
+func (k Keeper) PayFee(ctx context.Context, paymaster []byte, bundler, feePayer string, fee sdk.Coins, rawTx *tx.TxRaw, protoTx *tx.Tx) error {
+	msg := &aa_interface_v1.MsgPayFee{
+		Bundler:  bundler,
+		FeePayer: feePayer,
+		Fee:      fee,
+		RawTx:    rawTx,
+		Tx:       protoTx,
+	}
+	_, err := k.Execute(ctx, paymaster, address.Module("accounts"), msg, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrPaymasterPayment, err)
+	}
+	return nil
+}
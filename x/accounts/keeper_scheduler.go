@@ -0,0 +1,163 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	scheduler_interface_v1 "cosmossdk.io/x/accounts/interfaces/scheduler/v1"
+	"cosmossdk.io/x/accounts/internal/implementation"
+	v1 "cosmossdk.io/x/accounts/v1"
+)
+
+// errNotScheduler is returned when scheduling an account which is not a scheduler.
+var errNotScheduler = errors.New("account is not a scheduler")
+
+// ScheduleAccount tracks the scheduler account in the scheduled accounts, such that its
+// due intents are collected at the end of the blocks until it has no pending intents.
+func (k Keeper) ScheduleAccount(ctx context.Context, addr []byte) error {
+	impl, err := k.getImplementation(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if !isScheduler(impl) {
+		return errNotScheduler
+	}
+	return k.ScheduledAccounts.Set(ctx, addr)
+}
+
+// maybeScheduleAccount tracks the account in the scheduled accounts if its implementation
+// is a scheduler, for accounts whose state may hold pending intents, such as imported or
+// migrated accounts.
+func (k Keeper) maybeScheduleAccount(ctx context.Context, addr []byte, impl implementation.Implementation) error {
+	if !isScheduler(impl) {
+		return nil
+	}
+	return k.ScheduledAccounts.Set(ctx, addr)
+}
+
+func isScheduler(impl implementation.Implementation) bool {
+	return impl.HasExec(&scheduler_interface_v1.MsgCollectDueIntents{})
+}
+
+// GetParams returns the parameters of the module, or the default ones if they were
+// never set.
+func (k Keeper) GetParams(ctx context.Context) (v1.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return v1.DefaultParams(), nil
+	}
+	return params, err
+}
+
+// ExecuteScheduledIntents collects the due intents of the scheduled accounts and executes
+// their messages on behalf of the accounts. Each block, at most ScheduledAccountsPerBlock
+// accounts are visited within ScheduledExecutionGasLimit, in a round-robin fashion, starting
+// from the account the previous block stopped at. A failing account does not prevent the
+// intents of other accounts from executing.
+func (k Keeper) ExecuteScheduledIntents(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	cursor, err := k.ScheduledAccountsCursor.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	// gather the accounts first, as their processing writes to the scheduled accounts.
+	accounts, next, err := k.scheduledAccountsFrom(ctx, cursor, params.ScheduledAccountsPerBlock)
+	if err != nil {
+		return err
+	}
+
+	remaining := params.ScheduledExecutionGasLimit
+	for i, addr := range accounts {
+		if remaining == 0 {
+			return k.ScheduledAccountsCursor.Set(ctx, addr)
+		}
+
+		pending, gasUsed, err := k.executeDueIntents(ctx, addr, remaining)
+		remaining -= min(gasUsed, remaining)
+		if err != nil {
+			k.Logger.Error("failed to execute scheduled intents", "account", fmt.Sprintf("%X", addr), "err", err)
+			// skip the account in the next block if it did not fit in the remaining budget.
+			if remaining == 0 && i+1 < len(accounts) {
+				return k.ScheduledAccountsCursor.Set(ctx, accounts[i+1])
+			}
+			continue
+		}
+		if pending == 0 {
+			if err := k.ScheduledAccounts.Remove(ctx, addr); err != nil {
+				return err
+			}
+		}
+	}
+	if next != nil {
+		return k.ScheduledAccountsCursor.Set(ctx, next)
+	}
+	return k.ScheduledAccountsCursor.Remove(ctx)
+}
+
+// scheduledAccountsFrom returns at most limit scheduled accounts from the cursor, wrapping
+// around to the first scheduled account, along with the account following them, nil if
+// all the scheduled accounts are returned.
+func (k Keeper) scheduledAccountsFrom(ctx context.Context, cursor []byte, limit uint64) (accounts [][]byte, next []byte, err error) {
+	collect := func(addr []byte) (bool, error) {
+		if uint64(len(accounts)) == limit {
+			next = addr
+			return true, nil
+		}
+		accounts = append(accounts, addr)
+		return false, nil
+	}
+
+	if cursor == nil {
+		err = k.ScheduledAccounts.Walk(ctx, nil, collect)
+		return accounts, next, err
+	}
+	err = k.ScheduledAccounts.Walk(ctx, new(collections.Range[[]byte]).StartInclusive(cursor), collect)
+	if err != nil || next != nil {
+		return accounts, next, err
+	}
+	err = k.ScheduledAccounts.Walk(ctx, new(collections.Range[[]byte]).EndExclusive(cursor), collect)
+	return accounts, next, err
+}
+
+// executeDueIntents collects the due intents of the account, then executes the messages
+// of each intent on behalf of the account in its own branch, limited by the gas limit
+// of the intent, and reports its result to the account. All of it is reverted if the
+// provided gas limit is exceeded, in which case the intents stay pending.
+func (k Keeper) executeDueIntents(ctx context.Context, addr []byte, gasLimit uint64) (pending, gasUsed uint64, err error) {
+	gasUsed, err = k.BranchService.ExecuteWithGasLimit(ctx, gasLimit, func(ctx context.Context) error {
+		resp, err := k.Execute(ctx, addr, ModuleAccountAddress, &scheduler_interface_v1.MsgCollectDueIntents{MaxGas: gasLimit}, nil)
+		if err != nil {
+			return err
+		}
+		collected, ok := resp.(*scheduler_interface_v1.MsgCollectDueIntentsResponse)
+		if !ok {
+			return fmt.Errorf("invalid response type %T for scheduled intents collection", resp)
+		}
+
+		for _, intent := range collected.Intents {
+			report := &scheduler_interface_v1.MsgReportIntentResult{Id: intent.Id}
+			intentGasUsed, execErr := k.BranchService.ExecuteWithGasLimit(ctx, intent.GasLimit, func(ctx context.Context) error {
+				responses, err := k.sendAnyMessages(ctx, addr, intent.Messages)
+				report.Responses = responses
+				return err
+			})
+			report.GasUsed = intentGasUsed
+			if execErr != nil {
+				report.Error = execErr.Error()
+				report.Responses = nil
+			}
+			if _, err := k.Execute(ctx, addr, ModuleAccountAddress, report, nil); err != nil {
+				return err
+			}
+		}
+		pending = collected.Pending
+		return nil
+	})
+	return pending, gasUsed, err
+}
@@ -1,14 +1,21 @@
 package accounts
 
 import (
+	"bytes"
+	"slices"
 	"testing"
 
 	"github.com/cosmos/gogoproto/types"
 	"github.com/stretchr/testify/require"
 
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	"cosmossdk.io/collections"
 	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/scheduler"
+	schedulerv1 "cosmossdk.io/x/accounts/defaults/scheduler/v1"
 	"cosmossdk.io/x/accounts/internal/implementation"
+	v1 "cosmossdk.io/x/accounts/v1"
 )
 
 func TestKeeper_Init(t *testing.T) {
@@ -90,3 +97,185 @@ func TestKeeper_Query(t *testing.T) {
 		require.True(t, implementation.Equal(&types.Int64Value{Value: 1000}, resp))
 	})
 }
+
+func TestKeeper_MigrateAccount(t *testing.T) {
+	m, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount), accountstd.AddAccount("test_v2", NewTestAccountV2))
+
+	// create account and set its counter
+	sender := []byte("sender")
+	_, accAddr, err := m.Init(ctx, "test", sender, &types.Empty{}, nil)
+	require.NoError(t, err)
+	_, err = m.Execute(ctx, accAddr, sender, &types.UInt64Value{Value: 10}, nil)
+	require.NoError(t, err)
+
+	t.Run("unauthorized sender", func(t *testing.T) {
+		_, err := m.MigrateAccount(ctx, accAddr, []byte("someone"), "test_v2", &types.UInt64Value{Value: 5})
+		require.ErrorContains(t, err, "unauthorized sender")
+
+		accType, err := m.AccountsByType.Get(ctx, accAddr)
+		require.NoError(t, err)
+		require.Equal(t, "test", accType)
+	})
+
+	t.Run("unknown account type", func(t *testing.T) {
+		_, err := m.MigrateAccount(ctx, accAddr, sender, "unknown", &types.UInt64Value{Value: 5})
+		require.ErrorIs(t, err, errAccountTypeNotFound)
+	})
+
+	t.Run("same account type", func(t *testing.T) {
+		_, err := m.MigrateAccount(ctx, accAddr, sender, "test", &types.UInt64Value{Value: 5})
+		require.ErrorContains(t, err, "already of type")
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		resp, err := m.MigrateAccount(ctx, accAddr, sender, "test_v2", &types.UInt64Value{Value: 5})
+		require.NoError(t, err)
+		require.True(t, implementation.Equal(&types.Empty{}, resp))
+
+		accType, err := m.AccountsByType.Get(ctx, accAddr)
+		require.NoError(t, err)
+		require.Equal(t, "test_v2", accType)
+
+		// the state of the account was kept, and migrated.
+		queryResp, err := m.Query(ctx, accAddr, &types.DoubleValue{})
+		require.NoError(t, err)
+		require.True(t, implementation.Equal(&types.UInt64Value{Value: 15}, queryResp))
+
+		migrations, err := m.AccountMigrations.Get(ctx, accAddr)
+		require.NoError(t, err)
+		require.Len(t, migrations.Migrations, 1)
+		require.Equal(t, "test", migrations.Migrations[0].FromAccountType)
+		require.Equal(t, "test_v2", migrations.Migrations[0].ToAccountType)
+		require.Equal(t, "sender", migrations.Migrations[0].Sender)
+	})
+
+	t.Run("no migration handler", func(t *testing.T) {
+		_, err := m.MigrateAccount(ctx, accAddr, sender, "test", &types.UInt64Value{Value: 5})
+		require.ErrorIs(t, err, implementation.ErrNoMigrationHandler)
+	})
+
+	t.Run("authority", func(t *testing.T) {
+		_, otherAddr, err := m.Init(ctx, "test", []byte("other"), &types.Empty{}, nil)
+		require.NoError(t, err)
+
+		_, err = m.MigrateAccount(ctx, otherAddr, []byte("authority"), "test_v2", &types.UInt64Value{Value: 1})
+		require.NoError(t, err)
+
+		migrations, err := m.AccountMigrations.Get(ctx, otherAddr)
+		require.NoError(t, err)
+		require.Len(t, migrations.Migrations, 1)
+		require.Equal(t, "authority", migrations.Migrations[0].Sender)
+	})
+}
+
+func TestKeeper_ExecuteScheduledIntents(t *testing.T) {
+	m, ctx := newKeeper(t, scheduler.NewAccount("scheduler"), accountstd.AddAccount("test", NewTestAccount))
+
+	owner := []byte("owner")
+	_, accAddr, err := m.Init(ctx, "scheduler", owner, &schedulerv1.MsgInit{Owner: string(owner)}, nil)
+	require.NoError(t, err)
+	_, testAddr, err := m.Init(ctx, "test", owner, &types.Empty{}, nil)
+	require.NoError(t, err)
+
+	// accounts are scheduled once they have an intent, and only scheduler accounts can be
+	scheduled, err := m.ScheduledAccounts.Has(ctx, accAddr)
+	require.NoError(t, err)
+	require.False(t, scheduled)
+	require.ErrorIs(t, m.ScheduleAccount(ctx, testAddr), errNotScheduler)
+
+	makeSend := func(from []byte) *implementation.Any {
+		msg, err := implementation.PackAny(&bankv1beta1.MsgSend{
+			FromAddress: string(from),
+			ToAddress:   "recipient",
+			Amount:      []*basev1beta1.Coin{{Denom: "atom", Amount: "10"}},
+		})
+		require.NoError(t, err)
+		return msg
+	}
+	// 0 executes, 1 fails as the account cannot send on behalf of the owner, 2 is not due
+	for i, intent := range []*schedulerv1.MsgScheduleIntent{
+		{Trigger: schedulerv1.Trigger{Height: 1}, Messages: []*implementation.Any{makeSend(accAddr)}, GasLimit: 100_000},
+		{Trigger: schedulerv1.Trigger{Height: 1}, Messages: []*implementation.Any{makeSend(owner)}, GasLimit: 100_000},
+		{Trigger: schedulerv1.Trigger{Height: 100}, Messages: []*implementation.Any{makeSend(accAddr)}, GasLimit: 100_000},
+	} {
+		resp, err := m.Execute(ctx, accAddr, owner, intent, nil)
+		require.NoError(t, err)
+		require.Equal(t, uint64(i), resp.(*schedulerv1.MsgScheduleIntentResponse).Id)
+	}
+	scheduled, err = m.ScheduledAccounts.Has(ctx, accAddr)
+	require.NoError(t, err)
+	require.True(t, scheduled)
+
+	require.NoError(t, m.ExecuteScheduledIntents(ctx))
+
+	resp, err := m.Query(ctx, accAddr, &schedulerv1.QueryIntentResult{Id: 0})
+	require.NoError(t, err)
+	result := resp.(*schedulerv1.QueryIntentResultResponse).Result
+	require.Equal(t, schedulerv1.IntentStatus_INTENT_STATUS_EXECUTED, result.Status)
+	require.Len(t, result.Responses, 1)
+
+	resp, err = m.Query(ctx, accAddr, &schedulerv1.QueryIntentResult{Id: 1})
+	require.NoError(t, err)
+	result = resp.(*schedulerv1.QueryIntentResultResponse).Result
+	require.Equal(t, schedulerv1.IntentStatus_INTENT_STATUS_FAILED, result.Status)
+	require.Contains(t, result.Error, ErrUnauthorized.Error())
+
+	// the account stays scheduled until its last intent is closed
+	scheduled, err = m.ScheduledAccounts.Has(ctx, accAddr)
+	require.NoError(t, err)
+	require.True(t, scheduled)
+
+	_, err = m.Execute(ctx, accAddr, owner, &schedulerv1.MsgCancelIntent{Id: 2}, nil)
+	require.NoError(t, err)
+	require.NoError(t, m.ExecuteScheduledIntents(ctx))
+
+	scheduled, err = m.ScheduledAccounts.Has(ctx, accAddr)
+	require.NoError(t, err)
+	require.False(t, scheduled)
+}
+
+func TestKeeper_ExecuteScheduledIntents_AccountsPerBlock(t *testing.T) {
+	m, ctx := newKeeper(t, scheduler.NewAccount("scheduler"))
+	require.NoError(t, m.Params.Set(ctx, v1.Params{ScheduledExecutionGasLimit: 10_000_000, ScheduledAccountsPerBlock: 2}))
+
+	owner := []byte("owner")
+	accounts := make([][]byte, 3)
+	for i := range accounts {
+		_, addr, err := m.Init(ctx, "scheduler", owner, &schedulerv1.MsgInit{Owner: string(owner)}, nil)
+		require.NoError(t, err)
+		send, err := implementation.PackAny(&bankv1beta1.MsgSend{
+			FromAddress: string(addr),
+			ToAddress:   "recipient",
+			Amount:      []*basev1beta1.Coin{{Denom: "atom", Amount: "10"}},
+		})
+		require.NoError(t, err)
+		_, err = m.Execute(ctx, addr, owner, &schedulerv1.MsgScheduleIntent{
+			Trigger:  schedulerv1.Trigger{Height: 1},
+			Messages: []*implementation.Any{send},
+			GasLimit: 100_000,
+		}, nil)
+		require.NoError(t, err)
+		accounts[i] = addr
+	}
+	slices.SortFunc(accounts, bytes.Compare)
+
+	isScheduled := func(addr []byte) bool {
+		scheduled, err := m.ScheduledAccounts.Has(ctx, addr)
+		require.NoError(t, err)
+		return scheduled
+	}
+
+	// the first block only visits the first two accounts, the next one starts from the third
+	require.NoError(t, m.ExecuteScheduledIntents(ctx))
+	require.False(t, isScheduled(accounts[0]))
+	require.False(t, isScheduled(accounts[1]))
+	require.True(t, isScheduled(accounts[2]))
+	cursor, err := m.ScheduledAccountsCursor.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, accounts[2], cursor)
+
+	require.NoError(t, m.ExecuteScheduledIntents(ctx))
+	require.False(t, isScheduled(accounts[2]))
+	_, err = m.ScheduledAccountsCursor.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
@@ -32,6 +32,7 @@ var (
 	_ appmodule.HasServices         = AppModule{}
 	_ appmodule.HasGenesis          = AppModule{}
 	_ appmodule.HasConsensusVersion = AppModule{}
+	_ appmodule.HasEndBlocker       = AppModule{}
 )
 
 func NewAppModule(cdc codec.Codec, k Keeper) AppModule {
@@ -65,7 +66,7 @@ func (m AppModule) RegisterServices(registar grpc.ServiceRegistrar) error {
 // App module genesis
 
 func (am AppModule) DefaultGenesis() json.RawMessage {
-	return am.cdc.MustMarshalJSON(&v1.GenesisState{})
+	return am.cdc.MustMarshalJSON(&v1.GenesisState{Params: v1.DefaultParams()})
 }
 
 func (am AppModule) ValidateGenesis(message json.RawMessage) error {
@@ -73,8 +74,7 @@ func (am AppModule) ValidateGenesis(message json.RawMessage) error {
 	if err := am.cdc.UnmarshalJSON(message, gs); err != nil {
 		return err
 	}
-	// Add validation logic for gs here
-	return nil
+	return gs.Params.Validate()
 }
 
 func (am AppModule) InitGenesis(ctx context.Context, message json.RawMessage) error {
@@ -97,6 +97,11 @@ func (am AppModule) ExportGenesis(ctx context.Context) (json.RawMessage, error)
 	return am.cdc.MarshalJSON(gs)
 }
 
+// EndBlock executes the due intents of the scheduler accounts.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.k.ExecuteScheduledIntents(ctx)
+}
+
 func (AppModule) GetTxCmd() *cobra.Command {
 	return cli.TxCmd(ModuleName)
 }
@@ -1,6 +1,7 @@
 package accounts
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
@@ -84,6 +85,83 @@ func (m msgServer) Execute(ctx context.Context, execute *v1.MsgExecute) (*v1.Msg
 	}, nil
 }
 
+func (m msgServer) MigrateAccount(ctx context.Context, request *v1.MsgMigrateAccount) (*v1.MsgMigrateAccountResponse, error) {
+	// decode sender address
+	senderAddr, err := m.k.addressCodec.StringToBytes(request.Sender)
+	if err != nil {
+		return nil, err
+	}
+	// decode the target address
+	targetAddr, err := m.k.addressCodec.StringToBytes(request.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	// decode message bytes into the concrete boxed message type
+	req, err := implementation.UnpackAnyRaw(request.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	// run account migration logic
+	resp, err := m.k.MigrateAccount(ctx, targetAddr, senderAddr, request.AccountType, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to migrate account: %w", err)
+	}
+
+	err = m.k.EventService.EventManager(ctx).EmitKV(
+		"account_migration",
+		event.NewAttribute("address", request.Target),
+		event.NewAttribute("account_type", request.AccountType),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// encode the response
+	respAny, err := implementation.PackAny(resp)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MsgMigrateAccountResponse{
+		Response: respAny,
+	}, nil
+}
+
+func (m msgServer) ScheduleAccount(ctx context.Context, request *v1.MsgScheduleAccount) (*v1.MsgScheduleAccountResponse, error) {
+	// decode the account address
+	accAddr, err := m.k.addressCodec.StringToBytes(request.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.k.ScheduleAccount(ctx, accAddr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to schedule account: %w", err)
+	}
+	return &v1.MsgScheduleAccountResponse{}, nil
+}
+
+func (m msgServer) UpdateParams(ctx context.Context, request *v1.MsgUpdateParams) (*v1.MsgUpdateParamsResponse, error) {
+	// decode the authority address
+	authority, err := m.k.addressCodec.StringToBytes(request.Authority)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(authority, m.k.authority) {
+		return nil, fmt.Errorf("%w: expected authority %X, got %X", ErrUnauthorized, m.k.authority, authority)
+	}
+
+	if err := request.Params.Validate(); err != nil {
+		return nil, err
+	}
+	err = m.k.Params.Set(ctx, request.Params)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MsgUpdateParamsResponse{}, nil
+}
+
 func (m msgServer) ExecuteBundle(ctx context.Context, req *v1.MsgExecuteBundle) (*v1.MsgExecuteBundleResponse, error) {
 	panic("impl")
 }
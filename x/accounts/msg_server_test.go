@@ -43,3 +43,23 @@ func TestMsgServer(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, execResp)
 }
+
+func TestMsgServer_UpdateParams(t *testing.T) {
+	k, ctx := newKeeper(t)
+	s := NewMsgServer(k)
+
+	params := v1.Params{ScheduledExecutionGasLimit: 1_000_000, ScheduledAccountsPerBlock: 10}
+
+	_, err := s.UpdateParams(ctx, &v1.MsgUpdateParams{Authority: "sender", Params: params})
+	require.ErrorIs(t, err, ErrUnauthorized)
+
+	_, err = s.UpdateParams(ctx, &v1.MsgUpdateParams{Authority: "authority", Params: v1.Params{ScheduledExecutionGasLimit: 1_000_000}})
+	require.Error(t, err)
+
+	_, err = s.UpdateParams(ctx, &v1.MsgUpdateParams{Authority: "authority", Params: params})
+	require.NoError(t, err)
+
+	got, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, got)
+}
@@ -2,8 +2,10 @@ package accounts
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/accounts/internal/implementation"
 	v1 "cosmossdk.io/x/accounts/v1"
 )
@@ -93,6 +95,33 @@ func (q *queryServer) AccountNumber(ctx context.Context, request *v1.AccountNumb
 	return &v1.AccountNumberResponse{Number: number}, nil
 }
 
+func (q *queryServer) AccountImplementation(ctx context.Context, request *v1.AccountImplementationRequest) (*v1.AccountImplementationResponse, error) {
+	addr, err := q.k.addressCodec.StringToBytes(request.Address)
+	if err != nil {
+		return nil, err
+	}
+	accType, err := q.k.AccountsByType.Get(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	migrations, err := q.k.AccountMigrations.Get(ctx, addr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	return &v1.AccountImplementationResponse{
+		AccountType: accType,
+		Migrations:  migrations.Migrations,
+	}, nil
+}
+
+func (q *queryServer) Params(ctx context.Context, _ *v1.QueryParamsRequest) (*v1.QueryParamsResponse, error) {
+	params, err := q.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryParamsResponse{Params: params}, nil
+}
+
 const (
 	// TODO(tip): evaluate if the following numbers should be parametrised over state, or over the node.
 	SimulateAuthenticateGasLimit   = 1_000_000
@@ -14,7 +14,7 @@ import (
 )
 
 func TestQueryServer(t *testing.T) {
-	k, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount))
+	k, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount), accountstd.AddAccount("test_v2", NewTestAccountV2))
 
 	ms := NewMsgServer(k)
 	qs := NewQueryServer(k)
@@ -59,6 +59,36 @@ func TestQueryServer(t *testing.T) {
 		require.Equal(t, "test", typ.AccountType)
 	})
 
+	t.Run("account implementation", func(t *testing.T) {
+		impl, err := qs.AccountImplementation(ctx, &v1.AccountImplementationRequest{Address: initResp.AccountAddress})
+		require.NoError(t, err)
+		require.Equal(t, "test", impl.AccountType)
+		require.Empty(t, impl.Migrations)
+
+		migrateMsg, err := implementation.PackAny(&wrapperspb.UInt64Value{Value: 1})
+		require.NoError(t, err)
+		_, err = ms.MigrateAccount(ctx, &v1.MsgMigrateAccount{
+			Sender:      "sender",
+			Target:      initResp.AccountAddress,
+			AccountType: "test_v2",
+			Message:     migrateMsg,
+		})
+		require.NoError(t, err)
+
+		impl, err = qs.AccountImplementation(ctx, &v1.AccountImplementationRequest{Address: initResp.AccountAddress})
+		require.NoError(t, err)
+		require.Equal(t, "test_v2", impl.AccountType)
+		require.Len(t, impl.Migrations, 1)
+		require.Equal(t, "test", impl.Migrations[0].FromAccountType)
+	})
+
+	t.Run("migration handlers schema", func(t *testing.T) {
+		schemaResp, err := qs.Schema(ctx, &v1.SchemaRequest{AccountType: "test_v2"})
+		require.NoError(t, err)
+		require.Contains(t, schemaResp.MigrationHandlers, "test")
+		require.Equal(t, "google.protobuf.UInt64Value", schemaResp.MigrationHandlers["test"].Request)
+	})
+
 	t.Run("schema caching", func(t *testing.T) {
 		// Request schema once
 		schemaReq := &v1.SchemaRequest{AccountType: "test"}
@@ -3,24 +3,29 @@ package accounts
 import (
 	"context"
 	"testing"
+	"time"
 
 	gogoproto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/reflect/protoregistry"
 
+	_ "cosmossdk.io/api/cosmos/accounts/v1" // registers the accounts messages, whose signers are read by the keeper
 	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/header"
 	coretesting "cosmossdk.io/core/testing"
 	coretransaction "cosmossdk.io/core/transaction"
 	"cosmossdk.io/x/accounts/internal/implementation"
+	v1 "cosmossdk.io/x/accounts/v1"
 	"cosmossdk.io/x/tx/signing"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
 var _ address.Codec = (*addressCodec)(nil)
@@ -40,6 +45,22 @@ func (e eventService) EmitKV(eventType string, attrs ...event.Attribute) error {
 
 func (e eventService) EventManager(ctx context.Context) event.Manager { return e }
 
+type branchService struct{}
+
+func (b branchService) Execute(ctx context.Context, f func(ctx context.Context) error) error {
+	return f(ctx)
+}
+
+func (b branchService) ExecuteWithGasLimit(ctx context.Context, _ uint64, f func(ctx context.Context) error) (uint64, error) {
+	return 0, f(ctx)
+}
+
+type headerService struct{}
+
+func (h headerService) HeaderInfo(context.Context) header.Info {
+	return header.Info{Height: 1, Time: time.Unix(0, 0).UTC()}
+}
+
 func newKeeper(t *testing.T, accounts ...implementation.AccountCreatorFunc) (Keeper, context.Context) {
 	t.Helper()
 
@@ -67,6 +88,11 @@ func newKeeper(t *testing.T, accounts ...implementation.AccountCreatorFunc) (Kee
 		&bankv1beta1.MsgSetSendEnabled{},
 		&bankv1beta1.MsgMultiSend{},
 		&bankv1beta1.MsgUpdateParams{},
+		&bankv1beta1.MsgCreateDenom{},
+		&bankv1beta1.MsgFactoryMint{},
+		&bankv1beta1.MsgFactoryBurn{},
+		&bankv1beta1.MsgChangeDenomAdmin{},
+		&bankv1beta1.MsgSetDenomMetadata{},
 	)
 	queryRouter.RegisterService(&bankv1beta1.Query_ServiceDesc, &bankQueryServer{})
 	msgRouter.RegisterService(&bankv1beta1.Msg_ServiceDesc, &bankMsgServer{})
@@ -75,8 +101,13 @@ func newKeeper(t *testing.T, accounts ...implementation.AccountCreatorFunc) (Kee
 	ss := coretesting.KVStoreService(ctx, "test")
 	env := runtime.NewEnvironment(ss, coretesting.NewNopLogger(), runtime.EnvWithQueryRouterService(queryRouter), runtime.EnvWithMsgRouterService(msgRouter))
 	env.EventService = eventService{}
-	m, err := NewKeeper(codec.NewProtoCodec(ir), env, addressCodec, ir, accounts...)
+	env.HeaderService = headerService{}
+	env.BranchService = branchService{}
+	m, err := NewKeeper(codec.NewProtoCodec(ir), env, addressCodec, ir, "authority", accounts...)
 	require.NoError(t, err)
+	// accounts reach the module itself, e.g. scheduler accounts scheduling themselves.
+	msgservice.RegisterMsgServiceDesc(ir, v1.MsgServiceDesc())
+	v1.RegisterMsgServer(msgRouter, NewMsgServer(m))
 	return m, ctx
 }
 
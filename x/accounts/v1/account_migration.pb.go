@@ -0,0 +1,708 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/accounts/v1/account_migration.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AccountMigration defines the migration of an account from an account type to another.
+type AccountMigration struct {
+	// from_account_type is the account type the account was migrated from.
+	FromAccountType string `protobuf:"bytes,1,opt,name=from_account_type,json=fromAccountType,proto3" json:"from_account_type,omitempty"`
+	// to_account_type is the account type the account was migrated to.
+	ToAccountType string `protobuf:"bytes,2,opt,name=to_account_type,json=toAccountType,proto3" json:"to_account_type,omitempty"`
+	// sender is the address which migrated the account.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// height is the block height at which the account was migrated.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the account was migrated.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *AccountMigration) Reset()         { *m = AccountMigration{} }
+func (m *AccountMigration) String() string { return proto.CompactTextString(m) }
+func (*AccountMigration) ProtoMessage()    {}
+func (*AccountMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad8888850388dbe9, []int{0}
+}
+func (m *AccountMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountMigration.Merge(m, src)
+}
+func (m *AccountMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountMigration proto.InternalMessageInfo
+
+func (m *AccountMigration) GetFromAccountType() string {
+	if m != nil {
+		return m.FromAccountType
+	}
+	return ""
+}
+
+func (m *AccountMigration) GetToAccountType() string {
+	if m != nil {
+		return m.ToAccountType
+	}
+	return ""
+}
+
+func (m *AccountMigration) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *AccountMigration) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AccountMigration) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// AccountMigrations defines the migrations of an account, from the oldest.
+type AccountMigrations struct {
+	// migrations are the migrations of the account.
+	Migrations []AccountMigration `protobuf:"bytes,1,rep,name=migrations,proto3" json:"migrations"`
+}
+
+func (m *AccountMigrations) Reset()         { *m = AccountMigrations{} }
+func (m *AccountMigrations) String() string { return proto.CompactTextString(m) }
+func (*AccountMigrations) ProtoMessage()    {}
+func (*AccountMigrations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad8888850388dbe9, []int{1}
+}
+func (m *AccountMigrations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountMigrations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountMigrations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountMigrations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountMigrations.Merge(m, src)
+}
+func (m *AccountMigrations) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountMigrations) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountMigrations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountMigrations proto.InternalMessageInfo
+
+func (m *AccountMigrations) GetMigrations() []AccountMigration {
+	if m != nil {
+		return m.Migrations
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AccountMigration)(nil), "cosmos.accounts.v1.AccountMigration")
+	proto.RegisterType((*AccountMigrations)(nil), "cosmos.accounts.v1.AccountMigrations")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/accounts/v1/account_migration.proto", fileDescriptor_ad8888850388dbe9)
+}
+
+var fileDescriptor_ad8888850388dbe9 = []byte{
+	// 315 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xcf, 0x4e, 0x02, 0x31,
+	0x10, 0xc6, 0xb7, 0x82, 0x44, 0x4b, 0x0c, 0xd2, 0x18, 0xb3, 0xd9, 0x43, 0xd9, 0x10, 0x63, 0x36,
+	0x1c, 0xda, 0x80, 0x1e, 0xbc, 0xca, 0xd1, 0xc4, 0xcb, 0x86, 0x93, 0x17, 0xc2, 0x9f, 0x52, 0x36,
+	0xba, 0xcc, 0x66, 0x5b, 0x88, 0xbc, 0x05, 0x8f, 0xc5, 0x91, 0xa3, 0x27, 0x35, 0xf0, 0x22, 0x66,
+	0x5b, 0x6a, 0x10, 0x6f, 0x33, 0x9d, 0xdf, 0x37, 0x33, 0xdf, 0x14, 0xb7, 0x46, 0xa0, 0x52, 0x50,
+	0x7c, 0x30, 0x1a, 0xc1, 0x7c, 0xa6, 0x15, 0x5f, 0xb4, 0x5d, 0xdc, 0x4f, 0x13, 0x99, 0x0f, 0x74,
+	0x02, 0x33, 0x96, 0xe5, 0xa0, 0x81, 0x10, 0xcb, 0x32, 0xc7, 0xb2, 0x45, 0x3b, 0xb8, 0x92, 0x20,
+	0xc1, 0x94, 0x79, 0x11, 0x59, 0x32, 0x68, 0x48, 0x00, 0xf9, 0x26, 0xb8, 0xc9, 0x86, 0xf3, 0x09,
+	0xd7, 0x49, 0x2a, 0x94, 0x1e, 0xa4, 0x99, 0x05, 0x9a, 0x1b, 0x84, 0x2f, 0x1f, 0x6d, 0x9b, 0x67,
+	0x37, 0x85, 0xb4, 0x70, 0x7d, 0x92, 0x43, 0xda, 0x77, 0xf3, 0xf5, 0x32, 0x13, 0x3e, 0x0a, 0x51,
+	0x74, 0x1e, 0xd7, 0x8a, 0xc2, 0x5e, 0xd0, 0x5b, 0x66, 0x82, 0xdc, 0xe2, 0x9a, 0x86, 0xbf, 0xe4,
+	0x89, 0x21, 0x2f, 0x34, 0x1c, 0x72, 0xd7, 0xb8, 0xa2, 0xc4, 0x6c, 0x2c, 0x72, 0xbf, 0x64, 0xca,
+	0xfb, 0xac, 0x78, 0x9f, 0x8a, 0x44, 0x4e, 0xb5, 0x5f, 0x0e, 0x51, 0x54, 0x8a, 0xf7, 0x19, 0x79,
+	0xc0, 0xe5, 0x62, 0x57, 0xff, 0x34, 0x44, 0x51, 0xb5, 0x13, 0x30, 0x6b, 0x84, 0x39, 0x23, 0xac,
+	0xe7, 0x8c, 0x74, 0xcf, 0xd6, 0x9f, 0x0d, 0x6f, 0xf5, 0xd5, 0x40, 0xb1, 0x51, 0x34, 0xfb, 0xb8,
+	0x7e, 0xec, 0x48, 0x91, 0x27, 0x8c, 0x7f, 0xaf, 0xa8, 0x7c, 0x14, 0x96, 0xa2, 0x6a, 0xe7, 0x86,
+	0xfd, 0xbf, 0x23, 0x3b, 0x96, 0x76, 0xcb, 0x45, 0xfb, 0xf8, 0x40, 0xdd, 0xbd, 0x5f, 0x6f, 0x29,
+	0xda, 0x6c, 0x29, 0xfa, 0xde, 0x52, 0xb4, 0xda, 0x51, 0x6f, 0xb3, 0xa3, 0xde, 0xc7, 0x8e, 0x7a,
+	0x2f, 0x81, 0x6d, 0xa8, 0xc6, 0xaf, 0x2c, 0x01, 0xfe, 0x7e, 0xf8, 0x99, 0xc3, 0x8a, 0x59, 0xfd,
+	0xee, 0x67, 0x00, 0x53, 0x5d, 0x97, 0xc5, 0xe9, 0x01, 0x00, 0x00,
+}
+
+func (m *AccountMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAccountMigration(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintAccountMigration(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintAccountMigration(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ToAccountType) > 0 {
+		i -= len(m.ToAccountType)
+		copy(dAtA[i:], m.ToAccountType)
+		i = encodeVarintAccountMigration(dAtA, i, uint64(len(m.ToAccountType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAccountType) > 0 {
+		i -= len(m.FromAccountType)
+		copy(dAtA[i:], m.FromAccountType)
+		i = encodeVarintAccountMigration(dAtA, i, uint64(len(m.FromAccountType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountMigrations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountMigrations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountMigrations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Migrations) > 0 {
+		for iNdEx := len(m.Migrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Migrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccountMigration(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAccountMigration(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAccountMigration(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccountMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAccountType)
+	if l > 0 {
+		n += 1 + l + sovAccountMigration(uint64(l))
+	}
+	l = len(m.ToAccountType)
+	if l > 0 {
+		n += 1 + l + sovAccountMigration(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovAccountMigration(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovAccountMigration(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovAccountMigration(uint64(l))
+	return n
+}
+
+func (m *AccountMigrations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Migrations) > 0 {
+		for _, e := range m.Migrations {
+			l = e.Size()
+			n += 1 + l + sovAccountMigration(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAccountMigration(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAccountMigration(x uint64) (n int) {
+	return sovAccountMigration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AccountMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountMigration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAccountType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccountMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAccountType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAccountType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccountMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAccountType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccountMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccountMigration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountMigration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccountMigration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountMigrations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountMigration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountMigrations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountMigrations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccountMigration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Migrations = append(m.Migrations, AccountMigration{})
+			if err := m.Migrations[len(m.Migrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountMigration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccountMigration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAccountMigration(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAccountMigration
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccountMigration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccountMigration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAccountMigration
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAccountMigration
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAccountMigration
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAccountMigration        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAccountMigration          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAccountMigration = fmt.Errorf("proto: unexpected end of group")
+)
@@ -6,7 +6,9 @@ package v1
 import (
 	fmt "fmt"
 	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
 	math "math"
+	math_bits "math/bits"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -20,15 +22,320 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Params defines the parameters of the x/accounts module.
+type Params struct {
+	// scheduled_execution_gas_limit defines the gas the module spends each block
+	// collecting and executing the due intents of the scheduler accounts.
+	ScheduledExecutionGasLimit uint64 `protobuf:"varint,1,opt,name=scheduled_execution_gas_limit,json=scheduledExecutionGasLimit,proto3" json:"scheduled_execution_gas_limit,omitempty"`
+	// scheduled_accounts_per_block defines the maximum number of scheduler accounts
+	// whose due intents are collected each block.
+	ScheduledAccountsPerBlock uint64 `protobuf:"varint,2,opt,name=scheduled_accounts_per_block,json=scheduledAccountsPerBlock,proto3" json:"scheduled_accounts_per_block,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2b21596ac228a1d, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetScheduledExecutionGasLimit() uint64 {
+	if m != nil {
+		return m.ScheduledExecutionGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetScheduledAccountsPerBlock() uint64 {
+	if m != nil {
+		return m.ScheduledAccountsPerBlock
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.accounts.v1.Params")
+}
+
 func init() { proto.RegisterFile("cosmos/accounts/v1/accounts.proto", fileDescriptor_c2b21596ac228a1d) }
 
 var fileDescriptor_c2b21596ac228a1d = []byte{
-	// 108 bytes of a gzipped FileDescriptorProto
+	// 202 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
 	0xcd, 0x2f, 0xd6, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29, 0xd6, 0x2f, 0x33, 0x84, 0xb3,
-	0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x84, 0x20, 0x4a, 0xf4, 0xe0, 0xc2, 0x65, 0x86, 0x4e,
-	0x26, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7,
-	0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x25, 0x05, 0x51, 0x5d, 0x9c,
-	0x92, 0xad, 0x97, 0x99, 0xaf, 0x5f, 0x81, 0x6c, 0x70, 0x12, 0x1b, 0xd8, 0x40, 0x63, 0x40, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x4b, 0x46, 0xb4, 0xb4, 0x75, 0x00, 0x00, 0x00,
+	0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x84, 0x20, 0x4a, 0xf4, 0xe0, 0xc2, 0x65, 0x86, 0x4a,
+	0x3d, 0x8c, 0x5c, 0x6c, 0x01, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x42, 0x8e, 0x5c, 0xb2, 0xc5, 0xc9,
+	0x19, 0xa9, 0x29, 0xa5, 0x39, 0xa9, 0x29, 0xf1, 0xa9, 0x15, 0xa9, 0xc9, 0xa5, 0x25, 0x99, 0xf9,
+	0x79, 0xf1, 0xe9, 0x89, 0xc5, 0xf1, 0x39, 0x99, 0xb9, 0x99, 0x25, 0x12, 0x8c, 0x0a, 0x8c, 0x1a,
+	0x2c, 0x41, 0x52, 0x70, 0x45, 0xae, 0x30, 0x35, 0xee, 0x89, 0xc5, 0x3e, 0x20, 0x15, 0x42, 0xf6,
+	0x5c, 0x32, 0x08, 0x23, 0x60, 0xd6, 0xc4, 0x17, 0xa4, 0x16, 0xc5, 0x27, 0xe5, 0xe4, 0x27, 0x67,
+	0x4b, 0x30, 0x81, 0x4d, 0x90, 0x84, 0xab, 0x71, 0x84, 0x2a, 0x09, 0x48, 0x2d, 0x72, 0x02, 0x29,
+	0x70, 0x32, 0x39, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27,
+	0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x29, 0x88, 0xe3,
+	0x8b, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x2b, 0x90, 0xfd, 0x99, 0xc4, 0x06, 0xf6, 0x9f, 0x31,
+	0x60, 0x00, 0xed, 0x15, 0xa0, 0xbd, 0x04, 0x01, 0x00, 0x00,
 }
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduledAccountsPerBlock != 0 {
+		i = encodeVarintAccounts(dAtA, i, uint64(m.ScheduledAccountsPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ScheduledExecutionGasLimit != 0 {
+		i = encodeVarintAccounts(dAtA, i, uint64(m.ScheduledExecutionGasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAccounts(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAccounts(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduledExecutionGasLimit != 0 {
+		n += 1 + sovAccounts(uint64(m.ScheduledExecutionGasLimit))
+	}
+	if m.ScheduledAccountsPerBlock != 0 {
+		n += 1 + sovAccounts(uint64(m.ScheduledAccountsPerBlock))
+	}
+	return n
+}
+
+func sovAccounts(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAccounts(x uint64) (n int) {
+	return sovAccounts(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccounts
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledExecutionGasLimit", wireType)
+			}
+			m.ScheduledExecutionGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledExecutionGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAccountsPerBlock", wireType)
+			}
+			m.ScheduledAccountsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledAccountsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccounts(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccounts
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAccounts(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAccounts
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccounts
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccounts
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAccounts
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAccounts
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAccounts
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAccounts        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAccounts          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAccounts = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,6 +5,7 @@ package v1
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -28,6 +29,8 @@ type GenesisState struct {
 	Accounts []*GenesisAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
 	// init_accounts_msgs defines the genesis messages that will be executed to init the account.
 	InitAccountMsgs []*MsgInit `protobuf:"bytes,2,rep,name=init_account_msgs,json=initAccountMsgs,proto3" json:"init_account_msgs,omitempty"`
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +80,13 @@ func (m *GenesisState) GetInitAccountMsgs() []*MsgInit {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 type GenesisAccount struct {
 	// address is the address of the account.
@@ -87,6 +97,8 @@ type GenesisAccount struct {
 	AccountNumber uint64 `protobuf:"varint,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
 	// state is the account state represented as a slice of raw key value byte pairs.
 	State []*KVPair `protobuf:"bytes,4,rep,name=state,proto3" json:"state,omitempty"`
+	// migrations are the migrations of the account to other account types, from the oldest.
+	Migrations []AccountMigration `protobuf:"bytes,5,rep,name=migrations,proto3" json:"migrations"`
 }
 
 func (m *GenesisAccount) Reset()         { *m = GenesisAccount{} }
@@ -150,6 +162,13 @@ func (m *GenesisAccount) GetState() []*KVPair {
 	return nil
 }
 
+func (m *GenesisAccount) GetMigrations() []AccountMigration {
+	if m != nil {
+		return m.Migrations
+	}
+	return nil
+}
+
 // KVPair defines a key value pair.
 type KVPair struct {
 	// key is the key of the pair.
@@ -214,28 +233,33 @@ func init() {
 func init() { proto.RegisterFile("cosmos/accounts/v1/genesis.proto", fileDescriptor_409859d32eae9438) }
 
 var fileDescriptor_409859d32eae9438 = []byte{
-	// 331 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcd, 0x4a, 0xc3, 0x40,
-	0x14, 0x85, 0x3b, 0xfd, 0x53, 0x6f, 0x63, 0xd5, 0xc1, 0x45, 0x68, 0x21, 0xd4, 0x80, 0xd0, 0x55,
-	0xd2, 0xaa, 0x6b, 0x41, 0x37, 0x45, 0xa4, 0x22, 0xa3, 0xb8, 0x70, 0x53, 0xd2, 0x76, 0x08, 0x43,
-	0x6d, 0xa6, 0xe4, 0x4e, 0x4b, 0xfb, 0x16, 0xee, 0x7c, 0x03, 0x9f, 0xc5, 0x65, 0x97, 0x2e, 0xa5,
-	0x7d, 0x11, 0xc9, 0x4c, 0x22, 0x8a, 0xd9, 0xe5, 0x7e, 0x39, 0xe7, 0xdc, 0xc3, 0x5c, 0x68, 0x8d,
-	0x24, 0x4e, 0x25, 0xfa, 0xc1, 0x68, 0x24, 0xe7, 0x91, 0x42, 0x7f, 0xd1, 0xf5, 0x43, 0x1e, 0x71,
-	0x14, 0xe8, 0xcd, 0x62, 0xa9, 0x24, 0xa5, 0x46, 0xe1, 0x65, 0x0a, 0x6f, 0xd1, 0x6d, 0x34, 0x73,
-	0x5c, 0x6a, 0x69, 0x0c, 0xee, 0x1b, 0x01, 0xab, 0x67, 0x22, 0x1e, 0x54, 0xa0, 0x38, 0xbd, 0x84,
-	0xdd, 0x4c, 0x68, 0x93, 0x56, 0xa9, 0x5d, 0x3b, 0x73, 0xbd, 0xff, 0xa1, 0x5e, 0xea, 0xb9, 0x32,
-	0x88, 0xfd, 0x78, 0x68, 0x0f, 0x8e, 0x44, 0x24, 0xd4, 0x20, 0x05, 0x83, 0x29, 0x86, 0x68, 0x17,
-	0x75, 0x50, 0x33, 0x2f, 0xa8, 0x8f, 0xe1, 0x4d, 0x24, 0x14, 0x3b, 0x48, 0x5c, 0x69, 0x5c, 0x1f,
-	0x43, 0x74, 0xdf, 0x09, 0xd4, 0xff, 0x6e, 0xa1, 0x36, 0xec, 0x04, 0xe3, 0x71, 0xcc, 0x31, 0xa9,
-	0x46, 0xda, 0x7b, 0x2c, 0x1b, 0xe9, 0x09, 0x58, 0xd9, 0x42, 0xb5, 0x9a, 0x71, 0xbb, 0xa8, 0x7f,
-	0xd7, 0x52, 0xf6, 0xb8, 0x9a, 0x71, 0x7a, 0x0a, 0xf5, 0x4c, 0x12, 0xcd, 0xa7, 0x43, 0x1e, 0xdb,
-	0xa5, 0x16, 0x69, 0x97, 0xd9, 0x7e, 0x4a, 0xef, 0x34, 0xa4, 0x1d, 0xa8, 0x60, 0xf2, 0x10, 0x76,
-	0x59, 0x77, 0x6e, 0xe4, 0x75, 0xbe, 0x7d, 0xba, 0x0f, 0x44, 0xcc, 0x8c, 0xd0, 0xed, 0x40, 0xd5,
-	0x00, 0x7a, 0x08, 0xa5, 0x09, 0x5f, 0xe9, 0x6e, 0x16, 0x4b, 0x3e, 0xe9, 0x31, 0x54, 0x16, 0xc1,
-	0xcb, 0xdc, 0x14, 0xb2, 0x98, 0x19, 0xae, 0x2f, 0x3e, 0x36, 0x0e, 0x59, 0x6f, 0x1c, 0xf2, 0xb5,
-	0x71, 0xc8, 0xeb, 0xd6, 0x29, 0xac, 0xb7, 0x4e, 0xe1, 0x73, 0xeb, 0x14, 0x9e, 0x1b, 0x66, 0x1b,
-	0x8e, 0x27, 0x9e, 0x90, 0xfe, 0xf2, 0xf7, 0xcd, 0x86, 0x55, 0x7d, 0xb1, 0xf3, 0xef, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x06, 0xd4, 0x9c, 0x6a, 0x06, 0x02, 0x00, 0x00,
+	// 406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0xcf, 0xd2, 0x30,
+	0x18, 0xc7, 0xd7, 0x77, 0x80, 0x5a, 0xe6, 0xab, 0x36, 0xef, 0x61, 0x19, 0xc9, 0x1c, 0x8b, 0x26,
+	0xc4, 0xc3, 0x06, 0xe8, 0xc1, 0x93, 0x89, 0x5c, 0x88, 0x1a, 0x0c, 0xa9, 0xc6, 0x83, 0x17, 0x52,
+	0xa0, 0x59, 0x1a, 0xdc, 0xba, 0xac, 0x85, 0xc0, 0xb7, 0xf0, 0x63, 0x71, 0xc4, 0x9b, 0x27, 0x63,
+	0xe0, 0x1b, 0xf8, 0x09, 0xcc, 0xda, 0x8e, 0x60, 0x1c, 0xb7, 0xa7, 0x4f, 0xff, 0xbf, 0x67, 0xff,
+	0x7f, 0xf7, 0xc0, 0x60, 0xc1, 0x45, 0xca, 0x45, 0x4c, 0x16, 0x0b, 0xbe, 0xce, 0xa4, 0x88, 0x37,
+	0x83, 0x38, 0xa1, 0x19, 0x15, 0x4c, 0x44, 0x79, 0xc1, 0x25, 0x47, 0x48, 0x2b, 0xa2, 0x4a, 0x11,
+	0x6d, 0x06, 0xde, 0x8b, 0x1a, 0xca, 0xd4, 0xb3, 0x94, 0x25, 0x05, 0x91, 0x8c, 0x67, 0x9a, 0xf7,
+	0xba, 0xd7, 0xb5, 0xe6, 0x13, 0x5e, 0xa7, 0x46, 0x22, 0xb7, 0xe6, 0xf2, 0x2e, 0xe1, 0x09, 0x57,
+	0x65, 0x5c, 0x56, 0xba, 0x1b, 0xfe, 0x00, 0xd0, 0x19, 0x6b, 0x9f, 0x9f, 0x24, 0x91, 0x14, 0xbd,
+	0x81, 0xf7, 0x2b, 0xdc, 0x05, 0x81, 0xdd, 0x6b, 0x0f, 0xc3, 0xe8, 0x7f, 0xe7, 0x91, 0x61, 0xde,
+	0xea, 0x16, 0x3e, 0x33, 0x68, 0x0c, 0x9f, 0xb0, 0x8c, 0xc9, 0xd9, 0x39, 0x86, 0x48, 0x84, 0x7b,
+	0xa3, 0x06, 0x75, 0xea, 0x06, 0x4d, 0x44, 0xf2, 0x2e, 0x63, 0x12, 0x3f, 0x2a, 0x29, 0x33, 0x6e,
+	0x22, 0x12, 0x81, 0x5e, 0xc3, 0x56, 0x4e, 0x0a, 0x92, 0x0a, 0xd7, 0x0e, 0x40, 0xaf, 0x3d, 0xf4,
+	0xea, 0xe8, 0xa9, 0x52, 0x8c, 0x1a, 0xfb, 0x5f, 0x4f, 0x2d, 0x6c, 0xf4, 0xe1, 0x1f, 0x00, 0x6f,
+	0xff, 0xf5, 0x87, 0x5c, 0x78, 0x8f, 0x2c, 0x97, 0x05, 0x15, 0x65, 0x28, 0xd0, 0x7b, 0x80, 0xab,
+	0x23, 0xea, 0x42, 0xa7, 0xb2, 0x2a, 0x77, 0x39, 0x75, 0x6f, 0xd4, 0x75, 0xdb, 0xf4, 0x3e, 0xef,
+	0x72, 0x8a, 0x9e, 0xc3, 0xdb, 0x4a, 0x92, 0xad, 0xd3, 0x39, 0x2d, 0x94, 0xa3, 0x06, 0x7e, 0x68,
+	0xba, 0x1f, 0x55, 0x13, 0xf5, 0x61, 0x53, 0x94, 0x4f, 0xe8, 0x36, 0x02, 0xfb, 0x9a, 0xdf, 0x0f,
+	0x5f, 0xa6, 0x84, 0x15, 0x58, 0x0b, 0xd1, 0x7b, 0x08, 0xcf, 0x7f, 0x59, 0xb8, 0x4d, 0x85, 0x3d,
+	0xab, 0xc3, 0xaa, 0x77, 0xa9, 0xc4, 0x26, 0xf0, 0x05, 0x1d, 0xf6, 0x61, 0x4b, 0x0f, 0x47, 0x8f,
+	0xa1, 0xbd, 0xa2, 0x3b, 0x95, 0xd3, 0xc1, 0x65, 0x89, 0xee, 0x60, 0x73, 0x43, 0xbe, 0xad, 0x75,
+	0x38, 0x07, 0xeb, 0xc3, 0xe8, 0xd5, 0xfe, 0xe8, 0x83, 0xc3, 0xd1, 0x07, 0xbf, 0x8f, 0x3e, 0xf8,
+	0x7e, 0xf2, 0xad, 0xc3, 0xc9, 0xb7, 0x7e, 0x9e, 0x7c, 0xeb, 0xab, 0xa7, 0x2d, 0x88, 0xe5, 0x2a,
+	0x62, 0x3c, 0xde, 0x5e, 0xee, 0xd3, 0xbc, 0xa5, 0xf6, 0xe6, 0xe5, 0xdf, 0x01, 0x00, 0x1f, 0x5d,
+	0x13, 0xd3, 0xf1, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -258,6 +282,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.InitAccountMsgs) > 0 {
 		for iNdEx := len(m.InitAccountMsgs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -309,6 +343,20 @@ func (m *GenesisAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Migrations) > 0 {
+		for iNdEx := len(m.Migrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Migrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.State) > 0 {
 		for iNdEx := len(m.State) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -411,6 +459,8 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -437,6 +487,12 @@ func (m *GenesisAccount) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Migrations) > 0 {
+		for _, e := range m.Migrations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -560,6 +616,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -727,6 +816,40 @@ func (m *GenesisAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Migrations = append(m.Migrations, AccountMigration{})
+			if err := m.Migrations[len(m.Migrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
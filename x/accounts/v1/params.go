@@ -0,0 +1,31 @@
+package v1
+
+import "errors"
+
+const (
+	// DefaultScheduledExecutionGasLimit is the default gas the accounts module spends
+	// each block collecting and executing the due intents of scheduler accounts.
+	DefaultScheduledExecutionGasLimit = 10_000_000
+	// DefaultScheduledAccountsPerBlock is the default maximum number of scheduler
+	// accounts whose due intents are collected each block.
+	DefaultScheduledAccountsPerBlock = 100
+)
+
+// DefaultParams returns the default parameters of the accounts module.
+func DefaultParams() Params {
+	return Params{
+		ScheduledExecutionGasLimit: DefaultScheduledExecutionGasLimit,
+		ScheduledAccountsPerBlock:  DefaultScheduledAccountsPerBlock,
+	}
+}
+
+// Validate validates the parameters of the accounts module.
+func (p Params) Validate() error {
+	if p.ScheduledExecutionGasLimit == 0 {
+		return errors.New("scheduled execution gas limit must be positive")
+	}
+	if p.ScheduledAccountsPerBlock == 0 {
+		return errors.New("scheduled accounts per block must be positive")
+	}
+	return nil
+}
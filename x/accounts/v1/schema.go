@@ -23,11 +23,23 @@ func makeAccountSchema(impl implementation.Implementation) *SchemaResponse {
 			Request:  impl.InitHandlerSchema.RequestSchema.Name,
 			Response: impl.InitHandlerSchema.ResponseSchema.Name,
 		},
-		ExecuteHandlers: makeHandlersSchema(impl.ExecuteHandlersSchema),
-		QueryHandlers:   makeHandlersSchema(impl.QueryHandlersSchema),
+		ExecuteHandlers:   makeHandlersSchema(impl.ExecuteHandlersSchema),
+		QueryHandlers:     makeHandlersSchema(impl.QueryHandlersSchema),
+		MigrationHandlers: makeMigrationHandlersSchema(impl.MigrationHandlersSchema),
 	}
 }
 
+func makeMigrationHandlersSchema(handlers map[string]implementation.HandlerSchema) map[string]*SchemaResponse_Handler {
+	schemas := make(map[string]*SchemaResponse_Handler, len(handlers))
+	for fromAccountType, handler := range handlers {
+		schemas[fromAccountType] = &SchemaResponse_Handler{
+			Request:  handler.RequestSchema.Name,
+			Response: handler.ResponseSchema.Name,
+		}
+	}
+	return schemas
+}
+
 func makeHandlersSchema(handlers map[string]implementation.HandlerSchema) []*SchemaResponse_Handler {
 	schemas := make([]*SchemaResponse_Handler, 0, len(handlers))
 	for name, handler := range handlers {
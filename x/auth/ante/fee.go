@@ -10,6 +10,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 // TxFeeChecker checks if the provided fee is enough and returns the effective fee and tx priority.
@@ -17,6 +18,7 @@ import (
 type TxFeeChecker func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error)
 
 // DeductFeeDecorator deducts fees from the fee payer. The fee payer is the fee granter (if specified) or first signer of the tx.
+// If the fee granter is a paymaster account of x/accounts, it must accept to pay the fees instead of granting them with x/feegrant.
 // If the fee payer does not have the funds to pay for the fees, return an InsufficientFunds error.
 // Call next AnteHandler if fees are successfully deducted.
 // CONTRACT: The Tx must implement the FeeTx interface to use DeductFeeDecorator.
@@ -25,9 +27,10 @@ type DeductFeeDecorator struct {
 	bankKeeper     types.BankKeeper
 	feegrantKeeper FeegrantKeeper
 	txFeeChecker   TxFeeChecker
+	aaKeeper       AccountAbstractionKeeper
 }
 
-func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper, tfc TxFeeChecker) DeductFeeDecorator {
+func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper, tfc TxFeeChecker, aaKeeper AccountAbstractionKeeper) DeductFeeDecorator {
 	if tfc == nil {
 		tfc = checkTxFeeWithValidatorMinGasPrices
 	}
@@ -37,6 +40,7 @@ func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKee
 		bankKeeper:     bk,
 		feegrantKeeper: fk,
 		txFeeChecker:   tfc,
+		aaKeeper:       aaKeeper,
 	}
 }
 
@@ -89,11 +93,20 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 	deductFeesFrom := feePayer
 
 	// if feegranter set, deduct fee from feegranter account.
-	// this works only when feegrant is enabled.
+	// this works only when feegrant is enabled, or when the fee granter is a paymaster account.
 	if feeGranter != nil {
 		feeGranterAddr := sdk.AccAddress(feeGranter)
 
-		if dfd.feegrantKeeper == nil {
+		isPaymaster, err := dfd.isPaymaster(ctx, feeGranter)
+		if err != nil {
+			return err
+		}
+
+		if isPaymaster {
+			if err := dfd.payWithPaymaster(ctx, sdkTx, feeGranter, feePayer, fee); err != nil {
+				return err
+			}
+		} else if dfd.feegrantKeeper == nil {
 			return sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
 		} else if !bytes.Equal(feeGranterAddr, feePayer) {
 			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranterAddr, feePayer, fee, sdkTx.GetMsgs())
@@ -133,6 +146,43 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 	return nil
 }
 
+// isPaymaster returns if the fee granter is a paymaster account of x/accounts.
+func (dfd DeductFeeDecorator) isPaymaster(ctx sdk.Context, feeGranter []byte) (bool, error) {
+	if dfd.aaKeeper == nil {
+		return false, nil
+	}
+	return dfd.aaKeeper.IsPaymasterAccount(ctx, feeGranter)
+}
+
+// payWithPaymaster asks the paymaster account to pay the fees of the fee payer.
+func (dfd DeductFeeDecorator) payWithPaymaster(ctx sdk.Context, sdkTx sdk.Tx, paymaster, feePayer []byte, fee sdk.Coins) error {
+	// the operation is sent directly by the fee payer, which is then the bundler.
+	feePayerAddr, err := dfd.accountKeeper.AddressCodec().BytesToString(feePayer)
+	if err != nil {
+		return err
+	}
+
+	infoTx, ok := sdkTx.(interface {
+		AsTxRaw() (*tx.TxRaw, error)
+		AsTx() (*tx.Tx, error)
+	})
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "paymasters require a protobuf tx")
+	}
+
+	txRaw, err := infoTx.AsTxRaw()
+	if err != nil {
+		return fmt.Errorf("unable to get raw tx: %w", err)
+	}
+
+	protoTx, err := infoTx.AsTx()
+	if err != nil {
+		return fmt.Errorf("unable to get proto tx: %w", err)
+	}
+
+	return dfd.aaKeeper.PayFee(ctx, paymaster, feePayerAddr, feePayerAddr, fee, txRaw, protoTx)
+}
+
 // DeductFees deducts fees from the given account.
 func DeductFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc []byte, fees sdk.Coins) error {
 	if !fees.IsValid() {
@@ -1,6 +1,9 @@
 package ante_test
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -14,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
@@ -21,7 +25,7 @@ func TestDeductFeeDecorator_ZeroGas(t *testing.T) {
 	s := SetupTestSuite(t, true)
 	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
 
-	mfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil)
+	mfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil, nil)
 	antehandler := sdk.ChainAnteDecorators(mfd)
 
 	// keys and addresses
@@ -54,7 +58,7 @@ func TestEnsureMempoolFees(t *testing.T) {
 	s := SetupTestSuite(t, true) // setup
 	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
 
-	mfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil)
+	mfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil, nil)
 	antehandler := sdk.ChainAnteDecorators(mfd)
 
 	// keys and addresses
@@ -124,7 +128,7 @@ func TestDeductFees(t *testing.T) {
 	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
 	require.NoError(t, err)
 
-	dfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, nil, nil)
+	dfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, nil, nil, nil)
 	antehandler := sdk.ChainAnteDecorators(dfd)
 	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(sdkerrors.ErrInsufficientFunds)
 
@@ -137,3 +141,68 @@ func TestDeductFees(t *testing.T) {
 
 	require.Nil(t, err, "Tx errored after account has been set with sufficient funds")
 }
+
+// mockPaymasterKeeper is an AccountAbstractionKeeper whose only account is a paymaster
+// which pays fees when payErr is nil.
+type mockPaymasterKeeper struct {
+	paymaster []byte
+	payErr    error
+	paid      sdk.Coins
+}
+
+func (m *mockPaymasterKeeper) IsAbstractedAccount(context.Context, []byte) (bool, error) {
+	return false, nil
+}
+
+func (m *mockPaymasterKeeper) AuthenticateAccount(context.Context, []byte, string, *txtypes.TxRaw, *txtypes.Tx, uint32) error {
+	return errors.New("not an abstracted account")
+}
+
+func (m *mockPaymasterKeeper) IsPaymasterAccount(_ context.Context, addr []byte) (bool, error) {
+	return bytes.Equal(addr, m.paymaster), nil
+}
+
+func (m *mockPaymasterKeeper) PayFee(_ context.Context, _ []byte, _, _ string, fee sdk.Coins, rawTx *txtypes.TxRaw, protoTx *txtypes.Tx) error {
+	if rawTx == nil || protoTx == nil {
+		return errors.New("missing tx")
+	}
+	if m.payErr != nil {
+		return m.payErr
+	}
+	m.paid = m.paid.Add(fee...)
+	return nil
+}
+
+func TestDeductFeesWithPaymaster(t *testing.T) {
+	s := SetupTestSuite(t, false)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	accs := s.CreateTestAccounts(2)
+	paymaster := accs[1].acc.GetAddress()
+
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	feeAmount := testdata.NewTestFeeAmount()
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetFeeAmount(feeAmount)
+	s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	s.txBuilder.SetFeeGranter(paymaster)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	// the paymaster is asked instead of x/feegrant, which is not enabled.
+	aaKeeper := &mockPaymasterKeeper{paymaster: paymaster, payErr: errors.New("budget exceeded")}
+	dfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, nil, nil, aaKeeper)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorContains(t, err, "budget exceeded")
+
+	// once accepted, the fees are deducted from the paymaster.
+	aaKeeper.payErr = nil
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), paymaster, authtypes.FeeCollectorName, feeAmount).Return(nil)
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, feeAmount, aaKeeper.paid)
+}
@@ -149,7 +149,7 @@ func TestDeductFeesNoDelegation(t *testing.T) {
 			signingCtx := suite.encCfg.InterfaceRegistry.SigningContext()
 			protoTxCfg := tx.NewTxConfig(cdc, signingCtx.AddressCodec(), signingCtx.ValidatorAddressCodec(), tx.DefaultSignModes)
 			// this just tests our handler
-			dfd := ante.NewDeductFeeDecorator(suite.accountKeeper, suite.bankKeeper, suite.feeGrantKeeper, nil)
+			dfd := ante.NewDeductFeeDecorator(suite.accountKeeper, suite.bankKeeper, suite.feeGrantKeeper, nil, nil)
 			feeAnteHandler := sdk.ChainAnteDecorators(dfd)
 
 			// this tests the whole stack
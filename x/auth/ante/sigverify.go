@@ -52,6 +52,8 @@ type SignatureVerificationGasConsumer = func(meter storetypes.GasMeter, sig sign
 type AccountAbstractionKeeper interface {
 	IsAbstractedAccount(ctx context.Context, addr []byte) (bool, error)
 	AuthenticateAccount(ctx context.Context, signer []byte, bundler string, rawTx *tx.TxRaw, protoTx *tx.Tx, signIndex uint32) error
+	IsPaymasterAccount(ctx context.Context, addr []byte) (bool, error)
+	PayFee(ctx context.Context, paymaster []byte, bundler, feePayer string, fee sdk.Coins, rawTx *tx.TxRaw, protoTx *tx.Tx) error
 }
 
 // SigVerificationDecorator verifies all signatures for a tx and returns an
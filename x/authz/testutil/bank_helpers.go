@@ -27,3 +27,23 @@ func (k MockBankKeeper) UpdateParams(ctx context.Context, req *bank.MsgUpdatePar
 func (k MockBankKeeper) SetSendEnabled(ctx context.Context, req *bank.MsgSetSendEnabled) (*bank.MsgSetSendEnabledResponse, error) {
 	return nil, nil
 }
+
+func (k MockBankKeeper) CreateDenom(ctx context.Context, msg *bank.MsgCreateDenom) (*bank.MsgCreateDenomResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) FactoryMint(ctx context.Context, msg *bank.MsgFactoryMint) (*bank.MsgFactoryMintResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) FactoryBurn(ctx context.Context, msg *bank.MsgFactoryBurn) (*bank.MsgFactoryBurnResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) ChangeDenomAdmin(ctx context.Context, msg *bank.MsgChangeDenomAdmin) (*bank.MsgChangeDenomAdminResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetDenomMetadata(ctx context.Context, msg *bank.MsgSetDenomMetadata) (*bank.MsgSetDenomMetadataResponse, error) {
+	return nil, nil
+}
@@ -87,6 +87,42 @@ To look up all denoms, do not provide any arguments.`,
 					),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denoms", Varargs: true}},
 				},
+				{
+					RpcMethod:      "SendHooks",
+					Use:            "send-hooks [denom]",
+					Short:          "Query the send hooks registered for a denom, in the order they run",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "DenomAdmin",
+					Use:            "denom-admin [denom]",
+					Short:          "Query the admin of a token factory denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "DenomsFromCreator",
+					Use:            "denoms-from-creator [creator]",
+					Short:          "Query the token factory denoms created by an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
+				},
+				{
+					RpcMethod:      "BalanceHistory",
+					Use:            "balance-history [address]",
+					Short:          "Query the balance changes of an account, optionally for a single denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "HistoricalBalance",
+					Use:            "historical-balance [address] [denom] [height]",
+					Short:          "Query the balance of an account for a denom at a past height",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "denom"}, {ProtoField: "height"}},
+				},
+				{
+					RpcMethod:      "AddressTransfers",
+					Use:            "transfers [address]",
+					Short:          "Query the transfers sent or received by an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -128,6 +164,37 @@ Note: multiple coins can be send by space separated.`,
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod:      "CreateDenom",
+					Use:            "create-denom [subdenom]",
+					Short:          "Create the token factory denom factory/{sender}/{subdenom}, paying the denom creation fee.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subdenom"}},
+				},
+				{
+					RpcMethod:      "FactoryMint",
+					Use:            "factory-mint [amount] [mint_to_address]",
+					Short:          "Mint coins of a token factory denom administered by the sender. The coins are minted to the sender by default.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}, {ProtoField: "mint_to_address", Optional: true}},
+				},
+				{
+					RpcMethod:      "FactoryBurn",
+					Use:            "factory-burn [amount]",
+					Short:          "Burn coins of a token factory denom administered by the sender from the balance of the sender.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "ChangeDenomAdmin",
+					Use:            "change-denom-admin [denom] [new_admin]",
+					Short:          "Transfer the admin rights of a token factory denom. An empty new admin renounces the admin rights.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "new_admin"}},
+				},
+				{
+					RpcMethod:      "SetDenomMetadata",
+					Use:            "set-denom-metadata [metadata]",
+					Short:          "Set the metadata of a token factory denom administered by the sender.",
+					Example:        fmt.Sprintf(`%s tx bank set-denom-metadata '{"base":"factory/cosmos1.../token","display":"token","denom_units":[{"denom":"factory/cosmos1.../token"},{"denom":"token","exponent":6}]}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "metadata"}},
+				},
 			},
 		},
 	}
@@ -27,6 +27,7 @@ func init() {
 		&modulev1.Module{},
 		appconfig.Provide(ProvideModule),
 		appconfig.Invoke(InvokeSetSendRestrictions),
+		appconfig.Invoke(InvokeRegisterSendHooks),
 	)
 }
 
@@ -89,6 +90,9 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		blockedAddresses,
 		authStr,
 	)
+	if in.Config.BalanceHistory {
+		bankKeeper = bankKeeper.WithBalanceHistory()
+	}
 	m := NewAppModule(in.Cdc, bankKeeper, in.AccountKeeper)
 
 	return ModuleOutputs{BankKeeper: bankKeeper, Module: m}
@@ -129,3 +133,28 @@ func InvokeSetSendRestrictions(
 
 	return nil
 }
+
+// InvokeRegisterSendHooks registers the denom send hooks provided by the modules.
+// The order the hooks run in is defined by the hooks, so the modules are registered by name.
+func InvokeRegisterSendHooks(
+	config *modulev1.Module,
+	keeper keeper.BaseKeeper,
+	hooks map[string]types.DenomSendHooks,
+) error {
+	if config == nil {
+		return nil
+	}
+
+	modules := maps.Keys(hooks)
+	sort.Strings(modules)
+
+	for _, module := range modules {
+		for _, hook := range hooks[module] {
+			if err := keeper.RegisterSendHook(hook); err != nil {
+				return fmt.Errorf("failed to register send hook of module %s: %w", module, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WithBalanceHistory returns a copy of the keeper which maintains the balance change log and the transfer log.
+// The logs are part of the state, so all the nodes of a chain must enable them at the same height.
+func (k BaseKeeper) WithBalanceHistory() BaseKeeper {
+	k.balanceHistory = true
+	return k
+}
+
+// IsBalanceHistoryEnabled returns true if the keeper maintains the balance change log and the transfer log.
+func (k BaseSendKeeper) IsBalanceHistoryEnabled() bool {
+	return k.balanceHistory
+}
+
+// GetHistoricalBalance returns the balance of an account for a denom at a height, along with the height of
+// the last balance change at or before it. The height is zero if no balance change was recorded.
+func (k BaseSendKeeper) GetHistoricalBalance(ctx context.Context, addr sdk.AccAddress, denom string, height int64) (sdk.Coin, int64, error) {
+	balance := sdk.NewCoin(denom, math.ZeroInt())
+	if height < 0 {
+		return balance, 0, nil
+	}
+
+	rng := new(collections.Range[collections.Triple[sdk.AccAddress, string, uint64]]).
+		StartInclusive(collections.Join3(addr, denom, uint64(0))).
+		EndInclusive(collections.Join3(addr, denom, uint64(height))).
+		Descending()
+	iter, err := k.BalanceLog.Iterate(ctx, rng)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return balance, 0, nil
+	}
+
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	return sdk.NewCoin(denom, kv.Value), int64(kv.Key.K3()), nil
+}
+
+// recordBalance records the new balance of an account in the balance change log, if it is enabled.
+// Only the last balance of an account for a denom in a block is kept.
+func (k BaseSendKeeper) recordBalance(ctx context.Context, addr sdk.AccAddress, balance sdk.Coin) error {
+	if !k.balanceHistory {
+		return nil
+	}
+
+	height := k.HeaderService.HeaderInfo(ctx).Height
+	if height < 0 {
+		return errors.New("negative block height")
+	}
+
+	return k.BalanceLog.Set(ctx, collections.Join3(addr, balance.Denom, uint64(height)), balance.Amount)
+}
+
+// recordTransfer records a transfer in the transfer log of both the sender and the receiver, if it is enabled.
+func (k BaseSendKeeper) recordTransfer(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if !k.balanceHistory {
+		return nil
+	}
+
+	id, err := k.TransferSequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	fromAddrStr, err := k.ak.AddressCodec().BytesToString(fromAddr)
+	if err != nil {
+		return err
+	}
+
+	toAddrStr, err := k.ak.AddressCodec().BytesToString(toAddr)
+	if err != nil {
+		return err
+	}
+
+	transfer := types.TransferRecord{
+		Id:          id,
+		Height:      k.HeaderService.HeaderInfo(ctx).Height,
+		FromAddress: fromAddrStr,
+		ToAddress:   toAddrStr,
+		Amount:      amt,
+	}
+
+	if err := k.TransferLog.Set(ctx, collections.Join(fromAddr, id), transfer); err != nil {
+		return err
+	}
+
+	if toAddr.Equals(fromAddr) {
+		return nil
+	}
+
+	return k.TransferLog.Set(ctx, collections.Join(toAddr, id), transfer)
+}
@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/core/header"
+	authtypes "cosmossdk.io/x/auth/types"
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (suite *KeeperTestSuite) TestBalanceHistory() {
+	require := suite.Require()
+	bk := suite.bankKeeper.WithBalanceHistory()
+	require.True(bk.IsBalanceHistoryEnabled())
+	require.False(suite.bankKeeper.IsBalanceHistoryEnabled())
+
+	addr0, addr1 := accAddrs[0], accAddrs[1]
+	addr0Str, err := suite.authKeeper.AddressCodec().BytesToString(addr0)
+	require.NoError(err)
+	addr1Str, err := suite.authKeeper.AddressCodec().BytesToString(addr1)
+	require.NoError(err)
+	sdkCtx := sdk.UnwrapSDKContext(suite.ctx)
+
+	// height 1: fund addr0
+	ctx1 := sdkCtx.WithHeaderInfo(header.Info{Height: 1})
+	suite.authKeeper.EXPECT().GetModuleAccount(ctx1, mintAcc.Name).Return(mintAcc)
+	suite.authKeeper.EXPECT().GetModuleAddress(mintAcc.Name).Return(mintAcc.GetAddress())
+	suite.authKeeper.EXPECT().GetAccount(ctx1, mintAcc.GetAddress()).Return(mintAcc)
+	require.NoError(banktestutil.FundAccount(ctx1, bk, addr0, sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+
+	// height 2: two sends from addr0 to addr1, only the last balance of the block is kept
+	ctx2 := sdkCtx.WithHeaderInfo(header.Info{Height: 2})
+	suite.authKeeper.EXPECT().GetAccount(ctx2, addr0).Return(authtypes.NewBaseAccountWithAddress(addr0)).Times(2)
+	require.NoError(bk.SendCoins(ctx2, addr0, addr1, sdk.NewCoins(newFooCoin(30))))
+	require.NoError(bk.SendCoins(ctx2, addr0, addr1, sdk.NewCoins(newFooCoin(20))))
+
+	// height 4: addr1 sends everything back
+	ctx4 := sdkCtx.WithHeaderInfo(header.Info{Height: 4})
+	suite.authKeeper.EXPECT().GetAccount(ctx4, addr1).Return(authtypes.NewBaseAccountWithAddress(addr1))
+	require.NoError(bk.SendCoins(ctx4, addr1, addr0, sdk.NewCoins(newFooCoin(50))))
+
+	for _, tc := range []struct {
+		height             int64
+		expBalance         sdk.Coin
+		expLastChange      int64
+		expAddr1Balance    sdk.Coin
+		expAddr1LastChange int64
+	}{
+		{height: 0, expBalance: newFooCoin(0), expAddr1Balance: newFooCoin(0)},
+		{height: 1, expBalance: newFooCoin(100), expLastChange: 1, expAddr1Balance: newFooCoin(0)},
+		{height: 2, expBalance: newFooCoin(50), expLastChange: 2, expAddr1Balance: newFooCoin(50), expAddr1LastChange: 2},
+		{height: 3, expBalance: newFooCoin(50), expLastChange: 2, expAddr1Balance: newFooCoin(50), expAddr1LastChange: 2},
+		{height: 10, expBalance: newFooCoin(100), expLastChange: 4, expAddr1Balance: newFooCoin(0), expAddr1LastChange: 4},
+	} {
+		balance, lastChange, err := bk.GetHistoricalBalance(suite.ctx, addr0, fooDenom, tc.height)
+		require.NoError(err)
+		require.Equal(tc.expBalance, balance, "height %d", tc.height)
+		require.Equal(tc.expLastChange, lastChange, "height %d", tc.height)
+
+		balance, lastChange, err = bk.GetHistoricalBalance(suite.ctx, addr1, fooDenom, tc.height)
+		require.NoError(err)
+		require.Equal(tc.expAddr1Balance, balance, "height %d", tc.height)
+		require.Equal(tc.expAddr1LastChange, lastChange, "height %d", tc.height)
+	}
+
+	queryHelper := baseapp.NewQueryServerTestHelper(sdkCtx, suite.encCfg.InterfaceRegistry)
+	banktypes.RegisterQueryServer(queryHelper, bk)
+	queryClient := banktypes.NewQueryClient(queryHelper)
+
+	historyRes, err := queryClient.BalanceHistory(suite.ctx, &banktypes.QueryBalanceHistoryRequest{Address: addr0Str})
+	require.NoError(err)
+	require.Equal([]banktypes.BalanceChange{
+		{Height: 1, Balance: newBarCoin(50)},
+		{Height: 1, Balance: newFooCoin(100)},
+		{Height: 2, Balance: newFooCoin(50)},
+		{Height: 4, Balance: newFooCoin(100)},
+	}, historyRes.Changes)
+
+	historyRes, err = queryClient.BalanceHistory(suite.ctx, &banktypes.QueryBalanceHistoryRequest{
+		Address:    addr1Str,
+		Denom:      fooDenom,
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	require.NoError(err)
+	require.Equal([]banktypes.BalanceChange{{Height: 4, Balance: newFooCoin(0)}}, historyRes.Changes)
+
+	balanceRes, err := queryClient.HistoricalBalance(suite.ctx, &banktypes.QueryHistoricalBalanceRequest{Address: addr1Str, Denom: fooDenom, Height: 3})
+	require.NoError(err)
+	require.Equal(newFooCoin(50), balanceRes.Balance)
+	require.Equal(int64(2), balanceRes.LastChangeHeight)
+
+	_, err = queryClient.HistoricalBalance(suite.ctx, &banktypes.QueryHistoricalBalanceRequest{Address: addr1Str, Denom: fooDenom, Height: -1})
+	require.Error(err)
+
+	transfersRes, err := queryClient.AddressTransfers(suite.ctx, &banktypes.QueryAddressTransfersRequest{Address: addr1Str})
+	require.NoError(err)
+	require.Equal([]banktypes.TransferRecord{
+		{Id: 1, Height: 2, FromAddress: addr0Str, ToAddress: addr1Str, Amount: sdk.NewCoins(newFooCoin(30))},
+		{Id: 2, Height: 2, FromAddress: addr0Str, ToAddress: addr1Str, Amount: sdk.NewCoins(newFooCoin(20))},
+		{Id: 3, Height: 4, FromAddress: addr1Str, ToAddress: addr0Str, Amount: sdk.NewCoins(newFooCoin(50))},
+	}, transfersRes.Transfers)
+
+	// the mint module to addr0 transfer of the funding is recorded too
+	transfersRes, err = queryClient.AddressTransfers(suite.ctx, &banktypes.QueryAddressTransfersRequest{Address: addr0Str})
+	require.NoError(err)
+	require.Len(transfersRes.Transfers, 4)
+	require.Equal(uint64(0), transfersRes.Transfers[0].Id)
+
+	// the queries fail when the balance history is not enabled
+	_, err = suite.queryClient.BalanceHistory(suite.ctx, &banktypes.QueryBalanceHistoryRequest{Address: addr0Str})
+	require.ErrorContains(err, "balance history is not enabled")
+	_, err = suite.queryClient.AddressTransfers(suite.ctx, &banktypes.QueryAddressTransfersRequest{Address: addr0Str})
+	require.ErrorContains(err, "balance history is not enabled")
+}
+
+func (suite *KeeperTestSuite) TestBalanceHistoryGenesis() {
+	require := suite.Require()
+	bk := suite.bankKeeper.WithBalanceHistory()
+
+	addr0, addr1 := accAddrs[0], accAddrs[1]
+	addr1Str, err := suite.authKeeper.AddressCodec().BytesToString(addr1)
+	require.NoError(err)
+	sdkCtx := sdk.UnwrapSDKContext(suite.ctx)
+
+	ctx1 := sdkCtx.WithHeaderInfo(header.Info{Height: 1})
+	suite.authKeeper.EXPECT().GetModuleAccount(ctx1, mintAcc.Name).Return(mintAcc)
+	suite.authKeeper.EXPECT().GetModuleAddress(mintAcc.Name).Return(mintAcc.GetAddress())
+	suite.authKeeper.EXPECT().GetAccount(ctx1, mintAcc.GetAddress()).Return(mintAcc)
+	require.NoError(banktestutil.FundAccount(ctx1, bk, addr0, sdk.NewCoins(newFooCoin(100))))
+
+	ctx2 := sdkCtx.WithHeaderInfo(header.Info{Height: 2})
+	suite.authKeeper.EXPECT().GetAccount(ctx2, addr0).Return(authtypes.NewBaseAccountWithAddress(addr0))
+	require.NoError(bk.SendCoins(ctx2, addr0, addr1, sdk.NewCoins(newFooCoin(30))))
+
+	genState, err := bk.ExportGenesis(ctx2)
+	require.NoError(err)
+	require.NoError(genState.Validate())
+	require.Len(genState.Transfers, 2)
+	require.Equal(uint64(2), genState.TransferSequence)
+	require.NotEmpty(genState.BalanceLog)
+
+	// import the exported state in a fresh keeper, at a later height
+	suite.SetupTest()
+	bk = suite.bankKeeper.WithBalanceHistory()
+	sdkCtx = sdk.UnwrapSDKContext(suite.ctx)
+	ctx3 := sdkCtx.WithHeaderInfo(header.Info{Height: 3})
+	require.NoError(bk.InitGenesis(ctx3, genState))
+
+	balance, lastChange, err := bk.GetHistoricalBalance(ctx3, addr0, fooDenom, 1)
+	require.NoError(err)
+	require.Equal(newFooCoin(100), balance)
+	require.Equal(int64(1), lastChange)
+
+	balance, lastChange, err = bk.GetHistoricalBalance(ctx3, addr1, fooDenom, 2)
+	require.NoError(err)
+	require.Equal(newFooCoin(30), balance)
+	require.Equal(int64(2), lastChange)
+
+	// the transfer ids continue from the imported sequence
+	ctx4 := sdkCtx.WithHeaderInfo(header.Info{Height: 4})
+	suite.authKeeper.EXPECT().GetAccount(ctx4, addr1).Return(authtypes.NewBaseAccountWithAddress(addr1))
+	require.NoError(bk.SendCoins(ctx4, addr1, addr0, sdk.NewCoins(newFooCoin(10))))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx4, suite.encCfg.InterfaceRegistry)
+	banktypes.RegisterQueryServer(queryHelper, bk)
+	queryClient := banktypes.NewQueryClient(queryHelper)
+
+	transfersRes, err := queryClient.AddressTransfers(ctx4, &banktypes.QueryAddressTransfersRequest{Address: addr1Str})
+	require.NoError(err)
+	require.Len(transfersRes.Transfers, 2)
+	require.Equal(genState.Transfers[1], transfersRes.Transfers[0])
+	require.Equal(uint64(2), transfersRes.Transfers[1].Id)
+
+	reexported, err := bk.ExportGenesis(ctx4)
+	require.NoError(err)
+	require.Equal(uint64(3), reexported.TransferSequence)
+	require.Subset(reexported.Transfers, genState.Transfers)
+}
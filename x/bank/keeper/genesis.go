@@ -1,10 +1,13 @@
 package keeper
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"slices"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/bank/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -28,6 +31,11 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 		return err
 	}
 
+	// the balance history is imported first, so that the balances recorded at genesis prevail
+	if err := k.initBalanceHistory(ctx, genState); err != nil {
+		return err
+	}
+
 	for _, balance := range genState.Balances {
 		addr := balance.GetAddress()
 		bz, err := k.ak.AddressCodec().StringToBytes(addr)
@@ -40,6 +48,10 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 			if err != nil {
 				return err
 			}
+
+			if err := k.recordBalance(ctx, bz, coin); err != nil {
+				return err
+			}
 		}
 
 		totalSupplyMap.Add(balance.Coins...)
@@ -57,6 +69,12 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, factoryDenom := range genState.FactoryDenoms {
+		if err := k.FactoryDenomAdmins.Set(ctx, factoryDenom.Denom, factoryDenom.Admin); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -74,5 +92,98 @@ func (k BaseKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState, err
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+
+	err = k.FactoryDenomAdmins.Walk(ctx, nil, func(denom, admin string) (bool, error) {
+		rv.FactoryDenoms = append(rv.FactoryDenoms, types.FactoryDenom{Denom: denom, Admin: admin})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.exportBalanceHistory(ctx, rv); err != nil {
+		return nil, err
+	}
 	return rv, nil
 }
+
+// initBalanceHistory imports the balance change log, the transfer log and the transfer sequence.
+func (k BaseKeeper) initBalanceHistory(ctx context.Context, genState *types.GenesisState) error {
+	for _, entry := range genState.BalanceLog {
+		addr, err := k.ak.AddressCodec().StringToBytes(entry.Address)
+		if err != nil {
+			return err
+		}
+		if entry.Height < 0 {
+			return fmt.Errorf("negative balance log height %d", entry.Height)
+		}
+
+		err = k.BalanceLog.Set(ctx, collections.Join3(sdk.AccAddress(addr), entry.Balance.Denom, uint64(entry.Height)), entry.Balance.Amount)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, transfer := range genState.Transfers {
+		fromAddr, err := k.ak.AddressCodec().StringToBytes(transfer.FromAddress)
+		if err != nil {
+			return err
+		}
+		toAddr, err := k.ak.AddressCodec().StringToBytes(transfer.ToAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := k.TransferLog.Set(ctx, collections.Join(sdk.AccAddress(fromAddr), transfer.Id), transfer); err != nil {
+			return err
+		}
+		if err := k.TransferLog.Set(ctx, collections.Join(sdk.AccAddress(toAddr), transfer.Id), transfer); err != nil {
+			return err
+		}
+	}
+
+	if genState.TransferSequence == 0 {
+		return nil
+	}
+	return k.TransferSequence.Set(ctx, genState.TransferSequence)
+}
+
+// exportBalanceHistory exports the balance change log, the transfer log and the transfer sequence.
+// Each transfer is exported once, from the transfer log of its sender, and the transfers are sorted by id.
+func (k BaseKeeper) exportBalanceHistory(ctx context.Context, genState *types.GenesisState) error {
+	err := k.BalanceLog.Walk(ctx, nil, func(key collections.Triple[sdk.AccAddress, string, uint64], amount math.Int) (bool, error) {
+		addr, err := k.ak.AddressCodec().BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+
+		genState.BalanceLog = append(genState.BalanceLog, types.BalanceLogEntry{
+			Address: addr,
+			Height:  int64(key.K3()),
+			Balance: sdk.Coin{Denom: key.K2(), Amount: amount},
+		})
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = k.TransferLog.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, uint64], transfer types.TransferRecord) (bool, error) {
+		addr, err := k.ak.AddressCodec().BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+
+		if addr == transfer.FromAddress {
+			genState.Transfers = append(genState.Transfers, transfer)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	slices.SortFunc(genState.Transfers, func(a, b types.TransferRecord) int { return cmp.Compare(a.Id, b.Id) })
+
+	genState.TransferSequence, err = k.TransferSequence.Peek(ctx)
+	return err
+}
@@ -49,6 +49,27 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	suite.Require().Equal(expectedMetadata, exportGenesis.DenomMetadata)
 }
 
+func (suite *KeeperTestSuite) TestFactoryDenomsGenesis() {
+	ctx := suite.ctx
+	adminStr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	suite.Require().NoError(err)
+
+	denom, err := suite.bankKeeper.CreateDenom(ctx, accAddrs[0], "token")
+	suite.Require().NoError(err)
+
+	exportGenesis, err := suite.bankKeeper.ExportGenesis(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.FactoryDenom{{Denom: denom, Admin: adminStr}}, exportGenesis.FactoryDenoms)
+
+	genesis := types.DefaultGenesisState()
+	genesis.FactoryDenoms = []types.FactoryDenom{{Denom: "factory/" + adminStr + "/renounced"}}
+	suite.Require().NoError(suite.bankKeeper.InitGenesis(ctx, genesis))
+
+	admin, err := suite.bankKeeper.GetDenomAdmin(ctx, "factory/"+adminStr+"/renounced")
+	suite.Require().NoError(err)
+	suite.Require().Empty(admin)
+}
+
 func (suite *KeeperTestSuite) getTestBalancesAndSupply() ([]types.Balance, sdk.Coins) {
 	ac := codectestutil.CodecOptions{}.GetAddressCodec()
 	addr2, err := suite.authKeeper.AddressCodec().StringToBytes("cosmos1f9xjhxm0plzrh9cskf4qee4pc2xwp0n0556gh0")
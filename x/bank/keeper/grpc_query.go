@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -351,3 +352,164 @@ func (k BaseKeeper) DenomOwnersByQuery(ctx context.Context, req *types.QueryDeno
 
 	return &types.QueryDenomOwnersByQueryResponse{DenomOwners: resp.DenomOwners, Pagination: resp.Pagination}, nil
 }
+
+// SendHooks implements the Query/SendHooks gRPC method
+func (k BaseKeeper) SendHooks(_ context.Context, req *types.QuerySendHooksRequest) (*types.QuerySendHooksResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	hooks := k.GetSendHooks(req.Denom)
+	res := &types.QuerySendHooksResponse{Hooks: make([]types.SendHookInfo, len(hooks))}
+	for i, hook := range hooks {
+		res.Hooks[i] = hook.Info()
+	}
+
+	return res, nil
+}
+
+// DenomAdmin implements the Query/DenomAdmin gRPC method
+func (k BaseKeeper) DenomAdmin(ctx context.Context, req *types.QueryDenomAdminRequest) (*types.QueryDenomAdminResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if _, _, err := types.ParseFactoryDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	admin, err := k.GetDenomAdmin(ctx, req.Denom)
+	if err != nil {
+		if errors.Is(err, types.ErrInvalidFactoryDenom) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return &types.QueryDenomAdminResponse{Admin: admin}, nil
+}
+
+// DenomsFromCreator implements the Query/DenomsFromCreator gRPC method
+func (k BaseKeeper) DenomsFromCreator(ctx context.Context, req *types.QueryDenomsFromCreatorRequest) (*types.QueryDenomsFromCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := k.ak.AddressCodec().StringToBytes(req.Creator); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator address: %s", err)
+	}
+
+	denoms, err := k.GetDenomsFromCreator(ctx, req.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomsFromCreatorResponse{Denoms: denoms}, nil
+}
+
+// BalanceHistory implements the Query/BalanceHistory gRPC method
+func (k BaseKeeper) BalanceHistory(ctx context.Context, req *types.QueryBalanceHistoryRequest) (*types.QueryBalanceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if !k.IsBalanceHistoryEnabled() {
+		return nil, status.Error(codes.FailedPrecondition, "balance history is not enabled")
+	}
+
+	addr, err := k.ak.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	prefix := collections.TriplePrefix[sdk.AccAddress, string, uint64](addr)
+	if req.Denom != "" {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		prefix = collections.TripleSuperPrefix[sdk.AccAddress, string, uint64](addr, req.Denom)
+	}
+
+	changes, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.BalanceLog,
+		req.Pagination,
+		func(key collections.Triple[sdk.AccAddress, string, uint64], value math.Int) (types.BalanceChange, error) {
+			return types.BalanceChange{Height: int64(key.K3()), Balance: sdk.NewCoin(key.K2(), value)}, nil
+		},
+		func(o *query.CollectionsPaginateOptions[collections.Triple[sdk.AccAddress, string, uint64]]) {
+			o.Prefix = &prefix
+		},
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryBalanceHistoryResponse{Changes: changes, Pagination: pageRes}, nil
+}
+
+// HistoricalBalance implements the Query/HistoricalBalance gRPC method
+func (k BaseKeeper) HistoricalBalance(ctx context.Context, req *types.QueryHistoricalBalanceRequest) (*types.QueryHistoricalBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if !k.IsBalanceHistoryEnabled() {
+		return nil, status.Error(codes.FailedPrecondition, "balance history is not enabled")
+	}
+
+	addr, err := k.ak.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height cannot be negative")
+	}
+
+	balance, lastChangeHeight, err := k.GetHistoricalBalance(ctx, addr, req.Denom, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryHistoricalBalanceResponse{Balance: balance, LastChangeHeight: lastChangeHeight}, nil
+}
+
+// AddressTransfers implements the Query/AddressTransfers gRPC method
+func (k BaseKeeper) AddressTransfers(ctx context.Context, req *types.QueryAddressTransfersRequest) (*types.QueryAddressTransfersResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if !k.IsBalanceHistoryEnabled() {
+		return nil, status.Error(codes.FailedPrecondition, "balance history is not enabled")
+	}
+
+	addr, err := k.ak.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	transfers, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.TransferLog,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, uint64], transfer types.TransferRecord) (types.TransferRecord, error) {
+			return transfer, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryAddressTransfersResponse{Transfers: transfers, Pagination: pageRes}, nil
+}
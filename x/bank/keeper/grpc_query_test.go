@@ -926,3 +926,64 @@ func (suite *KeeperTestSuite) TestGRPCDenomOwnersByQuery() {
 
 	suite.Require().True(true)
 }
+
+func (suite *KeeperTestSuite) TestQuerySendHooks() {
+	afterSend := func(gocontext.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) error { return nil }
+	suite.Require().NoError(suite.bankKeeper.RegisterSendHook(types.DenomSendHook{Name: "prefix", Denom: "fo", Prefix: true, Order: 1, Gas: 10, AfterSend: afterSend}))
+	suite.Require().NoError(suite.bankKeeper.RegisterSendHook(types.DenomSendHook{Name: "foo", Denom: fooDenom, AfterSend: afterSend}))
+
+	_, err := suite.queryClient.SendHooks(suite.ctx, &types.QuerySendHooksRequest{})
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.SendHooks(suite.ctx, &types.QuerySendHooksRequest{Denom: fooDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SendHookInfo{
+		{Name: "foo", Denom: fooDenom, HasAfterSend: true},
+		{Name: "prefix", Denom: "fo", Prefix: true, Order: 1, Gas: 10, HasAfterSend: true},
+	}, res.Hooks)
+
+	res, err = suite.queryClient.SendHooks(suite.ctx, &types.QuerySendHooksRequest{Denom: barDenom})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Hooks)
+}
+
+func (suite *KeeperTestSuite) TestQueryFactoryDenoms() {
+	creatorStr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	suite.Require().NoError(err)
+	otherStr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[1])
+	suite.Require().NoError(err)
+
+	denom1, err := suite.bankKeeper.CreateDenom(suite.ctx, accAddrs[0], "token1")
+	suite.Require().NoError(err)
+	denom2, err := suite.bankKeeper.CreateDenom(suite.ctx, accAddrs[0], "token2")
+	suite.Require().NoError(err)
+	_, err = suite.bankKeeper.CreateDenom(suite.ctx, accAddrs[1], "token1")
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.bankKeeper.ChangeDenomAdmin(suite.ctx, accAddrs[0], denom2, otherStr))
+
+	res, err := suite.queryClient.DenomAdmin(gocontext.Background(), &types.QueryDenomAdminRequest{Denom: denom1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(creatorStr, res.Admin)
+
+	res, err = suite.queryClient.DenomAdmin(gocontext.Background(), &types.QueryDenomAdminRequest{Denom: denom2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(otherStr, res.Admin)
+
+	_, err = suite.queryClient.DenomAdmin(gocontext.Background(), &types.QueryDenomAdminRequest{Denom: "foo"})
+	suite.Require().ErrorContains(err, "invalid token factory denom")
+
+	_, err = suite.queryClient.DenomAdmin(gocontext.Background(), &types.QueryDenomAdminRequest{Denom: "factory/" + creatorStr + "/unknown"})
+	suite.Require().ErrorContains(err, "was not created with the token factory")
+
+	denomsRes, err := suite.queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{Creator: creatorStr})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{denom1, denom2}, denomsRes.Denoms)
+
+	_, err = suite.queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{Creator: "invalid"})
+	suite.Require().Error(err)
+
+	// the token factory denoms are returned by the denom metadata queries
+	metadataRes, err := suite.queryClient.DenomMetadataByQueryString(gocontext.Background(), &types.QueryDenomMetadataByQueryStringRequest{Denom: denom1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(denom1, metadataRes.Metadata.Base)
+}
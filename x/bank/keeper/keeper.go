@@ -49,6 +49,14 @@ type Keeper interface {
 	DelegateCoins(ctx context.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx context.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
 
+	CreateDenom(ctx context.Context, creator sdk.AccAddress, subdenom string) (string, error)
+	FactoryMint(ctx context.Context, admin, toAddr sdk.AccAddress, amt sdk.Coin) error
+	FactoryBurn(ctx context.Context, admin sdk.AccAddress, amt sdk.Coin) error
+	ChangeDenomAdmin(ctx context.Context, admin sdk.AccAddress, denom, newAdmin string) error
+	SetFactoryDenomMetadata(ctx context.Context, admin sdk.AccAddress, metadata types.Metadata) error
+	GetDenomAdmin(ctx context.Context, denom string) (string, error)
+	GetDenomsFromCreator(ctx context.Context, creator string) ([]string, error)
+
 	types.QueryServer
 }
 
@@ -212,3 +212,92 @@ func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBu
 
 	return &types.MsgBurnResponse{}, nil
 }
+
+func (k msgServer) CreateDenom(ctx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	sender, err := k.stringToAddress(msg.Sender, "sender")
+	if err != nil {
+		return nil, err
+	}
+
+	denom, err := k.Keeper.CreateDenom(ctx, sender, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+func (k msgServer) FactoryMint(ctx context.Context, msg *types.MsgFactoryMint) (*types.MsgFactoryMintResponse, error) {
+	admin, err := k.stringToAddress(msg.Admin, "admin")
+	if err != nil {
+		return nil, err
+	}
+
+	to := admin
+	if msg.MintToAddress != "" {
+		to, err = k.stringToAddress(msg.MintToAddress, "mint to")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.Keeper.FactoryMint(ctx, admin, to, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFactoryMintResponse{}, nil
+}
+
+func (k msgServer) FactoryBurn(ctx context.Context, msg *types.MsgFactoryBurn) (*types.MsgFactoryBurnResponse, error) {
+	admin, err := k.stringToAddress(msg.Admin, "admin")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.FactoryBurn(ctx, admin, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFactoryBurnResponse{}, nil
+}
+
+func (k msgServer) ChangeDenomAdmin(ctx context.Context, msg *types.MsgChangeDenomAdmin) (*types.MsgChangeDenomAdminResponse, error) {
+	admin, err := k.stringToAddress(msg.Admin, "admin")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.ChangeDenomAdmin(ctx, admin, msg.Denom, msg.NewAdmin); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChangeDenomAdminResponse{}, nil
+}
+
+func (k msgServer) SetDenomMetadata(ctx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	admin, err := k.stringToAddress(msg.Admin, "admin")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.SetFactoryDenomMetadata(ctx, admin, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
+
+// stringToAddress decodes an address of a message with the address codec of the keeper.
+func (k msgServer) stringToAddress(addr, name string) (sdk.AccAddress, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	bz, err := base.ak.AddressCodec().StringToBytes(addr)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid %s address: %s", name, err)
+	}
+
+	return bz, nil
+}
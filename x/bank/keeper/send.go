@@ -25,6 +25,9 @@ type SendKeeper interface {
 	PrependSendRestriction(restriction types.SendRestrictionFn)
 	ClearSendRestriction()
 
+	RegisterSendHook(hook types.DenomSendHook) error
+	GetSendHooks(denom string) []types.DenomSendHook
+
 	InputOutputCoins(ctx context.Context, input types.Input, outputs []types.Output) error
 	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 
@@ -67,6 +70,10 @@ type BaseSendKeeper struct {
 	authority string
 
 	sendRestriction *sendRestriction
+	sendHooks       *sendHooks
+
+	// balanceHistory enables the balance change log and the transfer log.
+	balanceHistory bool
 }
 
 func NewBaseSendKeeper(
@@ -88,6 +95,7 @@ func NewBaseSendKeeper(
 		blockedAddrs:    blockedAddrs,
 		authority:       authority,
 		sendRestriction: newSendRestriction(),
+		sendHooks:       newSendHooks(),
 	}
 }
 
@@ -128,7 +136,7 @@ func (k BaseSendKeeper) SetParams(ctx context.Context, params types.Params) erro
 		k.SetAllSendEnabled(ctx, params.SendEnabled)
 
 		// override params without SendEnabled
-		params = types.NewParams(params.DefaultSendEnabled)
+		params.SendEnabled = nil
 	}
 	return k.Params.Set(ctx, params)
 }
@@ -165,10 +173,23 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 			return err
 		}
 
+		outAddress, err = k.applySendHookRestrictions(ctx, inAddress, outAddress, out.Coins)
+		if err != nil {
+			return err
+		}
+
 		if err := k.addCoins(ctx, outAddress, out.Coins); err != nil {
 			return err
 		}
 
+		if err := k.runAfterSendHooks(ctx, inAddress, outAddress, out.Coins); err != nil {
+			return err
+		}
+
+		if err := k.recordTransfer(ctx, inAddress, outAddress, out.Coins); err != nil {
+			return err
+		}
+
 		if err := k.EventService.EventManager(ctx).EmitKV(
 			types.EventTypeTransfer,
 			event.NewAttribute(types.AttributeKeyRecipient, out.Address),
@@ -194,6 +215,11 @@ func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccA
 		return err
 	}
 
+	toAddr, err = k.applySendHookRestrictions(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
 	err = k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
@@ -204,6 +230,16 @@ func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccA
 		return err
 	}
 
+	err = k.runAfterSendHooks(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	err = k.recordTransfer(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
 	fromAddrString, err := k.ak.AddressCodec().BytesToString(fromAddr)
 	if err != nil {
 		return err
@@ -307,6 +343,10 @@ func (k BaseSendKeeper) setBalance(ctx context.Context, addr sdk.AccAddress, bal
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, balance.String())
 	}
 
+	if err := k.recordBalance(ctx, addr, balance); err != nil {
+		return err
+	}
+
 	// x/bank invariants prohibit persistence of zero balances
 	if balance.IsZero() {
 		err := k.Balances.Remove(ctx, collections.Join(addr, balance.Denom))
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterSendHook registers a send restriction and/or an after send hook scoped to a denom or a denom prefix.
+// The hooks of a denom run by ascending order, then by name, after the send restriction of the keeper.
+func (k BaseSendKeeper) RegisterSendHook(hook types.DenomSendHook) error {
+	return k.sendHooks.register(hook)
+}
+
+// GetSendHooks returns the send hooks which apply to a denom, in the order they run.
+func (k BaseSendKeeper) GetSendHooks(denom string) []types.DenomSendHook {
+	return k.sendHooks.forDenom(denom)
+}
+
+// applySendHookRestrictions runs the restrictions of the send hooks which apply to the sent coins.
+// Each restriction is given the coins it applies to and the receiver address returned by the previous restriction.
+func (k BaseSendKeeper) applySendHookRestrictions(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	for _, hook := range k.sendHooks.hooks {
+		if hook.Restriction == nil {
+			continue
+		}
+
+		coins := hook.FilterCoins(amt)
+		if coins.Empty() {
+			continue
+		}
+
+		if err := k.GasService.GasMeter(ctx).Consume(hook.Gas, fmt.Sprintf("send hook %s", hook.Name)); err != nil {
+			return nil, err
+		}
+
+		var err error
+		toAddr, err = hook.Restriction(ctx, fromAddr, toAddr, coins)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return toAddr, nil
+}
+
+// runAfterSendHooks runs the after send hooks of the send hooks which apply to the sent coins.
+func (k BaseSendKeeper) runAfterSendHooks(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	for _, hook := range k.sendHooks.hooks {
+		if hook.AfterSend == nil {
+			continue
+		}
+
+		coins := hook.FilterCoins(amt)
+		if coins.Empty() {
+			continue
+		}
+
+		if err := k.GasService.GasMeter(ctx).Consume(hook.Gas, fmt.Sprintf("send hook %s", hook.Name)); err != nil {
+			return err
+		}
+
+		if err := hook.AfterSend(ctx, fromAddr, toAddr, coins); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendHooks is a struct that houses the registered DenomSendHook, sorted in the order they run.
+// It exists so that hooks can be registered in the SendKeeper without needing to have a pointer receiver.
+type sendHooks struct {
+	hooks []types.DenomSendHook
+}
+
+// newSendHooks creates a new sendHooks without any hook.
+func newSendHooks() *sendHooks {
+	return &sendHooks{}
+}
+
+// register adds the provided hook, keeping the hooks sorted by order then name.
+func (h *sendHooks) register(hook types.DenomSendHook) error {
+	if err := hook.Validate(); err != nil {
+		return err
+	}
+
+	for _, existing := range h.hooks {
+		if existing.Name == hook.Name {
+			return errorsmod.Wrapf(types.ErrDuplicateEntry, "send hook %s already registered", hook.Name)
+		}
+	}
+
+	h.hooks = append(h.hooks, hook)
+	sort.SliceStable(h.hooks, func(i, j int) bool {
+		if h.hooks[i].Order != h.hooks[j].Order {
+			return h.hooks[i].Order < h.hooks[j].Order
+		}
+
+		return h.hooks[i].Name < h.hooks[j].Name
+	})
+
+	return nil
+}
+
+// forDenom returns the hooks which apply to the denom, in the order they run.
+func (h *sendHooks) forDenom(denom string) []types.DenomSendHook {
+	var hooks []types.DenomSendHook
+	for _, hook := range h.hooks {
+		if hook.Matches(denom) {
+			hooks = append(hooks, hook)
+		}
+	}
+
+	return hooks
+}
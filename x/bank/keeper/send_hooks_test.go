@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+
+	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterSendHook() {
+	afterSend := func(context.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) error { return nil }
+
+	suite.Require().ErrorContains(suite.bankKeeper.RegisterSendHook(banktypes.DenomSendHook{Name: "invalid", Denom: fooDenom}), "cannot both be nil")
+	suite.Require().NoError(suite.bankKeeper.RegisterSendHook(banktypes.DenomSendHook{Name: "c", Denom: fooDenom, AfterSend: afterSend}))
+	suite.Require().NoError(suite.bankKeeper.RegisterSendHook(banktypes.DenomSendHook{Name: "b", Denom: "fo", Prefix: true, AfterSend: afterSend}))
+	suite.Require().NoError(suite.bankKeeper.RegisterSendHook(banktypes.DenomSendHook{Name: "a", Denom: fooDenom, Order: 1, AfterSend: afterSend}))
+	suite.Require().NoError(suite.bankKeeper.RegisterSendHook(banktypes.DenomSendHook{Name: "d", Denom: barDenom, Order: -1, AfterSend: afterSend}))
+
+	err := suite.bankKeeper.RegisterSendHook(banktypes.DenomSendHook{Name: "a", Denom: barDenom, AfterSend: afterSend})
+	suite.Require().ErrorIs(err, banktypes.ErrDuplicateEntry)
+
+	names := func(hooks []banktypes.DenomSendHook) []string {
+		var res []string
+		for _, hook := range hooks {
+			res = append(res, hook.Name)
+		}
+		return res
+	}
+	suite.Require().Equal([]string{"b", "c", "a"}, names(suite.bankKeeper.GetSendHooks(fooDenom)))
+	suite.Require().Equal([]string{"d"}, names(suite.bankKeeper.GetSendHooks(barDenom)))
+	suite.Require().Equal([]string{"b"}, names(suite.bankKeeper.GetSendHooks("fooo")))
+	suite.Require().Empty(suite.bankKeeper.GetSendHooks("baz"))
+}
+
+func (suite *KeeperTestSuite) TestSendCoinsWithSendHooks() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	fromAddr, toAddr, redirectAddr := accAddrs[0], accAddrs[1], accAddrs[2]
+	fromAcc := authtypes.NewBaseAccountWithAddress(fromAddr)
+
+	suite.mockFundAccount(fromAddr)
+	suite.Require().NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, fromAddr, sdk.NewCoins(newFooCoin(100), newBarCoin(100))))
+
+	var calls []string
+	var afterSendCoins sdk.Coins
+	var blocked bool
+	suite.Require().NoError(suite.bankKeeper.RegisterSendHook(banktypes.DenomSendHook{
+		Name:  "redirect",
+		Denom: fooDenom,
+		Gas:   100_000,
+		Restriction: func(_ context.Context, _, to sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+			calls = append(calls, "redirect")
+			suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), amt)
+			suite.Require().Equal(toAddr, to)
+			if blocked {
+				return nil, errors.New("foo is blocked")
+			}
+			return redirectAddr, nil
+		},
+	}))
+	suite.Require().NoError(suite.bankKeeper.RegisterSendHook(banktypes.DenomSendHook{
+		Name:  "observe",
+		Denom: fooDenom,
+		Order: 1,
+		Restriction: func(_ context.Context, _, to sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+			calls = append(calls, "observe")
+			suite.Require().Equal(redirectAddr, to)
+			return to, nil
+		},
+		AfterSend: func(_ context.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+			calls = append(calls, "after send")
+			suite.Require().Equal(fromAddr, from)
+			suite.Require().Equal(redirectAddr, to)
+			afterSendCoins = amt
+			return nil
+		},
+	}))
+	suite.Require().NoError(suite.bankKeeper.RegisterSendHook(banktypes.DenomSendHook{
+		Name:  "unrelated",
+		Denom: "baz",
+		Restriction: func(context.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) (sdk.AccAddress, error) {
+			calls = append(calls, "unrelated")
+			return nil, errors.New("should not run")
+		},
+	}))
+
+	// a send without the hooked denom does not run the hooks
+	suite.mockSendCoins(ctx, fromAcc, toAddr)
+	suite.Require().NoError(suite.bankKeeper.SendCoins(ctx, fromAddr, toAddr, sdk.NewCoins(newBarCoin(10))))
+	suite.Require().Empty(calls)
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(10)), suite.bankKeeper.GetAllBalances(ctx, toAddr))
+
+	// the hooks run in order, are given the hooked coins and can redirect the send
+	gasBefore := ctx.GasMeter().GasConsumed()
+	suite.mockSendCoins(ctx, fromAcc, toAddr)
+	suite.Require().NoError(suite.bankKeeper.SendCoins(ctx, fromAddr, toAddr, sdk.NewCoins(newFooCoin(10), newBarCoin(5))))
+	suite.Require().Equal([]string{"redirect", "observe", "after send"}, calls)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), afterSendCoins)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10), newBarCoin(5)), suite.bankKeeper.GetAllBalances(ctx, redirectAddr))
+	suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed()-gasBefore, uint64(100_000))
+
+	// a hook restriction can block the send
+	calls, blocked = nil, true
+	suite.Require().ErrorContains(suite.bankKeeper.SendCoins(ctx, fromAddr, toAddr, sdk.NewCoins(newFooCoin(10))), "foo is blocked")
+	suite.Require().Equal([]string{"redirect"}, calls)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(90), newBarCoin(85)), suite.bankKeeper.GetAllBalances(ctx, fromAddr))
+
+	// the hooks also run on each output of a multi send
+	calls, blocked = nil, false
+	toStrAddr, err := suite.authKeeper.AddressCodec().BytesToString(toAddr)
+	suite.Require().NoError(err)
+	fromStrAddr, err := suite.authKeeper.AddressCodec().BytesToString(fromAddr)
+	suite.Require().NoError(err)
+	suite.authKeeper.EXPECT().GetAccount(ctx, fromAddr).Return(fromAcc)
+	suite.Require().NoError(suite.bankKeeper.InputOutputCoins(ctx,
+		banktypes.Input{Address: fromStrAddr, Coins: sdk.NewCoins(newFooCoin(10), newBarCoin(5))},
+		[]banktypes.Output{
+			{Address: toStrAddr, Coins: sdk.NewCoins(newBarCoin(5))},
+			{Address: toStrAddr, Coins: sdk.NewCoins(newFooCoin(10))},
+		},
+	))
+	suite.Require().Equal([]string{"redirect", "observe", "after send"}, calls)
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(15)), suite.bankKeeper.GetAllBalances(ctx, toAddr))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(20), newBarCoin(5)), suite.bankKeeper.GetAllBalances(ctx, redirectAddr))
+}
@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateDenom creates the token factory denom factory/{creator}/{subdenom}, with the creator as its admin.
+// The denom creation fee is sent from the creator to the protocolpool module account, or burned if the
+// chain has no protocolpool module account, and the denom is given a default metadata so that it is
+// returned by the denom metadata queries.
+func (k BaseKeeper) CreateDenom(ctx context.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	creatorStr, err := k.ak.AddressCodec().BytesToString(creator)
+	if err != nil {
+		return "", err
+	}
+
+	denom, err := types.GetFactoryDenom(creatorStr, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	has, err := k.FactoryDenomAdmins.Has(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+
+	if has || k.HasSupply(ctx, denom) || k.HasDenomMetaData(ctx, denom) {
+		return "", errorsmod.Wrapf(types.ErrFactoryDenomExists, "denom %s", denom)
+	}
+
+	if fee := k.GetParams(ctx).DenomCreationFee; !fee.IsZero() {
+		if err := k.payDenomCreationFee(ctx, creator, fee); err != nil {
+			return "", errorsmod.Wrap(err, "failed to pay the denom creation fee")
+		}
+	}
+
+	if err := k.FactoryDenomAdmins.Set(ctx, denom, creatorStr); err != nil {
+		return "", err
+	}
+
+	k.SetDenomMetaData(ctx, types.Metadata{
+		DenomUnits: []*types.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:       denom,
+		Display:    denom,
+		Name:       denom,
+		Symbol:     subdenom,
+	})
+
+	return denom, k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCreateDenom,
+		event.NewAttribute(types.AttributeKeyCreator, creatorStr),
+		event.NewAttribute(types.AttributeKeyNewTokenDenom, denom),
+	)
+}
+
+// FactoryMint mints coins of a token factory denom to an account. Only the admin of the denom can mint.
+// The coins are minted to the admin, then sent to the account with SendCoins, so that minting to another
+// account than the admin goes through the send restrictions and requires the denom to be send enabled.
+func (k BaseKeeper) FactoryMint(ctx context.Context, admin, toAddr sdk.AccAddress, amt sdk.Coin) error {
+	adminStr, err := k.requireDenomAdmin(ctx, admin, amt.Denom)
+	if err != nil {
+		return err
+	}
+
+	if !amt.IsValid() || !amt.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	if k.BlockedAddr(toAddr) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", toAddr)
+	}
+
+	if !toAddr.Equals(admin) {
+		if err := k.IsSendEnabledCoins(ctx, amt); err != nil {
+			return err
+		}
+	}
+
+	amounts := sdk.NewCoins(amt)
+	if err := k.addCoins(ctx, admin, amounts); err != nil {
+		return err
+	}
+
+	supply := k.GetSupply(ctx, amt.Denom)
+	k.setSupply(ctx, supply.Add(amt))
+
+	k.Logger.Debug("minted token factory coins", "amount", amt.String(), "admin", adminStr)
+
+	if err := k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCoinMint,
+		event.NewAttribute(types.AttributeKeyMinter, adminStr),
+		event.NewAttribute(sdk.AttributeKeyAmount, amounts.String()),
+	); err != nil {
+		return err
+	}
+
+	if toAddr.Equals(admin) {
+		return nil
+	}
+
+	return k.SendCoins(ctx, admin, toAddr, amounts)
+}
+
+// FactoryBurn burns coins of a token factory denom from the balance of its admin. Only the admin of the denom can burn.
+// Like BurnCoins, a module account admin needs the burner permission.
+func (k BaseKeeper) FactoryBurn(ctx context.Context, admin sdk.AccAddress, amt sdk.Coin) error {
+	if _, err := k.requireDenomAdmin(ctx, admin, amt.Denom); err != nil {
+		return err
+	}
+
+	if !amt.IsValid() || !amt.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	return k.BurnCoins(ctx, admin, sdk.NewCoins(amt))
+}
+
+// ChangeDenomAdmin transfers the admin rights of a token factory denom to a new admin.
+// An empty new admin renounces the admin rights, after which the denom cannot be minted, burned or have its metadata changed.
+func (k BaseKeeper) ChangeDenomAdmin(ctx context.Context, admin sdk.AccAddress, denom, newAdmin string) error {
+	if _, err := k.requireDenomAdmin(ctx, admin, denom); err != nil {
+		return err
+	}
+
+	if newAdmin != "" {
+		if _, err := k.ak.AddressCodec().StringToBytes(newAdmin); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid new admin address: %s", err)
+		}
+	}
+
+	if err := k.FactoryDenomAdmins.Set(ctx, denom, newAdmin); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeChangeDenomAdmin,
+		event.NewAttribute(types.AttributeKeyDenom, denom),
+		event.NewAttribute(types.AttributeKeyNewAdmin, newAdmin),
+	)
+}
+
+// SetFactoryDenomMetadata sets the metadata of a token factory denom. Only the admin of the denom can set it.
+func (k BaseKeeper) SetFactoryDenomMetadata(ctx context.Context, admin sdk.AccAddress, metadata types.Metadata) error {
+	if _, err := k.requireDenomAdmin(ctx, admin, metadata.Base); err != nil {
+		return err
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return err
+	}
+
+	k.SetDenomMetaData(ctx, metadata)
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetDenomMetadata,
+		event.NewAttribute(types.AttributeKeyDenom, metadata.Base),
+	)
+}
+
+// GetDenomAdmin returns the admin of a token factory denom.
+// The admin is empty if the admin rights of the denom were renounced.
+func (k BaseKeeper) GetDenomAdmin(ctx context.Context, denom string) (string, error) {
+	admin, err := k.FactoryDenomAdmins.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return "", errorsmod.Wrapf(types.ErrInvalidFactoryDenom, "denom %s was not created with the token factory", denom)
+		}
+
+		return "", err
+	}
+
+	return admin, nil
+}
+
+// GetDenomsFromCreator returns the token factory denoms created by an account.
+func (k BaseKeeper) GetDenomsFromCreator(ctx context.Context, creator string) ([]string, error) {
+	var denoms []string
+	rng := new(collections.Range[string]).Prefix(types.FactoryDenomPrefix + "/" + creator + "/")
+	err := k.FactoryDenomAdmins.Walk(ctx, rng, func(denom, _ string) (bool, error) {
+		denoms = append(denoms, denom)
+		return false, nil
+	})
+
+	return denoms, err
+}
+
+// payDenomCreationFee sends the denom creation fee to the protocolpool module account.
+// The fee is burned instead if the protocolpool module account is not registered, as sending
+// coins to an unregistered module account panics.
+func (k BaseKeeper) payDenomCreationFee(ctx context.Context, creator sdk.AccAddress, fee sdk.Coins) error {
+	if k.ak.GetModuleAddress(types.ProtocolPoolModuleName) == nil {
+		return k.BurnCoins(ctx, creator, fee)
+	}
+
+	return k.SendCoinsFromAccountToModule(ctx, creator, types.ProtocolPoolModuleName, fee)
+}
+
+// requireDenomAdmin returns an error if the address is not the admin of the token factory denom.
+// It returns the address as a string otherwise.
+func (k BaseKeeper) requireDenomAdmin(ctx context.Context, addr sdk.AccAddress, denom string) (string, error) {
+	admin, err := k.GetDenomAdmin(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+
+	addrStr, err := k.ak.AddressCodec().BytesToString(addr)
+	if err != nil {
+		return "", err
+	}
+
+	if admin == "" || admin != addrStr {
+		return "", errorsmod.Wrapf(types.ErrUnauthorizedAdmin, "%s is not the admin of %s", addrStr, denom)
+	}
+
+	return addrStr, nil
+}
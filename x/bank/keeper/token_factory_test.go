@@ -0,0 +1,220 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+
+	authtypes "cosmossdk.io/x/auth/types"
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var protocolPoolAcc = authtypes.NewEmptyModuleAccount(banktypes.ProtocolPoolModuleName)
+
+func (suite *KeeperTestSuite) TestCreateDenom() {
+	ctx := suite.ctx
+	require := suite.Require()
+	creator, poor := accAddrs[0], accAddrs[1]
+	creatorStr, err := suite.authKeeper.AddressCodec().BytesToString(creator)
+	require.NoError(err)
+
+	params := banktypes.DefaultParams()
+	params.DenomCreationFee = sdk.NewCoins(newFooCoin(10))
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	suite.mockFundAccount(creator)
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, creator, sdk.NewCoins(newFooCoin(100))))
+
+	// the creation fee is sent to the protocolpool module account
+	suite.authKeeper.EXPECT().GetModuleAddress(banktypes.ProtocolPoolModuleName).Return(protocolPoolAcc.GetAddress())
+	suite.authKeeper.EXPECT().GetModuleAccount(ctx, banktypes.ProtocolPoolModuleName).Return(protocolPoolAcc)
+	suite.mockSendCoins(ctx, authtypes.NewBaseAccountWithAddress(creator), protocolPoolAcc.GetAddress())
+	denom, err := suite.bankKeeper.CreateDenom(ctx, creator, "token")
+	require.NoError(err)
+	require.Equal("factory/"+creatorStr+"/token", denom)
+	require.Equal(sdk.NewCoins(newFooCoin(90)), suite.bankKeeper.GetAllBalances(ctx, creator))
+	require.Equal(sdk.NewCoins(newFooCoin(10)), suite.bankKeeper.GetAllBalances(ctx, protocolPoolAcc.GetAddress()))
+
+	admin, err := suite.bankKeeper.GetDenomAdmin(ctx, denom)
+	require.NoError(err)
+	require.Equal(creatorStr, admin)
+
+	metadata, found := suite.bankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(found)
+	require.Equal(denom, metadata.Base)
+	require.Equal("token", metadata.Symbol)
+
+	// a denom cannot be created twice
+	_, err = suite.bankKeeper.CreateDenom(ctx, creator, "token")
+	require.ErrorIs(err, banktypes.ErrFactoryDenomExists)
+
+	_, err = suite.bankKeeper.CreateDenom(ctx, creator, "averyveryveryveryveryveryveryveryverylongsubdenom")
+	require.ErrorIs(err, banktypes.ErrInvalidFactoryDenom)
+
+	// the creator must be able to pay the creation fee
+	suite.authKeeper.EXPECT().GetModuleAddress(banktypes.ProtocolPoolModuleName).Return(protocolPoolAcc.GetAddress())
+	suite.authKeeper.EXPECT().GetModuleAccount(ctx, banktypes.ProtocolPoolModuleName).Return(protocolPoolAcc)
+	suite.mockSendCoins(ctx, authtypes.NewBaseAccountWithAddress(poor), protocolPoolAcc.GetAddress())
+	_, err = suite.bankKeeper.CreateDenom(ctx, poor, "token")
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	denoms, err := suite.bankKeeper.GetDenomsFromCreator(ctx, creatorStr)
+	require.NoError(err)
+	require.Equal([]string{denom}, denoms)
+
+	// the creation fee is burned if the protocolpool module account is not registered
+	supply := suite.bankKeeper.GetSupply(ctx, fooDenom)
+	suite.authKeeper.EXPECT().GetModuleAddress(banktypes.ProtocolPoolModuleName).Return(nil)
+	suite.authKeeper.EXPECT().GetAccount(ctx, creator).Return(authtypes.NewBaseAccountWithAddress(creator)).Times(2)
+	_, err = suite.bankKeeper.CreateDenom(ctx, creator, "burned")
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(newFooCoin(80)), suite.bankKeeper.GetAllBalances(ctx, creator))
+	require.Equal(sdk.NewCoins(newFooCoin(10)), suite.bankKeeper.GetAllBalances(ctx, protocolPoolAcc.GetAddress()))
+	require.Equal(supply.SubAmount(newFooCoin(10).Amount), suite.bankKeeper.GetSupply(ctx, fooDenom))
+}
+
+func (suite *KeeperTestSuite) TestFactoryMintAndBurn() {
+	ctx := suite.ctx
+	require := suite.Require()
+	admin, holder, other := accAddrs[0], accAddrs[1], accAddrs[2]
+
+	denom, err := suite.bankKeeper.CreateDenom(ctx, admin, "token")
+	require.NoError(err)
+	coin := func(amount int64) sdk.Coin { return sdk.NewInt64Coin(denom, amount) }
+
+	// only the admin can mint
+	require.ErrorIs(suite.bankKeeper.FactoryMint(ctx, other, other, coin(10)), banktypes.ErrUnauthorizedAdmin)
+	require.ErrorIs(suite.bankKeeper.FactoryMint(ctx, admin, admin, sdk.NewInt64Coin("foo", 10)), banktypes.ErrInvalidFactoryDenom)
+	require.ErrorIs(suite.bankKeeper.FactoryMint(ctx, admin, admin, coin(0)), sdkerrors.ErrInvalidCoins)
+	require.ErrorIs(suite.bankKeeper.FactoryMint(ctx, admin, accAddrs[4], coin(10)), sdkerrors.ErrUnauthorized)
+
+	require.NoError(suite.bankKeeper.FactoryMint(ctx, admin, admin, coin(10)))
+	suite.mockSendCoins(ctx, authtypes.NewBaseAccountWithAddress(admin), holder)
+	require.NoError(suite.bankKeeper.FactoryMint(ctx, admin, holder, coin(20)))
+	require.Equal(coin(10), suite.bankKeeper.GetBalance(ctx, admin, denom))
+	require.Equal(coin(20), suite.bankKeeper.GetBalance(ctx, holder, denom))
+	require.Equal(coin(30), suite.bankKeeper.GetSupply(ctx, denom))
+
+	// minting to another account than the admin requires the denom to be send enabled
+	suite.bankKeeper.SetSendEnabled(ctx, denom, false)
+	require.ErrorIs(suite.bankKeeper.FactoryMint(ctx, admin, holder, coin(5)), banktypes.ErrSendDisabled)
+	require.NoError(suite.bankKeeper.FactoryMint(ctx, admin, admin, coin(5)))
+	suite.bankKeeper.SetSendEnabled(ctx, denom, true)
+
+	// minting to another account than the admin goes through the send restrictions
+	existingSendRestrictionFn := suite.bankKeeper.GetSendRestrictionFn()
+	defer suite.bankKeeper.SetSendRestriction(existingSendRestrictionFn)
+	suite.bankKeeper.SetSendRestriction(func(_ context.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(holder) {
+			return nil, errors.New("restricted recipient")
+		}
+		return toAddr, nil
+	})
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	require.ErrorContains(suite.bankKeeper.FactoryMint(cacheCtx, admin, holder, coin(5)), "restricted recipient")
+	suite.bankKeeper.SetSendRestriction(existingSendRestrictionFn)
+
+	// only the admin can burn, and only from its own balance
+	require.ErrorIs(suite.bankKeeper.FactoryBurn(ctx, holder, coin(5)), banktypes.ErrUnauthorizedAdmin)
+
+	suite.authKeeper.EXPECT().GetAccount(ctx, admin).Return(authtypes.NewBaseAccountWithAddress(admin)).Times(4)
+	require.ErrorIs(suite.bankKeeper.FactoryBurn(ctx, admin, coin(20)), sdkerrors.ErrInsufficientFunds)
+	require.NoError(suite.bankKeeper.FactoryBurn(ctx, admin, coin(15)))
+	require.True(suite.bankKeeper.GetBalance(ctx, admin, denom).IsZero())
+	require.Equal(coin(20), suite.bankKeeper.GetBalance(ctx, holder, denom))
+	require.Equal(coin(20), suite.bankKeeper.GetSupply(ctx, denom))
+}
+
+func (suite *KeeperTestSuite) TestChangeDenomAdmin() {
+	ctx := suite.ctx
+	require := suite.Require()
+	admin, newAdmin := accAddrs[0], accAddrs[1]
+	newAdminStr, err := suite.authKeeper.AddressCodec().BytesToString(newAdmin)
+	require.NoError(err)
+
+	denom, err := suite.bankKeeper.CreateDenom(ctx, admin, "token")
+	require.NoError(err)
+
+	require.ErrorIs(suite.bankKeeper.ChangeDenomAdmin(ctx, newAdmin, denom, newAdminStr), banktypes.ErrUnauthorizedAdmin)
+	require.ErrorIs(suite.bankKeeper.ChangeDenomAdmin(ctx, admin, denom, "invalid"), sdkerrors.ErrInvalidAddress)
+	require.NoError(suite.bankKeeper.ChangeDenomAdmin(ctx, admin, denom, newAdminStr))
+
+	// the previous admin lost the admin rights
+	require.ErrorIs(suite.bankKeeper.FactoryMint(ctx, admin, admin, sdk.NewInt64Coin(denom, 1)), banktypes.ErrUnauthorizedAdmin)
+	require.NoError(suite.bankKeeper.FactoryMint(ctx, newAdmin, newAdmin, sdk.NewInt64Coin(denom, 1)))
+
+	metadata := banktypes.Metadata{
+		Description: "a token",
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: denom}, {Denom: "token", Exponent: 6}},
+		Base:        denom,
+		Display:     "token",
+		Name:        "Token",
+		Symbol:      "TKN",
+	}
+	require.ErrorIs(suite.bankKeeper.SetFactoryDenomMetadata(ctx, admin, metadata), banktypes.ErrUnauthorizedAdmin)
+	require.NoError(suite.bankKeeper.SetFactoryDenomMetadata(ctx, newAdmin, metadata))
+	actual, found := suite.bankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(found)
+	require.Equal(metadata, actual)
+
+	// renouncing the admin rights is final
+	require.NoError(suite.bankKeeper.ChangeDenomAdmin(ctx, newAdmin, denom, ""))
+	adminStr, err := suite.bankKeeper.GetDenomAdmin(ctx, denom)
+	require.NoError(err)
+	require.Empty(adminStr)
+	require.ErrorIs(suite.bankKeeper.FactoryMint(ctx, newAdmin, newAdmin, sdk.NewInt64Coin(denom, 1)), banktypes.ErrUnauthorizedAdmin)
+	require.ErrorIs(suite.bankKeeper.ChangeDenomAdmin(ctx, newAdmin, denom, newAdminStr), banktypes.ErrUnauthorizedAdmin)
+}
+
+func (suite *KeeperTestSuite) TestMsgFactoryDenom() {
+	ctx := suite.ctx
+	require := suite.Require()
+	adminStr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	require.NoError(err)
+	holderStr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[1])
+	require.NoError(err)
+
+	_, err = suite.msgServer.CreateDenom(ctx, banktypes.NewMsgCreateDenom("invalid", "token"))
+	require.ErrorContains(err, "invalid sender address")
+
+	res, err := suite.msgServer.CreateDenom(ctx, banktypes.NewMsgCreateDenom(adminStr, "token"))
+	require.NoError(err)
+	denom := res.NewTokenDenom
+
+	_, err = suite.msgServer.FactoryMint(ctx, banktypes.NewMsgFactoryMint(adminStr, sdk.NewInt64Coin(denom, 10), ""))
+	require.NoError(err)
+	suite.mockSendCoins(ctx, authtypes.NewBaseAccountWithAddress(accAddrs[0]), accAddrs[1])
+	_, err = suite.msgServer.FactoryMint(ctx, banktypes.NewMsgFactoryMint(adminStr, sdk.NewInt64Coin(denom, 10), holderStr))
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(denom, 10), suite.bankKeeper.GetBalance(ctx, accAddrs[0], denom))
+	require.Equal(sdk.NewInt64Coin(denom, 10), suite.bankKeeper.GetBalance(ctx, accAddrs[1], denom))
+
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(authtypes.NewBaseAccountWithAddress(accAddrs[0])).Times(2)
+	_, err = suite.msgServer.FactoryBurn(ctx, banktypes.NewMsgFactoryBurn(adminStr, sdk.NewInt64Coin(denom, 4)))
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(denom, 6), suite.bankKeeper.GetBalance(ctx, accAddrs[0], denom))
+
+	_, err = suite.msgServer.ChangeDenomAdmin(ctx, banktypes.NewMsgChangeDenomAdmin(adminStr, denom, holderStr))
+	require.NoError(err)
+
+	metadata := banktypes.Metadata{DenomUnits: []*banktypes.DenomUnit{{Denom: denom}}, Base: denom, Display: denom, Name: "Token", Symbol: "TKN"}
+	_, err = suite.msgServer.SetDenomMetadata(ctx, banktypes.NewMsgSetDenomMetadata(adminStr, metadata))
+	require.ErrorIs(err, banktypes.ErrUnauthorizedAdmin)
+	_, err = suite.msgServer.SetDenomMetadata(ctx, banktypes.NewMsgSetDenomMetadata(holderStr, metadata))
+	require.NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestSetParamsKeepsDenomCreationFee() {
+	params := banktypes.DefaultParams()
+	params.SendEnabled = []*banktypes.SendEnabled{{Denom: fooDenom, Enabled: false}} //nolint:staticcheck // we're testing the old way here
+	params.DenomCreationFee = sdk.NewCoins(newFooCoin(10))
+	suite.Require().NoError(suite.bankKeeper.SetParams(suite.ctx, params))
+
+	actual := suite.bankKeeper.GetParams(suite.ctx)
+	suite.Require().Empty(actual.SendEnabled) //nolint:staticcheck // we're testing the old way here
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), actual.DenomCreationFee)
+	suite.Require().False(suite.bankKeeper.IsSendEnabledDenom(suite.ctx, fooDenom))
+}
@@ -66,20 +66,31 @@ type BaseViewKeeper struct {
 	SendEnabled   collections.Map[string, bool]
 	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
 	Params        collections.Item[types.Params]
+	// FactoryDenomAdmins maps the token factory denoms to their admin. An empty admin means the admin rights were renounced.
+	FactoryDenomAdmins collections.Map[string, string]
+	// BalanceLog is the balance change log. It maps (address, denom, height) to the balance after the last change of the block.
+	BalanceLog collections.Map[collections.Triple[sdk.AccAddress, string, uint64], math.Int]
+	// TransferLog is the transfer log. It maps (address, transfer id) to the transfers sent or received by the address.
+	TransferLog      collections.Map[collections.Pair[sdk.AccAddress, uint64], types.TransferRecord]
+	TransferSequence collections.Sequence
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
 func NewBaseViewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak types.AccountKeeper) BaseViewKeeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	k := BaseViewKeeper{
-		Environment:   env,
-		cdc:           cdc,
-		ak:            ak,
-		Supply:        collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
-		DenomMetadata: collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc)),
-		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
-		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
-		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Environment:        env,
+		cdc:                cdc,
+		ak:                 ak,
+		Supply:             collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
+		DenomMetadata:      collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc)),
+		SendEnabled:        collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
+		Balances:           collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
+		Params:             collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		FactoryDenomAdmins: collections.NewMap(sb, types.FactoryDenomsPrefix, "factory_denom_admins", collections.StringKey, collections.StringValue),
+		BalanceLog:         collections.NewMap(sb, types.BalanceHistoryPrefix, "balance_history", collections.TripleKeyCodec(sdk.AccAddressKey, collections.StringKey, collections.Uint64Key), sdk.IntValue),
+		TransferLog:        collections.NewMap(sb, types.AddressTransfersPrefix, "address_transfers", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), codec.CollValue[types.TransferRecord](cdc)),
+		TransferSequence:   collections.NewSequence(sb, types.TransferSequenceKey, "transfer_sequence"),
 	}
 
 	schema, err := sb.Build()
@@ -37,6 +37,9 @@ type Params struct {
 	// As of cosmos-sdk 0.47, this only exists for backwards compatibility of genesis files.
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"` // Deprecated: Do not use.
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
+	// denom_creation_fee is the fee charged to create a token factory denom.
+	// It is sent to the protocolpool module account.
+	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=denom_creation_fee,json=denomCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_creation_fee"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -87,6 +90,13 @@ func (m *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
+func (m *Params) GetDenomCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DenomCreationFee
+	}
+	return nil
+}
+
 // SendEnabled maps coin denom to a send_enabled status (whether a denom is
 // sendable).
 type SendEnabled struct {
@@ -443,6 +453,141 @@ func (m *Metadata) GetURIHash() string {
 	return ""
 }
 
+// FactoryDenom defines a denom created with the token factory and its admin.
+type FactoryDenom struct {
+	// denom is the token factory denom, in the form factory/{creator}/{subdenom}.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// admin is the address allowed to mint, burn and set the metadata of the denom.
+	// An empty admin means the denom has no admin anymore.
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (m *FactoryDenom) Reset()         { *m = FactoryDenom{} }
+func (m *FactoryDenom) String() string { return proto.CompactTextString(m) }
+func (*FactoryDenom) ProtoMessage()    {}
+func (*FactoryDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *FactoryDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FactoryDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FactoryDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FactoryDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FactoryDenom.Merge(m, src)
+}
+func (m *FactoryDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FactoryDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FactoryDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FactoryDenom proto.InternalMessageInfo
+
+func (m *FactoryDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FactoryDenom) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+// TransferRecord is a transfer of coins recorded by the transfer log.
+type TransferRecord struct {
+	// id is the sequence number of the transfer.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// height is the block height of the transfer.
+	Height      int64                                    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	FromAddress string                                   `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress   string                                   `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *TransferRecord) Reset()         { *m = TransferRecord{} }
+func (m *TransferRecord) String() string { return proto.CompactTextString(m) }
+func (*TransferRecord) ProtoMessage()    {}
+func (*TransferRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{8}
+}
+func (m *TransferRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRecord.Merge(m, src)
+}
+func (m *TransferRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferRecord proto.InternalMessageInfo
+
+func (m *TransferRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TransferRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TransferRecord) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *TransferRecord) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *TransferRecord) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -451,57 +596,68 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*FactoryDenom)(nil), "cosmos.bank.v1beta1.FactoryDenom")
+	proto.RegisterType((*TransferRecord)(nil), "cosmos.bank.v1beta1.TransferRecord")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 706 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbd, 0x6f, 0x13, 0x4b,
-	0x10, 0xf7, 0xfa, 0x3b, 0xeb, 0x3c, 0x3d, 0xbd, 0x8d, 0xa5, 0x77, 0xf1, 0xd3, 0x3b, 0x5b, 0x6e,
-	0x30, 0x06, 0xdb, 0xf9, 0x40, 0x48, 0xb8, 0x41, 0x38, 0x7c, 0xb9, 0x40, 0xa0, 0x8b, 0x22, 0x24,
-	0x1a, 0x6b, 0xed, 0x5b, 0xec, 0x55, 0xee, 0x76, 0x4f, 0xb7, 0x7b, 0x21, 0x6e, 0xa9, 0x10, 0x15,
-	0x35, 0x55, 0x44, 0x85, 0x10, 0x85, 0x8b, 0xf4, 0xb4, 0x51, 0xaa, 0x88, 0x0a, 0xa5, 0x08, 0xc8,
-	0x29, 0x9c, 0x3f, 0x03, 0xdd, 0xde, 0x9d, 0xed, 0x08, 0xa7, 0x45, 0xa2, 0xb1, 0x77, 0xe6, 0xf7,
-	0x9b, 0x99, 0xdf, 0xcc, 0xce, 0x2d, 0xd4, 0x7b, 0x5c, 0xd8, 0x5c, 0x34, 0xba, 0x98, 0xed, 0x36,
-	0xf6, 0xd6, 0xbb, 0x44, 0xe2, 0x75, 0x65, 0xd4, 0x1d, 0x97, 0x4b, 0x8e, 0x56, 0x02, 0xbc, 0xae,
-	0x5c, 0x21, 0x5e, 0xc8, 0xf7, 0x79, 0x9f, 0x2b, 0xbc, 0xe1, 0x9f, 0x02, 0x6a, 0x61, 0x35, 0xa0,
-	0x76, 0x02, 0x20, 0x8c, 0x0b, 0xa0, 0x59, 0x15, 0x41, 0xa6, 0x55, 0x7a, 0x9c, 0xb2, 0x10, 0xff,
-	0x37, 0xc4, 0x6d, 0xd1, 0x6f, 0xec, 0xad, 0xfb, 0x7f, 0x21, 0xf0, 0x0f, 0xb6, 0x29, 0xe3, 0x0d,
-	0xf5, 0x1b, 0xb8, 0xca, 0x1f, 0x00, 0x4c, 0x3f, 0xc3, 0x2e, 0xb6, 0x05, 0x7a, 0x04, 0x97, 0x05,
-	0x61, 0x66, 0x87, 0x30, 0xdc, 0xb5, 0x88, 0xa9, 0x81, 0x52, 0xa2, 0x92, 0xdb, 0x28, 0xd5, 0x17,
-	0x68, 0xae, 0x6f, 0x13, 0x66, 0x3e, 0x08, 0x78, 0xad, 0xb8, 0x06, 0x8c, 0x9c, 0x98, 0x39, 0xd0,
-	0x1a, 0xcc, 0x9b, 0xe4, 0x25, 0xf6, 0x2c, 0xd9, 0xb9, 0x94, 0x30, 0x5e, 0x02, 0x95, 0xac, 0x81,
-	0x42, 0x6c, 0x2e, 0x45, 0xf3, 0xff, 0xb7, 0x93, 0x51, 0x55, 0x0b, 0x0a, 0xd5, 0x84, 0xb9, 0xdb,
-	0xd8, 0x0f, 0x46, 0x18, 0x28, 0x2b, 0x6f, 0xc1, 0xdc, 0x1c, 0x1b, 0xe5, 0x61, 0xca, 0x24, 0x8c,
-	0xdb, 0x1a, 0x28, 0x81, 0xca, 0x92, 0x11, 0x18, 0x48, 0x83, 0x99, 0xcb, 0x85, 0x22, 0xb3, 0x99,
-	0xbc, 0x38, 0x28, 0x82, 0xf2, 0x31, 0x80, 0xa9, 0x36, 0x73, 0x3c, 0x89, 0x36, 0x60, 0x06, 0x9b,
-	0xa6, 0x4b, 0x84, 0x08, 0x32, 0xb4, 0xb4, 0xaf, 0x87, 0xb5, 0x7c, 0xd8, 0xe6, 0xbd, 0x00, 0xd9,
-	0x96, 0x2e, 0x65, 0x7d, 0x23, 0x22, 0xa2, 0x57, 0x30, 0xe5, 0x4f, 0x58, 0x68, 0x71, 0x35, 0x95,
-	0xd5, 0xd9, 0x54, 0x04, 0x99, 0x4e, 0x65, 0x8b, 0x53, 0xd6, 0x7a, 0x78, 0x74, 0x56, 0x8c, 0x7d,
-	0xfa, 0x5e, 0xac, 0xf4, 0xa9, 0x1c, 0x78, 0xdd, 0x7a, 0x8f, 0xdb, 0xe1, 0xf5, 0x35, 0xe6, 0x1a,
-	0x94, 0x43, 0x87, 0x08, 0x15, 0x20, 0xde, 0x4f, 0x46, 0xd5, 0x65, 0x8b, 0xf4, 0x71, 0x6f, 0xd8,
-	0x51, 0x35, 0x3e, 0x4e, 0x46, 0x55, 0x60, 0x04, 0xf5, 0x9a, 0xf9, 0x37, 0x07, 0xc5, 0xd8, 0xc5,
-	0x41, 0x31, 0xf6, 0x7a, 0x32, 0xaa, 0x46, 0x72, 0xca, 0x5f, 0x00, 0x4c, 0x3f, 0xf5, 0xe4, 0x1f,
-	0xd7, 0x4d, 0x36, 0xea, 0xa6, 0xfc, 0x19, 0xc0, 0xf4, 0xb6, 0xe7, 0x38, 0xd6, 0xd0, 0x57, 0x23,
-	0xb9, 0xc4, 0x56, 0xb8, 0x71, 0xbf, 0x43, 0x8d, 0xaa, 0xd7, 0xbc, 0x1e, 0xaa, 0x01, 0xc7, 0x87,
-	0xb5, 0xff, 0x16, 0xae, 0xb9, 0x12, 0xd8, 0xd6, 0x40, 0xf9, 0x39, 0x5c, 0xba, 0xef, 0xaf, 0xd9,
-	0x0e, 0xa3, 0xf2, 0x8a, 0x05, 0x2c, 0xc0, 0x2c, 0xd9, 0x77, 0x38, 0x23, 0x4c, 0xaa, 0x0d, 0xfc,
-	0xcb, 0x98, 0xda, 0xfe, 0x72, 0x62, 0x8b, 0x62, 0x41, 0x84, 0x96, 0x28, 0x25, 0x2a, 0x4b, 0x46,
-	0x64, 0x96, 0x4f, 0xe3, 0x30, 0xfb, 0x84, 0x48, 0x6c, 0x62, 0x89, 0x51, 0x09, 0xe6, 0x4c, 0x22,
-	0x7a, 0x2e, 0x75, 0x24, 0xe5, 0x2c, 0x4c, 0x3f, 0xef, 0x42, 0x77, 0x7d, 0x06, 0xe3, 0x76, 0xc7,
-	0x63, 0x54, 0x46, 0xf7, 0xa7, 0x2f, 0xfc, 0x46, 0xa7, 0x7a, 0x0d, 0x68, 0x46, 0x47, 0x81, 0x10,
-	0x4c, 0xfa, 0x73, 0xd5, 0x12, 0x2a, 0xb7, 0x3a, 0xfb, 0xea, 0x4c, 0x2a, 0x1c, 0x0b, 0x0f, 0xb5,
-	0xa4, 0x72, 0x47, 0x26, 0xba, 0x06, 0x93, 0x0c, 0xdb, 0x44, 0x4b, 0xa9, 0xcd, 0x5a, 0x39, 0x3d,
-	0xac, 0xfd, 0x3d, 0x1b, 0x74, 0x69, 0xad, 0x7e, 0x6b, 0xd3, 0x50, 0x04, 0x74, 0x03, 0xa6, 0xc5,
-	0xd0, 0xee, 0x72, 0x4b, 0x4b, 0x5f, 0x4d, 0x0d, 0x29, 0xe8, 0x26, 0x4c, 0x78, 0x2e, 0xd5, 0x32,
-	0x8a, 0x59, 0x18, 0x9f, 0x15, 0x13, 0x3b, 0x46, 0xfb, 0xd7, 0x80, 0xdb, 0x86, 0x4f, 0x43, 0x77,
-	0x60, 0xd6, 0x73, 0x69, 0x67, 0x80, 0xc5, 0x40, 0xcb, 0xaa, 0x10, 0x7d, 0x7c, 0x56, 0xcc, 0xec,
-	0x18, 0xed, 0xc7, 0x58, 0x0c, 0x16, 0x85, 0x65, 0x3c, 0x97, 0xfa, 0x58, 0x6b, 0xf3, 0x68, 0xac,
-	0x83, 0x93, 0xb1, 0x0e, 0x7e, 0x8c, 0x75, 0xf0, 0xee, 0x5c, 0x8f, 0x9d, 0x9c, 0xeb, 0xb1, 0x6f,
-	0xe7, 0x7a, 0xec, 0x45, 0xf8, 0xbc, 0x0a, 0x73, 0xb7, 0x4e, 0x79, 0xf4, 0xdc, 0xa8, 0xc5, 0xe9,
-	0xa6, 0xd5, 0xcb, 0xb8, 0xf9, 0x33, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x68, 0x6d, 0x04, 0xcd, 0x05,
-	0x00, 0x00,
+	// 857 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xbd, 0x6f, 0x23, 0x45,
+	0x14, 0xf7, 0xf8, 0x3b, 0xcf, 0xe1, 0x80, 0x89, 0x05, 0x73, 0x41, 0xac, 0xad, 0x6d, 0x08, 0x81,
+	0xd8, 0x97, 0x0b, 0x1f, 0xc2, 0x14, 0x88, 0x04, 0x02, 0x29, 0x10, 0x68, 0x43, 0x84, 0xa0, 0xb1,
+	0xc6, 0x9e, 0x89, 0x3d, 0x8a, 0x77, 0xc6, 0xda, 0x99, 0x3d, 0xce, 0x2d, 0x15, 0xa2, 0xa2, 0xa6,
+	0x8a, 0xa8, 0x10, 0xa2, 0x48, 0x91, 0x1a, 0x4a, 0x4e, 0x57, 0x9d, 0xae, 0x42, 0x57, 0x04, 0xe4,
+	0x14, 0xb9, 0x3f, 0x03, 0xed, 0xec, 0xac, 0x93, 0x53, 0x1c, 0x45, 0x34, 0x91, 0x68, 0xec, 0x7d,
+	0xef, 0xf7, 0x3e, 0x7e, 0xf3, 0xdb, 0xf7, 0x76, 0xc0, 0xeb, 0x2b, 0x1d, 0x2a, 0xdd, 0xee, 0x51,
+	0x79, 0xd0, 0xbe, 0xb7, 0xde, 0xe3, 0x86, 0xae, 0x5b, 0xa3, 0x35, 0x8e, 0x94, 0x51, 0x78, 0x29,
+	0xc5, 0x5b, 0xd6, 0xe5, 0xf0, 0xe5, 0xfa, 0x40, 0x0d, 0x94, 0xc5, 0xdb, 0xc9, 0x53, 0x1a, 0xba,
+	0x7c, 0x3b, 0x0d, 0xed, 0xa6, 0x80, 0xcb, 0x4b, 0xa1, 0xf3, 0x2e, 0x9a, 0xcf, 0xba, 0xf4, 0x95,
+	0x90, 0x0e, 0x7f, 0xd9, 0xe1, 0xa1, 0x1e, 0xb4, 0xef, 0xad, 0x27, 0x7f, 0x0e, 0x78, 0x91, 0x86,
+	0x42, 0xaa, 0xb6, 0xfd, 0x4d, 0x5d, 0xfe, 0x9f, 0x79, 0x28, 0x7f, 0x41, 0x23, 0x1a, 0x6a, 0xfc,
+	0x09, 0x2c, 0x6a, 0x2e, 0x59, 0x97, 0x4b, 0xda, 0x1b, 0x71, 0x46, 0x50, 0xb3, 0xb0, 0x52, 0xbb,
+	0xdb, 0x6c, 0xcd, 0xe1, 0xdc, 0xda, 0xe5, 0x92, 0x7d, 0x9c, 0xc6, 0x6d, 0xe6, 0x09, 0x0a, 0x6a,
+	0xfa, 0xdc, 0x81, 0xef, 0x40, 0x9d, 0xf1, 0x7d, 0x1a, 0x8f, 0x4c, 0xf7, 0x99, 0x82, 0xf9, 0x26,
+	0x5a, 0xa9, 0x06, 0xd8, 0x61, 0x17, 0x4a, 0xe0, 0x9f, 0x11, 0x60, 0xc6, 0xa5, 0x0a, 0xbb, 0xfd,
+	0x88, 0x53, 0x23, 0x94, 0xec, 0xee, 0x73, 0x4e, 0x0a, 0x96, 0xc1, 0xed, 0x73, 0x06, 0x9a, 0xcf,
+	0x18, 0x6c, 0x29, 0x21, 0x37, 0xbf, 0x7e, 0x70, 0xd2, 0xc8, 0xfd, 0xfa, 0x77, 0x63, 0x65, 0x20,
+	0xcc, 0x30, 0xee, 0xb5, 0xfa, 0x2a, 0x74, 0x52, 0xb9, 0xbf, 0x35, 0xcd, 0x0e, 0xda, 0x66, 0x32,
+	0xe6, 0xda, 0x26, 0xe8, 0x27, 0xc7, 0x6b, 0xcf, 0x9f, 0x23, 0xcd, 0x3b, 0xad, 0xb7, 0xd7, 0x7f,
+	0x3a, 0x3b, 0x5a, 0x5d, 0x1c, 0xf1, 0x01, 0xed, 0x4f, 0xba, 0x89, 0x88, 0xfa, 0x97, 0xb3, 0xa3,
+	0x55, 0x14, 0xbc, 0x60, 0xf9, 0x6c, 0x39, 0x3a, 0xdb, 0x9c, 0x77, 0x5e, 0xfd, 0xe1, 0xec, 0x68,
+	0x95, 0x5c, 0xa8, 0x7b, 0x3f, 0x7d, 0xcf, 0xa9, 0x7c, 0xfe, 0x16, 0xd4, 0x2e, 0x1e, 0xa9, 0x0e,
+	0x25, 0x5b, 0x81, 0xa0, 0x26, 0x5a, 0x59, 0x08, 0x52, 0x03, 0x13, 0xa8, 0x3c, 0xab, 0x46, 0x66,
+	0x76, 0x8a, 0x4f, 0x0f, 0x1b, 0xc8, 0x7f, 0x88, 0xa0, 0xb4, 0x23, 0xc7, 0xb1, 0xc1, 0x77, 0xa1,
+	0x42, 0x19, 0x8b, 0xb8, 0xd6, 0x69, 0x85, 0x4d, 0xf2, 0xf8, 0x78, 0xad, 0xee, 0x94, 0xf8, 0x30,
+	0x45, 0x76, 0x4d, 0x24, 0xe4, 0x20, 0xc8, 0x02, 0xf1, 0xb7, 0x50, 0xb2, 0x27, 0x20, 0xf9, 0xeb,
+	0x84, 0xdb, 0xfe, 0xaf, 0xc2, 0x5d, 0xa1, 0x52, 0xda, 0xaf, 0x53, 0xff, 0xfe, 0xb0, 0x91, 0x7b,
+	0x7a, 0xd8, 0xc8, 0x7d, 0x77, 0x76, 0xb4, 0x9a, 0xd1, 0xf1, 0xff, 0x40, 0x50, 0xfe, 0x3c, 0x36,
+	0xff, 0xbb, 0xd3, 0x54, 0xb3, 0xd3, 0xf8, 0xbf, 0x21, 0x28, 0xef, 0xc6, 0xe3, 0xf1, 0x68, 0x92,
+	0xb0, 0x31, 0xca, 0xd0, 0x11, 0x41, 0x37, 0xc6, 0xc6, 0xf6, 0xeb, 0xbc, 0xee, 0xd8, 0xa0, 0x87,
+	0xc7, 0x6b, 0xaf, 0xcc, 0xdd, 0x45, 0x4b, 0x70, 0x87, 0x20, 0xff, 0x2b, 0x58, 0xf8, 0x28, 0x19,
+	0xb3, 0x3d, 0x29, 0xcc, 0x15, 0x03, 0xb8, 0x0c, 0x55, 0x7e, 0x7f, 0xac, 0x24, 0x97, 0xc6, 0x4e,
+	0xe0, 0x73, 0xc1, 0xcc, 0x4e, 0x86, 0x93, 0x8e, 0x04, 0xd5, 0x5c, 0xdb, 0xcd, 0x5b, 0x08, 0x32,
+	0xd3, 0x7f, 0x92, 0x87, 0xea, 0x67, 0xdc, 0x50, 0x46, 0x0d, 0xc5, 0x4d, 0xa8, 0x31, 0xae, 0xfb,
+	0x91, 0x18, 0x27, 0x9b, 0xe1, 0xca, 0x5f, 0x74, 0xe1, 0x0f, 0xa0, 0x96, 0x6e, 0x73, 0x2c, 0x85,
+	0xc9, 0xde, 0x9f, 0x37, 0xf7, 0x43, 0x32, 0xe3, 0x1b, 0x00, 0xcb, 0x1e, 0x35, 0xc6, 0x50, 0x4c,
+	0x74, 0x25, 0x05, 0x5b, 0xdb, 0x3e, 0x27, 0xec, 0x98, 0xd0, 0xe3, 0x11, 0x9d, 0x90, 0xa2, 0x75,
+	0x67, 0x26, 0x7e, 0x0d, 0x8a, 0x92, 0x86, 0x9c, 0x94, 0xec, 0x64, 0x2d, 0x5d, 0xda, 0xf1, 0xb7,
+	0x36, 0x02, 0x1b, 0x80, 0xdf, 0x80, 0xb2, 0x9e, 0x84, 0x3d, 0x35, 0x22, 0xe5, 0xab, 0x43, 0x5d,
+	0x08, 0x7e, 0x13, 0x0a, 0x71, 0x24, 0x48, 0xc5, 0x46, 0x2e, 0x4f, 0x4f, 0x1a, 0x85, 0xbd, 0x60,
+	0xe7, 0x72, 0xc2, 0x3b, 0x41, 0x12, 0x86, 0xdf, 0x83, 0x6a, 0x1c, 0x89, 0xee, 0x90, 0xea, 0x21,
+	0xa9, 0xda, 0x14, 0x6f, 0x7a, 0xd2, 0xa8, 0xec, 0x05, 0x3b, 0x9f, 0x52, 0x3d, 0x9c, 0x97, 0x56,
+	0x89, 0x23, 0x91, 0x60, 0xbe, 0x80, 0xc5, 0x6d, 0xda, 0x37, 0x2a, 0x9a, 0x58, 0x31, 0xae, 0x78,
+	0x71, 0x2d, 0x28, 0x51, 0x16, 0x0a, 0x49, 0xf2, 0xd7, 0xec, 0x4f, 0x1a, 0xd6, 0x59, 0x7a, 0x7c,
+	0xf9, 0x53, 0xe7, 0xff, 0x9e, 0x87, 0x5b, 0x5f, 0x46, 0x54, 0xea, 0x7d, 0x1e, 0x05, 0xbc, 0xaf,
+	0x22, 0x86, 0x6f, 0x41, 0x5e, 0x30, 0xdb, 0xaa, 0x18, 0xe4, 0x05, 0xc3, 0x2f, 0x41, 0x79, 0xc8,
+	0xc5, 0x60, 0x98, 0x8e, 0x47, 0x21, 0x70, 0x16, 0x7e, 0x1f, 0x16, 0xf7, 0x23, 0x15, 0x76, 0xb3,
+	0x35, 0x2e, 0x5c, 0x43, 0xa3, 0x96, 0x44, 0x3b, 0x17, 0x7e, 0x17, 0xc0, 0xa8, 0x59, 0x6a, 0xf1,
+	0x9a, 0xd4, 0x05, 0xa3, 0xb2, 0xc4, 0x09, 0x94, 0x69, 0xa8, 0x62, 0x69, 0x48, 0xe9, 0xa6, 0xd6,
+	0xce, 0x35, 0x9c, 0x2b, 0xe0, 0xe6, 0xc6, 0x83, 0xa9, 0x87, 0x1e, 0x4d, 0x3d, 0xf4, 0xcf, 0xd4,
+	0x43, 0x3f, 0x9e, 0x7a, 0xb9, 0x47, 0xa7, 0x5e, 0xee, 0xaf, 0x53, 0x2f, 0xf7, 0x8d, 0xbb, 0xaf,
+	0x35, 0x3b, 0x68, 0x09, 0x95, 0x5d, 0x0d, 0xb6, 0x5b, 0xaf, 0x6c, 0xaf, 0xda, 0x8d, 0x7f, 0x07,
+	0x00, 0x02, 0x32, 0xdd, 0x08, 0x1e, 0x08, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -580,6 +736,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomCreationFee) > 0 {
+		for iNdEx := len(m.DenomCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.DefaultSendEnabled {
 		i--
 		if m.DefaultSendEnabled {
@@ -902,6 +1072,104 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FactoryDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FactoryDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FactoryDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -928,6 +1196,12 @@ func (m *Params) Size() (n int) {
 	if m.DefaultSendEnabled {
 		n += 2
 	}
+	if len(m.DenomCreationFee) > 0 {
+		for _, e := range m.DenomCreationFee {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1065,6 +1339,52 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *FactoryDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBank(uint64(m.Id))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBank(uint64(m.Height))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1154,6 +1474,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DefaultSendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCreationFee = append(m.DenomCreationFee, types.Coin{})
+			if err := m.DenomCreationFee[len(m.DenomCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -2034,6 +2388,306 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FactoryDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FactoryDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FactoryDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
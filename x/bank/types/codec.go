@@ -28,6 +28,11 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgUpdateParams{},
 		&MsgBurn{},
 		&MsgSetSendEnabled{},
+		&MsgCreateDenom{},
+		&MsgFactoryMint{},
+		&MsgFactoryBurn{},
+		&MsgChangeDenomAdmin{},
+		&MsgSetDenomMetadata{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
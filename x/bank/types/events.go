@@ -21,4 +21,14 @@ const (
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+
+	// token factory events name and attributes
+	EventTypeCreateDenom      = "create_denom"
+	EventTypeChangeDenomAdmin = "change_denom_admin"
+	EventTypeSetDenomMetadata = "set_denom_metadata"
+
+	AttributeKeyCreator       = "creator"
+	AttributeKeyNewTokenDenom = "new_token_denom"
+	AttributeKeyDenom         = "denom"
+	AttributeKeyNewAdmin      = "new_admin"
 )
@@ -62,6 +62,42 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	seenFactoryDenoms := make(map[string]bool)
+	for _, factoryDenom := range gs.FactoryDenoms {
+		if seenFactoryDenoms[factoryDenom.Denom] {
+			return fmt.Errorf("duplicate token factory denom %s", factoryDenom.Denom)
+		}
+
+		if _, _, err := ParseFactoryDenom(factoryDenom.Denom); err != nil {
+			return err
+		}
+
+		seenFactoryDenoms[factoryDenom.Denom] = true
+	}
+
+	for _, entry := range gs.BalanceLog {
+		if entry.Height < 0 {
+			return fmt.Errorf("negative balance log height %d for address %s", entry.Height, entry.Address)
+		}
+
+		if err := entry.Balance.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seenTransfers := make(map[uint64]bool)
+	for _, transfer := range gs.Transfers {
+		if seenTransfers[transfer.Id] {
+			return fmt.Errorf("duplicate transfer %d", transfer.Id)
+		}
+
+		if transfer.Id >= gs.TransferSequence {
+			return fmt.Errorf("transfer %d is not below the transfer sequence %d", transfer.Id, gs.TransferSequence)
+		}
+
+		seenTransfers[transfer.Id] = true
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
@@ -40,6 +40,14 @@ type GenesisState struct {
 	DenomMetadata []Metadata `protobuf:"bytes,4,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata"`
 	// send_enabled defines the denoms where send is enabled or disabled.
 	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
+	// factory_denoms defines the denoms created with the token factory.
+	FactoryDenoms []FactoryDenom `protobuf:"bytes,6,rep,name=factory_denoms,json=factoryDenoms,proto3" json:"factory_denoms"`
+	// balance_log defines the balance change log, maintained when the balance history is enabled.
+	BalanceLog []BalanceLogEntry `protobuf:"bytes,7,rep,name=balance_log,json=balanceLog,proto3" json:"balance_log"`
+	// transfers defines the transfers of the transfer log, maintained when the balance history is enabled.
+	Transfers []TransferRecord `protobuf:"bytes,8,rep,name=transfers,proto3" json:"transfers"`
+	// transfer_sequence is the id of the next transfer recorded by the transfer log.
+	TransferSequence uint64 `protobuf:"varint,9,opt,name=transfer_sequence,json=transferSequence,proto3" json:"transfer_sequence,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -110,6 +118,34 @@ func (m *GenesisState) GetSendEnabled() []SendEnabled {
 	return nil
 }
 
+func (m *GenesisState) GetFactoryDenoms() []FactoryDenom {
+	if m != nil {
+		return m.FactoryDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBalanceLog() []BalanceLogEntry {
+	if m != nil {
+		return m.BalanceLog
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransfers() []TransferRecord {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferSequence() uint64 {
+	if m != nil {
+		return m.TransferSequence
+	}
+	return 0
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -152,45 +188,122 @@ func (m *Balance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Balance proto.InternalMessageInfo
 
+// BalanceLogEntry defines the balance of an account for a denom after the last change of a block,
+// used in the bank module's genesis state.
+type BalanceLogEntry struct {
+	// address is the address of the balance holder.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// height is the block height of the change.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// balance is the balance after the change.
+	Balance types.Coin `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance"`
+}
+
+func (m *BalanceLogEntry) Reset()         { *m = BalanceLogEntry{} }
+func (m *BalanceLogEntry) String() string { return proto.CompactTextString(m) }
+func (*BalanceLogEntry) ProtoMessage()    {}
+func (*BalanceLogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f007de11b420c6e, []int{2}
+}
+func (m *BalanceLogEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceLogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceLogEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BalanceLogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceLogEntry.Merge(m, src)
+}
+func (m *BalanceLogEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceLogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceLogEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceLogEntry proto.InternalMessageInfo
+
+func (m *BalanceLogEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BalanceLogEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BalanceLogEntry) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.bank.v1beta1.GenesisState")
 	proto.RegisterType((*Balance)(nil), "cosmos.bank.v1beta1.Balance")
+	proto.RegisterType((*BalanceLogEntry)(nil), "cosmos.bank.v1beta1.BalanceLogEntry")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6d, 0x42, 0xd3, 0xf6, 0x12, 0x40, 0x98, 0x0e, 0x6e, 0x29, 0x4e, 0xe8, 0x14, 0x55,
-	0xca, 0x99, 0xa6, 0x48, 0x48, 0x0c, 0x48, 0xb8, 0x02, 0x26, 0x04, 0x6a, 0x36, 0x96, 0xe8, 0xec,
-	0x3b, 0x19, 0x2b, 0xf1, 0x9d, 0x95, 0x77, 0x05, 0xf2, 0x0d, 0x18, 0x99, 0x99, 0x3a, 0x22, 0xa6,
-	0x0e, 0xfd, 0x00, 0x88, 0xa9, 0x63, 0xc5, 0x84, 0x18, 0x00, 0x25, 0x43, 0xf9, 0x18, 0xc8, 0xf7,
-	0xae, 0x49, 0x24, 0x3c, 0xb3, 0xd8, 0x96, 0xff, 0xff, 0xf7, 0xfb, 0xbf, 0xf7, 0xee, 0xc8, 0xdd,
-	0x44, 0x41, 0xae, 0x20, 0x8c, 0x99, 0x1c, 0x86, 0x6f, 0xf6, 0x62, 0xa1, 0xd9, 0x5e, 0x98, 0x0a,
-	0x29, 0x20, 0x03, 0x5a, 0x8c, 0x95, 0x56, 0xde, 0x2d, 0xb4, 0xd0, 0xd2, 0x42, 0xad, 0x65, 0x6b,
-	0x23, 0x55, 0xa9, 0x32, 0x7a, 0x58, 0x7e, 0xa1, 0x75, 0x2b, 0x98, 0xd3, 0x40, 0xcc, 0x69, 0x89,
-	0xca, 0xe4, 0x3f, 0xfa, 0x52, 0x9a, 0xe1, 0xa2, 0xbe, 0x89, 0xfa, 0x00, 0xc1, 0x36, 0x17, 0xa5,
-	0x9b, 0x2c, 0xcf, 0xa4, 0x0a, 0xcd, 0x13, 0x7f, 0xed, 0x7c, 0xad, 0x91, 0xe6, 0x33, 0x6c, 0xb5,
-	0xaf, 0x99, 0x16, 0xde, 0x23, 0x52, 0x2f, 0xd8, 0x98, 0xe5, 0xe0, 0xbb, 0x6d, 0xb7, 0xd3, 0xe8,
-	0xdd, 0xa6, 0x15, 0xad, 0xd3, 0x97, 0xc6, 0x12, 0xad, 0x9f, 0xfd, 0x6c, 0x39, 0x9f, 0x2e, 0x4e,
-	0x76, 0xdd, 0x43, 0x5b, 0xe5, 0x1d, 0x90, 0xb5, 0x98, 0x8d, 0x98, 0x4c, 0x04, 0xf8, 0x57, 0xda,
-	0xb5, 0x4e, 0xa3, 0xb7, 0x5d, 0x49, 0x88, 0xd0, 0xb4, 0x8c, 0x98, 0x17, 0x7a, 0x13, 0x52, 0x87,
-	0xa3, 0xa2, 0x18, 0x4d, 0xfc, 0x9a, 0x41, 0x6c, 0x2e, 0x10, 0x20, 0xe6, 0x88, 0x03, 0x95, 0xc9,
-	0xe8, 0x69, 0x59, 0xff, 0xf9, 0x57, 0xab, 0x93, 0x66, 0xfa, 0xf5, 0x51, 0x4c, 0x13, 0x95, 0xdb,
-	0xa1, 0xed, 0xab, 0x0b, 0x7c, 0x18, 0xea, 0x49, 0x21, 0xc0, 0x14, 0xc0, 0xc7, 0x8b, 0x93, 0xdd,
-	0xe6, 0x48, 0xa4, 0x2c, 0x99, 0x0c, 0xca, 0xb5, 0x82, 0xed, 0x1f, 0x03, 0xbd, 0x17, 0xe4, 0x3a,
-	0x17, 0x52, 0xe5, 0x83, 0x5c, 0x68, 0xc6, 0x99, 0x66, 0xfe, 0x55, 0xd3, 0xc2, 0x9d, 0xca, 0x29,
-	0x9e, 0x5b, 0xd3, 0xf2, 0x18, 0xd7, 0x4c, 0xfd, 0xa5, 0xe2, 0x31, 0xd2, 0x04, 0x21, 0xf9, 0x40,
-	0x48, 0x16, 0x8f, 0x04, 0xf7, 0x57, 0x0c, 0xae, 0x5d, 0x89, 0xeb, 0x0b, 0xc9, 0x9f, 0xa0, 0x2f,
-	0xda, 0x2e, 0x89, 0x3f, 0x4e, 0xbb, 0x37, 0x16, 0x63, 0xb4, 0xef, 0xd1, 0xfb, 0x0f, 0x30, 0xa4,
-	0x01, 0x0b, 0xeb, 0xce, 0x17, 0x97, 0xac, 0xda, 0x7d, 0x7a, 0x3d, 0xb2, 0xca, 0x38, 0x1f, 0x0b,
-	0xc0, 0x03, 0x5c, 0x8f, 0xfc, 0x6f, 0xa7, 0xdd, 0x0d, 0x1b, 0xf6, 0x18, 0x95, 0xbe, 0x1e, 0x67,
-	0x32, 0x3d, 0xbc, 0x34, 0x7a, 0x6f, 0xc9, 0x8a, 0xd9, 0x84, 0x3d, 0xb0, 0xff, 0xb0, 0x6d, 0xcc,
-	0x7b, 0xb8, 0xf6, 0xfe, 0xb8, 0xe5, 0xfc, 0x39, 0x6e, 0x39, 0xd1, 0xfe, 0xd9, 0x34, 0x70, 0xcf,
-	0xa7, 0x81, 0xfb, 0x7b, 0x1a, 0xb8, 0x1f, 0x66, 0x81, 0x73, 0x3e, 0x0b, 0x9c, 0xef, 0xb3, 0xc0,
-	0x79, 0x65, 0xef, 0x33, 0xf0, 0x21, 0xcd, 0x54, 0xf8, 0x0e, 0xef, 0xbd, 0x49, 0x88, 0xeb, 0xe6,
-	0x0e, 0xef, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x8e, 0xe3, 0x0e, 0x29, 0x81, 0x03, 0x00, 0x00,
+	// 644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x4f, 0x4f, 0x13, 0x41,
+	0x18, 0xc6, 0xbb, 0x14, 0x5a, 0x3a, 0x45, 0x90, 0x81, 0x98, 0x01, 0x71, 0xbb, 0xa0, 0x87, 0x86,
+	0x84, 0x5d, 0x01, 0x8d, 0x09, 0x07, 0xa2, 0x8b, 0xe0, 0x45, 0xa3, 0x69, 0x3d, 0x79, 0xd9, 0xcc,
+	0xee, 0x4e, 0x97, 0x0d, 0xed, 0x4c, 0xdd, 0x19, 0xd4, 0x7e, 0x03, 0x8f, 0x9e, 0x3d, 0x71, 0x34,
+	0x9e, 0x08, 0xe1, 0x03, 0x78, 0xe4, 0x48, 0x38, 0x19, 0x0f, 0x6a, 0xe0, 0x80, 0x1f, 0xc3, 0xec,
+	0xcc, 0xf4, 0x4f, 0x64, 0x85, 0xc4, 0x83, 0x97, 0xb6, 0xdb, 0xf7, 0xf7, 0x3e, 0xcf, 0xfb, 0xec,
+	0xbb, 0x3b, 0x60, 0x3e, 0x60, 0xbc, 0xc5, 0xb8, 0xe3, 0x63, 0xba, 0xe3, 0xbc, 0x59, 0xf6, 0x89,
+	0xc0, 0xcb, 0x4e, 0x44, 0x28, 0xe1, 0x31, 0xb7, 0xdb, 0x09, 0x13, 0x0c, 0x4e, 0x29, 0xc4, 0x4e,
+	0x11, 0x5b, 0x23, 0xb3, 0xd3, 0x11, 0x8b, 0x98, 0xac, 0x3b, 0xe9, 0x2f, 0x85, 0xce, 0x9a, 0x3d,
+	0x35, 0x4e, 0x7a, 0x6a, 0x01, 0x8b, 0xe9, 0x85, 0xfa, 0x80, 0x9b, 0xd4, 0x55, 0xf5, 0x19, 0x55,
+	0xf7, 0x94, 0xb0, 0xf6, 0x55, 0xa5, 0x49, 0xdc, 0x8a, 0x29, 0x73, 0xe4, 0xa7, 0xfa, 0x6b, 0xe1,
+	0xa0, 0x00, 0xc6, 0x9e, 0xa8, 0x51, 0xeb, 0x02, 0x0b, 0x02, 0xd7, 0x41, 0xa1, 0x8d, 0x13, 0xdc,
+	0xe2, 0xc8, 0xb0, 0x8c, 0x6a, 0x79, 0xe5, 0xa6, 0x9d, 0x31, 0xba, 0xfd, 0x42, 0x22, 0x6e, 0xe9,
+	0xe8, 0x7b, 0x25, 0xf7, 0xe9, 0x7c, 0x7f, 0xd1, 0xa8, 0xe9, 0x2e, 0xb8, 0x01, 0x46, 0x7d, 0xdc,
+	0xc4, 0x34, 0x20, 0x1c, 0x0d, 0x59, 0xf9, 0x6a, 0x79, 0x65, 0x2e, 0x53, 0xc1, 0x55, 0xd0, 0xa0,
+	0x44, 0xaf, 0x11, 0x76, 0x40, 0x81, 0xef, 0xb6, 0xdb, 0xcd, 0x0e, 0xca, 0x4b, 0x89, 0x99, 0xbe,
+	0x04, 0x27, 0x3d, 0x89, 0x0d, 0x16, 0x53, 0x77, 0x2b, 0xed, 0xff, 0xfc, 0xa3, 0x52, 0x8d, 0x62,
+	0xb1, 0xbd, 0xeb, 0xdb, 0x01, 0x6b, 0xe9, 0xd0, 0xfa, 0x6b, 0x89, 0x87, 0x3b, 0x8e, 0xe8, 0xb4,
+	0x09, 0x97, 0x0d, 0xfc, 0xe3, 0xf9, 0xfe, 0xe2, 0x58, 0x93, 0x44, 0x38, 0xe8, 0x78, 0xe9, 0x6d,
+	0xe5, 0x7a, 0x7e, 0x65, 0x08, 0x9f, 0x83, 0xf1, 0x90, 0x50, 0xd6, 0xf2, 0x5a, 0x44, 0xe0, 0x10,
+	0x0b, 0x8c, 0x86, 0xe5, 0x08, 0xb7, 0x32, 0x53, 0x3c, 0xd3, 0xd0, 0x60, 0x8c, 0x6b, 0xb2, 0xbf,
+	0x5b, 0x81, 0x18, 0x8c, 0x71, 0x42, 0x43, 0x8f, 0x50, 0xec, 0x37, 0x49, 0x88, 0x46, 0xa4, 0x9c,
+	0x95, 0x29, 0x57, 0x27, 0x34, 0xdc, 0x54, 0x9c, 0x3b, 0x97, 0x2a, 0x7e, 0x3b, 0x5c, 0x9a, 0xe8,
+	0xc7, 0xb0, 0xee, 0xda, 0xf7, 0x1e, 0x28, 0x93, 0x32, 0xef, 0xa3, 0xb0, 0x01, 0xc6, 0x1b, 0x38,
+	0x10, 0x2c, 0xe9, 0x78, 0xd2, 0x9b, 0xa3, 0x82, 0x34, 0x99, 0xcf, 0x34, 0xd9, 0x52, 0xe8, 0xe3,
+	0x94, 0xfc, 0x8b, 0xcb, 0xfd, 0x65, 0x1d, 0xa5, 0x31, 0xc0, 0x72, 0x18, 0x80, 0xb2, 0x5e, 0x91,
+	0xd7, 0x64, 0x11, 0x2a, 0x4a, 0x93, 0x3b, 0x97, 0xad, 0xf7, 0x29, 0x8b, 0x36, 0xa9, 0x48, 0x3a,
+	0x57, 0xf8, 0x00, 0xbf, 0x87, 0x43, 0x0f, 0x94, 0x44, 0x82, 0x29, 0x6f, 0x90, 0x84, 0xa3, 0x51,
+	0x69, 0x71, 0x3b, 0xd3, 0xe2, 0xa5, 0xa6, 0x6a, 0x24, 0x60, 0x49, 0x78, 0x85, 0x43, 0x5f, 0x13,
+	0x3e, 0x04, 0x93, 0xdd, 0x0b, 0x8f, 0x93, 0xd7, 0xbb, 0x84, 0x06, 0x04, 0x95, 0x2c, 0xa3, 0x3a,
+	0xec, 0x4e, 0x65, 0xf4, 0xd7, 0xae, 0x77, 0xe9, 0xba, 0x86, 0x17, 0xbe, 0x18, 0xa0, 0xa8, 0x03,
+	0xc2, 0x15, 0x50, 0xc4, 0x61, 0x98, 0x10, 0xae, 0x5e, 0x98, 0x92, 0x8b, 0x4e, 0x0e, 0x97, 0xa6,
+	0xf5, 0xbc, 0x8f, 0x54, 0xa5, 0x2e, 0x92, 0x98, 0x46, 0xb5, 0x2e, 0x08, 0xdf, 0x82, 0x11, 0xf9,
+	0xe4, 0xa1, 0xa1, 0xff, 0xf5, 0x74, 0x2b, 0xbf, 0xb5, 0xd1, 0xf7, 0x7b, 0x95, 0xdc, 0xaf, 0xbd,
+	0x4a, 0x6e, 0xe1, 0xc0, 0x00, 0x13, 0x7f, 0xec, 0xe8, 0x9f, 0xa2, 0xdc, 0x00, 0x85, 0x6d, 0x12,
+	0x47, 0xdb, 0x02, 0x0d, 0x59, 0x46, 0x35, 0x5f, 0xd3, 0x57, 0x70, 0x1d, 0x14, 0xf5, 0x4e, 0x51,
+	0xde, 0x32, 0x2e, 0x0f, 0x39, 0xf0, 0xee, 0x74, 0x9b, 0xd6, 0xa6, 0x4e, 0x2e, 0x6e, 0xc2, 0x5d,
+	0x3d, 0x3a, 0x35, 0x8d, 0xe3, 0x53, 0xd3, 0xf8, 0x79, 0x6a, 0x1a, 0x1f, 0xce, 0xcc, 0xdc, 0xf1,
+	0x99, 0x99, 0xfb, 0x7a, 0x66, 0xe6, 0x5e, 0xe9, 0x43, 0x8f, 0x87, 0x3b, 0x76, 0xcc, 0x9c, 0x77,
+	0xea, 0x70, 0x94, 0xb7, 0xc5, 0x2f, 0xc8, 0x83, 0x6e, 0xf5, 0xf7, 0x00, 0x75, 0xaf, 0xf2, 0x5a,
+	0xa6, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -213,6 +326,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TransferSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TransferSequence))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.BalanceLog) > 0 {
+		for iNdEx := len(m.BalanceLog) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BalanceLog[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FactoryDenoms) > 0 {
+		for iNdEx := len(m.FactoryDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FactoryDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SendEnabled) > 0 {
 		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -326,6 +486,51 @@ func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BalanceLogEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceLogEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceLogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -369,6 +574,27 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FactoryDenoms) > 0 {
+		for _, e := range m.FactoryDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BalanceLog) > 0 {
+		for _, e := range m.BalanceLog {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TransferSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.TransferSequence))
+	}
 	return n
 }
 
@@ -391,6 +617,24 @@ func (m *Balance) Size() (n int) {
 	return n
 }
 
+func (m *BalanceLogEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -595,6 +839,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FactoryDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FactoryDenoms = append(m.FactoryDenoms, FactoryDenom{})
+			if err := m.FactoryDenoms[len(m.FactoryDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceLog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BalanceLog = append(m.BalanceLog, BalanceLogEntry{})
+			if err := m.BalanceLog[len(m.BalanceLog)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transfers = append(m.Transfers, TransferRecord{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferSequence", wireType)
+			}
+			m.TransferSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -732,6 +1097,140 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BalanceLogEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceLogEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceLogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
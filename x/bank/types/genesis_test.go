@@ -46,6 +46,69 @@ func TestGenesisStateValidate(t *testing.T) {
 			false,
 		},
 		{"empty genesisState", GenesisState{}, false},
+		{
+			"valid factory denoms",
+			GenesisState{
+				FactoryDenoms: []FactoryDenom{
+					{Denom: "factory/cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du/token", Admin: "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"},
+					{Denom: "factory/cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du/renounced"},
+				},
+			},
+			false,
+		},
+		{
+			"invalid factory denom",
+			GenesisState{
+				FactoryDenoms: []FactoryDenom{{Denom: "token", Admin: "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"}},
+			},
+			true,
+		},
+		{
+			"dup factory denoms",
+			GenesisState{
+				FactoryDenoms: []FactoryDenom{
+					{Denom: "factory/cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du/token"},
+					{Denom: "factory/cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du/token"},
+				},
+			},
+			true,
+		},
+		{
+			"valid balance history",
+			GenesisState{
+				BalanceLog: []BalanceLogEntry{
+					{Address: "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du", Height: 2, Balance: sdk.NewInt64Coin("uatom", 0)},
+				},
+				Transfers:        []TransferRecord{{Id: 0, Height: 1}, {Id: 1, Height: 2}},
+				TransferSequence: 2,
+			},
+			false,
+		},
+		{
+			"negative balance log height",
+			GenesisState{
+				BalanceLog: []BalanceLogEntry{
+					{Address: "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du", Height: -1, Balance: sdk.NewInt64Coin("uatom", 1)},
+				},
+			},
+			true,
+		},
+		{
+			"dup transfers",
+			GenesisState{
+				Transfers:        []TransferRecord{{Id: 1, Height: 1}, {Id: 1, Height: 2}},
+				TransferSequence: 2,
+			},
+			true,
+		},
+		{
+			"transfer not below the transfer sequence",
+			GenesisState{
+				Transfers:        []TransferRecord{{Id: 0, Height: 1}, {Id: 1, Height: 2}},
+				TransferSequence: 1,
+			},
+			true,
+		},
 		{
 			"invalid params ",
 			GenesisState{
@@ -24,6 +24,11 @@ const (
 	// It should be synced with the mint module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/0e34478eb7420b69869ed50f129fc274a97a9b06/x/mint/types/keys.go#L13
 	MintModuleName = "mint"
+
+	// ProtocolPoolModuleName duplicates the protocolpool module's name to avoid a cyclic dependency with x/protocolpool.
+	// It should be synced with the protocolpool module's name if it is ever changed.
+	// The token factory denom creation fees are sent to its module account.
+	ProtocolPoolModuleName = "protocolpool"
 )
 
 // KVStore keys
@@ -39,6 +44,16 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// FactoryDenomsPrefix is the prefix for the admins of the token factory denoms.
+	FactoryDenomsPrefix = collections.NewPrefix(6)
+
+	// BalanceHistoryPrefix is the prefix for the balance change log.
+	BalanceHistoryPrefix = collections.NewPrefix(7)
+	// AddressTransfersPrefix is the prefix for the transfer log, indexed by the addresses sending or receiving the transfers.
+	AddressTransfersPrefix = collections.NewPrefix(8)
+	// TransferSequenceKey is the key of the sequence numbering the transfers of the transfer log.
+	TransferSequenceKey = collections.NewPrefix(9)
 )
 
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
@@ -10,6 +10,11 @@ var (
 	_ coretransaction.Msg = &MsgSend{}
 	_ coretransaction.Msg = &MsgMultiSend{}
 	_ coretransaction.Msg = &MsgUpdateParams{}
+	_ coretransaction.Msg = &MsgCreateDenom{}
+	_ coretransaction.Msg = &MsgFactoryMint{}
+	_ coretransaction.Msg = &MsgFactoryBurn{}
+	_ coretransaction.Msg = &MsgChangeDenomAdmin{}
+	_ coretransaction.Msg = &MsgSetDenomMetadata{}
 )
 
 // NewMsgSend - construct a msg to send coins from one account to another.
@@ -30,3 +35,28 @@ func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefau
 		UseDefaultFor: useDefaultFor,
 	}
 }
+
+// NewMsgCreateDenom creates a message to create the token factory denom factory/{sender}/{subdenom}.
+func NewMsgCreateDenom(sender, subdenom string) *MsgCreateDenom {
+	return &MsgCreateDenom{Sender: sender, Subdenom: subdenom}
+}
+
+// NewMsgFactoryMint creates a message to mint coins of a token factory denom.
+func NewMsgFactoryMint(admin string, amount sdk.Coin, mintToAddress string) *MsgFactoryMint {
+	return &MsgFactoryMint{Admin: admin, Amount: amount, MintToAddress: mintToAddress}
+}
+
+// NewMsgFactoryBurn creates a message to burn coins of a token factory denom from the balance of its admin.
+func NewMsgFactoryBurn(admin string, amount sdk.Coin) *MsgFactoryBurn {
+	return &MsgFactoryBurn{Admin: admin, Amount: amount}
+}
+
+// NewMsgChangeDenomAdmin creates a message to transfer the admin rights of a token factory denom.
+func NewMsgChangeDenomAdmin(admin, denom, newAdmin string) *MsgChangeDenomAdmin {
+	return &MsgChangeDenomAdmin{Admin: admin, Denom: denom, NewAdmin: newAdmin}
+}
+
+// NewMsgSetDenomMetadata creates a message to set the metadata of a token factory denom.
+func NewMsgSetDenomMetadata(admin string, metadata Metadata) *MsgSetDenomMetadata {
+	return &MsgSetDenomMetadata{Admin: admin, Metadata: metadata}
+}
@@ -23,6 +23,7 @@ func DefaultParams() Params {
 	return Params{
 		SendEnabled:        nil,
 		DefaultSendEnabled: DefaultDefaultSendEnabled,
+		DenomCreationFee:   sdk.Coins{},
 	}
 }
 
@@ -31,6 +32,9 @@ func (p Params) Validate() error {
 	if len(p.SendEnabled) > 0 {
 		return errors.New("use of send_enabled in params is no longer supported")
 	}
+	if err := p.DenomCreationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee: %w", err)
+	}
 	return validateIsBool(p.DefaultSendEnabled)
 }
 
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// An AfterSendHookFn is called after coins have been sent.
+type AfterSendHookFn func(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+
+// DenomSendHook is a send restriction and/or an after send hook scoped to a denom or to a denom prefix.
+// The hook functions are only given the coins of the sent amount which the hook applies to.
+type DenomSendHook struct {
+	// Name identifies the hook and must be unique, e.g. the name of the module registering it.
+	Name string
+	// Denom is the denom the hook applies to. If Prefix is true, the hook applies to all the denoms starting with Denom.
+	Denom  string
+	Prefix bool
+	// Order defines the order the hooks of a denom run in, lowest first. Hooks with the same order run by name.
+	Order int32
+	// Gas is the gas consumed each time the hook runs, in addition to the gas consumed by the hook functions.
+	Gas uint64
+	// Restriction is run before the coins are sent. It can restrict the send or provide a new receiver address.
+	Restriction SendRestrictionFn
+	// AfterSend is run after the coins are sent.
+	AfterSend AfterSendHookFn
+}
+
+// Validate returns an error if the hook is invalid.
+func (h DenomSendHook) Validate() error {
+	if strings.TrimSpace(h.Name) == "" {
+		return errors.New("send hook name cannot be empty")
+	}
+
+	if h.Prefix {
+		if h.Denom == "" {
+			return fmt.Errorf("send hook %s: denom prefix cannot be empty", h.Name)
+		}
+	} else if err := sdk.ValidateDenom(h.Denom); err != nil {
+		return fmt.Errorf("send hook %s: %w", h.Name, err)
+	}
+
+	if h.Restriction == nil && h.AfterSend == nil {
+		return fmt.Errorf("send hook %s: restriction and after send hook cannot both be nil", h.Name)
+	}
+
+	return nil
+}
+
+// Matches returns true if the hook applies to the denom.
+func (h DenomSendHook) Matches(denom string) bool {
+	if h.Prefix {
+		return strings.HasPrefix(denom, h.Denom)
+	}
+
+	return h.Denom == denom
+}
+
+// FilterCoins returns the coins the hook applies to.
+func (h DenomSendHook) FilterCoins(amt sdk.Coins) sdk.Coins {
+	var coins sdk.Coins
+	for _, coin := range amt {
+		if h.Matches(coin.Denom) {
+			coins = append(coins, coin)
+		}
+	}
+
+	return coins
+}
+
+// Info returns the description of the hook returned by queries.
+func (h DenomSendHook) Info() SendHookInfo {
+	return SendHookInfo{
+		Name:           h.Name,
+		Denom:          h.Denom,
+		Prefix:         h.Prefix,
+		Order:          h.Order,
+		Gas:            h.Gas,
+		HasRestriction: h.Restriction != nil,
+		HasAfterSend:   h.AfterSend != nil,
+	}
+}
+
+// DenomSendHooks are the denom send hooks provided by a module.
+type DenomSendHooks []DenomSendHook
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (DenomSendHooks) IsOnePerModuleType() {}
@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDenomSendHookValidate(t *testing.T) {
+	afterSend := func(context.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) error { return nil }
+	restriction := func(_ context.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) { return toAddr, nil }
+
+	tests := []struct {
+		name   string
+		hook   types.DenomSendHook
+		expErr string
+	}{
+		{
+			name: "valid denom hook",
+			hook: types.DenomSendHook{Name: "hook", Denom: "foo", AfterSend: afterSend},
+		},
+		{
+			name: "valid prefix hook",
+			hook: types.DenomSendHook{Name: "hook", Denom: "factory/", Prefix: true, Restriction: restriction},
+		},
+		{
+			name:   "empty name",
+			hook:   types.DenomSendHook{Name: " ", Denom: "foo", AfterSend: afterSend},
+			expErr: "name cannot be empty",
+		},
+		{
+			name:   "invalid denom",
+			hook:   types.DenomSendHook{Name: "hook", Denom: "f", AfterSend: afterSend},
+			expErr: "invalid denom",
+		},
+		{
+			name:   "empty prefix",
+			hook:   types.DenomSendHook{Name: "hook", Prefix: true, AfterSend: afterSend},
+			expErr: "denom prefix cannot be empty",
+		},
+		{
+			name:   "no hook function",
+			hook:   types.DenomSendHook{Name: "hook", Denom: "foo"},
+			expErr: "cannot both be nil",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.hook.Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDenomSendHookFilterCoins(t *testing.T) {
+	amt := sdk.NewCoins(sdk.NewInt64Coin("bar", 1), sdk.NewInt64Coin("factory/a/x", 2), sdk.NewInt64Coin("foo", 3))
+
+	hook := types.DenomSendHook{Denom: "foo"}
+	require.True(t, hook.Matches("foo"))
+	require.False(t, hook.Matches("fooo"))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foo", 3)), hook.FilterCoins(amt))
+
+	hook = types.DenomSendHook{Denom: "factory/", Prefix: true}
+	require.True(t, hook.Matches("factory/a/x"))
+	require.False(t, hook.Matches("foo"))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("factory/a/x", 2)), hook.FilterCoins(amt))
+
+	hook = types.DenomSendHook{Denom: "baz"}
+	require.True(t, hook.FilterCoins(amt).Empty())
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FactoryDenomPrefix is the first part of the token factory denoms, factory/{creator}/{subdenom}.
+	FactoryDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of the subdenom of a token factory denom.
+	MaxSubdenomLength = 44
+
+	// MaxCreatorLength is the maximum length of the creator address of a token factory denom.
+	MaxCreatorLength = 75
+)
+
+// GetFactoryDenom returns the token factory denom created by the creator with the subdenom.
+func GetFactoryDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) > MaxSubdenomLength {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "subdenom too long, max length is %d bytes", MaxSubdenomLength)
+	}
+
+	if len(creator) > MaxCreatorLength {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "creator too long, max length is %d bytes", MaxCreatorLength)
+	}
+
+	if strings.Contains(creator, "/") {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "creator %s cannot contain '/'", creator)
+	}
+
+	denom := strings.Join([]string{FactoryDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", errorsmod.Wrap(ErrInvalidFactoryDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// ParseFactoryDenom returns the creator and the subdenom of a token factory denom.
+// The subdenom may contain '/', but the creator cannot.
+func ParseFactoryDenom(denom string) (creator, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", errorsmod.Wrap(ErrInvalidFactoryDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) < 3 || parts[0] != FactoryDenomPrefix {
+		return "", "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "denom %s is not in the form %s/{creator}/{subdenom}", denom, FactoryDenomPrefix)
+	}
+
+	creator, subdenom = parts[1], parts[2]
+	if creator == "" {
+		return "", "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "denom %s has an empty creator", denom)
+	}
+
+	if len(subdenom) > MaxSubdenomLength || len(creator) > MaxCreatorLength {
+		return "", "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "denom %s is too long", denom)
+	}
+
+	return creator, subdenom, nil
+}
@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/bank/types"
+)
+
+func TestFactoryDenom(t *testing.T) {
+	creator := "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+
+	denom, err := types.GetFactoryDenom(creator, "token")
+	require.NoError(t, err)
+	require.Equal(t, "factory/"+creator+"/token", denom)
+
+	actualCreator, subdenom, err := types.ParseFactoryDenom(denom)
+	require.NoError(t, err)
+	require.Equal(t, creator, actualCreator)
+	require.Equal(t, "token", subdenom)
+
+	// the subdenom can contain '/'
+	denom, err = types.GetFactoryDenom(creator, "token/a")
+	require.NoError(t, err)
+	_, subdenom, err = types.ParseFactoryDenom(denom)
+	require.NoError(t, err)
+	require.Equal(t, "token/a", subdenom)
+
+	// an empty subdenom is allowed
+	_, err = types.GetFactoryDenom(creator, "")
+	require.NoError(t, err)
+
+	_, err = types.GetFactoryDenom(creator, "averyveryveryveryveryveryveryveryverylongsubdenom")
+	require.ErrorIs(t, err, types.ErrInvalidFactoryDenom)
+
+	_, err = types.GetFactoryDenom("cosmos/1", "token")
+	require.ErrorIs(t, err, types.ErrInvalidFactoryDenom)
+
+	_, err = types.GetFactoryDenom(creator, "to ken")
+	require.ErrorIs(t, err, types.ErrInvalidFactoryDenom)
+
+	for _, invalid := range []string{"foo", "factory/" + creator, "ibc/" + creator + "/token", "factory//token"} {
+		_, _, err = types.ParseFactoryDenom(invalid)
+		require.ErrorIs(t, err, types.ErrInvalidFactoryDenom, invalid)
+	}
+}
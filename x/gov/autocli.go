@@ -28,6 +28,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "msg_url"},
 					},
 				},
+				{
+					RpcMethod: "TallyStrategies",
+					Use:       "tally-strategies",
+					Short:     "Query the registered tally strategies and their params",
+				},
 				{
 					RpcMethod: "Proposals",
 					Use:       "proposals",
@@ -89,6 +94,55 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "proposal_id"},
 					},
 				},
+				{
+					RpcMethod: "Ballot",
+					Use:       "ballot [proposal-id] [voter-addr]",
+					Short:     "Query details of a single ballot on a ranked-choice or approval proposal",
+					Example:   fmt.Sprintf("%s query gov ballot 1 cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+						{ProtoField: "voter"},
+					},
+				},
+				{
+					RpcMethod: "Ballots",
+					Use:       "ballots [proposal-id]",
+					Short:     "Query ballots of a ranked-choice or approval proposal",
+					Example:   fmt.Sprintf("%s query gov ballots 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
+				{
+					RpcMethod: "BallotTally",
+					Use:       "ballot-tally [proposal-id]",
+					Short:     "Query the round-by-round tally of the ballots of a ranked-choice or approval proposal",
+					Example:   fmt.Sprintf("%s query gov ballot-tally 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
+				{
+					RpcMethod: "VoteDelegation",
+					Use:       "vote-delegation [delegator-addr]",
+					Short:     "Query the vote delegation of an account, globally or for a topic",
+					Example:   fmt.Sprintf("%s query gov vote-delegation cosmos1... --topic /cosmos.bank.v1beta1.MsgSend", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"topic": {Name: "topic", Usage: "The message type URL of the proposals the vote delegation applies to, empty for the global vote delegation"},
+					},
+				},
+				{
+					RpcMethod: "VoteDelegations",
+					Use:       "vote-delegations [delegator-addr]",
+					Short:     "Query all the vote delegations of an account",
+					Example:   fmt.Sprintf("%s query gov vote-delegations cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator"},
+					},
+				},
 				{
 					RpcMethod: "Constitution",
 					Use:       "constitution",
@@ -134,6 +188,56 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						"metadata": {Name: "metadata", Usage: "Add a description to the vote"},
 					},
 				},
+				{
+					RpcMethod: "VoteRankedChoice",
+					Use:       "vote-ranked-choice [proposal-id] [ranking]",
+					Short:     "Cast a ranked ballot on an active ranked-choice proposal, options ordered by preference: one/two/three/four",
+					Long:      fmt.Sprintf(`Cast a ranked ballot on an active ranked-choice proposal. The options are ordered by preference, the most preferred option first. Use the --metadata to optionally give a reason. You can find the proposal-id by running "%s query gov proposals"`, version.AppName),
+					Example:   fmt.Sprintf("$ %s tx gov vote-ranked-choice 1 two one three --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+						{ProtoField: "ranking", Varargs: true},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"metadata": {Name: "metadata", Usage: "Add a description to the ballot"},
+					},
+				},
+				{
+					RpcMethod: "VoteApproval",
+					Use:       "vote-approval [proposal-id] [approvals]",
+					Short:     "Cast an approval ballot on an active approval proposal, options: one/two/three/four",
+					Long:      fmt.Sprintf(`Cast an approval ballot on an active approval proposal, approving any number of options. Use the --metadata to optionally give a reason. You can find the proposal-id by running "%s query gov proposals"`, version.AppName),
+					Example:   fmt.Sprintf("$ %s tx gov vote-approval 1 one three --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+						{ProtoField: "approvals", Varargs: true},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"metadata": {Name: "metadata", Usage: "Add a description to the ballot"},
+					},
+				},
+				{
+					RpcMethod: "DelegateVote",
+					Use:       "delegate-vote [delegate-addr]",
+					Short:     "Delegate your governance voting power to another account, globally or for a topic",
+					Long:      "Delegate your governance voting power to another account. Your voting power is tallied with the vote of the delegate on the proposals you do not vote on. Use the --topic flag to only delegate on the proposals whose first message has the given type URL.",
+					Example:   fmt.Sprintf("$ %s tx gov delegate-vote cosmos1... --topic /cosmos.bank.v1beta1.MsgSend --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegate"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"topic": {Name: "topic", Usage: "The message type URL of the proposals to delegate on, empty to delegate on all proposals"},
+					},
+				},
+				{
+					RpcMethod: "UndelegateVote",
+					Use:       "undelegate-vote",
+					Short:     "Remove your vote delegation, globally or for a topic",
+					Example:   fmt.Sprintf("$ %s tx gov undelegate-vote --topic /cosmos.bank.v1beta1.MsgSend --from mykey", version.AppName),
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"topic": {Name: "topic", Usage: "The topic of the vote delegation to remove, empty for the global vote delegation"},
+					},
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal [params]",
@@ -154,6 +258,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod: "UpdateTallyStrategyParams",
+					Use:       "update-tally-strategy-params-proposal [strategy] [params]",
+					Short:     "Submit a proposal to update the params of a tally strategy. Note: the entire params must be provided.",
+					Example:   fmt.Sprintf(`%s tx gov update-tally-strategy-params-proposal supermajority '{"threshold":"0.75","floor":"0.2"}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "strategy"},
+						{ProtoField: "params"},
+					},
+					GovProposal: true,
+				},
 			},
 			EnhanceCustomCommand: true, // We still have manual commands in gov that we want to keep
 		},
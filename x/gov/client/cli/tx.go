@@ -112,6 +112,8 @@ Where proposal.json contains:
   "title": "My proposal",
   "summary": "A short summary of my proposal",
   "proposal_type": "standard",
+  // optional, the name of a registered tally strategy (see: %[1]s query gov tally-strategies)
+  "tally_strategy": "supermajority",
 }
 
 metadata example: 
@@ -147,6 +149,7 @@ metadata example:
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
+			msg.TallyStrategy = proposal.TallyStrategy
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -95,6 +95,7 @@ type proposal struct {
 	Title           string            `json:"title"`
 	Summary         string            `json:"summary"`
 	ProposalTypeStr string            `json:"proposal_type,omitempty"`
+	TallyStrategy   string            `json:"tally_strategy,omitempty"`
 
 	proposalType govv1.ProposalType
 }
@@ -55,6 +55,46 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 		}
 	}
 
+	for _, delegation := range data.VoteDelegations {
+		addr, err := ak.AddressCodec().StringToBytes(delegation.Delegator)
+		if err != nil {
+			return err
+		}
+		err = k.VoteDelegations.Set(ctx, collections.Join(sdk.AccAddress(addr), delegation.Topic), *delegation)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, voteOptions := range data.ProposalVoteOptions {
+		if err := k.ProposalVoteOptions.Set(ctx, voteOptions.ProposalId, *voteOptions.VoteOptions); err != nil {
+			return err
+		}
+	}
+
+	for _, ballot := range data.Ballots {
+		addr, err := ak.AddressCodec().StringToBytes(ballot.Voter)
+		if err != nil {
+			return err
+		}
+		err = k.Ballots.Set(ctx, collections.Join(ballot.ProposalId, sdk.AccAddress(addr)), *ballot)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, result := range data.BallotTallyResults {
+		if err := k.BallotTallyResults.Set(ctx, result.ProposalId, *result.Tally); err != nil {
+			return err
+		}
+	}
+
+	for _, strategy := range data.TallyStrategyParams {
+		if err := k.SetTallyStrategyParams(ctx, strategy.Name, strategy.Params); err != nil {
+			return err
+		}
+	}
+
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case v1.StatusDepositPeriod:
@@ -131,12 +171,67 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) (*v1.GenesisState, err
 		return nil, err
 	}
 
+	// export vote delegations
+	var voteDelegations []*v1.VoteDelegation
+	err = k.VoteDelegations.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, string], value v1.VoteDelegation) (stop bool, err error) {
+		voteDelegations = append(voteDelegations, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// export proposals vote options
+	var proposalVoteOptions []*v1.GenesisProposalVoteOptions
+	err = k.ProposalVoteOptions.Walk(ctx, nil, func(proposalID uint64, value v1.ProposalVoteOptions) (stop bool, err error) {
+		proposalVoteOptions = append(proposalVoteOptions, &v1.GenesisProposalVoteOptions{ProposalId: proposalID, VoteOptions: &value})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// export proposals ballots
+	var ballots []*v1.Ballot
+	err = k.Ballots.Walk(ctx, nil, func(_ collections.Pair[uint64, sdk.AccAddress], value v1.Ballot) (stop bool, err error) {
+		ballots = append(ballots, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// export ballot tally results
+	var ballotTallyResults []*v1.GenesisBallotTallyResult
+	err = k.BallotTallyResults.Walk(ctx, nil, func(proposalID uint64, value v1.BallotTallyResult) (stop bool, err error) {
+		ballotTallyResults = append(ballotTallyResults, &v1.GenesisBallotTallyResult{ProposalId: proposalID, Tally: &value})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// export tally strategy params
+	var tallyStrategyParams []*v1.TallyStrategyInfo
+	err = k.TallyStrategyParams.Walk(ctx, nil, func(name, params string) (stop bool, err error) {
+		tallyStrategyParams = append(tallyStrategyParams, &v1.TallyStrategyInfo{Name: name, Params: params})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
-		Constitution:       constitution,
+		StartingProposalId:  startingProposalID,
+		Deposits:            proposalsDeposits,
+		Votes:               proposalsVotes,
+		Proposals:           proposals,
+		Params:              &params,
+		Constitution:        constitution,
+		VoteDelegations:     voteDelegations,
+		TallyStrategyParams: tallyStrategyParams,
+		ProposalVoteOptions: proposalVoteOptions,
+		Ballots:             ballots,
+		BallotTallyResults:  ballotTallyResults,
 	}, nil
 }
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddBallot adds a ballot on a specific ranked-choice or approval multiple choice proposal.
+// The voting mode must match the voting mode of the proposal.
+// A ballot replaces any previous vote or ballot of the voter on the proposal.
+func (k Keeper) AddBallot(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress, votingMode v1.MultipleChoiceVotingMode, options []v1.VoteOption, metadata string) error {
+	// get proposal
+	proposal, err := k.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return errors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+		}
+
+		return err
+	}
+
+	// check if proposal is in voting period.
+	if proposal.Status != v1.StatusVotingPeriod {
+		return errors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+
+	if err := k.assertMetadataLength(metadata); err != nil {
+		return err
+	}
+
+	if proposal.ProposalType != v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE {
+		return errors.Wrapf(types.ErrInvalidBallot, "proposal %d is not a multiple choice proposal", proposalID)
+	}
+
+	proposalOptions, err := k.ProposalVoteOptions.Get(ctx, proposalID)
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return errors.Wrap(types.ErrInvalidProposal, "invalid multiple choice proposal, no options set")
+		}
+
+		return err
+	}
+
+	if proposalOptions.VotingMode != votingMode {
+		return errors.Wrapf(types.ErrInvalidBallot, "proposal %d voting mode is %s", proposalID, proposalOptions.VotingMode)
+	}
+
+	if err := validateBallotOptions(proposalOptions, options); err != nil {
+		return err
+	}
+
+	voterStrAddr, err := k.authKeeper.AddressCodec().BytesToString(voterAddr)
+	if err != nil {
+		return err
+	}
+
+	// a voter can either vote spam or cast a ballot
+	if err := k.Votes.Remove(ctx, collections.Join(proposalID, voterAddr)); err != nil {
+		return err
+	}
+
+	ballot := v1.NewBallot(proposalID, voterStrAddr, options, metadata)
+	if err := k.Ballots.Set(ctx, collections.Join(proposalID, voterAddr), ballot); err != nil {
+		return err
+	}
+
+	// called after a ballot on a proposal is cast
+	if err = k.Hooks().AfterProposalVote(ctx, proposalID, voterAddr); err != nil {
+		return err
+	}
+
+	optionsStr := make([]string, len(options))
+	for i, option := range options {
+		optionsStr[i] = option.String()
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeProposalVote,
+		event.NewAttribute(types.AttributeKeyVoter, voterStrAddr),
+		event.NewAttribute(types.AttributeKeyOption, strings.Join(optionsStr, ",")),
+		event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+	)
+}
+
+// validateBallotOptions checks that the options of a ballot are distinct options of the proposal.
+func validateBallotOptions(proposalOptions v1.ProposalVoteOptions, options []v1.VoteOption) error {
+	if len(options) == 0 {
+		return errors.Wrap(types.ErrInvalidBallot, "ballot cannot be empty")
+	}
+
+	valid := make(map[v1.VoteOption]bool)
+	for _, option := range ballotOptions(proposalOptions) {
+		valid[option] = true
+	}
+
+	seen := make(map[v1.VoteOption]bool, len(options))
+	for _, option := range options {
+		if !valid[option] {
+			return errors.Wrapf(types.ErrInvalidBallot, "invalid vote option %s", option)
+		}
+
+		if seen[option] {
+			return errors.Wrapf(types.ErrInvalidBallot, "duplicate vote option %s", option)
+		}
+		seen[option] = true
+	}
+
+	return nil
+}
+
+// ballotOptions returns the options of a multiple choice proposal which can be chosen on a ballot, in order.
+func ballotOptions(proposalOptions v1.ProposalVoteOptions) []v1.VoteOption {
+	names := []string{proposalOptions.OptionOne, proposalOptions.OptionTwo, proposalOptions.OptionThree, proposalOptions.OptionFour}
+	options := make([]v1.VoteOption, 0, len(names))
+	for i, name := range names {
+		if name != "" {
+			options = append(options, v1.OptionOne+v1.VoteOption(i))
+		}
+	}
+
+	return options
+}
+
+// deleteBallots deletes all the ballots from a given proposalID.
+func (k Keeper) deleteBallots(ctx context.Context, proposalID uint64) error {
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
+	return k.Ballots.Clear(ctx, rng)
+}
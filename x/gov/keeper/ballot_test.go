@@ -0,0 +1,370 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	v1 "cosmossdk.io/x/gov/types/v1"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	ranked   = v1.MultipleChoiceVotingMode_MULTIPLE_CHOICE_VOTING_MODE_RANKED_CHOICE
+	approval = v1.MultipleChoiceVotingMode_MULTIPLE_CHOICE_VOTING_MODE_APPROVAL
+
+	// handy functions
+	delegatorBallot = func(s tallyFixture, voter sdk.AccAddress, delegations []stakingtypes.Delegation, options ...v1.VoteOption) {
+		voteOptions, err := s.keeper.ProposalVoteOptions.Get(s.ctx, s.proposal.Id)
+		require.NoError(s.t, err)
+		err = s.keeper.AddBallot(s.ctx, s.proposal.Id, voter, voteOptions.VotingMode, options, "")
+		require.NoError(s.t, err)
+		s.mocks.stakingKeeper.EXPECT().
+			IterateDelegations(s.ctx, voter, gomock.Any()).
+			DoAndReturn(
+				func(ctx context.Context, voter sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+					for i, d := range delegations {
+						fn(int64(i), d)
+					}
+					return nil
+				})
+	}
+	validatorBallot = func(s tallyFixture, voter sdk.ValAddress, options ...v1.VoteOption) {
+		// validatorBallot is like delegatorBallot but without delegations
+		delegatorBallot(s, sdk.AccAddress(voter), nil, options...)
+	}
+	ballotTallyResult = func(one, two, three, four, spam int64) *v1.TallyResult {
+		tally := v1.NewTallyResult(sdkmath.NewInt(one), sdkmath.NewInt(two), sdkmath.NewInt(three), sdkmath.NewInt(four), sdkmath.NewInt(spam))
+		return &tally
+	}
+)
+
+func TestBallots(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	addrs := simtestutil.CreateRandomAccounts(2)
+
+	proposal, err := govKeeper.SubmitProposal(ctx, nil, "metadata", "title", "description", addrs[0], v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE)
+	require.NoError(t, err)
+	err = govKeeper.ProposalVoteOptions.Set(ctx, proposal.Id, v1.ProposalVoteOptions{
+		OptionOne:   "Vote Option 1",
+		OptionTwo:   "Vote Option 2",
+		OptionThree: "Vote Option 3",
+		VotingMode:  ranked,
+	})
+	require.NoError(t, err)
+
+	require.ErrorContains(t, govKeeper.AddBallot(ctx, proposal.Id, addrs[0], ranked, []v1.VoteOption{v1.OptionOne}, ""), "inactive proposal")
+	require.ErrorContains(t, govKeeper.AddBallot(ctx, 10, addrs[0], ranked, []v1.VoteOption{v1.OptionOne}, ""), "inactive proposal")
+
+	proposal.Status = v1.StatusVotingPeriod
+	err = govKeeper.Proposals.Set(ctx, proposal.Id, proposal)
+	require.NoError(t, err)
+
+	require.ErrorContains(t, govKeeper.AddBallot(ctx, proposal.Id, addrs[0], approval, []v1.VoteOption{v1.OptionOne}, ""), "voting mode")
+	require.ErrorContains(t, govKeeper.AddBallot(ctx, proposal.Id, addrs[0], ranked, nil, ""), "ballot cannot be empty")
+	require.ErrorContains(t, govKeeper.AddBallot(ctx, proposal.Id, addrs[0], ranked, []v1.VoteOption{v1.OptionFour}, ""), "invalid vote option")
+	require.ErrorContains(t, govKeeper.AddBallot(ctx, proposal.Id, addrs[0], ranked, []v1.VoteOption{v1.OptionSpam}, ""), "invalid vote option")
+	require.ErrorContains(t, govKeeper.AddBallot(ctx, proposal.Id, addrs[0], ranked, []v1.VoteOption{v1.OptionOne, v1.OptionTwo, v1.OptionOne}, ""), "duplicate vote option")
+	require.ErrorContains(t, govKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionOne), ""), "only spam votes are allowed")
+
+	// cast a ballot
+	require.NoError(t, govKeeper.AddBallot(ctx, proposal.Id, addrs[0], ranked, []v1.VoteOption{v1.OptionThree, v1.OptionOne}, "metadata"))
+	voter, err := mocks.acctKeeper.AddressCodec().BytesToString(addrs[0])
+	require.NoError(t, err)
+	ballot, err := govKeeper.Ballots.Get(ctx, collections.Join(proposal.Id, addrs[0]))
+	require.NoError(t, err)
+	require.Equal(t, v1.NewBallot(proposal.Id, voter, []v1.VoteOption{v1.OptionThree, v1.OptionOne}, "metadata"), ballot)
+
+	// a spam vote replaces the ballot
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionSpam), ""))
+	has, err := govKeeper.Ballots.Has(ctx, collections.Join(proposal.Id, addrs[0]))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// a ballot replaces the spam vote
+	require.NoError(t, govKeeper.AddBallot(ctx, proposal.Id, addrs[0], ranked, []v1.VoteOption{v1.OptionTwo}, ""))
+	has, err = govKeeper.Votes.Has(ctx, collections.Join(proposal.Id, addrs[0]))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = govKeeper.Ballots.Has(ctx, collections.Join(proposal.Id, addrs[0]))
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
+func TestTally_Ballots(t *testing.T) {
+	tests := []struct {
+		name                string
+		votingMode          v1.MultipleChoiceVotingMode
+		setup               func(tallyFixture)
+		expectedPass        bool
+		expectedBurn        bool
+		expectedTally       v1.TallyResult
+		expectedBallotTally v1.BallotTallyResult
+	}{
+		{
+			name:       "ranked choice: no ballots, no bonded tokens: prop fails",
+			votingMode: ranked,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 0)
+			},
+			expectedPass:  false,
+			expectedBurn:  false,
+			expectedTally: *ballotTallyResult(0, 0, 0, 0, 0),
+			expectedBallotTally: v1.BallotTallyResult{
+				VotingMode: ranked,
+				Rounds:     []*v1.BallotTallyRound{{Tally: ballotTallyResult(0, 0, 0, 0, 0)}},
+			},
+		},
+		{
+			name:       "ranked choice: plurality loser wins the runoff: prop passes",
+			votingMode: ranked,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				for i := 0; i < 4; i++ {
+					validatorBallot(s, s.valAddrs[i], v1.OptionOne, v1.OptionThree)
+				}
+				for i := 4; i < 7; i++ {
+					validatorBallot(s, s.valAddrs[i], v1.OptionTwo, v1.OptionThree)
+				}
+				validatorBallot(s, s.valAddrs[7], v1.OptionThree, v1.OptionTwo)
+				validatorBallot(s, s.valAddrs[8], v1.OptionThree, v1.OptionTwo)
+				validatorBallot(s, s.valAddrs[9], v1.OptionFour, v1.OptionTwo)
+			},
+			expectedPass:  true,
+			expectedBurn:  false,
+			expectedTally: *ballotTallyResult(4000000, 6000000, 0, 0, 0),
+			expectedBallotTally: v1.BallotTallyResult{
+				VotingMode: ranked,
+				Rounds: []*v1.BallotTallyRound{
+					{Tally: ballotTallyResult(4000000, 3000000, 2000000, 1000000, 0), Eliminated: v1.OptionFour},
+					{Tally: ballotTallyResult(4000000, 4000000, 2000000, 0, 0), Eliminated: v1.OptionThree},
+					{Tally: ballotTallyResult(4000000, 6000000, 0, 0, 0)},
+				},
+				Winner: v1.OptionTwo,
+			},
+		},
+		{
+			name:       "ranked choice: majority in first round: prop passes",
+			votingMode: ranked,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				for i := 0; i < 6; i++ {
+					validatorBallot(s, s.valAddrs[i], v1.OptionThree)
+				}
+				for i := 6; i < 10; i++ {
+					validatorBallot(s, s.valAddrs[i], v1.OptionOne, v1.OptionTwo)
+				}
+			},
+			expectedPass:  true,
+			expectedBurn:  false,
+			expectedTally: *ballotTallyResult(4000000, 0, 6000000, 0, 0),
+			expectedBallotTally: v1.BallotTallyResult{
+				VotingMode: ranked,
+				Rounds: []*v1.BallotTallyRound{
+					{Tally: ballotTallyResult(4000000, 0, 6000000, 0, 0)},
+				},
+				Winner: v1.OptionThree,
+			},
+		},
+		{
+			name:       "ranked choice: delegator overrides its validator ballot",
+			votingMode: ranked,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				del0Addr, err := s.mocks.acctKeeper.AddressCodec().BytesToString(s.delAddrs[0])
+				require.NoError(s.t, err)
+				val0Addr, err := s.mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(s.valAddrs[0])
+				require.NoError(s.t, err)
+				delegations := []stakingtypes.Delegation{{
+					DelegatorAddress: del0Addr,
+					ValidatorAddress: val0Addr,
+					Shares:           sdkmath.LegacyNewDec(42),
+				}}
+				delegatorBallot(s, s.delAddrs[0], delegations, v1.OptionTwo)
+				for i := 0; i < 4; i++ {
+					validatorBallot(s, s.valAddrs[i], v1.OptionOne)
+				}
+			},
+			expectedPass:  true,
+			expectedBurn:  false,
+			expectedTally: *ballotTallyResult(3999958, 42, 0, 0, 0),
+			expectedBallotTally: v1.BallotTallyResult{
+				VotingMode: ranked,
+				Rounds: []*v1.BallotTallyRound{
+					{Tally: ballotTallyResult(3999958, 42, 0, 0, 0)},
+				},
+				Winner: v1.OptionOne,
+			},
+		},
+		{
+			name:       "ranked choice: delegator is tallied with the ballot of its vote delegate",
+			votingMode: ranked,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				delegateVote(s, s.delAddrs[0], s.delAddrs[1], "")
+				delegatedVoter(s, s.delAddrs[0], delegationsToVal0(s, s.delAddrs[0], 42))
+				delegatorBallot(s, s.delAddrs[1], nil, v1.OptionTwo)
+				for i := 0; i < 4; i++ {
+					validatorBallot(s, s.valAddrs[i], v1.OptionOne)
+				}
+			},
+			expectedPass:  true,
+			expectedBurn:  false,
+			expectedTally: *ballotTallyResult(3999958, 42, 0, 0, 0),
+			expectedBallotTally: v1.BallotTallyResult{
+				VotingMode: ranked,
+				Rounds: []*v1.BallotTallyRound{
+					{Tally: ballotTallyResult(3999958, 42, 0, 0, 0)},
+				},
+				Winner: v1.OptionOne,
+			},
+		},
+		{
+			name:       "ranked choice: quorum not reached: prop fails/burn deposit",
+			votingMode: ranked,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				validatorBallot(s, s.valAddrs[0], v1.OptionOne)
+			},
+			expectedPass:  false,
+			expectedBurn:  true, // burn because quorum not reached
+			expectedTally: *ballotTallyResult(1000000, 0, 0, 0, 0),
+			expectedBallotTally: v1.BallotTallyResult{
+				VotingMode: ranked,
+				Rounds: []*v1.BallotTallyRound{
+					{Tally: ballotTallyResult(1000000, 0, 0, 0, 0)},
+				},
+				Winner: v1.OptionOne,
+			},
+		},
+		{
+			name:       "approval: most approved option wins, ties broken by option order: prop passes",
+			votingMode: approval,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				for i := 0; i < 4; i++ {
+					validatorBallot(s, s.valAddrs[i], v1.OptionOne)
+				}
+				for i := 4; i < 10; i++ {
+					validatorBallot(s, s.valAddrs[i], v1.OptionThree, v1.OptionTwo)
+				}
+			},
+			expectedPass:  true,
+			expectedBurn:  false,
+			expectedTally: *ballotTallyResult(4000000, 6000000, 6000000, 0, 0),
+			expectedBallotTally: v1.BallotTallyResult{
+				VotingMode: approval,
+				Rounds: []*v1.BallotTallyRound{
+					{Tally: ballotTallyResult(4000000, 6000000, 6000000, 0, 0)},
+				},
+				Winner: v1.OptionTwo,
+			},
+		},
+		{
+			name:       "approval: more spam than ballots: prop fails/burn deposit",
+			votingMode: approval,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				for i := 0; i < 5; i++ {
+					validatorVote(s, s.valAddrs[i], v1.VoteOption_VOTE_OPTION_SPAM)
+				}
+				for i := 5; i < 10; i++ {
+					validatorBallot(s, s.valAddrs[i], v1.OptionOne, v1.OptionTwo)
+				}
+			},
+			expectedPass:  false,
+			expectedBurn:  true,
+			expectedTally: *ballotTallyResult(5000000, 5000000, 0, 0, 5000000),
+			expectedBallotTally: v1.BallotTallyResult{
+				VotingMode: approval,
+				Rounds: []*v1.BallotTallyRound{
+					{Tally: ballotTallyResult(5000000, 5000000, 0, 0, 5000000)},
+				},
+				Winner: v1.OptionOne,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+			params := v1.DefaultParams()
+			// Ensure params value are different than false
+			params.BurnVoteQuorum = true
+			params.BurnVoteVeto = true
+			err := govKeeper.Params.Set(ctx, params)
+			require.NoError(t, err)
+			var (
+				numVals       = 10
+				numDelegators = 5
+				addrs         = simtestutil.CreateRandomAccounts(numVals + numDelegators)
+				valAddrs      = simtestutil.ConvertAddrsToValAddrs(addrs[:numVals])
+				delAddrs      = addrs[numVals:]
+			)
+			// Mocks a bunch of validators
+			mocks.stakingKeeper.EXPECT().
+				IterateBondedValidatorsByPower(ctx, gomock.Any()).
+				DoAndReturn(
+					func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+						for i := int64(0); i < int64(numVals); i++ {
+							valAddr, err := mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddrs[i])
+							require.NoError(t, err)
+							fn(i, stakingtypes.Validator{
+								OperatorAddress: valAddr,
+								Status:          stakingtypes.Bonded,
+								Tokens:          sdkmath.NewInt(1000000),
+								DelegatorShares: sdkmath.LegacyNewDec(1000000),
+							})
+						}
+						return nil
+					})
+
+			// Submit and activate a proposal
+			proposal, err := govKeeper.SubmitProposal(ctx, nil, "", "title", "summary", delAddrs[0], v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE)
+			require.NoError(t, err)
+			err = govKeeper.ProposalVoteOptions.Set(ctx, proposal.Id, v1.ProposalVoteOptions{
+				OptionOne:   "Vote Option 1",
+				OptionTwo:   "Vote Option 2",
+				OptionThree: "Vote Option 3",
+				OptionFour:  "Vote Option 4",
+				VotingMode:  tt.votingMode,
+			})
+			require.NoError(t, err)
+			err = govKeeper.ActivateVotingPeriod(ctx, proposal)
+			require.NoError(t, err)
+			suite := tallyFixture{
+				t:        t,
+				proposal: proposal,
+				valAddrs: valAddrs,
+				delAddrs: delAddrs,
+				ctx:      ctx,
+				keeper:   govKeeper,
+				mocks:    mocks,
+			}
+			tt.setup(suite)
+
+			pass, burn, tally, err := govKeeper.Tally(ctx, proposal)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPass, pass, "wrong pass")
+			assert.Equal(t, tt.expectedBurn, burn, "wrong burn")
+			assert.Equal(t, tt.expectedTally, tally)
+			ballotTally, err := govKeeper.BallotTallyResults.Get(ctx, proposal.Id)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedBallotTally, ballotTally)
+			// Assert ballots removal after tally
+			rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
+			iter, err := suite.keeper.Ballots.Iterate(suite.ctx, rng)
+			require.NoError(t, err)
+			assert.False(t, iter.Valid(), "ballots not removed")
+			require.NoError(t, iter.Close())
+		})
+	}
+}
@@ -181,6 +181,19 @@ func setupGovKeeperWithMaxVoteOptionsLen(t *testing.T, maxVoteOptionsLen uint64,
 	mocks,
 	moduletestutil.TestEncodingConfig,
 	sdk.Context,
+) {
+	t.Helper()
+	config := keeper.DefaultConfig()
+	config.MaxVoteOptionsLen = maxVoteOptionsLen
+	return setupGovKeeperWithConfig(t, config, expectations...)
+}
+
+// setupGovKeeperWithConfig creates a govKeeper with the given config, as well as all its dependencies.
+func setupGovKeeperWithConfig(t *testing.T, config keeper.Config, expectations ...func(sdk.Context, mocks)) (
+	*keeper.Keeper,
+	mocks,
+	moduletestutil.TestEncodingConfig,
+	sdk.Context,
 ) {
 	t.Helper()
 	key := storetypes.NewKVStoreKey(types.StoreKey)
@@ -223,9 +236,6 @@ func setupGovKeeperWithMaxVoteOptionsLen(t *testing.T, maxVoteOptionsLen uint64,
 	govAddr, err := m.acctKeeper.AddressCodec().BytesToString(govAcct)
 	require.NoError(t, err)
 
-	config := keeper.DefaultConfig()
-	config.MaxVoteOptionsLen = maxVoteOptionsLen
-
 	// Gov keeper initializations
 	govKeeper := keeper.NewKeeper(encCfg.Codec, environment, m.acctKeeper, m.bankKeeper, m.stakingKeeper, m.poolKeeper, config, govAddr)
 	require.NoError(t, govKeeper.ProposalID.Set(ctx, 1))
@@ -7,15 +7,21 @@ import (
 	v1 "cosmossdk.io/x/gov/types/v1"
 )
 
+// VoterPowerFn converts the stake of a voter into its voting power.
+type VoterPowerFn func(stake math.LegacyDec) (math.LegacyDec, error)
+
 // CalculateVoteResultsAndVotingPowerFn is a function signature for calculating vote results and voting power
 // It can be overridden to customize the voting power calculation for proposals
-// It gets the proposal tallied and the validators governance infos (bonded tokens, voting power, etc.)
+// It gets the proposal tallied, the validators governance infos (bonded tokens, voting power, etc.)
+// and the function converting the stake of each voter into its voting power, which applies the tally
+// strategy of the proposal
 // It must return the total voting power and the results of the vote
 type CalculateVoteResultsAndVotingPowerFn func(
 	ctx context.Context,
 	keeper Keeper,
 	proposalID uint64,
 	validators map[string]v1.ValidatorGovInfo,
+	voterPower VoterPowerFn,
 ) (totalVoterPower math.LegacyDec, results map[v1.VoteOption]math.LegacyDec, err error)
 
 // Config is a config struct used for initializing the gov module to avoid using globals.
@@ -33,6 +39,12 @@ type Config struct {
 	// CalculateVoteResultsAndVotingPowerFn is a function signature for calculating vote results and voting power
 	// Keeping it nil will use the default implementation
 	CalculateVoteResultsAndVotingPowerFn CalculateVoteResultsAndVotingPowerFn
+	// TallyStrategies are the tally strategies proposals can select at submission, keyed by name.
+	// Keeping it nil will register the default tally strategies.
+	TallyStrategies map[string]TallyStrategy
+	// MaxVoteDelegationDepth defines the maximum number of vote delegations followed to find the vote
+	// of an account which did not vote. 0 disables vote delegation.
+	MaxVoteDelegationDepth uint64
 }
 
 // DefaultConfig returns the default config for gov.
@@ -43,5 +55,10 @@ func DefaultConfig() Config {
 		MaxSummaryLen:                        10200,
 		MaxVoteOptionsLen:                    0, // 0 means this param is disabled, hence all supported options are allowed
 		CalculateVoteResultsAndVotingPowerFn: nil,
+		MaxVoteDelegationDepth:               3,
+		TallyStrategies: map[string]TallyStrategy{
+			TallyStrategySupermajority: SupermajorityTallyStrategy{},
+			TallyStrategyQuadratic:     QuadraticTallyStrategy{},
+		},
 	}
 }
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	stderrors "errors"
+	"sort"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -103,6 +104,7 @@ func (q queryServer) ProposalVoteOptions(ctx context.Context, req *v1.QueryPropo
 			OptionThree: voteOptions.OptionThree,
 			OptionFour:  voteOptions.OptionFour,
 			OptionSpam:  defaultVoteOptions.OptionSpam,
+			VotingMode:  voteOptions.VotingMode,
 		},
 	}, nil
 }
@@ -509,3 +511,181 @@ func (q legacyQueryServer) TallyResult(ctx context.Context, req *v1beta1.QueryTa
 
 	return &v1beta1.QueryTallyResultResponse{Tally: tally}, nil
 }
+
+// TallyStrategies queries the registered tally strategies and their params
+func (q queryServer) TallyStrategies(ctx context.Context, req *v1.QueryTallyStrategiesRequest) (*v1.QueryTallyStrategiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	names := make([]string, 0, len(q.k.config.TallyStrategies))
+	for name := range q.k.config.TallyStrategies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	strategies := make([]*v1.TallyStrategyInfo, 0, len(names))
+	for _, name := range names {
+		params, err := q.k.TallyStrategyParams.Get(ctx, name)
+		if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		strategies = append(strategies, &v1.TallyStrategyInfo{Name: name, Params: params})
+	}
+
+	return &v1.QueryTallyStrategiesResponse{Strategies: strategies}, nil
+}
+
+// Ballot returns the ballot of a voter on a ranked-choice or approval proposal
+func (q queryServer) Ballot(ctx context.Context, req *v1.QueryBallotRequest) (*v1.QueryBallotResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	if req.Voter == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty voter address")
+	}
+
+	voter, err := q.k.authKeeper.AddressCodec().StringToBytes(req.Voter)
+	if err != nil {
+		return nil, err
+	}
+	ballot, err := q.k.Ballots.Get(ctx, collections.Join(req.ProposalId, sdk.AccAddress(voter)))
+	if err == nil {
+		return &v1.QueryBallotResponse{Ballot: &ballot}, nil
+	}
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound,
+			"ballot of voter: %v not found for proposal: %v", req.Voter, req.ProposalId)
+	}
+	return nil, status.Error(codes.Internal, err.Error())
+}
+
+// Ballots returns the ballots of a ranked-choice or approval proposal
+func (q queryServer) Ballots(ctx context.Context, req *v1.QueryBallotsRequest) (*v1.QueryBallotsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ballots, pageRes, err := query.CollectionPaginate(ctx, q.k.Ballots, req.Pagination, func(_ collections.Pair[uint64, sdk.AccAddress], value v1.Ballot) (ballot *v1.Ballot, err error) {
+		return &value, nil
+	}, query.WithCollectionPaginationPairPrefix[uint64, sdk.AccAddress](req.ProposalId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryBallotsResponse{Ballots: ballots, Pagination: pageRes}, nil
+}
+
+// BallotTally returns the round-by-round tally of the ballots of a ranked-choice or approval proposal
+func (q queryServer) BallotTally(ctx context.Context, req *v1.QueryBallotTallyRequest) (*v1.QueryBallotTallyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	proposal, err := q.k.Proposals.Get(ctx, req.ProposalId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	voteOptions, err := q.k.ProposalVoteOptions.Get(ctx, req.ProposalId)
+	if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if voteOptions.VotingMode == v1.MultipleChoiceVotingMode_MULTIPLE_CHOICE_VOTING_MODE_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "proposal %d is not a ranked-choice or approval proposal", req.ProposalId)
+	}
+
+	var ballotTally v1.BallotTallyResult
+
+	switch {
+	case proposal.Status == v1.StatusDepositPeriod:
+		ballotTally = v1.BallotTallyResult{VotingMode: voteOptions.VotingMode}
+
+	case proposal.Status == v1.StatusPassed || proposal.Status == v1.StatusRejected || proposal.Status == v1.StatusFailed:
+		ballotTally, err = q.k.BallotTallyResults.Get(ctx, req.ProposalId)
+		if err != nil {
+			if errors.IsOf(err, collections.ErrNotFound) {
+				return nil, status.Errorf(codes.NotFound, "ballot tally of proposal %d doesn't exist", req.ProposalId)
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+	default:
+		// proposal is in voting period
+		_, _, _, ballotTally, err = q.k.tallyBallots(ctx, proposal, voteOptions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &v1.QueryBallotTallyResponse{Tally: &ballotTally}, nil
+}
+
+// VoteDelegation returns the vote delegation of an account for a topic
+func (q queryServer) VoteDelegation(ctx context.Context, req *v1.QueryVoteDelegationRequest) (*v1.QueryVoteDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Delegator == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	delegator, err := q.k.authKeeper.AddressCodec().StringToBytes(req.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	delegation, err := q.k.VoteDelegations.Get(ctx, collections.Join(sdk.AccAddress(delegator), req.Topic))
+	if err == nil {
+		return &v1.QueryVoteDelegationResponse{VoteDelegation: &delegation}, nil
+	}
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound,
+			"vote delegation of delegator: %v not found for topic: %q", req.Delegator, req.Topic)
+	}
+	return nil, status.Error(codes.Internal, err.Error())
+}
+
+// VoteDelegations returns all the vote delegations of an account
+func (q queryServer) VoteDelegations(ctx context.Context, req *v1.QueryVoteDelegationsRequest) (*v1.QueryVoteDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Delegator == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	delegator, err := q.k.authKeeper.AddressCodec().StringToBytes(req.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	delegations, pageRes, err := query.CollectionPaginate(ctx, q.k.VoteDelegations, req.Pagination, func(_ collections.Pair[sdk.AccAddress, string], value v1.VoteDelegation) (delegation *v1.VoteDelegation, err error) {
+		return &value, nil
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, string](delegator))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryVoteDelegationsResponse{VoteDelegations: delegations, Pagination: pageRes}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"cosmossdk.io/math"
+	"cosmossdk.io/x/gov/keeper"
 	v3 "cosmossdk.io/x/gov/migrations/v3"
 	v1 "cosmossdk.io/x/gov/types/v1"
 	"cosmossdk.io/x/gov/types/v1beta1"
@@ -100,6 +101,134 @@ func (suite *KeeperTestSuite) TestGRPCQueryConstitution() {
 	suite.Require().Equal(expRes, constitution)
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryTallyStrategies() {
+	suite.reset()
+	queryClient := suite.queryClient
+
+	err := suite.govKeeper.TallyStrategyParams.Set(suite.ctx, keeper.TallyStrategyQuadratic, `{"threshold":"0.6"}`)
+	suite.Require().NoError(err)
+
+	expRes := &v1.QueryTallyStrategiesResponse{
+		Strategies: []*v1.TallyStrategyInfo{
+			{Name: keeper.TallyStrategyQuadratic, Params: `{"threshold":"0.6"}`},
+			{Name: keeper.TallyStrategySupermajority},
+		},
+	}
+
+	strategies, err := queryClient.TallyStrategies(gocontext.Background(), &v1.QueryTallyStrategiesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expRes, strategies)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryBallots() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	addr0Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[0])
+	suite.Require().NoError(err)
+	addr1Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[1])
+	suite.Require().NoError(err)
+
+	propTime := time.Now()
+	proposal := v1.Proposal{
+		Id:              1,
+		Status:          v1.StatusVotingPeriod,
+		SubmitTime:      &propTime,
+		VotingStartTime: &propTime,
+		VotingEndTime:   &propTime,
+		Metadata:        "proposal metadata",
+		ProposalType:    v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE,
+	}
+	suite.Require().NoError(suite.govKeeper.Proposals.Set(ctx, proposal.Id, proposal))
+	suite.Require().NoError(suite.govKeeper.ProposalVoteOptions.Set(ctx, proposal.Id, v1.ProposalVoteOptions{
+		OptionOne:   "Vote for @tac0turle",
+		OptionTwo:   "Vote for @facudomedica",
+		OptionThree: "Vote for @alexanderbez",
+		VotingMode:  v1.MultipleChoiceVotingMode_MULTIPLE_CHOICE_VOTING_MODE_APPROVAL,
+	}))
+
+	_, err = queryClient.Ballot(gocontext.Background(), &v1.QueryBallotRequest{ProposalId: proposal.Id, Voter: addr0Str})
+	suite.Require().ErrorContains(err, "not found")
+
+	ballots := []*v1.Ballot{
+		{ProposalId: proposal.Id, Voter: addr0Str, Options: []v1.VoteOption{v1.OptionOne}},
+		{ProposalId: proposal.Id, Voter: addr1Str, Options: []v1.VoteOption{v1.OptionTwo, v1.OptionThree}},
+	}
+	suite.Require().NoError(suite.govKeeper.AddBallot(ctx, proposal.Id, suite.addrs[0], v1.MultipleChoiceVotingMode_MULTIPLE_CHOICE_VOTING_MODE_APPROVAL, ballots[0].Options, ""))
+	suite.Require().NoError(suite.govKeeper.AddBallot(ctx, proposal.Id, suite.addrs[1], v1.MultipleChoiceVotingMode_MULTIPLE_CHOICE_VOTING_MODE_APPROVAL, ballots[1].Options, ""))
+
+	ballotRes, err := queryClient.Ballot(gocontext.Background(), &v1.QueryBallotRequest{ProposalId: proposal.Id, Voter: addr1Str})
+	suite.Require().NoError(err)
+	suite.Require().Equal(ballots[1], ballotRes.Ballot)
+
+	ballotsRes, err := queryClient.Ballots(gocontext.Background(), &v1.QueryBallotsRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(ballots, ballotsRes.Ballots)
+
+	// ballot tally is only available for ranked-choice and approval proposals
+	_, err = queryClient.BallotTally(gocontext.Background(), &v1.QueryBallotTallyRequest{ProposalId: 2})
+	suite.Require().ErrorContains(err, "proposal 2 doesn't exist")
+
+	// voters without bonded tokens do not carry any voting power
+	suite.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+	tallyRes, err := queryClient.BallotTally(gocontext.Background(), &v1.QueryBallotTallyRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+	emptyTally := v1.EmptyTallyResult()
+	suite.Require().Equal(&v1.BallotTallyResult{
+		VotingMode: v1.MultipleChoiceVotingMode_MULTIPLE_CHOICE_VOTING_MODE_APPROVAL,
+		Rounds:     []*v1.BallotTallyRound{{Tally: &emptyTally}},
+	}, tallyRes.Tally)
+
+	// finished proposals return the stored ballot tally
+	proposal.Status = v1.StatusPassed
+	suite.Require().NoError(suite.govKeeper.Proposals.Set(ctx, proposal.Id, proposal))
+	_, err = queryClient.BallotTally(gocontext.Background(), &v1.QueryBallotTallyRequest{ProposalId: proposal.Id})
+	suite.Require().ErrorContains(err, "ballot tally of proposal 1 doesn't exist")
+
+	stored := v1.BallotTallyResult{
+		VotingMode: v1.MultipleChoiceVotingMode_MULTIPLE_CHOICE_VOTING_MODE_APPROVAL,
+		Rounds:     []*v1.BallotTallyRound{{Tally: &emptyTally}},
+		Winner:     v1.OptionTwo,
+	}
+	suite.Require().NoError(suite.govKeeper.BallotTallyResults.Set(ctx, proposal.Id, stored))
+	tallyRes, err = queryClient.BallotTally(gocontext.Background(), &v1.QueryBallotTallyRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&stored, tallyRes.Tally)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryVoteDelegations() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	addr0Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[0])
+	suite.Require().NoError(err)
+	addr1Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[1])
+	suite.Require().NoError(err)
+	addr2Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[2])
+	suite.Require().NoError(err)
+	topic := sdk.MsgTypeURL(&v1.MsgUpdateParams{})
+
+	_, err = queryClient.VoteDelegation(gocontext.Background(), &v1.QueryVoteDelegationRequest{})
+	suite.Require().ErrorContains(err, "empty delegator address")
+	_, err = queryClient.VoteDelegation(gocontext.Background(), &v1.QueryVoteDelegationRequest{Delegator: addr0Str})
+	suite.Require().ErrorContains(err, "not found")
+
+	suite.Require().NoError(suite.govKeeper.SetVoteDelegation(ctx, suite.addrs[0], suite.addrs[1], ""))
+	suite.Require().NoError(suite.govKeeper.SetVoteDelegation(ctx, suite.addrs[0], suite.addrs[2], topic))
+	suite.Require().NoError(suite.govKeeper.SetVoteDelegation(ctx, suite.addrs[1], suite.addrs[2], ""))
+
+	res, err := queryClient.VoteDelegation(gocontext.Background(), &v1.QueryVoteDelegationRequest{Delegator: addr0Str, Topic: topic})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&v1.VoteDelegation{Delegator: addr0Str, Delegate: addr2Str, Topic: topic}, res.VoteDelegation)
+
+	delegationsRes, err := queryClient.VoteDelegations(gocontext.Background(), &v1.QueryVoteDelegationsRequest{Delegator: addr0Str})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1.VoteDelegation{
+		{Delegator: addr0Str, Delegate: addr1Str},
+		{Delegator: addr0Str, Delegate: addr2Str, Topic: topic},
+	}, delegationsRes.VoteDelegations)
+}
+
 func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposal() {
 	suite.reset()
 	ctx, queryClient, addrs := suite.ctx, suite.legacyQueryClient, suite.addrs
@@ -50,10 +50,23 @@ type Keeper struct {
 	// MessageBasedParams store message-based governance parameters
 	// key:proposal-msg-url | value MessageBasedParams
 	MessageBasedParams collections.Map[string, v1.MessageBasedParams]
+	// TallyStrategyParams stores the JSON encoded params of the tally strategies
+	// key:strategy-name | value: params
+	TallyStrategyParams collections.Map[string, string]
 	// Deposits key: proposalID+depositorAddr | value: Deposit
 	Deposits collections.Map[collections.Pair[uint64, sdk.AccAddress], v1.Deposit]
 	// Votes key: proposalID+voterAddr | value: Vote
 	Votes collections.Map[collections.Pair[uint64, sdk.AccAddress], v1.Vote]
+	// Ballots key: proposalID+voterAddr | value: Ballot
+	// This is used to store the ballots of ranked-choice and approval multiple choice proposals
+	Ballots collections.Map[collections.Pair[uint64, sdk.AccAddress], v1.Ballot]
+	// VoteDelegations key: delegatorAddr+topic | value: VoteDelegation
+	// indexed by delegateAddr+topic
+	// An empty topic is the global vote delegation of the delegator
+	VoteDelegations *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], v1.VoteDelegation, VoteDelegationsIndexes]
+	// BallotTallyResults key: proposalID | value: BallotTallyResult
+	// This is used to store the final ballot tally of ranked-choice and approval multiple choice proposals
+	BallotTallyResults collections.Map[uint64, v1.BallotTallyResult]
 	// ProposalID is a counter for proposals. It tracks the next proposal ID to be issued.
 	ProposalID collections.Sequence
 	// Proposals key:proposalID | value: Proposal
@@ -110,6 +123,10 @@ func NewKeeper(
 	if config.MaxVoteOptionsLen == 0 {
 		config.MaxVoteOptionsLen = defaultConfig.MaxVoteOptionsLen
 	}
+	// If TallyStrategies not set by app developer, register the default tally strategies.
+	if config.TallyStrategies == nil {
+		config.TallyStrategies = defaultConfig.TallyStrategies
+	}
 
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	k := &Keeper{
@@ -124,8 +141,12 @@ func NewKeeper(
 		Constitution:           collections.NewItem(sb, types.ConstitutionKey, "constitution", collections.StringValue),
 		Params:                 collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[v1.Params](cdc)),
 		MessageBasedParams:     collections.NewMap(sb, types.MessageBasedParamsKey, "proposal_messaged_based_params", collections.StringKey, codec.CollValue[v1.MessageBasedParams](cdc)),
+		TallyStrategyParams:    collections.NewMap(sb, types.TallyStrategyParamsKey, "tally_strategy_params", collections.StringKey, collections.StringValue),
 		Deposits:               collections.NewMap(sb, types.DepositsKeyPrefix, "deposits", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[v1.Deposit](cdc)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 		Votes:                  collections.NewMap(sb, types.VotesKeyPrefix, "votes", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[v1.Vote](cdc)),          //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
+		Ballots:                collections.NewMap(sb, types.BallotsKeyPrefix, "ballots", collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey), codec.CollValue[v1.Ballot](cdc)),
+		VoteDelegations:        collections.NewIndexedMap(sb, types.VoteDelegationsKeyPrefix, "vote_delegations", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), codec.CollValue[v1.VoteDelegation](cdc), NewVoteDelegationsIndexes(sb, authKeeper.AddressCodec())),
+		BallotTallyResults:     collections.NewMap(sb, types.BallotTallyResultsKeyPrefix, "ballot_tally_results", collections.Uint64Key, codec.CollValue[v1.BallotTallyResult](cdc)),
 		ProposalID:             collections.NewSequence(sb, types.ProposalIDKey, "proposal_id"),
 		Proposals:              collections.NewMap(sb, types.ProposalsKeyPrefix, "proposals", collections.Uint64Key, codec.CollValue[v1.Proposal](cdc)),
 		ProposalVoteOptions:    collections.NewMap(sb, types.ProposalVoteOptionsKeyPrefix, "proposal_vote_options", collections.Uint64Key, codec.CollValue[v1.ProposalVoteOptions](cdc)),
@@ -87,11 +87,29 @@ func (k msgServer) SubmitProposal(ctx context.Context, msg *v1.MsgSubmitProposal
 		return nil, err
 	}
 
+	if msg.TallyStrategy != "" {
+		if _, ok := k.config.TallyStrategies[msg.TallyStrategy]; !ok {
+			return nil, errors.Wrapf(govtypes.ErrUnknownTallyStrategy, "%s", msg.TallyStrategy)
+		}
+
+		// tally strategies are applied within the thresholds of standard and expedited proposals
+		if msg.ProposalType == v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE || msg.ProposalType == v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC {
+			return nil, errors.Wrap(govtypes.ErrInvalidProposalType, "only standard and expedited proposals can select a tally strategy")
+		}
+	}
+
 	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.ProposalType)
 	if err != nil {
 		return nil, err
 	}
 
+	if msg.TallyStrategy != "" {
+		proposal.TallyStrategy = msg.TallyStrategy
+		if err := k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
+			return nil, err
+		}
+	}
+
 	bytes, err := proposal.Marshal()
 	if err != nil {
 		return nil, err
@@ -154,6 +172,10 @@ func (k msgServer) SubmitMultipleChoiceProposal(ctx context.Context, msg *v1.Msg
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("vote options cannot be empty, two or more options must be provided")
 	}
 
+	if _, ok := v1.MultipleChoiceVotingMode_name[int32(msg.VoteOptions.VotingMode)]; !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid voting mode: %s", msg.VoteOptions.VotingMode)
+	}
+
 	if err := k.ProposalVoteOptions.Set(ctx, resp.ProposalId, *msg.VoteOptions); err != nil {
 		return nil, err
 	}
@@ -357,6 +379,80 @@ func (k msgServer) UpdateMessageParams(ctx context.Context, msg *v1.MsgUpdateMes
 	return &v1.MsgUpdateMessageParamsResponse{}, nil
 }
 
+// UpdateTallyStrategyParams implements the v1.MsgServer method
+func (k msgServer) UpdateTallyStrategyParams(ctx context.Context, msg *v1.MsgUpdateTallyStrategyParams) (*v1.MsgUpdateTallyStrategyParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := k.SetTallyStrategyParams(ctx, msg.Strategy, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUpdateTallyStrategyParamsResponse{}, nil
+}
+
+// VoteRankedChoice implements the MsgServer.VoteRankedChoice method.
+func (k msgServer) VoteRankedChoice(ctx context.Context, msg *v1.MsgVoteRankedChoice) (*v1.MsgVoteRankedChoiceResponse, error) {
+	accAddr, err := k.authKeeper.AddressCodec().StringToBytes(msg.Voter)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+
+	if err = k.Keeper.AddBallot(ctx, msg.ProposalId, accAddr, v1.MultipleChoiceVotingMode_MULTIPLE_CHOICE_VOTING_MODE_RANKED_CHOICE, msg.Ranking, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgVoteRankedChoiceResponse{}, nil
+}
+
+// VoteApproval implements the MsgServer.VoteApproval method.
+func (k msgServer) VoteApproval(ctx context.Context, msg *v1.MsgVoteApproval) (*v1.MsgVoteApprovalResponse, error) {
+	accAddr, err := k.authKeeper.AddressCodec().StringToBytes(msg.Voter)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+
+	if err = k.Keeper.AddBallot(ctx, msg.ProposalId, accAddr, v1.MultipleChoiceVotingMode_MULTIPLE_CHOICE_VOTING_MODE_APPROVAL, msg.Approvals, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgVoteApprovalResponse{}, nil
+}
+
+// DelegateVote implements the MsgServer.DelegateVote method.
+func (k msgServer) DelegateVote(ctx context.Context, msg *v1.MsgDelegateVote) (*v1.MsgDelegateVoteResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(msg.Delegator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	delegate, err := k.authKeeper.AddressCodec().StringToBytes(msg.Delegate)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegate address: %s", err)
+	}
+
+	if err := k.Keeper.SetVoteDelegation(ctx, delegator, delegate, msg.Topic); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgDelegateVoteResponse{}, nil
+}
+
+// UndelegateVote implements the MsgServer.UndelegateVote method.
+func (k msgServer) UndelegateVote(ctx context.Context, msg *v1.MsgUndelegateVote) (*v1.MsgUndelegateVoteResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(msg.Delegator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if err := k.Keeper.RemoveVoteDelegation(ctx, delegator, msg.Topic); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUndelegateVoteResponse{}, nil
+}
+
 // SudoExec implements the v1.MsgServer method
 func (k msgServer) SudoExec(ctx context.Context, msg *v1.MsgSudoExec) (*v1.MsgSudoExecResponse, error) {
 	if msg == nil || msg.Msg == nil {
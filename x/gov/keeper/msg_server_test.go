@@ -4,8 +4,10 @@ import (
 	"strings"
 	"time"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/gov/keeper"
 	v1 "cosmossdk.io/x/gov/types/v1"
 	"cosmossdk.io/x/gov/types/v1beta1"
 
@@ -348,6 +350,24 @@ func (suite *KeeperTestSuite) TestSubmitMultipleChoiceProposal() {
 			expErr:    true,
 			expErrMsg: "if a vote option is provided, the previous one must also be provided",
 		},
+		"invalid voting mode": {
+			preRun: func() (*v1.MsgSubmitMultipleChoiceProposal, error) {
+				return v1.NewMultipleChoiceMsgSubmitProposal(
+					initialDeposit,
+					proposerAddr,
+					"mandatory metadata",
+					"Proposal",
+					"description of proposal",
+					&v1.ProposalVoteOptions{
+						OptionOne:  "Vote for me",
+						OptionTwo:  "Vote for them",
+						VotingMode: v1.MultipleChoiceVotingMode(42),
+					},
+				)
+			},
+			expErr:    true,
+			expErrMsg: "invalid voting mode",
+		},
 		"valid proposal": {
 			preRun: func() (*v1.MsgSubmitMultipleChoiceProposal, error) {
 				return v1.NewMultipleChoiceMsgSubmitProposal(
@@ -363,6 +383,23 @@ func (suite *KeeperTestSuite) TestSubmitMultipleChoiceProposal() {
 				)
 			},
 		},
+		"valid ranked-choice proposal": {
+			preRun: func() (*v1.MsgSubmitMultipleChoiceProposal, error) {
+				return v1.NewMultipleChoiceMsgSubmitProposal(
+					initialDeposit,
+					proposerAddr,
+					"mandatory metadata",
+					"Proposal",
+					"description of proposal",
+					&v1.ProposalVoteOptions{
+						OptionOne:   "Vote for me",
+						OptionTwo:   "Vote for them",
+						OptionThree: "Vote for us",
+						VotingMode:  v1.MultipleChoiceVotingMode_MULTIPLE_CHOICE_VOTING_MODE_RANKED_CHOICE,
+					},
+				)
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -381,6 +418,158 @@ func (suite *KeeperTestSuite) TestSubmitMultipleChoiceProposal() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgVoteBallots() {
+	suite.reset()
+	proposer := suite.addrs[0]
+	proposerAddr, err := suite.acctKeeper.AddressCodec().BytesToString(proposer)
+	suite.Require().NoError(err)
+	params, _ := suite.govKeeper.Params.Get(suite.ctx)
+
+	submit := func(mode v1.MultipleChoiceVotingMode) uint64 {
+		msg, err := v1.NewMultipleChoiceMsgSubmitProposal(
+			params.MinDeposit,
+			proposerAddr,
+			"mandatory metadata",
+			"Proposal",
+			"description of proposal",
+			&v1.ProposalVoteOptions{
+				OptionOne:   "Vote for me",
+				OptionTwo:   "Vote for them",
+				OptionThree: "Vote for us",
+				VotingMode:  mode,
+			},
+		)
+		suite.Require().NoError(err)
+		res, err := suite.msgSrvr.SubmitMultipleChoiceProposal(suite.ctx, msg)
+		suite.Require().NoError(err)
+		return res.ProposalId
+	}
+	rankedID := submit(v1.MultipleChoiceVotingMode_MULTIPLE_CHOICE_VOTING_MODE_RANKED_CHOICE)
+	approvalID := submit(v1.MultipleChoiceVotingMode_MULTIPLE_CHOICE_VOTING_MODE_APPROVAL)
+
+	cases := map[string]struct {
+		msg       sdk.Msg
+		expErrMsg string
+	}{
+		"ranked choice: invalid voter": {
+			msg:       v1.NewMsgVoteRankedChoice("invalid", rankedID, []v1.VoteOption{v1.OptionOne}, ""),
+			expErrMsg: "invalid voter address",
+		},
+		"ranked choice: wrong voting mode": {
+			msg:       v1.NewMsgVoteRankedChoice(proposerAddr, approvalID, []v1.VoteOption{v1.OptionOne}, ""),
+			expErrMsg: "voting mode is MULTIPLE_CHOICE_VOTING_MODE_APPROVAL",
+		},
+		"ranked choice: undefined option": {
+			msg:       v1.NewMsgVoteRankedChoice(proposerAddr, rankedID, []v1.VoteOption{v1.OptionOne, v1.OptionFour}, ""),
+			expErrMsg: "invalid vote option",
+		},
+		"ranked choice: valid": {
+			msg: v1.NewMsgVoteRankedChoice(proposerAddr, rankedID, []v1.VoteOption{v1.OptionThree, v1.OptionOne}, ""),
+		},
+		"approval: wrong voting mode": {
+			msg:       v1.NewMsgVoteApproval(proposerAddr, rankedID, []v1.VoteOption{v1.OptionOne}, ""),
+			expErrMsg: "voting mode is MULTIPLE_CHOICE_VOTING_MODE_RANKED_CHOICE",
+		},
+		"approval: duplicate option": {
+			msg:       v1.NewMsgVoteApproval(proposerAddr, approvalID, []v1.VoteOption{v1.OptionTwo, v1.OptionTwo}, ""),
+			expErrMsg: "duplicate vote option",
+		},
+		"approval: valid": {
+			msg: v1.NewMsgVoteApproval(proposerAddr, approvalID, []v1.VoteOption{v1.OptionOne, v1.OptionTwo}, ""),
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			var err error
+			switch msg := tc.msg.(type) {
+			case *v1.MsgVoteRankedChoice:
+				_, err = suite.msgSrvr.VoteRankedChoice(suite.ctx, msg)
+			case *v1.MsgVoteApproval:
+				_, err = suite.msgSrvr.VoteApproval(suite.ctx, msg)
+			}
+			if tc.expErrMsg != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgDelegateVote() {
+	suite.reset()
+	delegator, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[0])
+	suite.Require().NoError(err)
+	delegate, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[1])
+	suite.Require().NoError(err)
+	topic := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	cases := map[string]struct {
+		msg       sdk.Msg
+		expErrMsg string
+	}{
+		"delegate: invalid delegator": {
+			msg:       v1.NewMsgDelegateVote("invalid", delegate, ""),
+			expErrMsg: "invalid delegator address",
+		},
+		"delegate: invalid delegate": {
+			msg:       v1.NewMsgDelegateVote(delegator, "invalid", ""),
+			expErrMsg: "invalid delegate address",
+		},
+		"delegate: self": {
+			msg:       v1.NewMsgDelegateVote(delegator, delegator, ""),
+			expErrMsg: "cannot delegate voting power to self",
+		},
+		"delegate: valid global delegation": {
+			msg: v1.NewMsgDelegateVote(delegator, delegate, ""),
+		},
+		"delegate: valid topic delegation": {
+			msg: v1.NewMsgDelegateVote(delegator, delegate, topic),
+		},
+		"delegate: cycle": {
+			msg:       v1.NewMsgDelegateVote(delegate, delegator, topic),
+			expErrMsg: "vote delegation cycle",
+		},
+		"undelegate: invalid delegator": {
+			msg:       v1.NewMsgUndelegateVote("invalid", ""),
+			expErrMsg: "invalid delegator address",
+		},
+		"undelegate: no delegation": {
+			msg:       v1.NewMsgUndelegateVote(delegate, ""),
+			expErrMsg: "no vote delegation",
+		},
+		"undelegate: valid": {
+			msg: v1.NewMsgUndelegateVote(delegator, topic),
+		},
+	}
+
+	// run the cases in order, as they build on each other
+	for _, name := range []string{
+		"delegate: invalid delegator", "delegate: invalid delegate", "delegate: self",
+		"delegate: valid global delegation", "delegate: valid topic delegation", "delegate: cycle",
+		"undelegate: invalid delegator", "undelegate: no delegation", "undelegate: valid",
+	} {
+		tc := cases[name]
+		suite.Run(name, func() {
+			var err error
+			switch msg := tc.msg.(type) {
+			case *v1.MsgDelegateVote:
+				_, err = suite.msgSrvr.DelegateVote(suite.ctx, msg)
+			case *v1.MsgUndelegateVote:
+				_, err = suite.msgSrvr.UndelegateVote(suite.ctx, msg)
+			}
+			if tc.expErrMsg != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestMsgCancelProposal() {
 	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
 	addrs := suite.addrs
@@ -2167,6 +2356,135 @@ func (suite *KeeperTestSuite) TestMsgUpdateMessageParams() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgUpdateTallyStrategyParams() {
+	testCases := []struct {
+		name      string
+		input     *v1.MsgUpdateTallyStrategyParams
+		expParams string
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &v1.MsgUpdateTallyStrategyParams{
+				Authority: "invalid",
+				Strategy:  keeper.TallyStrategySupermajority,
+			},
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "unknown strategy",
+			input: &v1.MsgUpdateTallyStrategyParams{
+				Authority: suite.govKeeper.GetAuthority(),
+				Strategy:  "unknown",
+			},
+			expErrMsg: "unknown tally strategy",
+		},
+		{
+			name: "invalid params",
+			input: &v1.MsgUpdateTallyStrategyParams{
+				Authority: suite.govKeeper.GetAuthority(),
+				Strategy:  keeper.TallyStrategySupermajority,
+				Params:    `{"threshold":"1.5"}`,
+			},
+			expErrMsg: "threshold must be between 0 and 1",
+		},
+		{
+			name: "valid",
+			input: &v1.MsgUpdateTallyStrategyParams{
+				Authority: suite.govKeeper.GetAuthority(),
+				Strategy:  keeper.TallyStrategySupermajority,
+				Params:    `{"threshold":"0.75"}`,
+			},
+			expParams: `{"threshold":"0.75"}`,
+		},
+		{
+			name: "empty params (valid = deleting params)",
+			input: &v1.MsgUpdateTallyStrategyParams{
+				Authority: suite.govKeeper.GetAuthority(),
+				Strategy:  keeper.TallyStrategySupermajority,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.UpdateTallyStrategyParams(suite.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			params, err := suite.govKeeper.TallyStrategyParams.Get(suite.ctx, tc.input.Strategy)
+			if tc.expParams == "" {
+				suite.Require().ErrorIs(err, collections.ErrNotFound)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expParams, params)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSubmitProposal_TallyStrategy() {
+	suite.reset()
+	proposerAddr, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[0])
+	suite.Require().NoError(err)
+	initialDeposit := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100000)))
+
+	cases := map[string]struct {
+		strategy     string
+		proposalType v1.ProposalType
+		expErrMsg    string
+	}{
+		"unknown strategy": {
+			strategy:     "unknown",
+			proposalType: v1.ProposalType_PROPOSAL_TYPE_STANDARD,
+			expErrMsg:    "unknown tally strategy",
+		},
+		"multiple choice proposal": {
+			strategy:     keeper.TallyStrategyQuadratic,
+			proposalType: v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE,
+			expErrMsg:    "only standard and expedited proposals can select a tally strategy",
+		},
+		"optimistic proposal": {
+			strategy:     keeper.TallyStrategySupermajority,
+			proposalType: v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC,
+			expErrMsg:    "only standard and expedited proposals can select a tally strategy",
+		},
+		"valid strategy": {
+			strategy:     keeper.TallyStrategyQuadratic,
+			proposalType: v1.ProposalType_PROPOSAL_TYPE_STANDARD,
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			var msgs []sdk.Msg
+			if tc.proposalType != v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE {
+				msgs = TestProposal
+			}
+			msg, err := v1.NewMsgSubmitProposal(msgs, initialDeposit, proposerAddr, "mandatory metadata", "Proposal", "description of proposal", tc.proposalType)
+			suite.Require().NoError(err)
+			msg.TallyStrategy = tc.strategy
+
+			res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+			if tc.expErrMsg != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			proposal, err := suite.govKeeper.Proposals.Get(suite.ctx, res.ProposalId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.strategy, proposal.TallyStrategy)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestSubmitProposal_InitialDeposit() {
 	const meetsDepositValue = baseDepositTestAmount * baseDepositTestPercent / 100
 	baseDepositRatioDec := sdkmath.LegacyNewDec(baseDepositTestPercent).Quo(sdkmath.LegacyNewDec(100))
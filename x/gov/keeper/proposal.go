@@ -225,6 +225,11 @@ func (k Keeper) CancelProposal(ctx context.Context, proposalID uint64, proposer
 		if err != nil {
 			return err
 		}
+
+		err = k.deleteBallots(ctx, proposal.Id)
+		if err != nil {
+			return err
+		}
 	}
 
 	err = k.DeleteProposal(ctx, proposal.Id)
@@ -13,20 +13,73 @@ import (
 
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the voters
 func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
-	validators, err := k.getCurrentValidators(ctx)
-	if err != nil {
-		return false, false, v1.TallyResult{}, err
+	var (
+		strategy       TallyStrategy
+		strategyParams string
+	)
+	if proposal.TallyStrategy != "" {
+		// If the tally strategy is no longer registered or its params are invalid, the votes are removed and the proposal fails
+		strategy, strategyParams, err = k.getTallyStrategy(ctx, proposal.TallyStrategy)
+		if err != nil {
+			k.Logger.Error("failed to tally proposal with tally strategy", "proposal", proposal.Id, "strategy", proposal.TallyStrategy, "error", err)
+
+			_, results, err := k.CalculateVoteResultsAndVotingPower(ctx, proposal.Id, stakeVoterPower)
+			if err != nil {
+				return false, false, v1.TallyResult{}, err
+			}
+
+			return false, false, v1.NewTallyResultFromMap(results), nil
+		}
 	}
 
-	if k.config.CalculateVoteResultsAndVotingPowerFn == nil {
-		k.config.CalculateVoteResultsAndVotingPowerFn = defaultCalculateVoteResultsAndVotingPower
+	if proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE {
+		voteOptions, err := k.ProposalVoteOptions.Get(ctx, proposal.Id)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return false, false, v1.TallyResult{}, err
+		}
+
+		if err == nil && voteOptions.VotingMode != v1.MultipleChoiceVotingMode_MULTIPLE_CHOICE_VOTING_MODE_UNSPECIFIED {
+			passes, burnDeposits, tallyResults, ballotTally, err := k.tallyBallots(ctx, proposal, voteOptions)
+			if err != nil {
+				return false, false, v1.TallyResult{}, err
+			}
+
+			if err := k.BallotTallyResults.Set(ctx, proposal.Id, ballotTally); err != nil {
+				return false, false, v1.TallyResult{}, err
+			}
+
+			return passes, burnDeposits, tallyResults, nil
+		}
+	}
+
+	// the quorum is computed on the stake of the voters, which is their voting power
+	// unless the tally strategy converts it
+	var strategyPower VoterPowerFn
+	if strategy != nil {
+		strategyPower, err = strategy.VotingPowerFn(strategyParams)
+		if err != nil {
+			return false, false, v1.TallyResult{}, err
+		}
+	}
+
+	totalVoterStake := math.LegacyZeroDec()
+	voterPower := stakeVoterPower
+	if strategyPower != nil {
+		voterPower = func(stake math.LegacyDec) (math.LegacyDec, error) {
+			totalVoterStake = totalVoterStake.Add(stake)
+			return strategyPower(stake)
+		}
 	}
 
-	totalVoterPower, results, err := k.config.CalculateVoteResultsAndVotingPowerFn(ctx, k, proposal.Id, validators)
+	totalVoterPower, results, err := k.CalculateVoteResultsAndVotingPower(ctx, proposal.Id, voterPower)
 	if err != nil {
 		return false, false, v1.TallyResult{}, err
 	}
 
+	if strategyPower == nil {
+		totalVoterStake = totalVoterPower
+	}
+
 	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return false, false, v1.TallyResult{}, err
@@ -45,21 +98,58 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 
 	// If there are more spam votes than the sum of all other options, proposal fails
 	// A proposal with no votes should not be considered spam
-	if !totalVoterPower.Equal(math.LegacyZeroDec()) &&
-		results[v1.OptionSpam].GTE(results[v1.OptionOne].Add(results[v1.OptionTwo].Add(results[v1.OptionThree].Add(results[v1.OptionFour])))) {
+	if isSpam(totalVoterPower, results) {
 		return false, true, tallyResults, nil
 	}
 
 	switch proposal.ProposalType {
 	case v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC:
 		return k.tallyOptimistic(totalVoterPower, totalBonded, results, params)
-	case v1.ProposalType_PROPOSAL_TYPE_EXPEDITED:
-		return k.tallyExpedited(totalVoterPower, totalBonded, results, params)
 	case v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE:
 		return k.tallyMultipleChoice(totalVoterPower, totalBonded, results, params)
+	case v1.ProposalType_PROPOSAL_TYPE_EXPEDITED:
+		passes, burnDeposits, tallyResults, err = k.tallyExpedited(totalVoterPower, totalVoterStake, totalBonded, results, params)
 	default:
-		return k.tallyStandard(ctx, proposal, totalVoterPower, totalBonded, results, params)
+		passes, burnDeposits, tallyResults, err = k.tallyStandard(ctx, proposal, totalVoterPower, totalVoterStake, totalBonded, results, params)
 	}
+
+	if err != nil || !passes || strategy == nil {
+		return passes, burnDeposits, tallyResults, err
+	}
+
+	// If the proposal does not pass the conditions of its tally strategy, proposal fails
+	passes, err = strategy.Passes(strategyParams, totalVoterPower, totalBonded, results)
+	if err != nil {
+		return false, false, tallyResults, err
+	}
+
+	return passes, false, tallyResults, nil
+}
+
+// CalculateVoteResultsAndVotingPower tallies the voting power of the votes of a proposal with the configured
+// CalculateVoteResultsAndVotingPowerFn and returns the total voting power and the results of the vote.
+// The voting power of each voter is computed from its stake with voterPower, its stake if nil.
+// The default implementation removes the tallied votes from the store.
+func (k Keeper) CalculateVoteResultsAndVotingPower(ctx context.Context, proposalID uint64, voterPower VoterPowerFn) (totalVoterPower math.LegacyDec, results map[v1.VoteOption]math.LegacyDec, err error) {
+	validators, err := k.getCurrentValidators(ctx)
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	if k.config.CalculateVoteResultsAndVotingPowerFn == nil {
+		k.config.CalculateVoteResultsAndVotingPowerFn = defaultCalculateVoteResultsAndVotingPower
+	}
+	if voterPower == nil {
+		voterPower = stakeVoterPower
+	}
+
+	return k.config.CalculateVoteResultsAndVotingPowerFn(ctx, k, proposalID, validators, voterPower)
+}
+
+// stakeVoterPower is the VoterPowerFn of the proposals without tally strategy converting the stake
+// of the voters: the voting power of a voter is its stake.
+func stakeVoterPower(stake math.LegacyDec) (math.LegacyDec, error) {
+	return stake, nil
 }
 
 // tallyStandard tallies the votes of a standard proposal
@@ -69,7 +159,8 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 // If more than 1/2 of non-abstaining voters vote Yes, proposal passes
 // If more than 1/2 of non-abstaining voters vote No, proposal fails
 // Checking for spam votes is done before calling this function
-func (k Keeper) tallyStandard(ctx context.Context, proposal v1.Proposal, totalVoterPower math.LegacyDec, totalBonded math.Int, results map[v1.VoteOption]math.LegacyDec, params v1.Params) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
+// The quorum is computed on the stake of the voters, the thresholds on their voting power
+func (k Keeper) tallyStandard(ctx context.Context, proposal v1.Proposal, totalVoterPower, totalVoterStake math.LegacyDec, totalBonded math.Int, results map[v1.VoteOption]math.LegacyDec, params v1.Params) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
 	tallyResults = v1.NewTallyResultFromMap(results)
 
 	quorumStr := params.Quorum
@@ -91,7 +182,7 @@ func (k Keeper) tallyStandard(ctx context.Context, proposal v1.Proposal, totalVo
 	}
 
 	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVoterPower.Quo(math.LegacyNewDecFromInt(totalBonded))
+	percentVoting := totalVoterStake.Quo(math.LegacyNewDecFromInt(totalBonded))
 	quorum, _ := math.LegacyNewDecFromStr(quorumStr)
 	if percentVoting.LT(quorum) {
 		return false, params.BurnVoteQuorum, tallyResults, nil
@@ -131,11 +222,12 @@ func (k Keeper) tallyStandard(ctx context.Context, proposal v1.Proposal, totalVo
 // If more than 2/3 of non-abstaining voters vote Yes, proposal passes
 // If more than 1/2 of non-abstaining voters vote No, proposal fails
 // Checking for spam votes is done before calling this function
-func (k Keeper) tallyExpedited(totalVoterPower math.LegacyDec, totalBonded math.Int, results map[v1.VoteOption]math.LegacyDec, params v1.Params) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
+// The quorum is computed on the stake of the voters, the thresholds on their voting power
+func (k Keeper) tallyExpedited(totalVoterPower, totalVoterStake math.LegacyDec, totalBonded math.Int, results map[v1.VoteOption]math.LegacyDec, params v1.Params) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
 	tallyResults = v1.NewTallyResultFromMap(results)
 
 	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVoterPower.Quo(math.LegacyNewDecFromInt(totalBonded))
+	percentVoting := totalVoterStake.Quo(math.LegacyNewDecFromInt(totalBonded))
 	expeditedQuorum, _ := math.LegacyNewDecFromStr(params.ExpeditedQuorum)
 	if percentVoting.LT(expeditedQuorum) {
 		return false, params.BurnVoteQuorum, tallyResults, nil
@@ -236,37 +328,50 @@ func (k Keeper) getCurrentValidators(ctx context.Context) (map[string]v1.Validat
 
 // calculateVoteResultsAndVotingPower iterate over all votes, tally up the voting power of each validator
 // and returns the votes results from voters
+// Accounts which did not vote are tallied with the vote of their vote delegate, if any
 func defaultCalculateVoteResultsAndVotingPower(
 	ctx context.Context,
 	k Keeper,
 	proposalID uint64,
 	validators map[string]v1.ValidatorGovInfo,
+	voterPower VoterPowerFn,
 ) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
 	totalVP := math.LegacyZeroDec()
 	results := createEmptyResults()
 
-	// iterate over all votes, tally up the voting power of each validator
-	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
-	votesToRemove := []collections.Pair[uint64, sdk.AccAddress]{}
-	if err := k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
-		// if validator, just record it in the map
-		voter, err := k.authKeeper.AddressCodec().StringToBytes(vote.Voter)
+	// addVote tallies up the voting power of a voter with the given stake
+	addVote := func(stake math.LegacyDec, options v1.WeightedVoteOptions) error {
+		votingPower, err := voterPower(stake)
 		if err != nil {
-			return false, err
+			return err
 		}
 
+		for _, option := range options {
+			weight, _ := math.LegacyNewDecFromStr(option.Weight)
+			subPower := votingPower.Mul(weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
+		totalVP = totalVP.Add(votingPower)
+
+		return nil
+	}
+
+	// tallyVoter records the vote of a validator and tallies up the voting power of the delegations of a voter
+	tallyVoter := func(voter sdk.AccAddress, options v1.WeightedVoteOptions) error {
+		// if validator, just record it in the map
 		valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(voter)
 		if err != nil {
-			return false, err
+			return err
 		}
 
 		if val, ok := validators[valAddrStr]; ok {
-			val.Vote = vote.Options
+			val.Vote = options
 			validators[valAddrStr] = val
 		}
 
 		// iterate over all delegations from voter, deduct from any delegated-to validators
-		err = k.sk.IterateDelegations(ctx, voter, func(index int64, delegation sdk.DelegationI) (stop bool) {
+		stake := math.LegacyZeroDec()
+		if err := k.sk.IterateDelegations(ctx, voter, func(index int64, delegation sdk.DelegationI) (stop bool) {
 			valAddrStr := delegation.GetValidatorAddr()
 
 			if val, ok := validators[valAddrStr]; ok {
@@ -276,29 +381,57 @@ func defaultCalculateVoteResultsAndVotingPower(
 				validators[valAddrStr] = val
 
 				// delegation shares * bonded / total shares
-				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
-
-				for _, option := range vote.Options {
-					weight, _ := math.LegacyNewDecFromStr(option.Weight)
-					subPower := votingPower.Mul(weight)
-					results[option.Option] = results[option.Option].Add(subPower)
-				}
-
-				totalVP = totalVP.Add(votingPower)
+				stake = stake.Add(delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares))
 			}
 
 			return false
-		})
+		}); err != nil {
+			return err
+		}
+
+		return addVote(stake, options)
+	}
+
+	// iterate over all votes, tally up the voting power of each validator
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
+	votesToRemove := []collections.Pair[uint64, sdk.AccAddress]{}
+	votes := make(map[string]v1.WeightedVoteOptions)
+	var voters []sdk.AccAddress
+	if err := k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+		voter, err := k.authKeeper.AddressCodec().StringToBytes(vote.Voter)
 		if err != nil {
 			return false, err
 		}
 
+		if err := tallyVoter(voter, vote.Options); err != nil {
+			return false, err
+		}
+
+		votes[string(voter)] = vote.Options
+		voters = append(voters, voter)
 		votesToRemove = append(votesToRemove, key)
 		return false, nil
 	}); err != nil {
 		return math.LegacyDec{}, nil, err
 	}
 
+	// tally up the voting power of the accounts which delegated their vote and did not vote
+	proposal, err := k.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	delegators, delegatedVoters, err := k.resolveDelegatedVoters(ctx, proposal, voters)
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	for _, delegator := range delegators {
+		if err := tallyVoter(delegator, votes[string(delegatedVoters[string(delegator)])]); err != nil {
+			return math.LegacyDec{}, nil, err
+		}
+	}
+
 	// remove all votes from store
 	for _, key := range votesToRemove {
 		if err := k.Votes.Remove(ctx, key); err != nil {
@@ -313,14 +446,11 @@ func defaultCalculateVoteResultsAndVotingPower(
 		}
 
 		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
-		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+		stake := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
 
-		for _, option := range val.Vote {
-			weight, _ := math.LegacyNewDecFromStr(option.Weight)
-			subPower := votingPower.Mul(weight)
-			results[option.Option] = results[option.Option].Add(subPower)
+		if err := addVote(stake, val.Vote); err != nil {
+			return math.LegacyDec{}, nil, err
 		}
-		totalVP = totalVP.Add(votingPower)
 	}
 
 	return totalVP, results, nil
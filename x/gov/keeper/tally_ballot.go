@@ -0,0 +1,318 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// weightedBallot is a ballot with the voting power of its voter.
+type weightedBallot struct {
+	options []v1.VoteOption
+	power   math.LegacyDec
+}
+
+// tallyBallots tallies the ballots of a ranked-choice or approval multiple choice proposal
+// If there is not enough quorum of votes, the proposal fails
+// If there are more spam votes than ballots, the proposal fails
+// If no option wins, the proposal fails
+// Any other case, proposal passes
+func (k Keeper) tallyBallots(ctx context.Context, proposal v1.Proposal, voteOptions v1.ProposalVoteOptions) (passes, burnDeposits bool, tallyResults v1.TallyResult, ballotTally v1.BallotTallyResult, err error) {
+	validators, err := k.getCurrentValidators(ctx)
+	if err != nil {
+		return false, false, v1.TallyResult{}, v1.BallotTallyResult{}, err
+	}
+
+	totalVoterPower, spamPower, ballots, err := k.calculateBallotsVotingPower(ctx, proposal, validators)
+	if err != nil {
+		return false, false, v1.TallyResult{}, v1.BallotTallyResult{}, err
+	}
+
+	ballotTally = v1.BallotTallyResult{VotingMode: voteOptions.VotingMode}
+	if voteOptions.VotingMode == v1.MultipleChoiceVotingMode_MULTIPLE_CHOICE_VOTING_MODE_RANKED_CHOICE {
+		ballotTally.Rounds, ballotTally.Winner = tallyRankedChoice(ballotOptions(voteOptions), ballots, spamPower)
+	} else {
+		ballotTally.Rounds, ballotTally.Winner = tallyApproval(ballotOptions(voteOptions), ballots, spamPower)
+	}
+	tallyResults = *ballotTally.Rounds[len(ballotTally.Rounds)-1].Tally
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, false, tallyResults, ballotTally, err
+	}
+
+	// If there is no staked coins, the proposal fails
+	totalBonded, err := k.sk.TotalBondedTokens(ctx)
+	if err != nil {
+		return false, false, tallyResults, ballotTally, err
+	}
+
+	if totalBonded.IsZero() {
+		return false, false, tallyResults, ballotTally, nil
+	}
+
+	// If there are more spam votes than ballots, proposal fails
+	// A proposal with no votes should not be considered spam
+	if !totalVoterPower.IsZero() && spamPower.GTE(totalVoterPower.Sub(spamPower)) {
+		return false, true, tallyResults, ballotTally, nil
+	}
+
+	// If there is not enough quorum of votes, the proposal fails
+	percentVoting := totalVoterPower.Quo(math.LegacyNewDecFromInt(totalBonded))
+	quorum, _ := math.LegacyNewDecFromStr(params.Quorum)
+	if percentVoting.LT(quorum) {
+		return false, params.BurnVoteQuorum, tallyResults, ballotTally, nil
+	}
+
+	if ballotTally.Winner == v1.OptionEmpty {
+		return false, false, tallyResults, ballotTally, nil
+	}
+
+	return true, false, tallyResults, ballotTally, nil
+}
+
+// tallyRankedChoice tallies ranked ballots by instant runoff.
+// Each round, every ballot counts for its most preferred option which has not been eliminated.
+// An option wins when it gets more than half of the voting power of the ballots counted in the round,
+// or when it is the last option remaining. Otherwise, the option with the least voting power is eliminated.
+// Ties are broken in favor of the option listed first in the proposal.
+func tallyRankedChoice(options []v1.VoteOption, ballots []weightedBallot, spamPower math.LegacyDec) ([]*v1.BallotTallyRound, v1.VoteOption) {
+	remaining := make(map[v1.VoteOption]bool, len(options))
+	for _, option := range options {
+		remaining[option] = true
+	}
+
+	var rounds []*v1.BallotTallyRound
+	for {
+		results := createEmptyResults()
+		results[v1.OptionSpam] = spamPower
+		counted := math.LegacyZeroDec()
+		for _, ballot := range ballots {
+			for _, option := range ballot.options {
+				if remaining[option] {
+					results[option] = results[option].Add(ballot.power)
+					counted = counted.Add(ballot.power)
+					break
+				}
+			}
+		}
+
+		tally := v1.NewTallyResultFromMap(results)
+		round := &v1.BallotTallyRound{Tally: &tally}
+		rounds = append(rounds, round)
+
+		if counted.IsZero() {
+			return rounds, v1.OptionEmpty
+		}
+
+		leader, last := v1.OptionEmpty, v1.OptionEmpty
+		for _, option := range options {
+			if !remaining[option] {
+				continue
+			}
+
+			if leader == v1.OptionEmpty || results[option].GT(results[leader]) {
+				leader = option
+			}
+
+			if last == v1.OptionEmpty || results[option].LTE(results[last]) {
+				last = option
+			}
+		}
+
+		if len(remaining) == 1 || results[leader].MulInt64(2).GT(counted) {
+			return rounds, leader
+		}
+
+		round.Eliminated = last
+		delete(remaining, last)
+	}
+}
+
+// tallyApproval tallies approval ballots in a single round.
+// Every ballot counts for all its approved options and the option with the most voting power wins.
+// Ties are broken in favor of the option listed first in the proposal.
+func tallyApproval(options []v1.VoteOption, ballots []weightedBallot, spamPower math.LegacyDec) ([]*v1.BallotTallyRound, v1.VoteOption) {
+	results := createEmptyResults()
+	results[v1.OptionSpam] = spamPower
+	for _, ballot := range ballots {
+		for _, option := range ballot.options {
+			results[option] = results[option].Add(ballot.power)
+		}
+	}
+
+	winner := v1.OptionEmpty
+	for _, option := range options {
+		if results[option].IsPositive() && (winner == v1.OptionEmpty || results[option].GT(results[winner])) {
+			winner = option
+		}
+	}
+
+	tally := v1.NewTallyResultFromMap(results)
+	return []*v1.BallotTallyRound{{Tally: &tally}}, winner
+}
+
+// calculateBallotsVotingPower iterates over all ballots and spam votes of a proposal, and returns the total
+// voting power, the spam voting power and the ballots weighted by the voting power of their voters.
+// Like votes, delegators who did not cast a ballot inherit the ballot of their validator, and
+// accounts which delegated their vote and did not cast a ballot are tallied with the ballot of their delegate.
+// The ballots and votes are removed from the store.
+func (k Keeper) calculateBallotsVotingPower(
+	ctx context.Context,
+	proposal v1.Proposal,
+	validators map[string]v1.ValidatorGovInfo,
+) (totalVoterPower, spamPower math.LegacyDec, ballots []weightedBallot, err error) {
+	totalVoterPower = math.LegacyZeroDec()
+	spamPower = math.LegacyZeroDec()
+	validatorBallots := make(map[string][]v1.VoteOption)
+
+	// delegatorPower deducts the delegations of the voter from its validators and returns its voting power
+	delegatorPower := func(voter sdk.AccAddress) (math.LegacyDec, error) {
+		power := math.LegacyZeroDec()
+		err := k.sk.IterateDelegations(ctx, voter, func(index int64, delegation sdk.DelegationI) (stop bool) {
+			valAddrStr := delegation.GetValidatorAddr()
+			if val, ok := validators[valAddrStr]; ok {
+				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+				validators[valAddrStr] = val
+
+				// delegation shares * bonded / total shares
+				power = power.Add(delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares))
+			}
+
+			return false
+		})
+
+		return power, err
+	}
+
+	// tallyBallot records the ballot of a validator and tallies up the voting power of the delegations of a voter
+	tallyBallot := func(voter sdk.AccAddress, options []v1.VoteOption) error {
+		valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(voter)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := validators[valAddrStr]; ok {
+			validatorBallots[valAddrStr] = options
+		}
+
+		power, err := delegatorPower(voter)
+		if err != nil {
+			return err
+		}
+
+		ballots = append(ballots, weightedBallot{options: options, power: power})
+		totalVoterPower = totalVoterPower.Add(power)
+		return nil
+	}
+
+	// tallySpam records the spam vote of a validator and tallies up the voting power of the delegations of a voter
+	tallySpam := func(voter sdk.AccAddress, options v1.WeightedVoteOptions) error {
+		valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(voter)
+		if err != nil {
+			return err
+		}
+
+		if val, ok := validators[valAddrStr]; ok {
+			val.Vote = options
+			validators[valAddrStr] = val
+		}
+
+		power, err := delegatorPower(voter)
+		if err != nil {
+			return err
+		}
+
+		spamPower = spamPower.Add(power)
+		totalVoterPower = totalVoterPower.Add(power)
+		return nil
+	}
+
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
+	ballotsToRemove := []collections.Pair[uint64, sdk.AccAddress]{}
+	voterBallots := make(map[string][]v1.VoteOption)
+	var voters []sdk.AccAddress
+	if err := k.Ballots.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], ballot v1.Ballot) (bool, error) {
+		if err := tallyBallot(key.K2(), ballot.Options); err != nil {
+			return false, err
+		}
+
+		voterBallots[string(key.K2())] = ballot.Options
+		voters = append(voters, key.K2())
+		ballotsToRemove = append(ballotsToRemove, key)
+		return false, nil
+	}); err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, nil, err
+	}
+
+	// only spam votes can be cast on ranked-choice and approval proposals
+	votesToRemove := []collections.Pair[uint64, sdk.AccAddress]{}
+	spamVotes := make(map[string]v1.WeightedVoteOptions)
+	if err := k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+		if err := tallySpam(key.K2(), vote.Options); err != nil {
+			return false, err
+		}
+
+		spamVotes[string(key.K2())] = vote.Options
+		voters = append(voters, key.K2())
+		votesToRemove = append(votesToRemove, key)
+		return false, nil
+	}); err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, nil, err
+	}
+
+	// tally up the voting power of the accounts which delegated their vote and did not cast a ballot
+	delegators, delegatedVoters, err := k.resolveDelegatedVoters(ctx, proposal, voters)
+	if err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, nil, err
+	}
+
+	for _, delegator := range delegators {
+		voter := string(delegatedVoters[string(delegator)])
+		if options, ok := voterBallots[voter]; ok {
+			err = tallyBallot(delegator, options)
+		} else {
+			err = tallySpam(delegator, spamVotes[voter])
+		}
+		if err != nil {
+			return math.LegacyDec{}, math.LegacyDec{}, nil, err
+		}
+	}
+
+	// remove all ballots and votes from store
+	for _, key := range ballotsToRemove {
+		if err := k.Ballots.Remove(ctx, key); err != nil {
+			return math.LegacyDec{}, math.LegacyDec{}, nil, err
+		}
+	}
+
+	for _, key := range votesToRemove {
+		if err := k.Votes.Remove(ctx, key); err != nil {
+			return math.LegacyDec{}, math.LegacyDec{}, nil, err
+		}
+	}
+
+	// iterate over the validators again to tally their voting power
+	for valAddrStr, val := range validators {
+		ballot, hasBallot := validatorBallots[valAddrStr]
+		if !hasBallot && len(val.Vote) == 0 {
+			continue
+		}
+
+		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
+		power := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+
+		if hasBallot {
+			ballots = append(ballots, weightedBallot{options: ballot, power: power})
+		} else {
+			spamPower = spamPower.Add(power)
+		}
+		totalVoterPower = totalVoterPower.Add(power)
+	}
+
+	return totalVoterPower, spamPower, ballots, nil
+}
@@ -0,0 +1,222 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+)
+
+const (
+	// TallyStrategySupermajority is the name of the SupermajorityTallyStrategy.
+	TallyStrategySupermajority = "supermajority"
+	// TallyStrategyQuadratic is the name of the QuadraticTallyStrategy.
+	TallyStrategyQuadratic = "quadratic"
+)
+
+// TallyStrategy defines additional rules to tally the votes of a proposal.
+// Tally strategies are registered by name in the Config and selected by proposals at submission.
+// Their params are JSON encoded and set by governance with MsgUpdateTallyStrategyParams.
+// A tally strategy is applied within the quorum and thresholds of the proposal type, which it can only make stricter.
+type TallyStrategy interface {
+	// ValidateParams validates the JSON encoded params of the tally strategy.
+	// Empty params must be accepted and select the default params of the tally strategy.
+	ValidateParams(params string) error
+
+	// VotingPowerFn returns the function converting the stake of a voter into its voting power,
+	// or nil if the voting power of a voter is its stake. When a function is returned, the quorum
+	// is computed on the stake of the voters and the thresholds on their voting power.
+	VotingPowerFn(params string) (VoterPowerFn, error)
+
+	// Passes returns whether a proposal which passed the quorum and thresholds of its proposal type
+	// also passes the conditions of the tally strategy. The results are expressed in voting power.
+	Passes(params string, totalVoterPower math.LegacyDec, totalBonded math.Int, results map[v1.VoteOption]math.LegacyDec) (bool, error)
+}
+
+// SupermajorityParams are the params of the SupermajorityTallyStrategy.
+type SupermajorityParams struct {
+	// Threshold is the minimum proportion of Yes votes among the non-abstaining votes for a proposal to pass.
+	// Default value: 2/3.
+	Threshold string `json:"threshold,omitempty"`
+	// Floor is the minimum proportion of the total bonded stake which must vote Yes for a proposal to pass.
+	// Default value: 0.
+	Floor string `json:"floor,omitempty"`
+}
+
+// SupermajorityTallyStrategy tallies the votes with a configurable threshold
+// and an additional floor of Yes votes relative to the total bonded stake.
+type SupermajorityTallyStrategy struct{}
+
+var _ TallyStrategy = SupermajorityTallyStrategy{}
+
+func (SupermajorityTallyStrategy) parseParams(params string) (threshold, floor math.LegacyDec, err error) {
+	p := SupermajorityParams{Threshold: "0.667", Floor: "0"}
+	if err := decodeTallyStrategyParams(params, &p); err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, err
+	}
+
+	threshold, err = parseTallyStrategyRatio("threshold", p.Threshold)
+	if err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, err
+	}
+
+	floor, err = parseTallyStrategyRatio("floor", p.Floor)
+	return threshold, floor, err
+}
+
+// ValidateParams implements TallyStrategy.
+func (s SupermajorityTallyStrategy) ValidateParams(params string) error {
+	_, _, err := s.parseParams(params)
+	return err
+}
+
+// VotingPowerFn implements TallyStrategy. The voting power of a voter is its stake.
+func (SupermajorityTallyStrategy) VotingPowerFn(string) (VoterPowerFn, error) {
+	return nil, nil
+}
+
+// Passes implements TallyStrategy.
+// If less than the floor of the total bonded stake votes Yes, proposal fails
+// If more than the threshold of non-abstaining voters vote Yes, proposal passes
+func (s SupermajorityTallyStrategy) Passes(params string, totalVoterPower math.LegacyDec, totalBonded math.Int, results map[v1.VoteOption]math.LegacyDec) (bool, error) {
+	threshold, floor, err := s.parseParams(params)
+	if err != nil {
+		return false, err
+	}
+
+	if results[v1.OptionYes].Quo(math.LegacyNewDecFromInt(totalBonded)).LT(floor) {
+		return false, nil
+	}
+
+	return results[v1.OptionYes].Quo(totalVoterPower.Sub(results[v1.OptionAbstain])).GT(threshold), nil
+}
+
+// QuadraticParams are the params of the QuadraticTallyStrategy.
+type QuadraticParams struct {
+	// Threshold is the minimum proportion of quadratic Yes voting power among the non-abstaining
+	// quadratic voting power for a proposal to pass.
+	// Default value: 0.5.
+	Threshold string `json:"threshold,omitempty"`
+}
+
+// QuadraticTallyStrategy tallies the votes with a voting power equal to the square root of the stake
+// of each voter. A validator voting on behalf of its delegators which did not vote is a single voter
+// with their stake. The quorum is computed on the stake of the voters.
+type QuadraticTallyStrategy struct{}
+
+var _ TallyStrategy = QuadraticTallyStrategy{}
+
+func (QuadraticTallyStrategy) parseParams(params string) (threshold math.LegacyDec, err error) {
+	p := QuadraticParams{Threshold: "0.5"}
+	if err := decodeTallyStrategyParams(params, &p); err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return parseTallyStrategyRatio("threshold", p.Threshold)
+}
+
+// ValidateParams implements TallyStrategy.
+func (s QuadraticTallyStrategy) ValidateParams(params string) error {
+	_, err := s.parseParams(params)
+	return err
+}
+
+// VotingPowerFn implements TallyStrategy. The voting power of a voter is the square root of its stake.
+func (QuadraticTallyStrategy) VotingPowerFn(string) (VoterPowerFn, error) {
+	return func(stake math.LegacyDec) (math.LegacyDec, error) {
+		return stake.ApproxSqrt()
+	}, nil
+}
+
+// Passes implements TallyStrategy.
+// If more than the threshold of non-abstaining quadratic voting power votes Yes, proposal passes
+func (s QuadraticTallyStrategy) Passes(params string, totalVoterPower math.LegacyDec, _ math.Int, results map[v1.VoteOption]math.LegacyDec) (bool, error) {
+	threshold, err := s.parseParams(params)
+	if err != nil {
+		return false, err
+	}
+
+	return results[v1.OptionYes].Quo(totalVoterPower.Sub(results[v1.OptionAbstain])).GT(threshold), nil
+}
+
+// getTallyStrategy returns the tally strategy registered under the given name and its validated params.
+func (k Keeper) getTallyStrategy(ctx context.Context, name string) (TallyStrategy, string, error) {
+	strategy, ok := k.config.TallyStrategies[name]
+	if !ok {
+		return nil, "", errorsmod.Wrapf(types.ErrUnknownTallyStrategy, "%s", name)
+	}
+
+	params, err := k.TallyStrategyParams.Get(ctx, name)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, "", err
+	}
+
+	if err := strategy.ValidateParams(params); err != nil {
+		return nil, "", err
+	}
+
+	return strategy, params, nil
+}
+
+// SetTallyStrategyParams validates and sets the JSON encoded params of a registered tally strategy.
+// Empty params reset the tally strategy to its default params.
+func (k Keeper) SetTallyStrategyParams(ctx context.Context, name, params string) error {
+	strategy, ok := k.config.TallyStrategies[name]
+	if !ok {
+		return errorsmod.Wrapf(types.ErrUnknownTallyStrategy, "%s", name)
+	}
+
+	// delete the tally strategy params if the params are empty, so that the default params are used
+	if params == "" {
+		return k.TallyStrategyParams.Remove(ctx, name)
+	}
+
+	if err := strategy.ValidateParams(params); err != nil {
+		return err
+	}
+
+	return k.TallyStrategyParams.Set(ctx, name, params)
+}
+
+// isSpam returns true if there are more spam votes than the sum of all other options.
+// A proposal with no votes is not considered spam.
+func isSpam(totalVoterPower math.LegacyDec, results map[v1.VoteOption]math.LegacyDec) bool {
+	return !totalVoterPower.IsZero() &&
+		results[v1.OptionSpam].GTE(results[v1.OptionOne].Add(results[v1.OptionTwo].Add(results[v1.OptionThree].Add(results[v1.OptionFour]))))
+}
+
+// decodeTallyStrategyParams decodes JSON encoded tally strategy params into v, rejecting unknown fields.
+// Empty params leave v unchanged.
+func decodeTallyStrategyParams(params string, v any) error {
+	if params == "" {
+		return nil
+	}
+
+	dec := json.NewDecoder(strings.NewReader(params))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidTallyParams, err.Error())
+	}
+
+	return nil
+}
+
+// parseTallyStrategyRatio parses a tally strategy param which must be a decimal between 0 and 1.
+func parseTallyStrategyRatio(name, value string) (math.LegacyDec, error) {
+	ratio, err := math.LegacyNewDecFromStr(value)
+	if err != nil {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidTallyParams, "invalid %s: %s", name, err)
+	}
+
+	if ratio.IsNegative() || ratio.GT(math.LegacyOneDec()) {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidTallyParams, "%s must be between 0 and 1, got %s", name, value)
+	}
+
+	return ratio, nil
+}
@@ -0,0 +1,592 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/gov/keeper"
+	v1 "cosmossdk.io/x/gov/types/v1"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// stakeVote makes a delegator vote with the given stake delegated to the first validator
+var stakeVote = func(s tallyFixture, voter sdk.AccAddress, stake int64, vote v1.VoteOption) {
+	delAddr, err := s.mocks.acctKeeper.AddressCodec().BytesToString(voter)
+	require.NoError(s.t, err)
+	valAddr, err := s.mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(s.valAddrs[0])
+	require.NoError(s.t, err)
+	delegations := []stakingtypes.Delegation{{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Shares:           sdkmath.LegacyNewDec(stake),
+	}}
+	delegatorVote(s, voter, delegations, vote)
+}
+
+func TestTally_Strategies(t *testing.T) {
+	tests := []struct {
+		name          string
+		strategy      string
+		params        string
+		proposalType  v1.ProposalType
+		setup         func(tallyFixture)
+		expectedPass  bool
+		expectedBurn  bool
+		expectedTally v1.TallyResult
+	}{
+		{
+			name:     "supermajority: no votes, no bonded tokens: prop fails",
+			strategy: keeper.TallyStrategySupermajority,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 0)
+			},
+			expectedPass: false,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "0",
+				AbstainCount:     "0",
+				NoCount:          "0",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "0",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "0",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name:     "supermajority: 7 of 10 vote yes: prop passes",
+			strategy: keeper.TallyStrategySupermajority,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				for i := 0; i < 7; i++ {
+					validatorVote(s, s.valAddrs[i], v1.VoteOption_VOTE_OPTION_YES)
+				}
+				for i := 7; i < 10; i++ {
+					validatorVote(s, s.valAddrs[i], v1.VoteOption_VOTE_OPTION_NO)
+				}
+			},
+			expectedPass: true,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "7000000",
+				AbstainCount:     "0",
+				NoCount:          "3000000",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "7000000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "3000000",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name:     "supermajority: 6 of 10 vote yes: prop fails",
+			strategy: keeper.TallyStrategySupermajority,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				for i := 0; i < 6; i++ {
+					validatorVote(s, s.valAddrs[i], v1.VoteOption_VOTE_OPTION_YES)
+				}
+				for i := 6; i < 10; i++ {
+					validatorVote(s, s.valAddrs[i], v1.VoteOption_VOTE_OPTION_NO)
+				}
+			},
+			expectedPass: false,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "6000000",
+				AbstainCount:     "0",
+				NoCount:          "4000000",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "6000000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "4000000",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name:     "supermajority: 6 of 10 vote yes, custom threshold: prop passes",
+			strategy: keeper.TallyStrategySupermajority,
+			params:   `{"threshold":"0.55"}`,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				for i := 0; i < 6; i++ {
+					validatorVote(s, s.valAddrs[i], v1.VoteOption_VOTE_OPTION_YES)
+				}
+				for i := 6; i < 10; i++ {
+					validatorVote(s, s.valAddrs[i], v1.VoteOption_VOTE_OPTION_NO)
+				}
+			},
+			expectedPass: true,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "6000000",
+				AbstainCount:     "0",
+				NoCount:          "4000000",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "6000000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "4000000",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name:         "supermajority: expedited threshold still applies: prop fails",
+			strategy:     keeper.TallyStrategySupermajority,
+			params:       `{"threshold":"0.55"}`,
+			proposalType: v1.ProposalType_PROPOSAL_TYPE_EXPEDITED,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				for i := 0; i < 6; i++ {
+					validatorVote(s, s.valAddrs[i], v1.VoteOption_VOTE_OPTION_YES)
+				}
+				for i := 6; i < 10; i++ {
+					validatorVote(s, s.valAddrs[i], v1.VoteOption_VOTE_OPTION_NO)
+				}
+			},
+			expectedPass: false,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "6000000",
+				AbstainCount:     "0",
+				NoCount:          "4000000",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "6000000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "4000000",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name:     "supermajority: yes below floor: prop fails",
+			strategy: keeper.TallyStrategySupermajority,
+			params:   `{"floor":"0.5"}`,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				for i := 0; i < 4; i++ {
+					validatorVote(s, s.valAddrs[i], v1.VoteOption_VOTE_OPTION_YES)
+				}
+				validatorVote(s, s.valAddrs[4], v1.VoteOption_VOTE_OPTION_ABSTAIN)
+			},
+			expectedPass: false,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "4000000",
+				AbstainCount:     "1000000",
+				NoCount:          "0",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "4000000",
+				OptionTwoCount:   "1000000",
+				OptionThreeCount: "0",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name:     "supermajority: quorum not reached: prop fails/burn deposit",
+			strategy: keeper.TallyStrategySupermajority,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_YES)
+			},
+			expectedPass: false,
+			expectedBurn: true,
+			expectedTally: v1.TallyResult{
+				YesCount:         "1000000",
+				AbstainCount:     "0",
+				NoCount:          "0",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "1000000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "0",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name:     "quadratic: linear majority but quadratic tie: prop fails",
+			strategy: keeper.TallyStrategyQuadratic,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				stakeVote(s, s.delAddrs[0], 4000000, v1.VoteOption_VOTE_OPTION_YES)
+				stakeVote(s, s.delAddrs[1], 1000000, v1.VoteOption_VOTE_OPTION_NO)
+				stakeVote(s, s.delAddrs[2], 1000000, v1.VoteOption_VOTE_OPTION_NO)
+			},
+			expectedPass: false,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "2000",
+				AbstainCount:     "0",
+				NoCount:          "2000",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "2000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "2000",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name:     "quadratic: quadratic majority: prop passes",
+			strategy: keeper.TallyStrategyQuadratic,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				stakeVote(s, s.delAddrs[0], 4000000, v1.VoteOption_VOTE_OPTION_YES)
+				stakeVote(s, s.delAddrs[1], 1000000, v1.VoteOption_VOTE_OPTION_NO)
+			},
+			expectedPass: true,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "2000",
+				AbstainCount:     "0",
+				NoCount:          "1000",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "2000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "1000",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name:     "quadratic: validators vote with the square root of their inherited stake: prop passes",
+			strategy: keeper.TallyStrategyQuadratic,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				for i := 0; i < 10; i++ {
+					validatorVote(s, s.valAddrs[i], v1.VoteOption_VOTE_OPTION_YES)
+				}
+			},
+			expectedPass: true,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "10000",
+				AbstainCount:     "0",
+				NoCount:          "0",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "10000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "0",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name:     "quadratic: delegated votes are tallied: prop passes",
+			strategy: keeper.TallyStrategyQuadratic,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				delegateVote(s, s.delAddrs[0], s.delAddrs[1], "")
+				delegatedVoter(s, s.delAddrs[0], delegationsToVal0(s, s.delAddrs[0], 4000000))
+				stakeVote(s, s.delAddrs[1], 1000000, v1.VoteOption_VOTE_OPTION_YES)
+				stakeVote(s, s.delAddrs[2], 1000000, v1.VoteOption_VOTE_OPTION_NO)
+			},
+			expectedPass: true,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "3000",
+				AbstainCount:     "0",
+				NoCount:          "1000",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "3000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "1000",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name:     "quadratic: quorum is computed on stake: prop fails/burn deposit",
+			strategy: keeper.TallyStrategyQuadratic,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				stakeVote(s, s.delAddrs[0], 3000000, v1.VoteOption_VOTE_OPTION_YES)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "1732",
+				AbstainCount:     "0",
+				NoCount:          "0",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "1732",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "0",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name:     "quadratic: custom threshold: prop fails",
+			strategy: keeper.TallyStrategyQuadratic,
+			params:   `{"threshold":"0.7"}`,
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				stakeVote(s, s.delAddrs[0], 4000000, v1.VoteOption_VOTE_OPTION_YES)
+				stakeVote(s, s.delAddrs[1], 1000000, v1.VoteOption_VOTE_OPTION_NO)
+			},
+			expectedPass: false,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "2000",
+				AbstainCount:     "0",
+				NoCount:          "1000",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "2000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "1000",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name:     "unknown strategy: prop fails",
+			strategy: "unknown",
+			setup: func(s tallyFixture) {
+				s.mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+				for i := 0; i < 10; i++ {
+					validatorVote(s, s.valAddrs[i], v1.VoteOption_VOTE_OPTION_YES)
+				}
+			},
+			expectedPass: false,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "10000000",
+				AbstainCount:     "0",
+				NoCount:          "0",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "10000000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "0",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name:     "invalid strategy params: prop fails",
+			strategy: keeper.TallyStrategySupermajority,
+			params:   `{"threshold":"2"}`,
+			setup: func(s tallyFixture) {
+				s.mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+				for i := 0; i < 10; i++ {
+					validatorVote(s, s.valAddrs[i], v1.VoteOption_VOTE_OPTION_YES)
+				}
+			},
+			expectedPass: false,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "10000000",
+				AbstainCount:     "0",
+				NoCount:          "0",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "10000000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "0",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+			params := v1.DefaultParams()
+			// Ensure params value are different than false
+			params.BurnVoteQuorum = true
+			params.BurnVoteVeto = true
+			err := govKeeper.Params.Set(ctx, params)
+			require.NoError(t, err)
+			var (
+				numVals       = 10
+				numDelegators = 5
+				addrs         = simtestutil.CreateRandomAccounts(numVals + numDelegators)
+				valAddrs      = simtestutil.ConvertAddrsToValAddrs(addrs[:numVals])
+				delAddrs      = addrs[numVals:]
+			)
+			// Mocks a bunch of validators
+			mocks.stakingKeeper.EXPECT().
+				IterateBondedValidatorsByPower(ctx, gomock.Any()).
+				DoAndReturn(
+					func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+						for i := int64(0); i < int64(numVals); i++ {
+							valAddr, err := mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddrs[i])
+							require.NoError(t, err)
+							fn(i, stakingtypes.Validator{
+								OperatorAddress: valAddr,
+								Status:          stakingtypes.Bonded,
+								Tokens:          sdkmath.NewInt(1000000),
+								DelegatorShares: sdkmath.LegacyNewDec(1000000),
+							})
+						}
+						return nil
+					})
+
+			// Submit and activate a proposal
+			proposalType := tt.proposalType
+			if proposalType == v1.ProposalType_PROPOSAL_TYPE_UNSPECIFIED {
+				proposalType = v1.ProposalType_PROPOSAL_TYPE_STANDARD
+			}
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddrs[0], proposalType)
+			require.NoError(t, err)
+			proposal.TallyStrategy = tt.strategy
+			err = govKeeper.ActivateVotingPeriod(ctx, proposal)
+			require.NoError(t, err)
+			if tt.params != "" {
+				err = govKeeper.TallyStrategyParams.Set(ctx, tt.strategy, tt.params)
+				require.NoError(t, err)
+			}
+			suite := tallyFixture{
+				t:        t,
+				proposal: proposal,
+				valAddrs: valAddrs,
+				delAddrs: delAddrs,
+				ctx:      ctx,
+				keeper:   govKeeper,
+				mocks:    mocks,
+			}
+			tt.setup(suite)
+
+			pass, burn, tally, err := govKeeper.Tally(ctx, proposal)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPass, pass, "wrong pass")
+			assert.Equal(t, tt.expectedBurn, burn, "wrong burn")
+			assert.Equal(t, tt.expectedTally, tally)
+			// Assert votes removal after tally
+			rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
+			has, err := suite.keeper.Votes.Iterate(suite.ctx, rng)
+			require.NoError(t, err)
+			assert.False(t, has.Valid(), "votes not removed")
+		})
+	}
+}
+
+func TestTally_StrategiesWithCustomCalculateVoteResultsFn(t *testing.T) {
+	// the custom function closes over the keeper of the app, as applications wire it,
+	// and tallies a yes voter with 4000000 stake and a no voter with 1000000 stake
+	var appKeeper *keeper.Keeper
+	config := keeper.DefaultConfig()
+	config.CalculateVoteResultsAndVotingPowerFn = func(
+		ctx context.Context, _ keeper.Keeper, proposalID uint64, _ map[string]v1.ValidatorGovInfo, voterPower keeper.VoterPowerFn,
+	) (sdkmath.LegacyDec, map[v1.VoteOption]sdkmath.LegacyDec, error) {
+		if _, err := appKeeper.Proposals.Get(ctx, proposalID); err != nil {
+			return sdkmath.LegacyDec{}, nil, err
+		}
+		yes, err := voterPower(sdkmath.LegacyNewDec(4000000))
+		if err != nil {
+			return sdkmath.LegacyDec{}, nil, err
+		}
+		no, err := voterPower(sdkmath.LegacyNewDec(1000000))
+		if err != nil {
+			return sdkmath.LegacyDec{}, nil, err
+		}
+		results := map[v1.VoteOption]sdkmath.LegacyDec{
+			v1.OptionYes:        yes,
+			v1.OptionAbstain:    sdkmath.LegacyZeroDec(),
+			v1.OptionNo:         no,
+			v1.OptionNoWithVeto: sdkmath.LegacyZeroDec(),
+			v1.OptionSpam:       sdkmath.LegacyZeroDec(),
+		}
+		return yes.Add(no), results, nil
+	}
+
+	govKeeper, _, _, ctx := setupGovKeeperWithConfig(t, config)
+	appKeeper = govKeeper
+	proposer := simtestutil.CreateRandomAccounts(1)[0]
+
+	for _, strategy := range []string{"", keeper.TallyStrategyQuadratic} {
+		proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", proposer, v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+		require.NoError(t, err)
+		proposal.TallyStrategy = strategy
+		require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+
+		// the quadratic voting power is tallied, while the quorum is computed on the 5000000 stake of the voters
+		pass, _, tally, err := govKeeper.Tally(ctx, proposal)
+		require.NoError(t, err)
+		require.True(t, pass, strategy)
+		if strategy == "" {
+			require.Equal(t, "4000000", tally.YesCount)
+		} else {
+			require.Equal(t, "2000", tally.YesCount)
+			require.Equal(t, "1000", tally.NoCount)
+		}
+	}
+}
+
+func TestTallyStrategies_ValidateParams(t *testing.T) {
+	tests := []struct {
+		name      string
+		strategy  keeper.TallyStrategy
+		params    string
+		expErrMsg string
+	}{
+		{
+			name:     "supermajority: empty params",
+			strategy: keeper.SupermajorityTallyStrategy{},
+		},
+		{
+			name:     "supermajority: valid params",
+			strategy: keeper.SupermajorityTallyStrategy{},
+			params:   `{"threshold":"0.75","floor":"0.2"}`,
+		},
+		{
+			name:      "supermajority: invalid json",
+			strategy:  keeper.SupermajorityTallyStrategy{},
+			params:    `{"threshold":`,
+			expErrMsg: "invalid tally strategy params",
+		},
+		{
+			name:      "supermajority: unknown field",
+			strategy:  keeper.SupermajorityTallyStrategy{},
+			params:    `{"quorum":"0.5"}`,
+			expErrMsg: "unknown field",
+		},
+		{
+			name:      "supermajority: invalid threshold",
+			strategy:  keeper.SupermajorityTallyStrategy{},
+			params:    `{"threshold":"abc"}`,
+			expErrMsg: "invalid threshold",
+		},
+		{
+			name:      "supermajority: negative floor",
+			strategy:  keeper.SupermajorityTallyStrategy{},
+			params:    `{"floor":"-0.1"}`,
+			expErrMsg: "floor must be between 0 and 1",
+		},
+		{
+			name:     "quadratic: valid params",
+			strategy: keeper.QuadraticTallyStrategy{},
+			params:   `{"threshold":"0.6"}`,
+		},
+		{
+			name:      "quadratic: threshold above 1",
+			strategy:  keeper.QuadraticTallyStrategy{},
+			params:    `{"threshold":"1.1"}`,
+			expErrMsg: "threshold must be between 0 and 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.strategy.ValidateParams(tt.params)
+			if tt.expErrMsg != "" {
+				require.ErrorContains(t, err, tt.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
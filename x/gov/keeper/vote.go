@@ -66,6 +66,11 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 			} else if proposalOptionsStr.OptionFour == "" && option.Option == v1.OptionFour {
 				return errors.Wrap(types.ErrInvalidVote, "invalid vote option")
 			}
+
+			// ranked-choice and approval proposals are voted on with ballots, only spam votes are allowed
+			if proposalOptionsStr.VotingMode != v1.MultipleChoiceVotingMode_MULTIPLE_CHOICE_VOTING_MODE_UNSPECIFIED && option.Option != v1.OptionSpam {
+				return errors.Wrapf(types.ErrInvalidVote, "proposal %d voting mode is %s, only spam votes are allowed", proposalID, proposalOptionsStr.VotingMode)
+			}
 		}
 
 		if !v1.ValidWeightedVoteOption(*option) {
@@ -77,6 +82,13 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 	if err != nil {
 		return err
 	}
+	// a voter can either vote spam or cast a ballot
+	if proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE {
+		if err := k.Ballots.Remove(ctx, collections.Join(proposalID, voterAddr)); err != nil {
+			return err
+		}
+	}
+
 	vote := v1.NewVote(proposalID, voterStrAddr, options, metadata)
 	err = k.Votes.Set(ctx, collections.Join(proposalID, voterAddr), vote)
 	if err != nil {
@@ -0,0 +1,243 @@
+package keeper
+
+import (
+	"context"
+	stderrors "errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VoteDelegationsIndexes defines the indexes of the vote delegations.
+type VoteDelegationsIndexes struct {
+	// Delegate indexes the vote delegations by delegate address and topic.
+	Delegate *indexes.Multi[collections.Pair[sdk.AccAddress, string], collections.Pair[sdk.AccAddress, string], v1.VoteDelegation]
+}
+
+// IndexesList implements collections.Indexes.
+func (i VoteDelegationsIndexes) IndexesList() []collections.Index[collections.Pair[sdk.AccAddress, string], v1.VoteDelegation] {
+	return []collections.Index[collections.Pair[sdk.AccAddress, string], v1.VoteDelegation]{i.Delegate}
+}
+
+// NewVoteDelegationsIndexes returns the indexes of the vote delegations.
+func NewVoteDelegationsIndexes(sb *collections.SchemaBuilder, ac address.Codec) VoteDelegationsIndexes {
+	return VoteDelegationsIndexes{
+		Delegate: indexes.NewMulti(
+			sb,
+			types.VoteDelegationsByDelegateKey,
+			"vote_delegations_by_delegate",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey),
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey),
+			func(_ collections.Pair[sdk.AccAddress, string], v v1.VoteDelegation) (collections.Pair[sdk.AccAddress, string], error) {
+				delegate, err := ac.StringToBytes(v.Delegate)
+				if err != nil {
+					return collections.Pair[sdk.AccAddress, string]{}, err
+				}
+
+				return collections.Join(sdk.AccAddress(delegate), v.Topic), nil
+			},
+		),
+	}
+}
+
+// SetVoteDelegation delegates the governance voting power of the delegator to the delegate on the proposals of a topic.
+// An empty topic delegates the voting power on all proposals without a delegation for their topic.
+// A delegation replaces any previous delegation of the delegator for the same topic.
+func (k Keeper) SetVoteDelegation(ctx context.Context, delegator, delegate sdk.AccAddress, topic string) error {
+	if k.config.MaxVoteDelegationDepth == 0 {
+		return errors.Wrap(types.ErrInvalidVoteDelegation, "vote delegation is disabled")
+	}
+
+	if delegator.Equals(delegate) {
+		return errors.Wrap(types.ErrInvalidVoteDelegation, "cannot delegate voting power to self")
+	}
+
+	// reject delegations which would make the delegator vote on its own behalf
+	voter := delegate
+	for i := uint64(0); i < k.config.MaxVoteDelegationDepth; i++ {
+		next, found, err := k.getVoteDelegate(ctx, voter, topic)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			break
+		}
+
+		if next.Equals(delegator) {
+			return errors.Wrap(types.ErrInvalidVoteDelegation, "vote delegation cycle")
+		}
+		voter = next
+	}
+
+	delegatorStrAddr, err := k.authKeeper.AddressCodec().BytesToString(delegator)
+	if err != nil {
+		return err
+	}
+
+	delegateStrAddr, err := k.authKeeper.AddressCodec().BytesToString(delegate)
+	if err != nil {
+		return err
+	}
+
+	// note: the topic is not validated against the message router, so that accounts
+	// can delegate their voting power on messages which are not yet routable.
+	delegation := v1.NewVoteDelegation(delegatorStrAddr, delegateStrAddr, topic)
+	if err := k.VoteDelegations.Set(ctx, collections.Join(delegator, topic), delegation); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeDelegateVote,
+		event.NewAttribute(types.AttributeKeyDelegator, delegatorStrAddr),
+		event.NewAttribute(types.AttributeKeyDelegate, delegateStrAddr),
+		event.NewAttribute(types.AttributeKeyTopic, topic),
+	)
+}
+
+// RemoveVoteDelegation removes the vote delegation of the delegator for a topic.
+func (k Keeper) RemoveVoteDelegation(ctx context.Context, delegator sdk.AccAddress, topic string) error {
+	has, err := k.VoteDelegations.Has(ctx, collections.Join(delegator, topic))
+	if err != nil {
+		return err
+	}
+
+	if !has {
+		return errors.Wrapf(types.ErrInvalidVoteDelegation, "no vote delegation for topic %q", topic)
+	}
+
+	if err := k.VoteDelegations.Remove(ctx, collections.Join(delegator, topic)); err != nil {
+		return err
+	}
+
+	delegatorStrAddr, err := k.authKeeper.AddressCodec().BytesToString(delegator)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeUndelegateVote,
+		event.NewAttribute(types.AttributeKeyDelegator, delegatorStrAddr),
+		event.NewAttribute(types.AttributeKeyTopic, topic),
+	)
+}
+
+// getVoteDelegate returns the delegate of an account on the proposals of a topic.
+// The delegation for the topic takes precedence over the global delegation of the account.
+func (k Keeper) getVoteDelegate(ctx context.Context, delegator sdk.AccAddress, topic string) (sdk.AccAddress, bool, error) {
+	topics := []string{""}
+	if topic != "" {
+		topics = []string{topic, ""}
+	}
+
+	for _, t := range topics {
+		delegation, err := k.VoteDelegations.Get(ctx, collections.Join(delegator, t))
+		if err != nil {
+			if stderrors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+
+			return nil, false, err
+		}
+
+		delegate, err := k.authKeeper.AddressCodec().StringToBytes(delegation.Delegate)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return delegate, true, nil
+	}
+
+	return nil, false, nil
+}
+
+// proposalTopic returns the topic of a proposal, which is the type URL of its first message.
+// Proposals without messages have no topic.
+func proposalTopic(proposal v1.Proposal) string {
+	if len(proposal.Messages) == 0 {
+		return ""
+	}
+
+	return proposal.Messages[0].TypeUrl
+}
+
+// resolveDelegatedVoters returns the voter whose vote each delegator which did not vote on a proposal is tallied with.
+// The vote delegations are followed backwards from the voters of the proposal, with the delegate index, up to the
+// configured depth. A delegator is therefore tallied with the first voter found by following its vote delegations.
+// Delegators whose delegations do not lead to a vote within the depth, or lead to a cycle, are not returned.
+// The voters are keyed by address bytes and the delegators are returned in the order they are resolved.
+func (k Keeper) resolveDelegatedVoters(ctx context.Context, proposal v1.Proposal, voters []sdk.AccAddress) ([]sdk.AccAddress, map[string]sdk.AccAddress, error) {
+	if k.config.MaxVoteDelegationDepth == 0 {
+		return nil, nil, nil
+	}
+
+	topic := proposalTopic(proposal)
+	topics := []string{""}
+	if topic != "" {
+		topics = []string{topic, ""}
+	}
+
+	resolved := make(map[string]sdk.AccAddress)
+	voted := make(map[string]bool, len(voters))
+	for _, voter := range voters {
+		voted[string(voter)] = true
+	}
+
+	var resolvedDelegators []sdk.AccAddress
+	// each level holds the accounts found at the same number of vote delegations from a voter,
+	// and the voter whose vote they are tallied with
+	level, levelVoters := voters, voters
+	for depth := uint64(0); depth < k.config.MaxVoteDelegationDepth && len(level) > 0; depth++ {
+		var next, nextVoters []sdk.AccAddress
+		for i, delegate := range level {
+			for _, t := range topics {
+				iter, err := k.VoteDelegations.Indexes.Delegate.MatchExact(ctx, collections.Join(delegate, t))
+				if err != nil {
+					return nil, nil, err
+				}
+
+				delegators, err := iter.PrimaryKeys()
+				if err != nil {
+					return nil, nil, err
+				}
+
+				for _, key := range delegators {
+					delegator := key.K1()
+					// a direct vote overrides the vote delegation
+					if voted[string(delegator)] {
+						continue
+					}
+
+					if _, ok := resolved[string(delegator)]; ok {
+						continue
+					}
+
+					// the topic delegation of the delegator takes precedence over its global delegation
+					if t == "" && topic != "" {
+						has, err := k.VoteDelegations.Has(ctx, collections.Join(delegator, topic))
+						if err != nil {
+							return nil, nil, err
+						}
+
+						if has {
+							continue
+						}
+					}
+
+					resolved[string(delegator)] = levelVoters[i]
+					resolvedDelegators = append(resolvedDelegators, delegator)
+					next = append(next, delegator)
+					nextVoters = append(nextVoters, levelVoters[i])
+				}
+			}
+		}
+		level, levelVoters = next, nextVoters
+	}
+
+	return resolvedDelegators, resolved, nil
+}
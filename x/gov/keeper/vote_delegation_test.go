@@ -0,0 +1,271 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	v1 "cosmossdk.io/x/gov/types/v1"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// handy functions
+	delegateVote = func(s tallyFixture, delegator, delegate sdk.AccAddress, topic string) {
+		err := s.keeper.SetVoteDelegation(s.ctx, delegator, delegate, topic)
+		require.NoError(s.t, err)
+	}
+	// delegatedVoter mocks the delegations of an account whose voting power is tallied through a vote delegation
+	delegatedVoter = func(s tallyFixture, voter sdk.AccAddress, delegations []stakingtypes.Delegation) {
+		s.mocks.stakingKeeper.EXPECT().
+			IterateDelegations(s.ctx, voter, gomock.Any()).
+			DoAndReturn(
+				func(ctx context.Context, voter sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+					for i, d := range delegations {
+						fn(int64(i), d)
+					}
+					return nil
+				})
+	}
+	delegationsToVal0 = func(s tallyFixture, delegator sdk.AccAddress, shares int64) []stakingtypes.Delegation {
+		delAddr, err := s.mocks.acctKeeper.AddressCodec().BytesToString(delegator)
+		require.NoError(s.t, err)
+		val0Addr, err := s.mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(s.valAddrs[0])
+		require.NoError(s.t, err)
+		return []stakingtypes.Delegation{{
+			DelegatorAddress: delAddr,
+			ValidatorAddress: val0Addr,
+			Shares:           sdkmath.LegacyNewDec(shares),
+		}}
+	}
+)
+
+func TestVoteDelegations(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	addrs := simtestutil.CreateRandomAccounts(4)
+	topic := sdk.MsgTypeURL(&v1.MsgUpdateParams{})
+
+	require.ErrorContains(t, govKeeper.SetVoteDelegation(ctx, addrs[0], addrs[0], ""), "cannot delegate voting power to self")
+
+	require.NoError(t, govKeeper.SetVoteDelegation(ctx, addrs[0], addrs[1], ""))
+	require.NoError(t, govKeeper.SetVoteDelegation(ctx, addrs[1], addrs[2], topic))
+	delegator, err := mocks.acctKeeper.AddressCodec().BytesToString(addrs[0])
+	require.NoError(t, err)
+	delegate, err := mocks.acctKeeper.AddressCodec().BytesToString(addrs[1])
+	require.NoError(t, err)
+	delegation, err := govKeeper.VoteDelegations.Get(ctx, collections.Join(addrs[0], ""))
+	require.NoError(t, err)
+	require.Equal(t, v1.NewVoteDelegation(delegator, delegate, ""), delegation)
+
+	// addrs[2] -> addrs[0] -> addrs[1] -> addrs[2] is a cycle for the topic, but not globally
+	require.ErrorContains(t, govKeeper.SetVoteDelegation(ctx, addrs[2], addrs[0], topic), "vote delegation cycle")
+	require.NoError(t, govKeeper.SetVoteDelegation(ctx, addrs[2], addrs[0], ""))
+	require.ErrorContains(t, govKeeper.SetVoteDelegation(ctx, addrs[1], addrs[2], ""), "vote delegation cycle")
+
+	// a delegation replaces the previous delegation of the topic
+	require.NoError(t, govKeeper.SetVoteDelegation(ctx, addrs[1], addrs[3], topic))
+	delegation, err = govKeeper.VoteDelegations.Get(ctx, collections.Join(addrs[1], topic))
+	require.NoError(t, err)
+	delegate, err = mocks.acctKeeper.AddressCodec().BytesToString(addrs[3])
+	require.NoError(t, err)
+	require.Equal(t, delegate, delegation.Delegate)
+
+	// the vote delegations are indexed by delegate and topic
+	delegatorsOf := func(delegate sdk.AccAddress, topic string) []collections.Pair[sdk.AccAddress, string] {
+		iter, err := govKeeper.VoteDelegations.Indexes.Delegate.MatchExact(ctx, collections.Join(delegate, topic))
+		require.NoError(t, err)
+		keys, err := iter.PrimaryKeys()
+		require.NoError(t, err)
+		return keys
+	}
+	require.Equal(t, []collections.Pair[sdk.AccAddress, string]{collections.Join(addrs[1], topic)}, delegatorsOf(addrs[3], topic))
+	require.Empty(t, delegatorsOf(addrs[2], topic))
+
+	require.ErrorContains(t, govKeeper.RemoveVoteDelegation(ctx, addrs[1], ""), "no vote delegation")
+	require.NoError(t, govKeeper.RemoveVoteDelegation(ctx, addrs[1], topic))
+	has, err := govKeeper.VoteDelegations.Has(ctx, collections.Join(addrs[1], topic))
+	require.NoError(t, err)
+	require.False(t, has)
+	require.Empty(t, delegatorsOf(addrs[3], topic))
+}
+
+func TestTally_VoteDelegations(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func(tallyFixture)
+		expectedPass  bool
+		expectedBurn  bool
+		expectedTally v1.TallyResult
+	}{
+		{
+			name: "delegator is tallied with the vote of its delegate instead of its validator",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				delegateVote(s, s.delAddrs[0], s.delAddrs[1], "")
+				delegatedVoter(s, s.delAddrs[0], delegationsToVal0(s, s.delAddrs[0], 42))
+				delegatorVote(s, s.delAddrs[1], nil, v1.VoteOption_VOTE_OPTION_THREE)
+				for i := 0; i < 4; i++ {
+					validatorVote(s, s.valAddrs[i], v1.VoteOption_VOTE_OPTION_ONE)
+				}
+			},
+			expectedPass:  true,
+			expectedBurn:  false,
+			expectedTally: *ballotTallyResult(3999958, 0, 42, 0, 0),
+		},
+		{
+			name: "direct vote overrides the vote delegation",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				delegateVote(s, s.delAddrs[0], s.delAddrs[1], "")
+				delegatorVote(s, s.delAddrs[0], delegationsToVal0(s, s.delAddrs[0], 42), v1.VoteOption_VOTE_OPTION_ONE)
+				delegatorVote(s, s.delAddrs[1], nil, v1.VoteOption_VOTE_OPTION_THREE)
+				for i := 0; i < 4; i++ {
+					validatorVote(s, s.valAddrs[i], v1.VoteOption_VOTE_OPTION_ONE)
+				}
+			},
+			expectedPass:  true,
+			expectedBurn:  false,
+			expectedTally: *ballotTallyResult(4000000, 0, 0, 0, 0),
+		},
+		{
+			name: "vote delegations are transitive up to the depth limit",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				// delAddrs[0] is 4 delegations away from the vote of delAddrs[4], delAddrs[1] is 3 delegations away
+				for i := 0; i < 4; i++ {
+					delegateVote(s, s.delAddrs[i], s.delAddrs[i+1], "")
+				}
+				delegatedVoter(s, s.delAddrs[1], delegationsToVal0(s, s.delAddrs[1], 42))
+				delegatedVoter(s, s.delAddrs[2], nil)
+				delegatedVoter(s, s.delAddrs[3], nil)
+				delegatorVote(s, s.delAddrs[4], nil, v1.VoteOption_VOTE_OPTION_THREE)
+				for i := 0; i < 4; i++ {
+					validatorVote(s, s.valAddrs[i], v1.VoteOption_VOTE_OPTION_ONE)
+				}
+			},
+			expectedPass:  true,
+			expectedBurn:  false,
+			expectedTally: *ballotTallyResult(3999958, 0, 42, 0, 0),
+		},
+		{
+			name: "topic vote delegation overrides the global vote delegation",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				delegateVote(s, s.delAddrs[0], s.delAddrs[1], "")
+				delegateVote(s, s.delAddrs[0], s.delAddrs[2], s.proposal.Messages[0].TypeUrl)
+				delegateVote(s, s.delAddrs[3], s.delAddrs[1], "/cosmos.gov.v1.MsgUpdateParams")
+				delegatedVoter(s, s.delAddrs[0], delegationsToVal0(s, s.delAddrs[0], 42))
+				delegatorVote(s, s.delAddrs[1], nil, v1.VoteOption_VOTE_OPTION_ONE)
+				delegatorVote(s, s.delAddrs[2], nil, v1.VoteOption_VOTE_OPTION_THREE)
+				for i := 0; i < 4; i++ {
+					validatorVote(s, s.valAddrs[i], v1.VoteOption_VOTE_OPTION_ONE)
+				}
+			},
+			expectedPass:  true,
+			expectedBurn:  false,
+			expectedTally: *ballotTallyResult(3999958, 0, 42, 0, 0),
+		},
+		{
+			name: "vote delegation cycle is not tallied",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				delegateVote(s, s.delAddrs[0], s.delAddrs[1], "")
+				// cycles are rejected on delegation, but can still arise from topic and global delegations
+				del0Addr, err := s.mocks.acctKeeper.AddressCodec().BytesToString(s.delAddrs[0])
+				require.NoError(s.t, err)
+				del1Addr, err := s.mocks.acctKeeper.AddressCodec().BytesToString(s.delAddrs[1])
+				require.NoError(s.t, err)
+				topic := s.proposal.Messages[0].TypeUrl
+				err = s.keeper.VoteDelegations.Set(s.ctx, collections.Join(s.delAddrs[1], topic), v1.NewVoteDelegation(del1Addr, del0Addr, topic))
+				require.NoError(s.t, err)
+				for i := 0; i < 4; i++ {
+					validatorVote(s, s.valAddrs[i], v1.VoteOption_VOTE_OPTION_ONE)
+				}
+			},
+			expectedPass:  true,
+			expectedBurn:  false,
+			expectedTally: *ballotTallyResult(4000000, 0, 0, 0, 0),
+		},
+		{
+			name: "validator which delegated its vote is tallied with the vote of its delegate",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				delegateVote(s, sdk.AccAddress(s.valAddrs[0]), s.delAddrs[0], "")
+				delegatedVoter(s, sdk.AccAddress(s.valAddrs[0]), nil)
+				delegatorVote(s, s.delAddrs[0], nil, v1.VoteOption_VOTE_OPTION_THREE)
+				for i := 1; i < 4; i++ {
+					validatorVote(s, s.valAddrs[i], v1.VoteOption_VOTE_OPTION_ONE)
+				}
+			},
+			expectedPass:  true,
+			expectedBurn:  false,
+			expectedTally: *ballotTallyResult(3000000, 0, 1000000, 0, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+			params := v1.DefaultParams()
+			// Ensure params value are different than false
+			params.BurnVoteQuorum = true
+			params.BurnVoteVeto = true
+			err := govKeeper.Params.Set(ctx, params)
+			require.NoError(t, err)
+			var (
+				numVals       = 10
+				numDelegators = 5
+				addrs         = simtestutil.CreateRandomAccounts(numVals + numDelegators)
+				valAddrs      = simtestutil.ConvertAddrsToValAddrs(addrs[:numVals])
+				delAddrs      = addrs[numVals:]
+			)
+			// Mocks a bunch of validators
+			mocks.stakingKeeper.EXPECT().
+				IterateBondedValidatorsByPower(ctx, gomock.Any()).
+				DoAndReturn(
+					func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+						for i := int64(0); i < int64(numVals); i++ {
+							valAddr, err := mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddrs[i])
+							require.NoError(t, err)
+							fn(i, stakingtypes.Validator{
+								OperatorAddress: valAddr,
+								Status:          stakingtypes.Bonded,
+								Tokens:          sdkmath.NewInt(1000000),
+								DelegatorShares: sdkmath.LegacyNewDec(1000000),
+							})
+						}
+						return nil
+					})
+
+			// Submit and activate a proposal
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+			require.NoError(t, err)
+			err = govKeeper.ActivateVotingPeriod(ctx, proposal)
+			require.NoError(t, err)
+			suite := tallyFixture{
+				t:        t,
+				proposal: proposal,
+				valAddrs: valAddrs,
+				delAddrs: delAddrs,
+				ctx:      ctx,
+				keeper:   govKeeper,
+				mocks:    mocks,
+			}
+			tt.setup(suite)
+
+			pass, burn, tally, err := govKeeper.Tally(ctx, proposal)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPass, pass, "wrong pass")
+			assert.Equal(t, tt.expectedBurn, burn, "wrong burn")
+			assert.Equal(t, tt.expectedTally, tally)
+		})
+	}
+}
@@ -28,4 +28,8 @@ var (
 	ErrTitleTooLong            = errors.Register(ModuleName, 24, "title too long")
 	ErrTooLateToCancel         = errors.Register(ModuleName, 25, "too late to cancel proposal")
 	ErrTooManyVoteOptions      = errors.Register(ModuleName, 26, "too many weighted vote options")
+	ErrUnknownTallyStrategy    = errors.Register(ModuleName, 27, "unknown tally strategy")
+	ErrInvalidTallyParams      = errors.Register(ModuleName, 28, "invalid tally strategy params")
+	ErrInvalidBallot           = errors.Register(ModuleName, 29, "invalid ballot")
+	ErrInvalidVoteDelegation   = errors.Register(ModuleName, 30, "invalid vote delegation")
 )
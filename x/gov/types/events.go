@@ -8,6 +8,8 @@ const (
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
 	EventTypeCancelProposal   = "cancel_proposal"
+	EventTypeDelegateVote     = "delegate_vote"
+	EventTypeUndelegateVote   = "undelegate_vote"
 
 	AttributeKeyProposalResult       = "proposal_result"
 	AttributeKeyVoter                = "voter"
@@ -19,6 +21,9 @@ const (
 	AttributeKeyProposalLog          = "proposal_log"           // log of proposal execution
 	AttributeKeyProposalDepositError = "proposal_deposit_error" // error on proposal deposit refund/burn
 	AttributeKeyProposalProposer     = "proposal_proposer"      // account address of the proposer
+	AttributeKeyDelegator            = "delegator"              // account address delegating its voting power
+	AttributeKeyDelegate             = "delegate"               // account address voting on behalf of the delegator
+	AttributeKeyTopic                = "topic"                  // message type URL of the proposals a vote delegation applies to
 
 	AttributeValueProposalDropped            = "proposal_dropped"             // didn't meet min deposit
 	AttributeValueProposalPassed             = "proposal_passed"              // met vote quorum
@@ -22,10 +22,15 @@ var (
 	ProposalIDKey                = collections.NewPrefix(3)  // ProposalIDKey stores the sequence representing the next proposal ID.
 	DepositsKeyPrefix            = collections.NewPrefix(16) // DepositsKeyPrefix stores deposits.
 	VotesKeyPrefix               = collections.NewPrefix(32) // VotesKeyPrefix stores the votes of proposals.
+	BallotsKeyPrefix             = collections.NewPrefix(33) // BallotsKeyPrefix stores the ballots of ranked-choice and approval proposals.
+	VoteDelegationsKeyPrefix     = collections.NewPrefix(34) // VoteDelegationsKeyPrefix stores the vote delegations of accounts.
+	VoteDelegationsByDelegateKey = collections.NewPrefix(35) // VoteDelegationsByDelegateKey indexes the vote delegations by delegate and topic.
 	ParamsKey                    = collections.NewPrefix(48) // ParamsKey stores the module's params.
 	ConstitutionKey              = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
 	ProposalVoteOptionsKeyPrefix = collections.NewPrefix(50) // ProposalVoteOptionsKeyPrefix stores the vote options of proposals.
 	MessageBasedParamsKey        = collections.NewPrefix(51) // MessageBasedParamsKey stores the message based gov params.
+	TallyStrategyParamsKey       = collections.NewPrefix(52) // TallyStrategyParamsKey stores the params of the tally strategies.
+	BallotTallyResultsKeyPrefix  = collections.NewPrefix(53) // BallotTallyResultsKeyPrefix stores the final ballot tally of ranked-choice and approval proposals.
 )
 
 // Reserved kvstore keys
@@ -21,6 +21,11 @@ func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/gov/v1/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateMessageParams{}, "x/gov/v1/MsgUpdateMessageParams")
 	legacy.RegisterAminoMsg(cdc, &MsgSudoExec{}, "cosmos-sdk/x/gov/v1/MsgSudoExec")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateTallyStrategyParams{}, "x/gov/v1/MsgUpdateTallyStrategyParams")
+	legacy.RegisterAminoMsg(cdc, &MsgVoteRankedChoice{}, "x/gov/v1/MsgVoteRankedChoice")
+	legacy.RegisterAminoMsg(cdc, &MsgVoteApproval{}, "x/gov/v1/MsgVoteApproval")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateVote{}, "x/gov/v1/MsgDelegateVote")
+	legacy.RegisterAminoMsg(cdc, &MsgUndelegateVote{}, "x/gov/v1/MsgUndelegateVote")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -35,6 +40,11 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgUpdateParams{},
 		&MsgUpdateMessageParams{},
 		&MsgSudoExec{},
+		&MsgUpdateTallyStrategyParams{},
+		&MsgVoteRankedChoice{},
+		&MsgVoteApproval{},
+		&MsgDelegateVote{},
+		&MsgUndelegateVote{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
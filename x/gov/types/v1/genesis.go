@@ -34,7 +34,7 @@ func (data GenesisState) Empty() bool {
 // ValidateGenesis checks if gov genesis state is valid ranges
 // It checks if params are in valid ranges
 // It also makes sure that the provided proposal IDs are unique and
-// that there are no duplicate deposit or vote records and no vote or deposits for non-existent proposals
+// that there are no duplicate deposit, vote, ballot or vote delegation records and no vote, ballot or deposits for non-existent proposals
 func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 	if data.StartingProposalId == 0 {
 		return errors.New("starting proposal id must be greater than 0")
@@ -98,6 +98,105 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 		return nil
 	})
 
+	// weed out duplicate ballots
+	errGroup.Go(func() error {
+		type ballotKey struct {
+			ProposalId uint64
+			Voter      string
+		}
+		ballotIds := make(map[ballotKey]struct{})
+		for _, b := range data.Ballots {
+			if _, ok := proposalIds[b.ProposalId]; !ok {
+				return fmt.Errorf("ballot %v has non-existent proposal id: %d", b, b.ProposalId)
+			}
+
+			bk := ballotKey{b.ProposalId, b.Voter}
+			if _, ok := ballotIds[bk]; ok {
+				return fmt.Errorf("duplicate ballot: %v", b)
+			}
+
+			ballotIds[bk] = struct{}{}
+		}
+
+		return nil
+	})
+
+	// weed out duplicate proposal vote options and ballot tally results
+	errGroup.Go(func() error {
+		voteOptionsIds := make(map[uint64]struct{})
+		for _, o := range data.ProposalVoteOptions {
+			if _, ok := proposalIds[o.ProposalId]; !ok {
+				return fmt.Errorf("vote options have non-existent proposal id: %d", o.ProposalId)
+			}
+
+			if o.VoteOptions == nil {
+				return fmt.Errorf("empty vote options for proposal id: %d", o.ProposalId)
+			}
+
+			if _, ok := voteOptionsIds[o.ProposalId]; ok {
+				return fmt.Errorf("duplicate vote options for proposal id: %d", o.ProposalId)
+			}
+
+			voteOptionsIds[o.ProposalId] = struct{}{}
+		}
+
+		tallyIds := make(map[uint64]struct{})
+		for _, r := range data.BallotTallyResults {
+			if _, ok := proposalIds[r.ProposalId]; !ok {
+				return fmt.Errorf("ballot tally result has non-existent proposal id: %d", r.ProposalId)
+			}
+
+			if r.Tally == nil {
+				return fmt.Errorf("empty ballot tally result for proposal id: %d", r.ProposalId)
+			}
+
+			if _, ok := tallyIds[r.ProposalId]; ok {
+				return fmt.Errorf("duplicate ballot tally result for proposal id: %d", r.ProposalId)
+			}
+
+			tallyIds[r.ProposalId] = struct{}{}
+		}
+
+		return nil
+	})
+
+	// weed out duplicate and self vote delegations
+	errGroup.Go(func() error {
+		type delegationKey struct {
+			Delegator string
+			Topic     string
+		}
+		delegationIds := make(map[delegationKey]struct{})
+		for _, d := range data.VoteDelegations {
+			if d.Delegator == d.Delegate {
+				return fmt.Errorf("vote delegation to self: %v", d)
+			}
+
+			dk := delegationKey{d.Delegator, d.Topic}
+			if _, ok := delegationIds[dk]; ok {
+				return fmt.Errorf("duplicate vote delegation: %v", d)
+			}
+
+			delegationIds[dk] = struct{}{}
+		}
+
+		return nil
+	})
+
+	// weed out duplicate tally strategy params
+	errGroup.Go(func() error {
+		strategies := make(map[string]struct{})
+		for _, strategy := range data.TallyStrategyParams {
+			if _, ok := strategies[strategy.Name]; ok {
+				return fmt.Errorf("duplicate tally strategy params: %s", strategy.Name)
+			}
+
+			strategies[strategy.Name] = struct{}{}
+		}
+
+		return nil
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic(ac)
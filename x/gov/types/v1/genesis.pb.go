@@ -49,6 +49,16 @@ type GenesisState struct {
 	// There are no amendments, to go outside of scope, just fork.
 	// constitution is an immutable string in genesis for a chain builder to lay out their vision, ideas and ideals.
 	Constitution string `protobuf:"bytes,9,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// vote_delegations defines all the vote delegations present at genesis.
+	VoteDelegations []*VoteDelegation `protobuf:"bytes,10,rep,name=vote_delegations,json=voteDelegations,proto3" json:"vote_delegations,omitempty"`
+	// tally_strategy_params defines the params set by governance for the tally strategies at genesis.
+	TallyStrategyParams []*TallyStrategyInfo `protobuf:"bytes,11,rep,name=tally_strategy_params,json=tallyStrategyParams,proto3" json:"tally_strategy_params,omitempty"`
+	// proposal_vote_options defines the vote options of the multiple choice proposals present at genesis.
+	ProposalVoteOptions []*GenesisProposalVoteOptions `protobuf:"bytes,12,rep,name=proposal_vote_options,json=proposalVoteOptions,proto3" json:"proposal_vote_options,omitempty"`
+	// ballots defines all the ballots present at genesis.
+	Ballots []*Ballot `protobuf:"bytes,13,rep,name=ballots,proto3" json:"ballots,omitempty"`
+	// ballot_tally_results defines the results of the tallied ballots present at genesis.
+	BallotTallyResults []*GenesisBallotTallyResult `protobuf:"bytes,14,rep,name=ballot_tally_results,json=ballotTallyResults,proto3" json:"ballot_tally_results,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -150,39 +160,200 @@ func (m *GenesisState) GetConstitution() string {
 	return ""
 }
 
+func (m *GenesisState) GetVoteDelegations() []*VoteDelegation {
+	if m != nil {
+		return m.VoteDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTallyStrategyParams() []*TallyStrategyInfo {
+	if m != nil {
+		return m.TallyStrategyParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposalVoteOptions() []*GenesisProposalVoteOptions {
+	if m != nil {
+		return m.ProposalVoteOptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBallots() []*Ballot {
+	if m != nil {
+		return m.Ballots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBallotTallyResults() []*GenesisBallotTallyResult {
+	if m != nil {
+		return m.BallotTallyResults
+	}
+	return nil
+}
+
+// GenesisProposalVoteOptions defines the vote options of a multiple choice proposal in the genesis state.
+type GenesisProposalVoteOptions struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// vote_options defines the vote options of the proposal.
+	VoteOptions *ProposalVoteOptions `protobuf:"bytes,2,opt,name=vote_options,json=voteOptions,proto3" json:"vote_options,omitempty"`
+}
+
+func (m *GenesisProposalVoteOptions) Reset()         { *m = GenesisProposalVoteOptions{} }
+func (m *GenesisProposalVoteOptions) String() string { return proto.CompactTextString(m) }
+func (*GenesisProposalVoteOptions) ProtoMessage()    {}
+func (*GenesisProposalVoteOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef7cfd15e3ded621, []int{1}
+}
+func (m *GenesisProposalVoteOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisProposalVoteOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisProposalVoteOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisProposalVoteOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisProposalVoteOptions.Merge(m, src)
+}
+func (m *GenesisProposalVoteOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisProposalVoteOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisProposalVoteOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisProposalVoteOptions proto.InternalMessageInfo
+
+func (m *GenesisProposalVoteOptions) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *GenesisProposalVoteOptions) GetVoteOptions() *ProposalVoteOptions {
+	if m != nil {
+		return m.VoteOptions
+	}
+	return nil
+}
+
+// GenesisBallotTallyResult defines the result of the tally of the ballots of a proposal in the genesis state.
+type GenesisBallotTallyResult struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// tally defines the round-by-round tally of the ballots of the proposal.
+	Tally *BallotTallyResult `protobuf:"bytes,2,opt,name=tally,proto3" json:"tally,omitempty"`
+}
+
+func (m *GenesisBallotTallyResult) Reset()         { *m = GenesisBallotTallyResult{} }
+func (m *GenesisBallotTallyResult) String() string { return proto.CompactTextString(m) }
+func (*GenesisBallotTallyResult) ProtoMessage()    {}
+func (*GenesisBallotTallyResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef7cfd15e3ded621, []int{2}
+}
+func (m *GenesisBallotTallyResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisBallotTallyResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisBallotTallyResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisBallotTallyResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisBallotTallyResult.Merge(m, src)
+}
+func (m *GenesisBallotTallyResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisBallotTallyResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisBallotTallyResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisBallotTallyResult proto.InternalMessageInfo
+
+func (m *GenesisBallotTallyResult) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *GenesisBallotTallyResult) GetTally() *BallotTallyResult {
+	if m != nil {
+		return m.Tally
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1.GenesisState")
+	proto.RegisterType((*GenesisProposalVoteOptions)(nil), "cosmos.gov.v1.GenesisProposalVoteOptions")
+	proto.RegisterType((*GenesisBallotTallyResult)(nil), "cosmos.gov.v1.GenesisBallotTallyResult")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/genesis.proto", fileDescriptor_ef7cfd15e3ded621) }
 
 var fileDescriptor_ef7cfd15e3ded621 = []byte{
-	// 400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xbd, 0x6e, 0xe2, 0x40,
-	0x14, 0x85, 0x19, 0xfe, 0x16, 0x06, 0xb3, 0x2b, 0x0d, 0xbb, 0x8b, 0x17, 0x56, 0x96, 0xb5, 0x95,
-	0xb7, 0xc0, 0x06, 0x12, 0x44, 0x1d, 0x84, 0x84, 0xd2, 0x21, 0x27, 0x4a, 0x91, 0x06, 0x39, 0xd8,
-	0xb2, 0x2c, 0xc0, 0x63, 0x71, 0x27, 0xa3, 0xf0, 0x16, 0x79, 0x89, 0xbc, 0x41, 0x1e, 0x22, 0x25,
-	0x65, 0x94, 0x2a, 0x82, 0x17, 0x89, 0x98, 0xb1, 0x03, 0x38, 0x94, 0x73, 0xcf, 0x77, 0xce, 0x1c,
-	0x5d, 0x5d, 0xdc, 0x9c, 0x52, 0x58, 0x50, 0xb0, 0x7c, 0xca, 0x2d, 0xde, 0xb1, 0x7c, 0x2f, 0xf4,
-	0x20, 0x00, 0x33, 0x5a, 0x52, 0x46, 0x49, 0x55, 0x8a, 0xa6, 0x4f, 0xb9, 0xc9, 0x3b, 0x8d, 0x7a,
-	0x8a, 0xa5, 0x5c, 0x72, 0x8d, 0x3f, 0x52, 0x98, 0x88, 0x97, 0x15, 0x9b, 0xc4, 0xe3, 0xdf, 0x53,
-	0x1e, 0x2b, 0x23, 0x19, 0x7a, 0xc5, 0x1c, 0xe6, 0x91, 0x36, 0xfe, 0x09, 0xcc, 0x59, 0xb2, 0x20,
-	0xf4, 0x77, 0x7c, 0x44, 0xc1, 0x99, 0x4f, 0x02, 0x57, 0x45, 0x3a, 0x32, 0xf2, 0x36, 0x49, 0xb4,
-	0x71, 0x2c, 0x5d, 0xba, 0xa4, 0x8b, 0x4b, 0xae, 0x17, 0x51, 0x08, 0x18, 0xa8, 0x59, 0x3d, 0x67,
-	0x54, 0xba, 0xbf, 0xcd, 0xa3, 0x62, 0xe6, 0x50, 0xca, 0xf6, 0x27, 0x47, 0xfe, 0xe3, 0x02, 0xa7,
-	0xcc, 0x03, 0x35, 0x27, 0x0c, 0xb5, 0x94, 0xe1, 0x86, 0x32, 0xcf, 0x96, 0x04, 0xe9, 0xe1, 0x72,
-	0xd2, 0x03, 0xd4, 0xbc, 0xc0, 0xeb, 0x29, 0x3c, 0x29, 0x63, 0xef, 0x49, 0x32, 0xc2, 0xdf, 0xe3,
-	0xdf, 0x26, 0x91, 0xb3, 0x74, 0x16, 0xa0, 0x16, 0x74, 0x64, 0x54, 0xba, 0x7f, 0x4f, 0x77, 0x1b,
-	0x0b, 0x66, 0x90, 0x55, 0x91, 0x5d, 0x75, 0x0f, 0x47, 0x64, 0x88, 0xab, 0x9c, 0xca, 0x75, 0xc8,
-	0x9c, 0xa2, 0xc8, 0x69, 0x7e, 0xad, 0xbc, 0x5b, 0xcb, 0x3e, 0x46, 0xe1, 0x07, 0x13, 0x72, 0x81,
-	0x15, 0xe6, 0xcc, 0xe7, 0xab, 0x24, 0xe4, 0x9b, 0x08, 0x69, 0xa4, 0x42, 0xae, 0x77, 0xc8, 0x41,
-	0x46, 0x85, 0xed, 0x07, 0x64, 0x80, 0x8b, 0xb1, 0xb9, 0x24, 0xcc, 0xbf, 0xd2, 0x5b, 0x90, 0xbe,
-	0xda, 0xdb, 0x73, 0xeb, 0x87, 0x54, 0x5a, 0xe0, 0xce, 0xf4, 0xb6, 0x79, 0xde, 0xb7, 0x63, 0x27,
-	0xe9, 0x63, 0x65, 0x4a, 0x43, 0x60, 0x01, 0xbb, 0x67, 0x01, 0x0d, 0xd5, 0xb2, 0x8e, 0x8c, 0xf2,
-	0x09, 0x4b, 0xaf, 0x6d, 0x1f, 0x81, 0x83, 0xde, 0xcb, 0x46, 0x43, 0xeb, 0x8d, 0x86, 0xde, 0x37,
-	0x1a, 0x7a, 0xdc, 0x6a, 0x99, 0xf5, 0x56, 0xcb, 0xbc, 0x6e, 0xb5, 0xcc, 0x6d, 0x7c, 0xa1, 0xe0,
-	0xce, 0xcc, 0x80, 0x5a, 0x0f, 0xe2, 0xfa, 0xd8, 0x2a, 0xf2, 0xc0, 0xe2, 0x9d, 0xbb, 0xa2, 0xb8,
-	0xb2, 0xb3, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x79, 0xe0, 0xfa, 0xb1, 0xc7, 0x02, 0x00, 0x00,
+	// 624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x6d, 0xda, 0xb5, 0x6b, 0x9d, 0x74, 0x45, 0xee, 0xca, 0x42, 0x07, 0x21, 0xea, 0x85, 0xee,
+	0xd0, 0xa4, 0x2d, 0x94, 0x49, 0x9c, 0xa0, 0x2a, 0x9a, 0x76, 0x62, 0xca, 0x10, 0x12, 0x5c, 0xaa,
+	0x74, 0x31, 0x51, 0x58, 0x16, 0x47, 0xb5, 0x17, 0xb1, 0x3b, 0xdc, 0xb9, 0xf0, 0x4f, 0xf6, 0x23,
+	0x38, 0x4e, 0x9c, 0xd0, 0x4e, 0xa8, 0xfd, 0x23, 0x28, 0x76, 0xd2, 0xa6, 0x49, 0x2a, 0x6e, 0xb1,
+	0xbf, 0xf7, 0xde, 0xf7, 0xfc, 0x3e, 0xc7, 0xe0, 0xf0, 0x02, 0x93, 0x2b, 0x4c, 0x74, 0x1b, 0x07,
+	0x7a, 0x30, 0xd0, 0x6d, 0xe4, 0x21, 0xe2, 0x10, 0xcd, 0x9f, 0x63, 0x8a, 0x61, 0x9d, 0x17, 0x35,
+	0x1b, 0x07, 0x5a, 0x30, 0x68, 0x1f, 0xa4, 0xb0, 0x38, 0xe0, 0xb8, 0xf6, 0x23, 0x5e, 0x98, 0xb2,
+	0x95, 0x1e, 0x91, 0xd8, 0xa2, 0xf3, 0xbd, 0x0a, 0xa4, 0x13, 0x2e, 0x7a, 0x4e, 0x4d, 0x8a, 0x60,
+	0x1f, 0xec, 0x13, 0x6a, 0xce, 0xa9, 0xe3, 0xd9, 0x21, 0xde, 0xc7, 0xc4, 0x74, 0xa7, 0x8e, 0x25,
+	0x0b, 0xaa, 0xd0, 0xdd, 0x31, 0x60, 0x5c, 0x3b, 0x8b, 0x4a, 0xa7, 0x16, 0x1c, 0x82, 0xaa, 0x85,
+	0x7c, 0x4c, 0x1c, 0x4a, 0xe4, 0xa2, 0x5a, 0xea, 0x8a, 0xc3, 0x87, 0xda, 0x86, 0x31, 0x6d, 0xc2,
+	0xcb, 0xc6, 0x0a, 0x07, 0x8f, 0x40, 0x39, 0xc0, 0x14, 0x11, 0xb9, 0xc4, 0x08, 0xcd, 0x14, 0xe1,
+	0x03, 0xa6, 0xc8, 0xe0, 0x08, 0x38, 0x02, 0xb5, 0xd8, 0x07, 0x91, 0x77, 0x18, 0xfc, 0x20, 0x05,
+	0x8f, 0xcd, 0x18, 0x6b, 0x24, 0x3c, 0x01, 0x7b, 0x51, 0xb7, 0xa9, 0x6f, 0xce, 0xcd, 0x2b, 0x22,
+	0x97, 0x55, 0xa1, 0x2b, 0x0e, 0x1f, 0xe7, 0x7b, 0x3b, 0x63, 0x98, 0x71, 0x51, 0x16, 0x8c, 0xba,
+	0x95, 0xdc, 0x82, 0x13, 0x50, 0x0f, 0x30, 0x8f, 0x83, 0xeb, 0x54, 0x98, 0xce, 0x61, 0xd6, 0x72,
+	0x18, 0xcb, 0x5a, 0x46, 0x0a, 0x12, 0x3b, 0xf0, 0x0d, 0x90, 0xa8, 0xe9, 0xba, 0x37, 0xb1, 0xc8,
+	0x2e, 0x13, 0x69, 0xa7, 0x44, 0xde, 0x87, 0x90, 0x84, 0x86, 0x48, 0xd7, 0x1b, 0x70, 0x0c, 0x2a,
+	0x11, 0xb9, 0xca, 0xc8, 0xad, 0x74, 0x0a, 0x9c, 0xd7, 0xbc, 0xbf, 0xed, 0x35, 0x78, 0xa5, 0x47,
+	0xac, 0x4b, 0xb5, 0xaf, 0xbd, 0x38, 0x36, 0x22, 0x26, 0x3c, 0x06, 0xd2, 0x05, 0xf6, 0x08, 0x75,
+	0xe8, 0x35, 0x75, 0xb0, 0x27, 0xd7, 0x54, 0xa1, 0x5b, 0xcb, 0xa1, 0x8c, 0xfa, 0xc6, 0x06, 0x10,
+	0x7e, 0x04, 0x0f, 0xc2, 0x71, 0x4c, 0x2d, 0xe4, 0x22, 0xdb, 0x0c, 0xb7, 0x88, 0x0c, 0xd8, 0x30,
+	0x9e, 0xe4, 0xcc, 0x6e, 0xb2, 0x42, 0x8d, 0x1b, 0xf7, 0xb7, 0x3d, 0xf1, 0x6b, 0x78, 0x19, 0xd5,
+	0x81, 0xd6, 0xd7, 0xfa, 0x46, 0x23, 0xd8, 0x00, 0x10, 0x88, 0x40, 0x8b, 0x47, 0x43, 0xe8, 0xdc,
+	0xa4, 0xc8, 0x5e, 0x65, 0x24, 0x32, 0x7d, 0x35, 0x2f, 0xa3, 0xf3, 0x08, 0x7a, 0xea, 0x7d, 0xc6,
+	0xd9, 0x16, 0x4d, 0x9a, 0xc4, 0x44, 0xf1, 0x79, 0xa0, 0xb5, 0xba, 0xcf, 0xec, 0x28, 0xd8, 0xe7,
+	0xc7, 0x90, 0x58, 0x9b, 0xa3, 0x54, 0x9b, 0xe8, 0xa7, 0x88, 0xaf, 0x56, 0x78, 0xaa, 0x77, 0x9c,
+	0x90, 0xd3, 0xcf, 0xcf, 0xa2, 0xe0, 0x6b, 0xb0, 0x3b, 0x33, 0x5d, 0x17, 0x53, 0x22, 0xd7, 0xd5,
+	0x52, 0xce, 0xbc, 0xc6, 0xac, 0x9a, 0x55, 0x8b, 0x69, 0xf0, 0x0b, 0xd8, 0xe7, 0x9f, 0x53, 0x9e,
+	0xcf, 0x1c, 0x91, 0x6b, 0x97, 0x12, 0x79, 0x8f, 0xc9, 0x3d, 0xcb, 0x37, 0xcc, 0x55, 0x59, 0x48,
+	0x06, 0xc3, 0x67, 0x1b, 0xc0, 0x59, 0x1a, 0x43, 0x3a, 0x3f, 0x05, 0xd0, 0xde, 0x7e, 0x64, 0xf8,
+	0x14, 0x88, 0xd9, 0xc7, 0x00, 0xf8, 0xeb, 0x47, 0xe0, 0x2d, 0x90, 0x36, 0x42, 0x2d, 0xb2, 0x2b,
+	0xda, 0xd9, 0xf2, 0xa3, 0x26, 0xa4, 0x0d, 0x31, 0x58, 0x2f, 0x5e, 0x35, 0x7e, 0x6f, 0x7a, 0xed,
+	0x7c, 0x13, 0x80, 0xbc, 0xed, 0x64, 0xff, 0x77, 0xf5, 0x12, 0x94, 0x59, 0x74, 0x91, 0x1d, 0x35,
+	0x77, 0x02, 0x09, 0x45, 0x83, 0xc3, 0x33, 0x36, 0xc6, 0xa3, 0x5f, 0x0b, 0x45, 0xb8, 0x5b, 0x28,
+	0xc2, 0xdf, 0x85, 0x22, 0xfc, 0x58, 0x2a, 0x85, 0xbb, 0xa5, 0x52, 0xf8, 0xb3, 0x54, 0x0a, 0x9f,
+	0xa2, 0x07, 0x9a, 0x58, 0x97, 0x9a, 0x83, 0x75, 0xc6, 0xd1, 0xe9, 0x8d, 0x8f, 0x88, 0x1e, 0x0c,
+	0x66, 0x15, 0xf6, 0xc8, 0x3e, 0xff, 0x37, 0x00, 0x77, 0xfb, 0xe0, 0xa2, 0xc6, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -205,6 +376,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BallotTallyResults) > 0 {
+		for iNdEx := len(m.BallotTallyResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BallotTallyResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Ballots) > 0 {
+		for iNdEx := len(m.Ballots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ballots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ProposalVoteOptions) > 0 {
+		for iNdEx := len(m.ProposalVoteOptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalVoteOptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.TallyStrategyParams) > 0 {
+		for iNdEx := len(m.TallyStrategyParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TallyStrategyParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.VoteDelegations) > 0 {
+		for iNdEx := len(m.VoteDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Constitution) > 0 {
 		i -= len(m.Constitution)
 		copy(dAtA[i:], m.Constitution)
@@ -310,6 +551,86 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisProposalVoteOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisProposalVoteOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisProposalVoteOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VoteOptions != nil {
+		{
+			size, err := m.VoteOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisBallotTallyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisBallotTallyResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisBallotTallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tally != nil {
+		{
+			size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -368,6 +689,68 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.VoteDelegations) > 0 {
+		for _, e := range m.VoteDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TallyStrategyParams) > 0 {
+		for _, e := range m.TallyStrategyParams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProposalVoteOptions) > 0 {
+		for _, e := range m.ProposalVoteOptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Ballots) > 0 {
+		for _, e := range m.Ballots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BallotTallyResults) > 0 {
+		for _, e := range m.BallotTallyResults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisProposalVoteOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGenesis(uint64(m.ProposalId))
+	}
+	if m.VoteOptions != nil {
+		l = m.VoteOptions.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisBallotTallyResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGenesis(uint64(m.ProposalId))
+	}
+	if m.Tally != nil {
+		l = m.Tally.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -703,6 +1086,386 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Constitution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteDelegations = append(m.VoteDelegations, &VoteDelegation{})
+			if err := m.VoteDelegations[len(m.VoteDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyStrategyParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TallyStrategyParams = append(m.TallyStrategyParams, &TallyStrategyInfo{})
+			if err := m.TallyStrategyParams[len(m.TallyStrategyParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalVoteOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalVoteOptions = append(m.ProposalVoteOptions, &GenesisProposalVoteOptions{})
+			if err := m.ProposalVoteOptions[len(m.ProposalVoteOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ballots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ballots = append(m.Ballots, &Ballot{})
+			if err := m.Ballots[len(m.Ballots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotTallyResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotTallyResults = append(m.BallotTallyResults, &GenesisBallotTallyResult{})
+			if err := m.BallotTallyResults[len(m.BallotTallyResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisProposalVoteOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisProposalVoteOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisProposalVoteOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VoteOptions == nil {
+				m.VoteOptions = &ProposalVoteOptions{}
+			}
+			if err := m.VoteOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisBallotTallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisBallotTallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisBallotTallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tally == nil {
+				m.Tally = &BallotTallyResult{}
+			}
+			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
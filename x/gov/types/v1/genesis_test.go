@@ -125,6 +125,118 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "duplicate vote",
 		},
+		{
+			name: "duplicate vote delegations",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.VoteDelegations = append(state.VoteDelegations,
+					&v1.VoteDelegation{
+						Delegator: "delegator",
+						Delegate:  "delegate",
+					},
+					&v1.VoteDelegation{
+						Delegator: "delegator",
+						Delegate:  "delegate",
+						Topic:     "/cosmos.gov.v1.MsgUpdateParams",
+					},
+					&v1.VoteDelegation{
+						Delegator: "delegator",
+						Delegate:  "other delegate",
+					})
+
+				return state
+			},
+			expErrMsg: "duplicate vote delegation",
+		},
+		{
+			name: "vote delegation to self",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.VoteDelegations = append(state.VoteDelegations,
+					&v1.VoteDelegation{
+						Delegator: "delegator",
+						Delegate:  "delegator",
+					})
+
+				return state
+			},
+			expErrMsg: "vote delegation to self",
+		},
+		{
+			name: "duplicate ballots",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1})
+				state.Ballots = append(state.Ballots,
+					&v1.Ballot{
+						ProposalId: 1,
+						Voter:      "voter",
+					},
+					&v1.Ballot{
+						ProposalId: 1,
+						Voter:      "voter",
+					})
+
+				return state
+			},
+			expErrMsg: "duplicate ballot",
+		},
+		{
+			name: "non-existent proposal id in ballots",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Ballots = append(state.Ballots,
+					&v1.Ballot{
+						ProposalId: 1,
+						Voter:      "voter",
+					})
+
+				return state
+			},
+			expErrMsg: "ballot proposal_id:1 voter:\"voter\"  has non-existent proposal id: 1",
+		},
+		{
+			name: "duplicate proposal vote options",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1})
+				state.ProposalVoteOptions = append(state.ProposalVoteOptions,
+					&v1.GenesisProposalVoteOptions{ProposalId: 1, VoteOptions: &v1.ProposalVoteOptions{}},
+					&v1.GenesisProposalVoteOptions{ProposalId: 1, VoteOptions: &v1.ProposalVoteOptions{}})
+
+				return state
+			},
+			expErrMsg: "duplicate vote options for proposal id: 1",
+		},
+		{
+			name: "non-existent proposal id in ballot tally results",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.BallotTallyResults = append(state.BallotTallyResults,
+					&v1.GenesisBallotTallyResult{ProposalId: 1, Tally: &v1.BallotTallyResult{}})
+
+				return state
+			},
+			expErrMsg: "ballot tally result has non-existent proposal id: 1",
+		},
+		{
+			name: "duplicate tally strategy params",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.TallyStrategyParams = append(state.TallyStrategyParams,
+					&v1.TallyStrategyInfo{
+						Name:   "quadratic",
+						Params: `{"threshold":"0.6"}`,
+					},
+					&v1.TallyStrategyInfo{
+						Name:   "quadratic",
+						Params: `{"threshold":"0.7"}`,
+					})
+
+				return state
+			},
+			expErrMsg: "duplicate tally strategy params: quadratic",
+		},
 		{
 			name: "duplicate deposits",
 			genesisState: func() *v1.GenesisState {
@@ -73,6 +73,41 @@ func (ProposalType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e05cb1c0d030febb, []int{0}
 }
 
+// MultipleChoiceVotingMode enumerates the voting modes of a multiple choice proposal.
+type MultipleChoiceVotingMode int32
+
+const (
+	// MULTIPLE_CHOICE_VOTING_MODE_UNSPECIFIED defines plurality voting, where voters cast weighted votes on the
+	// options and the option with the most voting power wins.
+	MultipleChoiceVotingMode_MULTIPLE_CHOICE_VOTING_MODE_UNSPECIFIED MultipleChoiceVotingMode = 0
+	// MULTIPLE_CHOICE_VOTING_MODE_RANKED_CHOICE defines ranked-choice voting, where voters rank the options and the
+	// winner is determined by instant runoff.
+	MultipleChoiceVotingMode_MULTIPLE_CHOICE_VOTING_MODE_RANKED_CHOICE MultipleChoiceVotingMode = 1
+	// MULTIPLE_CHOICE_VOTING_MODE_APPROVAL defines approval voting, where voters approve any number of options and
+	// the option approved by the most voting power wins.
+	MultipleChoiceVotingMode_MULTIPLE_CHOICE_VOTING_MODE_APPROVAL MultipleChoiceVotingMode = 2
+)
+
+var MultipleChoiceVotingMode_name = map[int32]string{
+	0: "MULTIPLE_CHOICE_VOTING_MODE_UNSPECIFIED",
+	1: "MULTIPLE_CHOICE_VOTING_MODE_RANKED_CHOICE",
+	2: "MULTIPLE_CHOICE_VOTING_MODE_APPROVAL",
+}
+
+var MultipleChoiceVotingMode_value = map[string]int32{
+	"MULTIPLE_CHOICE_VOTING_MODE_UNSPECIFIED":   0,
+	"MULTIPLE_CHOICE_VOTING_MODE_RANKED_CHOICE": 1,
+	"MULTIPLE_CHOICE_VOTING_MODE_APPROVAL":      2,
+}
+
+func (x MultipleChoiceVotingMode) String() string {
+	return proto.EnumName(MultipleChoiceVotingMode_name, int32(x))
+}
+
+func (MultipleChoiceVotingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{1}
+}
+
 // VoteOption enumerates the valid vote options for a given governance proposal.
 type VoteOption int32
 
@@ -130,7 +165,7 @@ func (x VoteOption) String() string {
 }
 
 func (VoteOption) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{1}
+	return fileDescriptor_e05cb1c0d030febb, []int{2}
 }
 
 // ProposalStatus enumerates the valid statuses of a proposal.
@@ -179,7 +214,7 @@ func (x ProposalStatus) String() string {
 }
 
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{2}
+	return fileDescriptor_e05cb1c0d030febb, []int{3}
 }
 
 // WeightedVoteOption defines a unit of vote for vote split.
@@ -342,6 +377,9 @@ type Proposal struct {
 	FailedReason string `protobuf:"bytes,15,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`
 	// proposal_type defines the type of the proposal
 	ProposalType ProposalType `protobuf:"varint,16,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// tally_strategy is the name of the tally strategy used to tally the proposal.
+	// When empty, the proposal is tallied according to its proposal type.
+	TallyStrategy string `protobuf:"bytes,17,opt,name=tally_strategy,json=tallyStrategy,proto3" json:"tally_strategy,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -490,6 +528,13 @@ func (m *Proposal) GetProposalType() ProposalType {
 	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
 }
 
+func (m *Proposal) GetTallyStrategy() string {
+	if m != nil {
+		return m.TallyStrategy
+	}
+	return ""
+}
+
 // ProposalVoteOptions defines the stringified vote options for proposals.
 // This allows to support multiple choice options for a given proposal.
 type ProposalVoteOptions struct {
@@ -503,6 +548,9 @@ type ProposalVoteOptions struct {
 	OptionFour string `protobuf:"bytes,4,opt,name=option_four,json=optionFour,proto3" json:"option_four,omitempty"`
 	// option_spam is always present for all proposals.
 	OptionSpam string `protobuf:"bytes,5,opt,name=option_spam,json=optionSpam,proto3" json:"option_spam,omitempty"`
+	// voting_mode is the voting mode of the proposal.
+	// Ranked-choice and approval proposals are voted on with ballots instead of weighted votes.
+	VotingMode MultipleChoiceVotingMode `protobuf:"varint,6,opt,name=voting_mode,json=votingMode,proto3,enum=cosmos.gov.v1.MultipleChoiceVotingMode" json:"voting_mode,omitempty"`
 }
 
 func (m *ProposalVoteOptions) Reset()         { *m = ProposalVoteOptions{} }
@@ -573,6 +621,13 @@ func (m *ProposalVoteOptions) GetOptionSpam() string {
 	return ""
 }
 
+func (m *ProposalVoteOptions) GetVotingMode() MultipleChoiceVotingMode {
+	if m != nil {
+		return m.VotingMode
+	}
+	return MultipleChoiceVotingMode_MULTIPLE_CHOICE_VOTING_MODE_UNSPECIFIED
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	// yes_count is the number of yes votes on a proposal.
@@ -956,15 +1011,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	ProposalCancelRatio string `protobuf:"bytes,8,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
@@ -975,7 +1030,7 @@ type Params struct {
 	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,10,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
 	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.67.
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -1279,8 +1334,328 @@ func (m *MessageBasedParams) GetVetoThreshold() string {
 	return ""
 }
 
+// TallyStrategyInfo describes a tally strategy registered in the gov module.
+type TallyStrategyInfo struct {
+	// name is the name used to select the tally strategy when submitting a proposal.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// params are the JSON encoded params of the tally strategy set by governance.
+	// When empty, the tally strategy uses its default params.
+	Params string `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *TallyStrategyInfo) Reset()         { *m = TallyStrategyInfo{} }
+func (m *TallyStrategyInfo) String() string { return proto.CompactTextString(m) }
+func (*TallyStrategyInfo) ProtoMessage()    {}
+func (*TallyStrategyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
+}
+func (m *TallyStrategyInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallyStrategyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallyStrategyInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TallyStrategyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyStrategyInfo.Merge(m, src)
+}
+func (m *TallyStrategyInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallyStrategyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyStrategyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TallyStrategyInfo proto.InternalMessageInfo
+
+func (m *TallyStrategyInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TallyStrategyInfo) GetParams() string {
+	if m != nil {
+		return m.Params
+	}
+	return ""
+}
+
+// Ballot defines a ballot cast on a ranked-choice or approval multiple choice proposal.
+type Ballot struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is the voter address of the proposal.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// options are the options of the ballot.
+	// For ranked-choice proposals, they are ordered by preference, the most preferred option first.
+	// For approval proposals, they are the approved options.
+	Options []VoteOption `protobuf:"varint,3,rep,packed,name=options,proto3,enum=cosmos.gov.v1.VoteOption" json:"options,omitempty"`
+	// metadata is any arbitrary metadata attached to the ballot.
+	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *Ballot) Reset()         { *m = Ballot{} }
+func (m *Ballot) String() string { return proto.CompactTextString(m) }
+func (*Ballot) ProtoMessage()    {}
+func (*Ballot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{12}
+}
+func (m *Ballot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Ballot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Ballot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Ballot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Ballot.Merge(m, src)
+}
+func (m *Ballot) XXX_Size() int {
+	return m.Size()
+}
+func (m *Ballot) XXX_DiscardUnknown() {
+	xxx_messageInfo_Ballot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Ballot proto.InternalMessageInfo
+
+func (m *Ballot) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *Ballot) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *Ballot) GetOptions() []VoteOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *Ballot) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+// BallotTallyRound defines a round of the tally of the ballots of a proposal.
+type BallotTallyRound struct {
+	// tally is the voting power counted for each option in the round.
+	Tally *TallyResult `protobuf:"bytes,1,opt,name=tally,proto3" json:"tally,omitempty"`
+	// eliminated is the option eliminated at the end of the round.
+	// It is unspecified in the last round.
+	Eliminated VoteOption `protobuf:"varint,2,opt,name=eliminated,proto3,enum=cosmos.gov.v1.VoteOption" json:"eliminated,omitempty"`
+}
+
+func (m *BallotTallyRound) Reset()         { *m = BallotTallyRound{} }
+func (m *BallotTallyRound) String() string { return proto.CompactTextString(m) }
+func (*BallotTallyRound) ProtoMessage()    {}
+func (*BallotTallyRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{13}
+}
+func (m *BallotTallyRound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BallotTallyRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BallotTallyRound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BallotTallyRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BallotTallyRound.Merge(m, src)
+}
+func (m *BallotTallyRound) XXX_Size() int {
+	return m.Size()
+}
+func (m *BallotTallyRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_BallotTallyRound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BallotTallyRound proto.InternalMessageInfo
+
+func (m *BallotTallyRound) GetTally() *TallyResult {
+	if m != nil {
+		return m.Tally
+	}
+	return nil
+}
+
+func (m *BallotTallyRound) GetEliminated() VoteOption {
+	if m != nil {
+		return m.Eliminated
+	}
+	return VoteOption_VOTE_OPTION_UNSPECIFIED
+}
+
+// BallotTallyResult defines the result of the tally of the ballots of a proposal.
+type BallotTallyResult struct {
+	// voting_mode is the voting mode of the proposal.
+	VotingMode MultipleChoiceVotingMode `protobuf:"varint,1,opt,name=voting_mode,json=votingMode,proto3,enum=cosmos.gov.v1.MultipleChoiceVotingMode" json:"voting_mode,omitempty"`
+	// rounds are the rounds of the tally.
+	// Ranked-choice proposals have a round per eliminated option, approval proposals have a single round.
+	Rounds []*BallotTallyRound `protobuf:"bytes,2,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	// winner is the winning option. It is unspecified if no option won.
+	Winner VoteOption `protobuf:"varint,3,opt,name=winner,proto3,enum=cosmos.gov.v1.VoteOption" json:"winner,omitempty"`
+}
+
+func (m *BallotTallyResult) Reset()         { *m = BallotTallyResult{} }
+func (m *BallotTallyResult) String() string { return proto.CompactTextString(m) }
+func (*BallotTallyResult) ProtoMessage()    {}
+func (*BallotTallyResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{14}
+}
+func (m *BallotTallyResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BallotTallyResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BallotTallyResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BallotTallyResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BallotTallyResult.Merge(m, src)
+}
+func (m *BallotTallyResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BallotTallyResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BallotTallyResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BallotTallyResult proto.InternalMessageInfo
+
+func (m *BallotTallyResult) GetVotingMode() MultipleChoiceVotingMode {
+	if m != nil {
+		return m.VotingMode
+	}
+	return MultipleChoiceVotingMode_MULTIPLE_CHOICE_VOTING_MODE_UNSPECIFIED
+}
+
+func (m *BallotTallyResult) GetRounds() []*BallotTallyRound {
+	if m != nil {
+		return m.Rounds
+	}
+	return nil
+}
+
+func (m *BallotTallyResult) GetWinner() VoteOption {
+	if m != nil {
+		return m.Winner
+	}
+	return VoteOption_VOTE_OPTION_UNSPECIFIED
+}
+
+// VoteDelegation defines a delegation of the governance voting power of an account to another account.
+// When the delegator does not vote on a proposal, its voting power is tallied with the vote of its delegate.
+type VoteDelegation struct {
+	// delegator is the account address delegating its voting power.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegate is the account address voting on behalf of the delegator.
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// topic is the message type URL of the proposals the delegation applies to.
+	// The topic of a proposal is the type URL of its first message.
+	// An empty topic applies to all proposals without a delegation for their topic.
+	Topic string `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
+}
+
+func (m *VoteDelegation) Reset()         { *m = VoteDelegation{} }
+func (m *VoteDelegation) String() string { return proto.CompactTextString(m) }
+func (*VoteDelegation) ProtoMessage()    {}
+func (*VoteDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{15}
+}
+func (m *VoteDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteDelegation.Merge(m, src)
+}
+func (m *VoteDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteDelegation proto.InternalMessageInfo
+
+func (m *VoteDelegation) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *VoteDelegation) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+func (m *VoteDelegation) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.ProposalType", ProposalType_name, ProposalType_value)
+	proto.RegisterEnum("cosmos.gov.v1.MultipleChoiceVotingMode", MultipleChoiceVotingMode_name, MultipleChoiceVotingMode_value)
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1.WeightedVoteOption")
@@ -1294,138 +1669,163 @@ func init() {
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
 	proto.RegisterType((*MessageBasedParams)(nil), "cosmos.gov.v1.MessageBasedParams")
+	proto.RegisterType((*TallyStrategyInfo)(nil), "cosmos.gov.v1.TallyStrategyInfo")
+	proto.RegisterType((*Ballot)(nil), "cosmos.gov.v1.Ballot")
+	proto.RegisterType((*BallotTallyRound)(nil), "cosmos.gov.v1.BallotTallyRound")
+	proto.RegisterType((*BallotTallyResult)(nil), "cosmos.gov.v1.BallotTallyResult")
+	proto.RegisterType((*VoteDelegation)(nil), "cosmos.gov.v1.VoteDelegation")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 2006 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6f, 0xdb, 0xc8,
-	0x19, 0x0e, 0x25, 0xf9, 0x43, 0xaf, 0x25, 0x99, 0x1e, 0xdb, 0x31, 0x63, 0xaf, 0x3f, 0x62, 0x14,
-	0x0b, 0x37, 0xbb, 0x96, 0xec, 0x6c, 0xdd, 0x6e, 0xd3, 0xcd, 0x41, 0xb2, 0x98, 0x84, 0x41, 0x6c,
-	0xa9, 0x14, 0xe3, 0x24, 0x2d, 0x0a, 0x82, 0x36, 0x27, 0x32, 0x77, 0x45, 0x8e, 0x4a, 0x8e, 0x6c,
-	0xab, 0xbf, 0x62, 0x8f, 0x3d, 0x15, 0xbd, 0x75, 0x8f, 0x3d, 0x04, 0xbd, 0xf7, 0xd4, 0x45, 0x0f,
-	0xc5, 0x22, 0xa7, 0x62, 0x81, 0xa6, 0x45, 0x72, 0x28, 0xb0, 0x3f, 0xa1, 0xe8, 0xa1, 0x98, 0xe1,
-	0x50, 0xa4, 0x3e, 0x1c, 0xcb, 0x8b, 0x5e, 0x12, 0x79, 0xe6, 0x79, 0x9e, 0x79, 0xe7, 0xfd, 0x9a,
-	0x57, 0x82, 0xa5, 0x13, 0x12, 0xb8, 0x24, 0x28, 0x35, 0xc9, 0x59, 0xe9, 0x6c, 0x97, 0xfd, 0x57,
-	0x6c, 0xfb, 0x84, 0x12, 0x94, 0x0f, 0x37, 0x8a, 0x6c, 0xe5, 0x6c, 0x77, 0x79, 0x4d, 0xe0, 0x8e,
-	0xad, 0x00, 0x97, 0xce, 0x76, 0x8f, 0x31, 0xb5, 0x76, 0x4b, 0x27, 0xc4, 0xf1, 0x42, 0xf8, 0xf2,
-	0x42, 0x93, 0x34, 0x09, 0xff, 0x58, 0x62, 0x9f, 0xc4, 0xea, 0x7a, 0x93, 0x90, 0x66, 0x0b, 0x97,
-	0xf8, 0x5f, 0xc7, 0x9d, 0x97, 0x25, 0xea, 0xb8, 0x38, 0xa0, 0x96, 0xdb, 0x16, 0x80, 0x5b, 0x83,
-	0x00, 0xcb, 0xeb, 0x8a, 0xad, 0xb5, 0xc1, 0x2d, 0xbb, 0xe3, 0x5b, 0xd4, 0x21, 0xd1, 0x89, 0xb7,
-	0x42, 0x8b, 0xcc, 0xf0, 0x50, 0x61, 0x6d, 0xb8, 0x35, 0x67, 0xb9, 0x8e, 0x47, 0x4a, 0xfc, 0xdf,
-	0x70, 0x69, 0x93, 0x00, 0x7a, 0x86, 0x9d, 0xe6, 0x29, 0xc5, 0xf6, 0x11, 0xa1, 0xb8, 0xd6, 0x66,
-	0x4a, 0x68, 0x17, 0x26, 0x09, 0xff, 0xa4, 0x48, 0x1b, 0xd2, 0x56, 0xe1, 0xee, 0xad, 0x62, 0xdf,
-	0xad, 0x8b, 0x31, 0x54, 0x17, 0x40, 0xf4, 0x21, 0x4c, 0x9e, 0x73, 0x21, 0x25, 0xb5, 0x21, 0x6d,
-	0x65, 0x2b, 0x85, 0xd7, 0xaf, 0xb6, 0x41, 0xb0, 0xaa, 0xf8, 0x44, 0x17, 0xbb, 0x9b, 0xbf, 0x97,
-	0x60, 0xaa, 0x8a, 0xdb, 0x24, 0x70, 0x28, 0x5a, 0x87, 0x99, 0xb6, 0x4f, 0xda, 0x24, 0xb0, 0x5a,
-	0xa6, 0x63, 0xf3, 0xb3, 0x32, 0x3a, 0x44, 0x4b, 0x9a, 0x8d, 0x7e, 0x0c, 0x59, 0x3b, 0xc4, 0x12,
-	0x5f, 0xe8, 0x2a, 0xaf, 0x5f, 0x6d, 0x2f, 0x08, 0xdd, 0xb2, 0x6d, 0xfb, 0x38, 0x08, 0x1a, 0xd4,
-	0x77, 0xbc, 0xa6, 0x1e, 0x43, 0xd1, 0x67, 0x30, 0x69, 0xb9, 0xa4, 0xe3, 0x51, 0x25, 0xbd, 0x91,
-	0xde, 0x9a, 0x89, 0xed, 0x67, 0x61, 0x2a, 0x8a, 0x30, 0x15, 0xf7, 0x89, 0xe3, 0x55, 0xb2, 0x5f,
-	0xbf, 0x59, 0xbf, 0xf1, 0xd5, 0xbf, 0xff, 0x78, 0x47, 0xd2, 0x05, 0x67, 0xf3, 0xcf, 0x53, 0x30,
-	0x5d, 0x17, 0x46, 0xa0, 0x02, 0xa4, 0x7a, 0xa6, 0xa5, 0x1c, 0x1b, 0xed, 0xc0, 0xb4, 0x8b, 0x83,
-	0xc0, 0x6a, 0xe2, 0x40, 0x49, 0x71, 0xf1, 0x85, 0x62, 0x18, 0x91, 0x62, 0x14, 0x91, 0x62, 0xd9,
-	0xeb, 0xea, 0x3d, 0x14, 0xda, 0x83, 0xc9, 0x80, 0x5a, 0xb4, 0x13, 0x28, 0x69, 0xee, 0xcc, 0xd5,
-	0x01, 0x67, 0x46, 0x47, 0x35, 0x38, 0x48, 0x17, 0x60, 0xf4, 0x08, 0xd0, 0x4b, 0xc7, 0xb3, 0x5a,
-	0x26, 0xb5, 0x5a, 0xad, 0xae, 0xe9, 0xe3, 0xa0, 0xd3, 0xa2, 0x4a, 0x66, 0x43, 0xda, 0x9a, 0xb9,
-	0xbb, 0x3c, 0x20, 0x61, 0x30, 0x88, 0xce, 0x11, 0xba, 0xcc, 0x59, 0x89, 0x15, 0x54, 0x86, 0x99,
-	0xa0, 0x73, 0xec, 0x3a, 0xd4, 0x64, 0x69, 0xa6, 0x4c, 0x08, 0x89, 0x41, 0xab, 0x8d, 0x28, 0x07,
-	0x2b, 0x99, 0x2f, 0xff, 0xb9, 0x2e, 0xe9, 0x10, 0x92, 0xd8, 0x32, 0x7a, 0x0c, 0xb2, 0xf0, 0xae,
-	0x89, 0x3d, 0x3b, 0xd4, 0x99, 0x1c, 0x53, 0xa7, 0x20, 0x98, 0xaa, 0x67, 0x73, 0x2d, 0x0d, 0xf2,
-	0x94, 0x50, 0xab, 0x65, 0x8a, 0x75, 0x65, 0xea, 0x1a, 0x31, 0xca, 0x71, 0x6a, 0x94, 0x40, 0x4f,
-	0x60, 0xee, 0x8c, 0x50, 0xc7, 0x6b, 0x9a, 0x01, 0xb5, 0x7c, 0x71, 0xbf, 0xe9, 0x31, 0xed, 0x9a,
-	0x0d, 0xa9, 0x0d, 0xc6, 0xe4, 0x86, 0x3d, 0x02, 0xb1, 0x14, 0xdf, 0x31, 0x3b, 0xa6, 0x56, 0x3e,
-	0x24, 0x46, 0x57, 0x5c, 0x66, 0x49, 0x42, 0x2d, 0xdb, 0xa2, 0x96, 0x02, 0x2c, 0x6d, 0xf5, 0xde,
-	0xdf, 0xe8, 0x87, 0x30, 0x41, 0x1d, 0xda, 0xc2, 0xca, 0x0c, 0xcf, 0xe7, 0xf9, 0x6f, 0x5f, 0x6d,
-	0xcf, 0x86, 0x37, 0xdf, 0x0e, 0xec, 0x2f, 0x36, 0x76, 0x8a, 0x3f, 0xfa, 0x89, 0x1e, 0x22, 0xd0,
-	0x36, 0x4c, 0x05, 0x1d, 0xd7, 0xb5, 0xfc, 0xae, 0x92, 0xbb, 0x1c, 0x1c, 0x61, 0xd0, 0x43, 0x98,
-	0x0e, 0x6b, 0x07, 0xfb, 0x4a, 0x9e, 0xe3, 0x3f, 0xba, 0xac, 0x58, 0x46, 0xe9, 0xf4, 0xc8, 0xe8,
-	0x13, 0xc8, 0xe2, 0x8b, 0x36, 0xb6, 0x1d, 0x8a, 0x6d, 0xa5, 0xb0, 0x21, 0x6d, 0x4d, 0x57, 0x16,
-	0x87, 0x18, 0x7b, 0x3b, 0x8a, 0xa4, 0xc7, 0x38, 0xf4, 0x29, 0xe4, 0x5f, 0x5a, 0x4e, 0x0b, 0xdb,
-	0xa6, 0x8f, 0xad, 0x80, 0x78, 0xca, 0xec, 0x25, 0x26, 0xef, 0xed, 0xe8, 0xb9, 0x10, 0xa9, 0x73,
-	0x20, 0xd2, 0x21, 0xdf, 0x6b, 0x03, 0xb4, 0xdb, 0xc6, 0x8a, 0xcc, 0xeb, 0x64, 0xe5, 0x92, 0x3a,
-	0x31, 0xba, 0x6d, 0x5c, 0x91, 0xbf, 0x7d, 0xb5, 0x9d, 0xbb, 0x60, 0x7d, 0x79, 0xe3, 0x6c, 0xa7,
-	0x78, 0xb7, 0xb8, 0xa3, 0xe7, 0xda, 0x89, 0xfd, 0xcd, 0xbf, 0x4a, 0x30, 0x1f, 0x11, 0xe2, 0x6e,
-	0x15, 0xa0, 0x55, 0x80, 0xb0, 0x61, 0x99, 0xc4, 0xc3, 0xbc, 0xac, 0xb3, 0x7a, 0x36, 0x5c, 0xa9,
-	0x79, 0x38, 0xb1, 0x4d, 0xcf, 0x49, 0xd8, 0x71, 0xa2, 0x6d, 0xe3, 0x9c, 0xa0, 0xdb, 0x90, 0x8b,
-	0xb6, 0x4f, 0x7d, 0x8c, 0x79, 0x41, 0x67, 0xf5, 0x19, 0x01, 0x60, 0x4b, 0xac, 0xa7, 0x09, 0xc8,
-	0x4b, 0xd2, 0xf1, 0x79, 0xbd, 0x66, 0x75, 0x21, 0xfa, 0x80, 0x74, 0xfc, 0x04, 0x20, 0x68, 0x5b,
-	0x2e, 0xaf, 0xc6, 0x1e, 0xa0, 0xd1, 0xb6, 0xdc, 0x7b, 0xf2, 0xeb, 0x81, 0xab, 0x6d, 0xfe, 0x37,
-	0x0d, 0x33, 0xc9, 0x82, 0xde, 0x86, 0x6c, 0x17, 0x07, 0xe6, 0x09, 0xef, 0x70, 0xfc, 0x0e, 0x15,
-	0x39, 0xd1, 0x6e, 0x35, 0xb6, 0xaa, 0x4f, 0x77, 0x71, 0xb0, 0xcf, 0x10, 0x68, 0x0f, 0xf2, 0xd6,
-	0x71, 0x40, 0x2d, 0xc7, 0x13, 0x94, 0xd4, 0x25, 0x94, 0x9c, 0x80, 0x85, 0xb4, 0x8f, 0x60, 0xda,
-	0x23, 0x82, 0x91, 0xbe, 0x84, 0x31, 0xe5, 0x91, 0x10, 0x7c, 0x1f, 0x90, 0x47, 0xcc, 0x73, 0x87,
-	0x9e, 0x9a, 0x67, 0x98, 0x46, 0xb4, 0xcc, 0x25, 0xb4, 0x59, 0x8f, 0x3c, 0x73, 0xe8, 0xe9, 0x11,
-	0xa6, 0x82, 0xfe, 0x29, 0xc8, 0x71, 0x58, 0x04, 0x79, 0x62, 0xe8, 0x1d, 0xd1, 0x3c, 0xaa, 0x17,
-	0x7a, 0xc1, 0x1a, 0x64, 0xd2, 0xf3, 0xe8, 0xd8, 0xc9, 0xf7, 0x31, 0x8d, 0x73, 0x71, 0xe6, 0x67,
-	0x80, 0x92, 0xc1, 0x14, 0xdc, 0xa9, 0x91, 0x5c, 0x39, 0x11, 0xe2, 0x90, 0x7d, 0x0f, 0xe6, 0x12,
-	0x71, 0x16, 0xe4, 0xe9, 0x91, 0xe4, 0xd9, 0x38, 0xfa, 0x21, 0x77, 0x1b, 0x80, 0xc5, 0x5e, 0x90,
-	0xb2, 0x23, 0x49, 0x59, 0x86, 0xe0, 0xf0, 0xcd, 0x3f, 0x49, 0x90, 0x61, 0x39, 0x7c, 0xf5, 0x7b,
-	0x59, 0x84, 0x89, 0x33, 0x42, 0xf1, 0xd5, 0x6f, 0x65, 0x08, 0x43, 0x3f, 0x83, 0xa9, 0xd0, 0xb6,
-	0x40, 0xc9, 0xf0, 0x26, 0x7c, 0x7b, 0xa0, 0xe6, 0x86, 0x67, 0x03, 0x3d, 0x62, 0xf4, 0x35, 0xb9,
-	0x89, 0xfe, 0x26, 0xf7, 0x38, 0x33, 0x9d, 0x96, 0x33, 0x9b, 0xff, 0x90, 0x20, 0x2f, 0x5a, 0x75,
-	0xdd, 0xf2, 0x2d, 0x37, 0x40, 0x2f, 0x60, 0xc6, 0x75, 0xbc, 0x5e, 0xe7, 0x97, 0xae, 0xea, 0xfc,
-	0xab, 0xac, 0xf3, 0x7f, 0xf7, 0x66, 0x7d, 0x31, 0xc1, 0xfa, 0x98, 0xb8, 0x0e, 0xc5, 0x6e, 0x9b,
-	0x76, 0x75, 0x70, 0x1d, 0x2f, 0x7a, 0x0b, 0x5c, 0x40, 0xae, 0x75, 0x11, 0x81, 0xcc, 0x36, 0xf6,
-	0x1d, 0x62, 0x73, 0x47, 0xb0, 0x13, 0x06, 0x1b, 0x78, 0x55, 0x0c, 0x4d, 0x95, 0x1f, 0x7c, 0xf7,
-	0x66, 0xfd, 0x83, 0x61, 0x62, 0x7c, 0xc8, 0x6f, 0x59, 0x7f, 0x97, 0x5d, 0xeb, 0x22, 0xba, 0x09,
-	0xdf, 0xbf, 0x97, 0x52, 0xa4, 0xcd, 0xe7, 0x90, 0x3b, 0xe2, 0x7d, 0x5f, 0xdc, 0xae, 0x0a, 0xe2,
-	0x1d, 0x88, 0x4e, 0x97, 0xae, 0x3a, 0x3d, 0xc3, 0xd5, 0x73, 0x21, 0x2b, 0xa1, 0xfc, 0x3b, 0x49,
-	0x54, 0xbc, 0x50, 0xfe, 0x10, 0x26, 0x7f, 0xdd, 0x21, 0x7e, 0xc7, 0x15, 0xe5, 0x3e, 0x34, 0x5d,
-	0x85, 0xbb, 0xe8, 0x63, 0xc8, 0xb2, 0x64, 0x0e, 0x4e, 0x49, 0xcb, 0xbe, 0x64, 0x10, 0x8b, 0x01,
-	0x68, 0x0f, 0x0a, 0xbc, 0x58, 0x63, 0x4a, 0x7a, 0x24, 0x25, 0xcf, 0x50, 0x46, 0x04, 0xe2, 0x06,
-	0xfe, 0x25, 0x0f, 0x93, 0xc2, 0x36, 0xf5, 0x9a, 0x31, 0x4d, 0xbc, 0xe6, 0xc9, 0xf8, 0x1d, 0x7c,
-	0xbf, 0xf8, 0x65, 0x46, 0xc7, 0x67, 0x38, 0x16, 0xe9, 0xef, 0x11, 0x8b, 0x84, 0xdf, 0x33, 0xe3,
-	0xfb, 0x7d, 0xe2, 0xfa, 0x7e, 0x9f, 0x1c, 0xc3, 0xef, 0x48, 0x83, 0x5b, 0xcc, 0xd1, 0x8e, 0xe7,
-	0x50, 0x27, 0x1e, 0x9f, 0x4c, 0x6e, 0xfe, 0x88, 0xbe, 0xc5, 0x14, 0x6e, 0xba, 0x8e, 0xa7, 0x85,
-	0x78, 0xe1, 0x1e, 0x9d, 0xa1, 0xd1, 0x53, 0x58, 0xec, 0x75, 0x92, 0x13, 0xcb, 0x3b, 0xc1, 0x2d,
-	0x21, 0x13, 0x76, 0xb0, 0xdb, 0xfd, 0x32, 0xa3, 0x9e, 0xf0, 0xf9, 0x88, 0xbf, 0xcf, 0xe9, 0xa1,
-	0xec, 0xaf, 0x60, 0x61, 0x50, 0xd6, 0xc6, 0x41, 0xd4, 0xe2, 0xc6, 0x9f, 0x46, 0xf6, 0x76, 0x74,
-	0xd4, 0xaf, 0x5f, 0xc5, 0x01, 0x45, 0x9f, 0xc3, 0x52, 0x6f, 0xde, 0x30, 0xfb, 0xa3, 0x0b, 0x57,
-	0x45, 0x77, 0x89, 0x45, 0x77, 0xd4, 0x41, 0x8b, 0x3d, 0xc9, 0xa3, 0x64, 0xe4, 0x75, 0x98, 0x8f,
-	0xcf, 0x8a, 0x03, 0x35, 0x33, 0xae, 0x7f, 0x50, 0x8f, 0x1d, 0x07, 0xf0, 0x39, 0xc4, 0x87, 0x99,
-	0xc9, 0x9a, 0xc9, 0x5d, 0xa3, 0x66, 0x62, 0xb3, 0x0e, 0xe2, 0xe2, 0xb9, 0x0f, 0xf2, 0x71, 0xc7,
-	0xf7, 0x98, 0x53, 0xb0, 0x29, 0x32, 0x36, 0xcf, 0x07, 0xb7, 0x91, 0x23, 0x63, 0x81, 0x81, 0x59,
-	0x4f, 0xff, 0x79, 0x98, 0xbe, 0x47, 0xb0, 0xca, 0xe9, 0xbd, 0xe0, 0xf5, 0xaa, 0xd0, 0xc7, 0x4c,
-	0x52, 0x0c, 0x81, 0x23, 0xb5, 0x96, 0x19, 0x33, 0x1a, 0xb5, 0xa2, 0x1a, 0x0c, 0x69, 0xe8, 0xa7,
-	0x50, 0x88, 0xcd, 0x62, 0xc9, 0xcc, 0x87, 0xc2, 0x4b, 0x84, 0x72, 0x91, 0x51, 0x6c, 0x2c, 0x40,
-	0x07, 0x30, 0x97, 0xf0, 0x90, 0xc8, 0x4e, 0x79, 0x5c, 0xef, 0xcf, 0xc6, 0x8d, 0x25, 0xcc, 0xcc,
-	0x5f, 0xc2, 0xf2, 0x60, 0x66, 0xb2, 0x6e, 0x23, 0xb2, 0x67, 0x8e, 0xeb, 0xae, 0x0d, 0xe9, 0xf6,
-	0x4f, 0x98, 0x4b, 0xfd, 0x29, 0x79, 0x60, 0x5d, 0x88, 0x5c, 0x69, 0xc3, 0x3a, 0x7b, 0x14, 0x5d,
-	0x27, 0xa0, 0xce, 0x89, 0x69, 0x75, 0xe8, 0x29, 0xf1, 0x9d, 0xdf, 0x60, 0xdb, 0xb4, 0xc2, 0x2c,
-	0xc7, 0x81, 0x82, 0x36, 0xd2, 0x5b, 0xd9, 0xca, 0xd6, 0x7b, 0x2a, 0xa0, 0xff, 0xac, 0xd5, 0x58,
-	0xb0, 0xdc, 0xd3, 0x2b, 0x47, 0x72, 0xe8, 0x18, 0x12, 0x00, 0xd3, 0xc7, 0x9f, 0xe3, 0x93, 0xfe,
-	0x3c, 0x9d, 0x1f, 0xeb, 0x46, 0x2b, 0xb1, 0x88, 0x2e, 0x34, 0xe2, 0x6c, 0xbd, 0x0f, 0xc0, 0xa6,
-	0x4c, 0x91, 0x4d, 0x0b, 0x63, 0x09, 0xb2, 0xb9, 0x54, 0xe4, 0x94, 0x06, 0x72, 0x9c, 0xec, 0x42,
-	0x64, 0xf1, 0x0a, 0x91, 0xdd, 0xe2, 0x4e, 0x71, 0x47, 0x9f, 0xed, 0xf1, 0x84, 0xd4, 0x03, 0xb8,
-	0xd9, 0x0b, 0x1e, 0xbe, 0xc0, 0x27, 0x1d, 0x3e, 0x77, 0x35, 0xad, 0x40, 0xb9, 0xc9, 0x46, 0xa0,
-	0x11, 0x5f, 0x06, 0x7a, 0x6d, 0x48, 0x8d, 0xe0, 0x0f, 0xad, 0xe0, 0xde, 0xfc, 0xeb, 0xe1, 0xb4,
-	0xdb, 0xfc, 0x2a, 0x05, 0xe8, 0x20, 0xfc, 0xae, 0x5e, 0xb1, 0x02, 0x6c, 0xff, 0x3f, 0xdf, 0xf2,
-	0xc4, 0xfb, 0x91, 0x7a, 0xef, 0xfb, 0xb1, 0x3d, 0xc2, 0xd7, 0x43, 0x0f, 0x48, 0xec, 0xdb, 0xbe,
-	0xe7, 0x26, 0x7d, 0xfd, 0xe7, 0x26, 0x33, 0xce, 0x33, 0x3f, 0xf4, 0x3d, 0xe4, 0xce, 0x1f, 0x24,
-	0xc8, 0x25, 0xbf, 0x85, 0xa1, 0x55, 0xb8, 0x55, 0xd7, 0x6b, 0xf5, 0x5a, 0xa3, 0xfc, 0xc4, 0x34,
-	0x5e, 0xd4, 0x55, 0xf3, 0xe9, 0x61, 0xa3, 0xae, 0xee, 0x6b, 0x0f, 0x34, 0xb5, 0x2a, 0xdf, 0x40,
-	0xcb, 0x70, 0xb3, 0x7f, 0xbb, 0x61, 0x94, 0x0f, 0xab, 0x65, 0xbd, 0x2a, 0x4b, 0xe8, 0x36, 0xac,
-	0xf6, 0xef, 0x1d, 0x3c, 0x7d, 0x62, 0x68, 0xf5, 0x27, 0xaa, 0xb9, 0xff, 0xa8, 0xa6, 0xed, 0xab,
-	0x72, 0x0a, 0x7d, 0x00, 0x4a, 0x3f, 0xa4, 0x56, 0x37, 0xb4, 0x03, 0xad, 0x61, 0x68, 0xfb, 0x72,
-	0x1a, 0xad, 0xc0, 0x52, 0xff, 0xae, 0xfa, 0xbc, 0xae, 0x56, 0x35, 0x43, 0xad, 0xca, 0x99, 0x3b,
-	0xff, 0x91, 0x00, 0x12, 0xbf, 0x67, 0xad, 0xc0, 0xd2, 0x51, 0xcd, 0x08, 0x05, 0x6a, 0x87, 0x03,
-	0x56, 0xce, 0xc3, 0x6c, 0x72, 0xf3, 0x85, 0xda, 0x90, 0xa5, 0xc1, 0xc5, 0xda, 0xa1, 0x2a, 0x4b,
-	0x68, 0x09, 0xe6, 0x93, 0x8b, 0xe5, 0x4a, 0xc3, 0x28, 0x6b, 0x87, 0x72, 0x6a, 0x10, 0x6d, 0x3c,
-	0xab, 0xc9, 0x29, 0x84, 0xa0, 0x90, 0x5c, 0x3c, 0xac, 0xc9, 0x69, 0xb4, 0x08, 0x73, 0x7d, 0xc0,
-	0x47, 0xba, 0xaa, 0xca, 0x69, 0x76, 0xd3, 0x7e, 0xa8, 0xf9, 0x4c, 0x33, 0x1e, 0x99, 0x47, 0xaa,
-	0x51, 0x93, 0x33, 0x68, 0x01, 0xe4, 0xe4, 0xee, 0x83, 0xda, 0x53, 0x7d, 0x78, 0xb5, 0x51, 0x2f,
-	0x1f, 0xc8, 0x13, 0xcb, 0x29, 0x59, 0xba, 0xf3, 0x37, 0x09, 0x0a, 0xfd, 0x3f, 0x2a, 0xa1, 0x75,
-	0x58, 0xe9, 0x39, 0xab, 0x61, 0x94, 0x8d, 0xa7, 0x8d, 0x01, 0x27, 0x6c, 0xc2, 0xda, 0x20, 0xa0,
-	0xaa, 0xd6, 0x6b, 0x0d, 0xcd, 0x30, 0xeb, 0xaa, 0xae, 0xd5, 0x06, 0x43, 0x26, 0x30, 0x47, 0x35,
-	0x43, 0x3b, 0x7c, 0x18, 0x41, 0x52, 0x7d, 0x11, 0x17, 0x90, 0x7a, 0xb9, 0xd1, 0x50, 0xab, 0xe1,
-	0x25, 0x07, 0xf7, 0x74, 0xf5, 0xb1, 0xba, 0xcf, 0x23, 0x36, 0x8a, 0xf9, 0xa0, 0xac, 0x3d, 0x51,
-	0xab, 0xf2, 0x44, 0x65, 0xef, 0xeb, 0xb7, 0x6b, 0xd2, 0x37, 0x6f, 0xd7, 0xa4, 0x7f, 0xbd, 0x5d,
-	0x93, 0xbe, 0x7c, 0xb7, 0x76, 0xe3, 0x9b, 0x77, 0x6b, 0x37, 0xfe, 0xfe, 0x6e, 0xed, 0xc6, 0x2f,
-	0x56, 0xc2, 0xf4, 0x0d, 0xec, 0x2f, 0x8a, 0x0e, 0x29, 0xf1, 0x64, 0x2d, 0xd1, 0x6e, 0x1b, 0x07,
-	0xa5, 0xb3, 0xdd, 0xe3, 0x49, 0x5e, 0xa3, 0x9f, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x10, 0x4b,
-	0xae, 0xb9, 0xcc, 0x15, 0x00, 0x00,
+	// 2327 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x4d, 0x6f, 0x1b, 0xc7,
+	0xf9, 0xf7, 0x92, 0x14, 0x25, 0x3e, 0x22, 0xa9, 0xd5, 0x48, 0xb2, 0xd6, 0x52, 0xf4, 0x62, 0x21,
+	0x48, 0x14, 0x3b, 0xa2, 0x24, 0x3b, 0xca, 0x8b, 0xff, 0xf1, 0x81, 0x14, 0x69, 0x8b, 0xfe, 0x4b,
+	0x22, 0xbb, 0xa4, 0x65, 0xbb, 0x45, 0xb1, 0x58, 0x89, 0x63, 0x6a, 0x13, 0xee, 0x0e, 0xbb, 0x3b,
+	0xd4, 0x4b, 0x3f, 0x85, 0x8f, 0x3d, 0x15, 0xb9, 0x04, 0xcd, 0xb1, 0x07, 0xa3, 0x97, 0x7e, 0x80,
+	0xe6, 0x54, 0x04, 0x3e, 0x15, 0x06, 0xea, 0x16, 0xf6, 0xa1, 0x40, 0xbe, 0x41, 0x8b, 0x1e, 0x8a,
+	0x79, 0x59, 0xee, 0x72, 0x49, 0xbd, 0x05, 0xb9, 0xd8, 0xdc, 0x99, 0xdf, 0xef, 0x99, 0x67, 0x9e,
+	0x97, 0x79, 0x9e, 0x19, 0xc1, 0xf4, 0x01, 0xf1, 0x6c, 0xe2, 0xad, 0x36, 0xc9, 0xd1, 0xea, 0xd1,
+	0x3a, 0xfb, 0x2f, 0xd7, 0x76, 0x09, 0x25, 0x28, 0x23, 0x26, 0x72, 0x6c, 0xe4, 0x68, 0x7d, 0x66,
+	0x5e, 0xe2, 0xf6, 0x4d, 0x0f, 0xaf, 0x1e, 0xad, 0xef, 0x63, 0x6a, 0xae, 0xaf, 0x1e, 0x10, 0xcb,
+	0x11, 0xf0, 0x99, 0xc9, 0x26, 0x69, 0x12, 0xfe, 0x73, 0x95, 0xfd, 0x92, 0xa3, 0x0b, 0x4d, 0x42,
+	0x9a, 0x2d, 0xbc, 0xca, 0xbf, 0xf6, 0x3b, 0xcf, 0x57, 0xa9, 0x65, 0x63, 0x8f, 0x9a, 0x76, 0x5b,
+	0x02, 0x6e, 0x44, 0x01, 0xa6, 0x73, 0x2a, 0xa7, 0xe6, 0xa3, 0x53, 0x8d, 0x8e, 0x6b, 0x52, 0x8b,
+	0xf8, 0x2b, 0xde, 0x10, 0x1a, 0x19, 0x62, 0x51, 0xa9, 0xad, 0x98, 0x1a, 0x37, 0x6d, 0xcb, 0x21,
+	0xab, 0xfc, 0x5f, 0x31, 0xb4, 0x44, 0x00, 0x3d, 0xc1, 0x56, 0xf3, 0x90, 0xe2, 0xc6, 0x1e, 0xa1,
+	0xb8, 0xd2, 0x66, 0x92, 0xd0, 0x3a, 0x24, 0x09, 0xff, 0xa5, 0x29, 0x8b, 0xca, 0x72, 0xf6, 0xce,
+	0x8d, 0x5c, 0xcf, 0xae, 0x73, 0x01, 0x54, 0x97, 0x40, 0xf4, 0x01, 0x24, 0x8f, 0xb9, 0x20, 0x2d,
+	0xb6, 0xa8, 0x2c, 0xa7, 0x0a, 0xd9, 0x57, 0x2f, 0x57, 0x40, 0xb2, 0x8a, 0xf8, 0x40, 0x97, 0xb3,
+	0x4b, 0xdf, 0x28, 0x30, 0x5c, 0xc4, 0x6d, 0xe2, 0x59, 0x14, 0x2d, 0xc0, 0x68, 0xdb, 0x25, 0x6d,
+	0xe2, 0x99, 0x2d, 0xc3, 0x6a, 0xf0, 0xb5, 0x12, 0x3a, 0xf8, 0x43, 0xe5, 0x06, 0xfa, 0x14, 0x52,
+	0x0d, 0x81, 0x25, 0xae, 0x94, 0xab, 0xbd, 0x7a, 0xb9, 0x32, 0x29, 0xe5, 0xe6, 0x1b, 0x0d, 0x17,
+	0x7b, 0x5e, 0x8d, 0xba, 0x96, 0xd3, 0xd4, 0x03, 0x28, 0xfa, 0x12, 0x92, 0xa6, 0x4d, 0x3a, 0x0e,
+	0xd5, 0xe2, 0x8b, 0xf1, 0xe5, 0xd1, 0x40, 0x7f, 0xe6, 0xa6, 0x9c, 0x74, 0x53, 0x6e, 0x93, 0x58,
+	0x4e, 0x21, 0xf5, 0xfd, 0x9b, 0x85, 0x6b, 0xdf, 0xfd, 0xeb, 0x8f, 0xb7, 0x14, 0x5d, 0x72, 0x96,
+	0xfe, 0x3d, 0x0c, 0x23, 0x55, 0xa9, 0x04, 0xca, 0x42, 0xac, 0xab, 0x5a, 0xcc, 0x6a, 0xa0, 0x35,
+	0x18, 0xb1, 0xb1, 0xe7, 0x99, 0x4d, 0xec, 0x69, 0x31, 0x2e, 0x7c, 0x32, 0x27, 0x3c, 0x92, 0xf3,
+	0x3d, 0x92, 0xcb, 0x3b, 0xa7, 0x7a, 0x17, 0x85, 0x36, 0x20, 0xe9, 0x51, 0x93, 0x76, 0x3c, 0x2d,
+	0xce, 0x8d, 0x39, 0x17, 0x31, 0xa6, 0xbf, 0x54, 0x8d, 0x83, 0x74, 0x09, 0x46, 0x5b, 0x80, 0x9e,
+	0x5b, 0x8e, 0xd9, 0x32, 0xa8, 0xd9, 0x6a, 0x9d, 0x1a, 0x2e, 0xf6, 0x3a, 0x2d, 0xaa, 0x25, 0x16,
+	0x95, 0xe5, 0xd1, 0x3b, 0x33, 0x11, 0x11, 0x75, 0x06, 0xd1, 0x39, 0x42, 0x57, 0x39, 0x2b, 0x34,
+	0x82, 0xf2, 0x30, 0xea, 0x75, 0xf6, 0x6d, 0x8b, 0x1a, 0x2c, 0xcc, 0xb4, 0x21, 0x29, 0x22, 0xaa,
+	0x75, 0xdd, 0x8f, 0xc1, 0x42, 0xe2, 0xc5, 0x3f, 0x16, 0x14, 0x1d, 0x04, 0x89, 0x0d, 0xa3, 0x47,
+	0xa0, 0x4a, 0xeb, 0x1a, 0xd8, 0x69, 0x08, 0x39, 0xc9, 0x4b, 0xca, 0xc9, 0x4a, 0x66, 0xc9, 0x69,
+	0x70, 0x59, 0x65, 0xc8, 0x50, 0x42, 0xcd, 0x96, 0x21, 0xc7, 0xb5, 0xe1, 0x2b, 0xf8, 0x28, 0xcd,
+	0xa9, 0x7e, 0x00, 0x6d, 0xc3, 0xf8, 0x11, 0xa1, 0x96, 0xd3, 0x34, 0x3c, 0x6a, 0xba, 0x72, 0x7f,
+	0x23, 0x97, 0xd4, 0x6b, 0x4c, 0x50, 0x6b, 0x8c, 0xc9, 0x15, 0xdb, 0x02, 0x39, 0x14, 0xec, 0x31,
+	0x75, 0x49, 0x59, 0x19, 0x41, 0xf4, 0xb7, 0x38, 0xc3, 0x82, 0x84, 0x9a, 0x0d, 0x93, 0x9a, 0x1a,
+	0xb0, 0xb0, 0xd5, 0xbb, 0xdf, 0xe8, 0x23, 0x18, 0xa2, 0x16, 0x6d, 0x61, 0x6d, 0x94, 0xc7, 0xf3,
+	0xc4, 0xeb, 0x97, 0x2b, 0x63, 0x62, 0xe7, 0x2b, 0x5e, 0xe3, 0xeb, 0xc5, 0xb5, 0xdc, 0x27, 0x9f,
+	0xe9, 0x02, 0x81, 0x56, 0x60, 0xd8, 0xeb, 0xd8, 0xb6, 0xe9, 0x9e, 0x6a, 0xe9, 0xb3, 0xc1, 0x3e,
+	0x06, 0x3d, 0x84, 0x11, 0x91, 0x3b, 0xd8, 0xd5, 0x32, 0x1c, 0x7f, 0xfb, 0xac, 0x64, 0x19, 0x24,
+	0xa7, 0x4b, 0x46, 0x77, 0x21, 0x85, 0x4f, 0xda, 0xb8, 0x61, 0x51, 0xdc, 0xd0, 0xb2, 0x8b, 0xca,
+	0xf2, 0x48, 0x61, 0xaa, 0x8f, 0xb1, 0xb1, 0xa6, 0x29, 0x7a, 0x80, 0x43, 0x9f, 0x43, 0xe6, 0xb9,
+	0x69, 0xb5, 0x70, 0xc3, 0x70, 0xb1, 0xe9, 0x11, 0x47, 0x1b, 0x3b, 0x43, 0xe5, 0x8d, 0x35, 0x3d,
+	0x2d, 0x90, 0x3a, 0x07, 0x22, 0x1d, 0x32, 0xdd, 0x63, 0x80, 0x9e, 0xb6, 0xb1, 0xa6, 0xf2, 0x3c,
+	0x99, 0x3d, 0x23, 0x4f, 0xea, 0xa7, 0x6d, 0x5c, 0x50, 0x5f, 0xbf, 0x5c, 0x49, 0x9f, 0xb0, 0x73,
+	0x79, 0xf1, 0x68, 0x2d, 0x77, 0x27, 0xb7, 0xa6, 0xa7, 0xdb, 0xa1, 0x79, 0xf4, 0x29, 0x64, 0x45,
+	0xde, 0x78, 0xd4, 0x35, 0x29, 0x6e, 0x9e, 0x6a, 0xe3, 0x5c, 0x9d, 0xb1, 0xd7, 0x2f, 0x57, 0x46,
+	0x05, 0x6f, 0x3d, 0xb7, 0x96, 0x5b, 0xd3, 0x33, 0x1c, 0x56, 0x93, 0xa8, 0xa5, 0x6f, 0x63, 0x30,
+	0xe1, 0x2f, 0x14, 0x9c, 0x72, 0x1e, 0x9a, 0x03, 0x10, 0x07, 0x9d, 0x41, 0x1c, 0xcc, 0x8f, 0x83,
+	0x94, 0x9e, 0x12, 0x23, 0x15, 0x07, 0x87, 0xa6, 0xe9, 0x31, 0xd1, 0x62, 0xe1, 0xe9, 0xfa, 0x31,
+	0x41, 0x37, 0x21, 0xed, 0x4f, 0x1f, 0xba, 0x18, 0xf3, 0x83, 0x20, 0xa5, 0x8f, 0x4a, 0x00, 0x1b,
+	0x62, 0x67, 0xa1, 0x84, 0x3c, 0x27, 0x1d, 0x97, 0xe7, 0x79, 0x4a, 0x97, 0x42, 0x1f, 0x90, 0x8e,
+	0x1b, 0x02, 0x78, 0x6d, 0xd3, 0xd6, 0x86, 0xc2, 0x80, 0x5a, 0xdb, 0xb4, 0xd1, 0x33, 0x18, 0x95,
+	0xe1, 0x6b, 0x93, 0x86, 0x48, 0xcf, 0xec, 0x9d, 0x0f, 0x23, 0x46, 0xdc, 0xe9, 0xb4, 0xa8, 0xd5,
+	0x6e, 0xe1, 0xcd, 0x43, 0x62, 0x1d, 0xe0, 0x3d, 0x8e, 0xdf, 0x21, 0x0d, 0xdc, 0x6f, 0x18, 0x38,
+	0xea, 0x4e, 0xde, 0x53, 0x5f, 0x45, 0xac, 0xbd, 0xf4, 0xdf, 0x38, 0x8c, 0x86, 0xcf, 0x98, 0x15,
+	0x48, 0x9d, 0x62, 0xcf, 0x38, 0xe0, 0x87, 0x2e, 0x37, 0x4f, 0x41, 0x0d, 0x55, 0x80, 0x32, 0x1b,
+	0xd5, 0x47, 0x4e, 0xb1, 0xb7, 0xc9, 0x10, 0x68, 0x03, 0x32, 0xe6, 0xbe, 0x47, 0x4d, 0xcb, 0x91,
+	0x94, 0xd8, 0x19, 0x94, 0xb4, 0x84, 0x09, 0xda, 0x6d, 0x18, 0x71, 0x88, 0x64, 0xc4, 0xcf, 0x60,
+	0x0c, 0x3b, 0x44, 0x80, 0xef, 0x03, 0x72, 0x88, 0x71, 0x6c, 0xd1, 0x43, 0xe3, 0x08, 0x53, 0x9f,
+	0x96, 0x38, 0x83, 0x36, 0xe6, 0x90, 0x27, 0x16, 0x3d, 0xdc, 0xc3, 0x54, 0xd2, 0x3f, 0x07, 0x35,
+	0xf0, 0xb8, 0x24, 0x0f, 0xf5, 0x95, 0xb6, 0xb2, 0x43, 0xf5, 0x6c, 0x37, 0x0e, 0xa2, 0x4c, 0x7a,
+	0xec, 0x2f, 0x9b, 0x3c, 0x8f, 0x59, 0x3f, 0x96, 0x6b, 0x7e, 0x09, 0x28, 0x1c, 0x27, 0x92, 0x3b,
+	0x3c, 0x90, 0xab, 0x86, 0xa2, 0x47, 0xb0, 0xef, 0xc1, 0x78, 0x28, 0x84, 0x24, 0x79, 0x64, 0x20,
+	0x79, 0x2c, 0x08, 0x2c, 0xc1, 0x5d, 0x01, 0x60, 0x61, 0x25, 0x49, 0xa9, 0x81, 0xa4, 0x14, 0x43,
+	0x70, 0xf8, 0xd2, 0x9f, 0x14, 0x48, 0xb0, 0xf4, 0xb8, 0xb8, 0x84, 0xe7, 0x60, 0xe8, 0x88, 0x50,
+	0x7c, 0x71, 0xf9, 0x16, 0x30, 0xf4, 0x7f, 0x30, 0x2c, 0x74, 0xf3, 0xb4, 0x04, 0xaf, 0x0b, 0x37,
+	0x23, 0x11, 0xdc, 0xdf, 0xae, 0xe8, 0x3e, 0xa3, 0xe7, 0xdc, 0x1d, 0xea, 0x3d, 0x77, 0x1f, 0x25,
+	0x46, 0xe2, 0x6a, 0x62, 0xe9, 0xef, 0x0a, 0x64, 0x64, 0xf5, 0xa8, 0x9a, 0xae, 0x69, 0x7b, 0x2c,
+	0x6d, 0x6c, 0xcb, 0xe9, 0x16, 0x23, 0xe5, 0xa2, 0x62, 0x34, 0xc7, 0x8a, 0xd1, 0x8f, 0x6f, 0x16,
+	0xa6, 0x42, 0xac, 0x8f, 0x89, 0x6d, 0x51, 0x6c, 0xb7, 0xe9, 0xa9, 0x0e, 0xb6, 0xe5, 0xf8, 0xe5,
+	0xc9, 0x06, 0x64, 0x9b, 0x27, 0x3e, 0xc8, 0x68, 0x63, 0xd7, 0x22, 0x0d, 0x6e, 0x08, 0xb6, 0x42,
+	0xb4, 0xa6, 0x14, 0x65, 0x1f, 0x57, 0x78, 0xff, 0xc7, 0x37, 0x0b, 0xef, 0xf5, 0x13, 0x83, 0x45,
+	0x7e, 0xc7, 0x4a, 0x8e, 0x6a, 0x9b, 0x27, 0xfe, 0x4e, 0xf8, 0xfc, 0xbd, 0x98, 0xa6, 0x2c, 0x3d,
+	0x85, 0xb4, 0x48, 0x6a, 0xb9, 0xbb, 0x22, 0xc8, 0xd2, 0xe4, 0xaf, 0xae, 0x5c, 0xb4, 0x7a, 0x82,
+	0x4b, 0x4f, 0x0b, 0x56, 0x48, 0xf2, 0xef, 0x15, 0x99, 0xf1, 0x52, 0xf2, 0x07, 0x90, 0xfc, 0x4d,
+	0x87, 0xb8, 0x1d, 0x5b, 0x53, 0xfa, 0xa2, 0x85, 0x37, 0x7c, 0x62, 0x16, 0x7d, 0x0c, 0x29, 0x16,
+	0xcc, 0xde, 0x21, 0x69, 0x35, 0xce, 0xe8, 0x0d, 0x03, 0x00, 0xda, 0x80, 0x2c, 0x4f, 0xd6, 0x80,
+	0x12, 0x1f, 0x48, 0xc9, 0x30, 0x54, 0xdd, 0x07, 0x71, 0x05, 0xff, 0x92, 0x81, 0xa4, 0xd4, 0xad,
+	0x74, 0x45, 0x9f, 0x86, 0x1a, 0x8c, 0xb0, 0xff, 0x76, 0x7e, 0x9a, 0xff, 0x12, 0x83, 0xfd, 0xd3,
+	0xef, 0x8b, 0xf8, 0x4f, 0xf0, 0x45, 0xc8, 0xee, 0x89, 0xcb, 0xdb, 0x7d, 0xe8, 0xea, 0x76, 0x4f,
+	0x5e, 0xc2, 0xee, 0xa8, 0x0c, 0x37, 0x98, 0xa1, 0x2d, 0xc7, 0xa2, 0x56, 0xd0, 0xd1, 0x19, 0x5c,
+	0x7d, 0x6d, 0x78, 0xa0, 0x84, 0xeb, 0xb6, 0xe5, 0x94, 0x05, 0x5e, 0x9a, 0x47, 0x67, 0x68, 0xf4,
+	0x18, 0xa6, 0xba, 0x27, 0xc9, 0x81, 0xe9, 0x1c, 0xe0, 0x96, 0x14, 0x23, 0x4e, 0xb0, 0x9b, 0xbd,
+	0x62, 0x06, 0x75, 0x15, 0x13, 0x3e, 0x7f, 0x93, 0xd3, 0x85, 0xd8, 0x5f, 0xc3, 0x64, 0x54, 0x6c,
+	0x03, 0x7b, 0xfe, 0x11, 0x77, 0xf9, 0x06, 0x69, 0x63, 0x4d, 0x47, 0xbd, 0xf2, 0x8b, 0xd8, 0xa3,
+	0xe8, 0x2b, 0x98, 0xee, 0xb6, 0x40, 0x46, 0xaf, 0x77, 0xe1, 0x22, 0xef, 0x4e, 0x33, 0xef, 0x0e,
+	0x5a, 0x68, 0xaa, 0x2b, 0x72, 0x2f, 0xec, 0x79, 0x1d, 0x26, 0x82, 0xb5, 0x02, 0x47, 0x8d, 0x5e,
+	0xd6, 0x3e, 0xa8, 0xcb, 0x0e, 0x1c, 0xf8, 0x14, 0x82, 0xc5, 0x8c, 0x70, 0xce, 0xa4, 0xaf, 0x90,
+	0x33, 0x81, 0x5a, 0x3b, 0x41, 0xf2, 0xdc, 0x07, 0x75, 0xbf, 0xe3, 0x3a, 0xcc, 0x28, 0xd8, 0x90,
+	0x11, 0x9b, 0xe1, 0xbd, 0xe4, 0xc0, 0x2e, 0x36, 0xcb, 0xc0, 0xec, 0x4c, 0xff, 0x85, 0x08, 0xdf,
+	0x3d, 0x98, 0xe3, 0xf4, 0xae, 0xf3, 0xba, 0x59, 0xe8, 0x62, 0x26, 0x52, 0xcb, 0x9e, 0x2d, 0x6b,
+	0x86, 0x31, 0xfd, 0x2e, 0xce, 0xcf, 0x41, 0x41, 0x43, 0x5f, 0x40, 0x36, 0x50, 0x8b, 0x05, 0xb3,
+	0x36, 0x76, 0xb6, 0xa0, 0xb4, 0xaf, 0x14, 0x6b, 0x0b, 0xd0, 0x0e, 0x8c, 0x87, 0x2c, 0x24, 0xa3,
+	0x53, 0xbd, 0xac, 0xf5, 0xc7, 0x82, 0x83, 0x45, 0x44, 0xe6, 0xaf, 0x60, 0x26, 0x1a, 0x99, 0xec,
+	0xb4, 0x91, 0xd1, 0x23, 0xda, 0xd5, 0xf9, 0x3e, 0xb9, 0xbd, 0x4d, 0xef, 0x74, 0x6f, 0x48, 0xee,
+	0x98, 0x27, 0x32, 0x56, 0xda, 0xb0, 0xc0, 0x8a, 0xa2, 0x6d, 0x79, 0xd4, 0x3a, 0x30, 0xcc, 0x0e,
+	0x3d, 0x24, 0xae, 0xf5, 0x5b, 0xdc, 0x30, 0x4c, 0x11, 0xe5, 0xd8, 0xd3, 0xd0, 0x62, 0x7c, 0x39,
+	0x55, 0x58, 0x3e, 0x27, 0x03, 0x7a, 0xd7, 0x9a, 0x0b, 0x04, 0xe6, 0xbb, 0xf2, 0xf2, 0xbe, 0x38,
+	0xb4, 0x0f, 0x21, 0x80, 0xe1, 0xe2, 0xaf, 0xf0, 0x41, 0x6f, 0x9c, 0x4e, 0x5c, 0x6a, 0x47, 0xb3,
+	0x81, 0x10, 0x5d, 0xca, 0x08, 0xa2, 0xf5, 0x3e, 0x00, 0xeb, 0x32, 0x65, 0x34, 0x4d, 0x5e, 0x4a,
+	0x20, 0xeb, 0x4b, 0x65, 0x4c, 0x95, 0x41, 0x0d, 0x82, 0x5d, 0x0a, 0x99, 0xba, 0x40, 0x88, 0x68,
+	0x86, 0xc7, 0xba, 0x3c, 0x29, 0xea, 0x01, 0x5c, 0xef, 0x3a, 0x0f, 0x9f, 0xe0, 0x83, 0x0e, 0xef,
+	0xbb, 0x9a, 0xa6, 0xa7, 0x5d, 0x67, 0x2d, 0xd0, 0x80, 0xfb, 0x49, 0xf7, 0x18, 0x2a, 0xf9, 0xf0,
+	0x87, 0xa6, 0x77, 0x6f, 0xe2, 0x55, 0x7f, 0xd8, 0x2d, 0x7d, 0x17, 0x03, 0xb4, 0x23, 0x9e, 0x0f,
+	0x0a, 0xa6, 0x87, 0x1b, 0x3f, 0x67, 0x2d, 0x0f, 0xd5, 0x8f, 0xd8, 0xb9, 0xf5, 0x63, 0x65, 0x80,
+	0xad, 0xfb, 0x0a, 0x48, 0x60, 0xdb, 0x9e, 0x72, 0x13, 0xbf, 0x7a, 0xb9, 0x49, 0x5c, 0xa6, 0xcc,
+	0xf7, 0xdf, 0x43, 0xaa, 0x30, 0x5e, 0x0f, 0x5f, 0xe0, 0xca, 0xce, 0x73, 0x82, 0x10, 0x24, 0x1c,
+	0xd3, 0xf6, 0xaf, 0x69, 0xfc, 0x37, 0xba, 0x0e, 0xc9, 0x36, 0x37, 0xa3, 0xbc, 0x9d, 0xc9, 0xaf,
+	0x7b, 0x63, 0xaf, 0x7a, 0xef, 0x3d, 0x4b, 0x7f, 0x56, 0x20, 0x59, 0x30, 0x5b, 0x2d, 0x42, 0x7f,
+	0xfe, 0xe6, 0xf6, 0x6e, 0xd0, 0xdc, 0xb2, 0x87, 0xa9, 0x73, 0x1f, 0xd6, 0x06, 0x36, 0xb5, 0x89,
+	0xde, 0xa6, 0xb6, 0x5f, 0xfb, 0x17, 0x0a, 0xa8, 0x42, 0x7b, 0x71, 0x3b, 0x23, 0x1d, 0x87, 0xbd,
+	0x59, 0x0d, 0xf1, 0x5b, 0xae, 0xa6, 0x5c, 0xf8, 0x7a, 0x24, 0x80, 0xe8, 0x0b, 0x00, 0xdc, 0xb2,
+	0x6c, 0xcb, 0x31, 0xd9, 0x13, 0x40, 0xec, 0xa2, 0x47, 0xc0, 0x10, 0xb8, 0x5f, 0xa5, 0xd7, 0x0a,
+	0x8c, 0x87, 0x55, 0x12, 0x17, 0xc6, 0xad, 0xde, 0xdb, 0xaa, 0x72, 0xa5, 0xdb, 0x6a, 0xf8, 0x72,
+	0x8a, 0x3e, 0x83, 0xa4, 0xcb, 0xb6, 0xe9, 0xbf, 0xc7, 0x2d, 0x44, 0x84, 0x44, 0xcd, 0xa1, 0x4b,
+	0x38, 0x7b, 0xe5, 0x3c, 0xb6, 0x1c, 0x07, 0xbb, 0x5a, 0xfc, 0xa2, 0x0d, 0x4a, 0x60, 0xff, 0xe6,
+	0xbe, 0x55, 0x20, 0xcb, 0x70, 0x45, 0xdc, 0xc2, 0x4d, 0x9e, 0x74, 0xe2, 0xd1, 0x92, 0x7f, 0x11,
+	0x57, 0x53, 0x2e, 0x08, 0x8c, 0x00, 0x8a, 0x3e, 0x81, 0x11, 0xf9, 0x81, 0x2f, 0x8c, 0xa7, 0x2e,
+	0x12, 0x4d, 0xc2, 0x10, 0x25, 0x6d, 0xeb, 0x40, 0xbe, 0x29, 0x88, 0x8f, 0x3e, 0x3d, 0x6f, 0xfd,
+	0x41, 0x81, 0x74, 0xf8, 0x01, 0x05, 0xcd, 0xc1, 0x8d, 0xaa, 0x5e, 0xa9, 0x56, 0x6a, 0xf9, 0x6d,
+	0xa3, 0xfe, 0xac, 0x5a, 0x32, 0x1e, 0xef, 0xd6, 0xaa, 0xa5, 0xcd, 0xf2, 0x83, 0x72, 0xa9, 0xa8,
+	0x5e, 0x43, 0x33, 0x70, 0xbd, 0x77, 0xba, 0x56, 0xcf, 0xef, 0x16, 0xf3, 0x7a, 0x51, 0x55, 0xd0,
+	0x4d, 0x98, 0xeb, 0x9d, 0xdb, 0x79, 0xbc, 0x5d, 0x2f, 0x57, 0xb7, 0x4b, 0xc6, 0xe6, 0x56, 0xa5,
+	0xbc, 0x59, 0x52, 0x63, 0xe8, 0x3d, 0xd0, 0x7a, 0x21, 0x95, 0x6a, 0xbd, 0xbc, 0x53, 0xae, 0xd5,
+	0xcb, 0x9b, 0x6a, 0x1c, 0xcd, 0xc2, 0x74, 0xef, 0x6c, 0xe9, 0x69, 0xb5, 0x54, 0x2c, 0xd7, 0x4b,
+	0x45, 0x35, 0x71, 0xeb, 0x1b, 0x05, 0xb4, 0xb3, 0xfc, 0x8e, 0x6e, 0xc3, 0x87, 0x91, 0xc5, 0x8c,
+	0xbd, 0x4a, 0xbd, 0xbc, 0xfb, 0xd0, 0xd8, 0xa9, 0x14, 0xa3, 0x7b, 0x58, 0x81, 0x8f, 0xce, 0x03,
+	0xeb, 0xf9, 0xdd, 0xff, 0x2f, 0x15, 0x7d, 0x9d, 0x15, 0xb4, 0x0c, 0xef, 0x9f, 0x07, 0xcf, 0x57,
+	0xab, 0x7a, 0x65, 0x2f, 0xbf, 0xad, 0xc6, 0x6e, 0xfd, 0x47, 0x01, 0x08, 0xbd, 0x96, 0xcf, 0xc2,
+	0xf4, 0x5e, 0xa5, 0x2e, 0xf6, 0x58, 0xd9, 0x8d, 0x28, 0x31, 0x01, 0x63, 0xe1, 0xc9, 0x67, 0xa5,
+	0x9a, 0xaa, 0x44, 0x07, 0x2b, 0xbb, 0x6c, 0xfd, 0x69, 0x98, 0x08, 0x0f, 0xe6, 0x0b, 0xb5, 0x7a,
+	0xbe, 0xbc, 0xab, 0xc6, 0xa2, 0xe8, 0xfa, 0x93, 0x8a, 0x1a, 0x43, 0x08, 0xb2, 0xe1, 0xc1, 0xdd,
+	0x8a, 0x1a, 0x47, 0x53, 0x30, 0xde, 0x03, 0xdc, 0xd2, 0x4b, 0x25, 0x35, 0xce, 0x9c, 0xd1, 0x0b,
+	0x35, 0x9e, 0x94, 0xeb, 0x5b, 0xc6, 0x5e, 0xa9, 0x5e, 0x51, 0x13, 0x68, 0x12, 0xd4, 0xf0, 0xec,
+	0x83, 0xca, 0x63, 0xbd, 0x7f, 0xb4, 0x56, 0xcd, 0xef, 0xa8, 0x43, 0x33, 0x31, 0x55, 0xb9, 0xf5,
+	0x57, 0x05, 0xb2, 0xbd, 0x4f, 0xd6, 0x68, 0x01, 0x66, 0xbb, 0xfe, 0xac, 0xd5, 0xf3, 0xf5, 0xc7,
+	0xb5, 0x88, 0x11, 0x96, 0x60, 0x3e, 0x0a, 0x28, 0x96, 0xaa, 0x95, 0x5a, 0xb9, 0x6e, 0x54, 0x4b,
+	0x7a, 0xb9, 0x12, 0x8d, 0x2a, 0x89, 0x91, 0xe6, 0x97, 0x90, 0x58, 0x4f, 0x50, 0x4a, 0x48, 0x35,
+	0x5f, 0xab, 0x95, 0x8a, 0x62, 0x93, 0xd1, 0x39, 0xbd, 0xf4, 0xa8, 0xb4, 0xc9, 0x83, 0x6a, 0x10,
+	0xf3, 0x41, 0xbe, 0xbc, 0x5d, 0x2a, 0xaa, 0x43, 0x85, 0x8d, 0xef, 0xdf, 0xce, 0x2b, 0x3f, 0xbc,
+	0x9d, 0x57, 0xfe, 0xf9, 0x76, 0x5e, 0x79, 0xf1, 0x6e, 0xfe, 0xda, 0x0f, 0xef, 0xe6, 0xaf, 0xfd,
+	0xed, 0xdd, 0xfc, 0xb5, 0x5f, 0xce, 0x8a, 0xdc, 0xf3, 0x1a, 0x5f, 0xe7, 0x2c, 0xb2, 0xca, 0xd3,
+	0x69, 0x95, 0x3d, 0x50, 0x7a, 0xec, 0x2f, 0x3d, 0x49, 0x5e, 0x6e, 0xef, 0xfe, 0x6f, 0x00, 0xb3,
+	0xfe, 0xf9, 0xf2, 0x2a, 0x1a, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1532,6 +1932,15 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TallyStrategy) > 0 {
+		i -= len(m.TallyStrategy)
+		copy(dAtA[i:], m.TallyStrategy)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.TallyStrategy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.ProposalType != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.ProposalType))
 		i--
@@ -1697,6 +2106,11 @@ func (m *ProposalVoteOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VotingMode != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.VotingMode))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.OptionSpam) > 0 {
 		i -= len(m.OptionSpam)
 		copy(dAtA[i:], m.OptionSpam)
@@ -2287,31 +2701,259 @@ func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TallyStrategyInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *WeightedVoteOption) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TallyStrategyInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyStrategyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Option != 0 {
-		n += 1 + sovGov(uint64(m.Option))
+	if len(m.Params) > 0 {
+		i -= len(m.Params)
+		copy(dAtA[i:], m.Params)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Params)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Weight)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *Ballot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Ballot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Ballot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Options) > 0 {
+		dAtA13 := make([]byte, len(m.Options)*10)
+		var j12 int
+		for _, num := range m.Options {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintGov(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BallotTallyRound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BallotTallyRound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BallotTallyRound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Eliminated != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Eliminated))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Tally != nil {
+		{
+			size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BallotTallyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BallotTallyResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BallotTallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Winner != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Winner))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Rounds) > 0 {
+		for iNdEx := len(m.Rounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.VotingMode != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.VotingMode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Topic) > 0 {
+		i -= len(m.Topic)
+		copy(dAtA[i:], m.Topic)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Topic)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
 }
 
 func (m *Deposit) Size() (n int) {
@@ -2406,6 +3048,10 @@ func (m *Proposal) Size() (n int) {
 	if m.ProposalType != 0 {
 		n += 2 + sovGov(uint64(m.ProposalType))
 	}
+	l = len(m.TallyStrategy)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2435,6 +3081,9 @@ func (m *ProposalVoteOptions) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.VotingMode != 0 {
+		n += 1 + sovGov(uint64(m.VotingMode))
+	}
 	return n
 }
 
@@ -2690,6 +3339,108 @@ func (m *MessageBasedParams) Size() (n int) {
 	return n
 }
 
+func (m *TallyStrategyInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Params)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Ballot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		l = 0
+		for _, e := range m.Options {
+			l += sovGov(uint64(e))
+		}
+		n += 1 + sovGov(uint64(l)) + l
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *BallotTallyRound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tally != nil {
+		l = m.Tally.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Eliminated != 0 {
+		n += 1 + sovGov(uint64(m.Eliminated))
+	}
+	return n
+}
+
+func (m *BallotTallyResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotingMode != 0 {
+		n += 1 + sovGov(uint64(m.VotingMode))
+	}
+	if len(m.Rounds) > 0 {
+		for _, e := range m.Rounds {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Winner != 0 {
+		n += 1 + sovGov(uint64(m.Winner))
+	}
+	return n
+}
+
+func (m *VoteDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Topic)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3446,9 +4197,41 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyStrategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TallyStrategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
@@ -3656,6 +4439,25 @@ func (m *ProposalVoteOptions) Unmarshal(dAtA []byte) error {
 			}
 			m.OptionSpam = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingMode", wireType)
+			}
+			m.VotingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingMode |= MultipleChoiceVotingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -5469,6 +6271,695 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TallyStrategyInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyStrategyInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyStrategyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Ballot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Ballot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Ballot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v VoteOption
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= VoteOption(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Options = append(m.Options, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGov
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGov
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Options) == 0 {
+					m.Options = make([]VoteOption, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v VoteOption
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGov
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= VoteOption(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Options = append(m.Options, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BallotTallyRound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BallotTallyRound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BallotTallyRound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tally == nil {
+				m.Tally = &TallyResult{}
+			}
+			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eliminated", wireType)
+			}
+			m.Eliminated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eliminated |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BallotTallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BallotTallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BallotTallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingMode", wireType)
+			}
+			m.VotingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingMode |= MultipleChoiceVotingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rounds = append(m.Rounds, &BallotTallyRound{})
+			if err := m.Rounds[len(m.Rounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+			}
+			m.Winner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Winner |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
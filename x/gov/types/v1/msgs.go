@@ -103,6 +103,26 @@ func NewMsgVoteWeighted(voter string, proposalID uint64, options WeightedVoteOpt
 	return &MsgVoteWeighted{proposalID, voter, options, metadata}
 }
 
+// NewMsgVoteRankedChoice creates a message to cast a ranked ballot on an active ranked-choice proposal
+func NewMsgVoteRankedChoice(voter string, proposalID uint64, ranking []VoteOption, metadata string) *MsgVoteRankedChoice {
+	return &MsgVoteRankedChoice{proposalID, voter, ranking, metadata}
+}
+
+// NewMsgVoteApproval creates a message to cast an approval ballot on an active approval proposal
+func NewMsgVoteApproval(voter string, proposalID uint64, approvals []VoteOption, metadata string) *MsgVoteApproval {
+	return &MsgVoteApproval{proposalID, voter, approvals, metadata}
+}
+
+// NewMsgDelegateVote creates a message to delegate the voting power of an account on the proposals of a topic
+func NewMsgDelegateVote(delegator, delegate, topic string) *MsgDelegateVote {
+	return &MsgDelegateVote{delegator, delegate, topic}
+}
+
+// NewMsgUndelegateVote creates a message to remove the vote delegation of an account for a topic
+func NewMsgUndelegateVote(delegator, topic string) *MsgUndelegateVote {
+	return &MsgUndelegateVote{delegator, topic}
+}
+
 // NewMsgExecLegacyContent creates a new MsgExecLegacyContent instance.
 func NewMsgExecLegacyContent(content *codectypes.Any, authority string) *MsgExecLegacyContent {
 	return &MsgExecLegacyContent{
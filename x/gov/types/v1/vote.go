@@ -27,6 +27,11 @@ func NewVote(proposalID uint64, voter string, options WeightedVoteOptions, metad
 	return Vote{ProposalId: proposalID, Voter: voter, Options: options, Metadata: metadata}
 }
 
+// NewBallot creates a new Ballot instance
+func NewBallot(proposalID uint64, voter string, options []VoteOption, metadata string) Ballot {
+	return Ballot{ProposalId: proposalID, Voter: voter, Options: options, Metadata: metadata}
+}
+
 // Empty returns whether a vote is empty.
 func (v Vote) Empty() bool {
 	return v.ProposalId == 0 || v.Voter == "" || len(v.Options) == 0
@@ -155,3 +160,8 @@ func (vo VoteOption) Format(s fmt.State, verb rune) {
 		_, _ = s.Write([]byte(fmt.Sprintf("%v", byte(vo))))
 	}
 }
+
+// NewVoteDelegation creates a new VoteDelegation instance
+func NewVoteDelegation(delegator, delegate, topic string) VoteDelegation {
+	return VoteDelegation{delegator, delegate, topic}
+}
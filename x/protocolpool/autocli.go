@@ -28,6 +28,20 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`$ %s query protocolpool unclaimed-budget cosmos1...`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
 				},
+				{
+					RpcMethod:      "PaymentStream",
+					Use:            "payment-stream [stream-id]",
+					Short:          "Query a payment stream and the amount claimable by its recipient",
+					Example:        fmt.Sprintf(`$ %s query protocolpool payment-stream 1`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "stream_id"}},
+				},
+				{
+					RpcMethod:      "PaymentStreams",
+					Use:            "payment-streams [address]",
+					Short:          "Query the payment streams sent by an address, or received by it with --received",
+					Example:        fmt.Sprintf(`$ %s query protocolpool payment-streams cosmos1... --received`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -89,6 +103,42 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod: "CreatePaymentStream",
+					Use:       "create-payment-stream [recipient] [deposit] [rate] <epoch-identifier>",
+					Short:     "Escrow a deposit and stream it to a recipient at a rate per block, or per epoch if an epoch identifier is given",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool create-payment-stream cosmos1... 1000000uatom 1000uatom week --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "recipient"},
+						{ProtoField: "deposit"},
+						{ProtoField: "rate"},
+						{ProtoField: "epoch_identifier", Optional: true},
+					},
+				},
+				{
+					RpcMethod: "TopUpPaymentStream",
+					Use:       "top-up-payment-stream [stream-id] [amount]",
+					Short:     "Add funds to the deposit of a payment stream",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool top-up-payment-stream 1 1000000uatom --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "stream_id"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod:      "CancelPaymentStream",
+					Use:            "cancel-payment-stream [stream-id]",
+					Short:          "Cancel a payment stream, paying the released funds to the recipient and refunding the rest of the deposit",
+					Example:        fmt.Sprintf(`$ %s tx protocolpool cancel-payment-stream 1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "stream_id"}},
+				},
+				{
+					RpcMethod:      "ClaimPaymentStream",
+					Use:            "claim-payment-stream [stream-id]",
+					Short:          "Claim the funds released by a payment stream",
+					Example:        fmt.Sprintf(`$ %s tx protocolpool claim-payment-stream 1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "stream_id"}},
+				},
 			},
 		},
 	}
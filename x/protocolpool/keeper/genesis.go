@@ -69,6 +69,30 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error
 		return errors.New("total to be distributed is greater than the last balance")
 	}
 
+	for _, stream := range data.PaymentStreams {
+		sender, err := k.authKeeper.AddressCodec().StringToBytes(stream.Sender)
+		if err != nil {
+			return fmt.Errorf("failed to decode sender address: %w", err)
+		}
+		recipient, err := k.authKeeper.AddressCodec().StringToBytes(stream.Recipient)
+		if err != nil {
+			return fmt.Errorf("failed to decode recipient address: %w", err)
+		}
+		if err := k.setPaymentStream(ctx, sender, recipient, *stream); err != nil {
+			return fmt.Errorf("failed to set payment stream %d: %w", stream.Id, err)
+		}
+	}
+
+	if err := k.NextPaymentStreamID.Set(ctx, data.NextPaymentStreamId); err != nil {
+		return fmt.Errorf("failed to set next payment stream id: %w", err)
+	}
+
+	for _, epoch := range data.StreamEpochs {
+		if err := k.StreamEpochs.Set(ctx, epoch.Identifier, epoch.Number); err != nil {
+			return fmt.Errorf("failed to set stream epoch %s: %w", epoch.Identifier, err)
+		}
+	}
+
 	return nil
 }
 
@@ -129,5 +153,29 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	err = k.PaymentStreams.Walk(ctx, nil, func(_ uint64, stream types.PaymentStream) (stop bool, err error) {
+		genState.PaymentStreams = append(genState.PaymentStreams, &stream)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genState.NextPaymentStreamId, err = k.NextPaymentStreamID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.StreamEpochs.Walk(ctx, nil, func(identifier string, number int64) (stop bool, err error) {
+		genState.StreamEpochs = append(genState.StreamEpochs, &types.StreamEpoch{
+			Identifier: identifier,
+			Number:     number,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return genState, nil
 }
@@ -50,4 +50,31 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().Equal(gs.ContinuousFund, exportedGenState.ContinuousFund)
 	suite.Require().Equal(gs.Budget, exportedGenState.Budget)
 	suite.Require().Equal(math.OneInt(), exportedGenState.LastBalance)
+	suite.Require().Empty(exportedGenState.PaymentStreams)
+	suite.Require().Equal(uint64(0), exportedGenState.NextPaymentStreamId)
+
+	// Payment streams
+	gs.PaymentStreams = []*types.PaymentStream{
+		{
+			Id:              1,
+			Sender:          "cosmos1qy3529yj3v4xw2z3vz3vz3vz3vz3vz3v3k0vyf",
+			Recipient:       "cosmos1wfjkx6tsd9jkuazlta047h6lta047h6l0n7r6e",
+			Rate:            sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			EpochIdentifier: "week",
+			Deposit:         sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			Claimed:         sdk.NewCoins(sdk.NewInt64Coin("stake", 20)),
+			LastSettled:     2,
+		},
+	}
+	gs.NextPaymentStreamId = 2
+	gs.StreamEpochs = []*types.StreamEpoch{{Identifier: "week", Number: 3}}
+	suite.Require().NoError(types.ValidateGenesis(gs))
+	err = suite.poolKeeper.InitGenesis(suite.ctx, gs)
+	suite.Require().NoError(err)
+
+	exportedGenState, err = suite.poolKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(gs.PaymentStreams, exportedGenState.PaymentStreams)
+	suite.Require().Equal(gs.NextPaymentStreamId, exportedGenState.NextPaymentStreamId)
+	suite.Require().Equal(gs.StreamEpochs, exportedGenState.StreamEpochs)
 }
@@ -12,6 +12,7 @@ import (
 	"cosmossdk.io/x/protocolpool/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ types.QueryServer = Querier{}
@@ -73,3 +74,51 @@ func (k Querier) UnclaimedBudget(ctx context.Context, req *types.QueryUnclaimedB
 		TranchesLeft:    budget.TranchesLeft,
 	}, nil
 }
+
+// PaymentStream queries a payment stream by id, along with the amount claimable by its recipient
+func (k Querier) PaymentStream(ctx context.Context, req *types.QueryPaymentStreamRequest) (*types.QueryPaymentStreamResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stream, err := k.Keeper.PaymentStreams.Get(ctx, req.StreamId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no payment stream found for id: %d", req.StreamId)
+		}
+		return nil, err
+	}
+
+	claimable, err := k.Keeper.ClaimablePaymentStreamFunds(ctx, stream)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPaymentStreamResponse{Stream: stream, Claimable: claimable}, nil
+}
+
+// PaymentStreams queries the payment streams sent or received by an address
+func (k Querier) PaymentStreams(ctx context.Context, req *types.QueryPaymentStreamsRequest) (*types.QueryPaymentStreamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := k.Keeper.authKeeper.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	index := k.Keeper.PaymentStreamsBySender
+	if req.Received {
+		index = k.Keeper.PaymentStreamsByRecipient
+	}
+
+	streams, pageRes, err := query.CollectionPaginate(ctx, index, req.Pagination, func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (types.PaymentStream, error) {
+		return k.Keeper.PaymentStreams.Get(ctx, key.K2())
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](address))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPaymentStreamsResponse{Streams: streams, Pagination: pageRes}, nil
+}
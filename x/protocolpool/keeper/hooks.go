@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	epochstypes "cosmossdk.io/x/epochs/types"
+	"cosmossdk.io/x/protocolpool/types"
+)
+
+// Hooks wrapper struct for the protocolpool keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// Hooks returns the epoch hooks of the protocolpool keeper, which track the epochs payment streams are released at.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// GetModuleName implements epochstypes.EpochHooks.
+func (Hooks) GetModuleName() string {
+	return types.ModuleName
+}
+
+// BeforeEpochStart records the number of the epoch starting, releasing the payments of the streams of the epoch.
+func (h Hooks) BeforeEpochStart(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	return h.k.StreamEpochs.Set(ctx, epochIdentifier, epochNumber)
+}
+
+// AfterEpochEnd is a noop
+func (Hooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
@@ -37,6 +37,14 @@ type Keeper struct {
 	RecipientFundDistribution collections.Map[sdk.AccAddress, math.Int]
 	Distributions             collections.Map[time.Time, math.Int] // key: time.Time | value: amount
 	LastBalance               collections.Item[math.Int]
+	PaymentStreams            collections.Map[uint64, types.PaymentStream]
+	// PaymentStreamsBySender key: SenderAddr+StreamID | value: none used (index key for payment streams by sender)
+	PaymentStreamsBySender collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// PaymentStreamsByRecipient key: RecipientAddr+StreamID | value: none used (index key for payment streams by recipient)
+	PaymentStreamsByRecipient collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	NextPaymentStreamID       collections.Sequence
+	// StreamEpochs key: EpochIdentifier | value: current epoch number
+	StreamEpochs collections.Map[string, int64]
 }
 
 func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, authority string,
@@ -53,6 +61,10 @@ func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.Accoun
 	if addr := ak.GetModuleAddress(types.ProtocolPoolDistrAccount); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ProtocolPoolDistrAccount))
 	}
+	// ensure payment stream account is set
+	if addr := ak.GetModuleAddress(types.PaymentStreamAccount); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.PaymentStreamAccount))
+	}
 
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 
@@ -68,6 +80,11 @@ func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.Accoun
 		RecipientFundDistribution: collections.NewMap(sb, types.RecipientFundDistributionKey, "recipient_fund_distribution", sdk.AccAddressKey, sdk.IntValue),
 		Distributions:             collections.NewMap(sb, types.DistributionsKey, "distributions", sdk.TimeKey, sdk.IntValue),
 		LastBalance:               collections.NewItem(sb, types.LastBalanceKey, "last_balance", sdk.IntValue),
+		PaymentStreams:            collections.NewMap(sb, types.PaymentStreamsKey, "payment_streams", collections.Uint64Key, codec.CollValue[types.PaymentStream](cdc)),
+		PaymentStreamsBySender:    collections.NewKeySet(sb, types.PaymentStreamsBySenderKey, "payment_streams_by_sender", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		PaymentStreamsByRecipient: collections.NewKeySet(sb, types.PaymentStreamsByRecipientKey, "payment_streams_by_recipient", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		NextPaymentStreamID:       collections.NewSequence(sb, types.NextPaymentStreamIDKey, "next_payment_stream_id"),
+		StreamEpochs:              collections.NewMap(sb, types.StreamEpochsKey, "stream_epochs", collections.StringKey, collections.Int64Value),
 	}
 
 	schema, err := sb.Build()
@@ -30,6 +30,7 @@ var (
 	poolAcc      = authtypes.NewEmptyModuleAccount(types.ModuleName)
 	streamAcc    = authtypes.NewEmptyModuleAccount(types.StreamAccount)
 	poolDistrAcc = authtypes.NewEmptyModuleAccount(types.ProtocolPoolDistrAccount)
+	streamEscrow = authtypes.NewEmptyModuleAccount(types.PaymentStreamAccount)
 )
 
 type KeeperTestSuite struct {
@@ -61,6 +62,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	accountKeeper.EXPECT().GetModuleAddress(types.ProtocolPoolDistrAccount).Return(poolDistrAcc.GetAddress())
 	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
 	accountKeeper.EXPECT().GetModuleAddress(types.StreamAccount).Return(streamAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAddress(types.PaymentStreamAccount).Return(streamEscrow.GetAddress())
 	s.authKeeper = accountKeeper
 
 	bankKeeper := pooltestutil.NewMockBankKeeper(ctrl)
@@ -225,6 +225,66 @@ func (k MsgServer) CancelContinuousFund(ctx context.Context, msg *types.MsgCance
 	}, nil
 }
 
+func (k MsgServer) CreatePaymentStream(ctx context.Context, msg *types.MsgCreatePaymentStream) (*types.MsgCreatePaymentStreamResponse, error) {
+	sender, err := k.authKeeper.AddressCodec().StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	id, err := k.Keeper.CreatePaymentStream(ctx, sender, recipient, msg.Deposit, msg.Rate, msg.EpochIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePaymentStreamResponse{StreamId: id}, nil
+}
+
+func (k MsgServer) TopUpPaymentStream(ctx context.Context, msg *types.MsgTopUpPaymentStream) (*types.MsgTopUpPaymentStreamResponse, error) {
+	sender, err := k.authKeeper.AddressCodec().StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := k.Keeper.TopUpPaymentStream(ctx, sender, msg.StreamId, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTopUpPaymentStreamResponse{}, nil
+}
+
+func (k MsgServer) CancelPaymentStream(ctx context.Context, msg *types.MsgCancelPaymentStream) (*types.MsgCancelPaymentStreamResponse, error) {
+	sender, err := k.authKeeper.AddressCodec().StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	paid, refunded, err := k.Keeper.CancelPaymentStream(ctx, sender, msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelPaymentStreamResponse{Paid: paid, Refunded: refunded}, nil
+}
+
+func (k MsgServer) ClaimPaymentStream(ctx context.Context, msg *types.MsgClaimPaymentStream) (*types.MsgClaimPaymentStreamResponse, error) {
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	amount, err := k.Keeper.ClaimPaymentStream(ctx, recipient, msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimPaymentStreamResponse{Amount: amount}, nil
+}
+
 func (k *Keeper) validateAuthority(authority string) error {
 	if _, err := k.authKeeper.AddressCodec().StringToBytes(authority); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
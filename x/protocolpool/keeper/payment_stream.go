@@ -0,0 +1,337 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreatePaymentStream escrows the deposit of the sender and streams it to the recipient at the given rate.
+// The rate is released every block, or at the start of every epoch if the epoch identifier is not empty.
+// It returns the id of the created payment stream.
+func (k Keeper) CreatePaymentStream(ctx context.Context, sender, recipient sdk.AccAddress, deposit, rate sdk.Coins, epochIdentifier string) (uint64, error) {
+	if err := validateAmount(deposit); err != nil {
+		return 0, err
+	}
+
+	if deposit.IsZero() {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "deposit cannot be zero")
+	}
+
+	if err := types.ValidatePaymentStreamRate(rate, deposit); err != nil {
+		return 0, err
+	}
+
+	if sender.Equals(recipient) {
+		return 0, errorsmod.Wrap(types.ErrInvalidPaymentStream, "sender and recipient cannot be the same")
+	}
+
+	current, err := k.currentStreamPeriod(ctx, epochIdentifier)
+	if err != nil {
+		return 0, err
+	}
+
+	senderStrAddr, err := k.authKeeper.AddressCodec().BytesToString(sender)
+	if err != nil {
+		return 0, err
+	}
+
+	recipientStrAddr, err := k.authKeeper.AddressCodec().BytesToString(recipient)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.PaymentStreamAccount, deposit); err != nil {
+		return 0, err
+	}
+
+	id, err := k.NextPaymentStreamID.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	stream := types.PaymentStream{
+		Id:              id,
+		Sender:          senderStrAddr,
+		Recipient:       recipientStrAddr,
+		Rate:            rate,
+		EpochIdentifier: epochIdentifier,
+		Deposit:         deposit,
+		LastSettled:     current,
+	}
+	if err := k.setPaymentStream(ctx, sender, recipient, stream); err != nil {
+		return 0, err
+	}
+
+	return id, k.EventService.EventManager(ctx).EmitKV(types.EventTypeCreatePaymentStream,
+		event.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(id, 10)),
+		event.NewAttribute(types.AttributeKeySender, senderStrAddr),
+		event.NewAttribute(types.AttributeKeyRecipient, recipientStrAddr),
+		event.NewAttribute(types.AttributeKeyAmount, deposit.String()),
+	)
+}
+
+// TopUpPaymentStream adds the amount to the deposit of a payment stream.
+// The funds released so far are paid to the recipient first, so that the amount added
+// is not released for the periods in which the deposit was depleted.
+func (k Keeper) TopUpPaymentStream(ctx context.Context, sender sdk.AccAddress, id uint64, amount sdk.Coins) error {
+	if err := validateAmount(amount); err != nil {
+		return err
+	}
+
+	if amount.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "amount cannot be zero")
+	}
+
+	stream, err := k.getSenderPaymentStream(ctx, sender, id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := k.settlePaymentStream(ctx, &stream); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.PaymentStreamAccount, amount); err != nil {
+		return err
+	}
+
+	stream.Deposit = stream.Deposit.Add(amount...)
+	if err := k.PaymentStreams.Set(ctx, id, stream); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeTopUpPaymentStream,
+		event.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(id, 10)),
+		event.NewAttribute(types.AttributeKeySender, stream.Sender),
+		event.NewAttribute(types.AttributeKeyAmount, amount.String()),
+	)
+}
+
+// CancelPaymentStream cancels a payment stream. The funds released so far are paid to the recipient
+// and the rest of the deposit is refunded to the sender.
+func (k Keeper) CancelPaymentStream(ctx context.Context, sender sdk.AccAddress, id uint64) (paid, refunded sdk.Coins, err error) {
+	stream, err := k.getSenderPaymentStream(ctx, sender, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	paid, err = k.settlePaymentStream(ctx, &stream)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	refunded = stream.Deposit
+	if !refunded.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.PaymentStreamAccount, sender, refunded); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := k.removePaymentStream(ctx, stream); err != nil {
+		return nil, nil, err
+	}
+
+	return paid, refunded, k.EventService.EventManager(ctx).EmitKV(types.EventTypeCancelPaymentStream,
+		event.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(id, 10)),
+		event.NewAttribute(types.AttributeKeySender, stream.Sender),
+		event.NewAttribute(types.AttributeKeyAmount, paid.String()),
+		event.NewAttribute(types.AttributeKeyRefunded, refunded.String()),
+	)
+}
+
+// ClaimPaymentStream pays the funds released by a payment stream to its recipient.
+// The payment stream is removed once its deposit is depleted.
+func (k Keeper) ClaimPaymentStream(ctx context.Context, recipient sdk.AccAddress, id uint64) (sdk.Coins, error) {
+	stream, err := k.GetPaymentStream(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	recipientStrAddr, err := k.authKeeper.AddressCodec().BytesToString(recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if stream.Recipient != recipientStrAddr {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the recipient of payment stream %d", recipientStrAddr, id)
+	}
+
+	amount, err := k.settlePaymentStream(ctx, &stream)
+	if err != nil {
+		return nil, err
+	}
+
+	if stream.Deposit.IsZero() {
+		err = k.removePaymentStream(ctx, stream)
+	} else {
+		err = k.PaymentStreams.Set(ctx, id, stream)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return amount, k.EventService.EventManager(ctx).EmitKV(types.EventTypeClaimPaymentStream,
+		event.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(id, 10)),
+		event.NewAttribute(types.AttributeKeyRecipient, recipientStrAddr),
+		event.NewAttribute(types.AttributeKeyAmount, amount.String()),
+	)
+}
+
+// GetPaymentStream returns a payment stream by id.
+func (k Keeper) GetPaymentStream(ctx context.Context, id uint64) (types.PaymentStream, error) {
+	stream, err := k.PaymentStreams.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.PaymentStream{}, errorsmod.Wrapf(types.ErrPaymentStreamNotFound, "id %d", id)
+		}
+		return types.PaymentStream{}, err
+	}
+
+	return stream, nil
+}
+
+// ClaimablePaymentStreamFunds returns the funds released by a payment stream which have not been claimed yet.
+func (k Keeper) ClaimablePaymentStreamFunds(ctx context.Context, stream types.PaymentStream) (sdk.Coins, error) {
+	current, err := k.currentStreamPeriod(ctx, stream.EpochIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return releasedPaymentStreamFunds(stream, current), nil
+}
+
+// settlePaymentStream pays the funds released by the payment stream to its recipient and updates
+// the deposit and the last settled period of the stream. The stream is not saved.
+func (k Keeper) settlePaymentStream(ctx context.Context, stream *types.PaymentStream) (sdk.Coins, error) {
+	current, err := k.currentStreamPeriod(ctx, stream.EpochIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	released := releasedPaymentStreamFunds(*stream, current)
+	stream.LastSettled = current
+	if released.IsZero() {
+		return sdk.NewCoins(), nil
+	}
+
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(stream.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.PaymentStreamAccount, recipient, released); err != nil {
+		return nil, err
+	}
+
+	stream.Deposit = stream.Deposit.Sub(released...)
+	stream.Claimed = stream.Claimed.Add(released...)
+	return released, nil
+}
+
+// releasedPaymentStreamFunds returns the funds released by a payment stream since it was last settled,
+// which is its rate for each period elapsed, capped to its deposit.
+func releasedPaymentStreamFunds(stream types.PaymentStream, current int64) sdk.Coins {
+	periods := current - stream.LastSettled
+	if periods <= 0 {
+		return sdk.NewCoins()
+	}
+
+	released := sdk.NewCoins()
+	for _, rate := range stream.Rate {
+		deposit := stream.Deposit.AmountOf(rate.Denom)
+
+		// compute with big.Int as the rate times the periods can overflow math.Int
+		amount := new(big.Int).Mul(rate.Amount.BigInt(), big.NewInt(periods))
+		if amount.Cmp(deposit.BigInt()) > 0 {
+			released = released.Add(sdk.NewCoin(rate.Denom, deposit))
+		} else {
+			released = released.Add(sdk.NewCoin(rate.Denom, math.NewIntFromBigInt(amount)))
+		}
+	}
+
+	return released
+}
+
+// currentStreamPeriod returns the current block height, or the current epoch number if the
+// epoch identifier is not empty.
+func (k Keeper) currentStreamPeriod(ctx context.Context, epochIdentifier string) (int64, error) {
+	if epochIdentifier == "" {
+		return k.HeaderService.HeaderInfo(ctx).Height, nil
+	}
+
+	number, err := k.StreamEpochs.Get(ctx, epochIdentifier)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, errorsmod.Wrapf(types.ErrInvalidPaymentStream, "epoch %s has not started", epochIdentifier)
+		}
+		return 0, err
+	}
+
+	return number, nil
+}
+
+// getSenderPaymentStream returns a payment stream, or an error if the sender did not create it.
+func (k Keeper) getSenderPaymentStream(ctx context.Context, sender sdk.AccAddress, id uint64) (types.PaymentStream, error) {
+	stream, err := k.GetPaymentStream(ctx, id)
+	if err != nil {
+		return types.PaymentStream{}, err
+	}
+
+	senderStrAddr, err := k.authKeeper.AddressCodec().BytesToString(sender)
+	if err != nil {
+		return types.PaymentStream{}, err
+	}
+
+	if stream.Sender != senderStrAddr {
+		return types.PaymentStream{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the sender of payment stream %d", senderStrAddr, id)
+	}
+
+	return stream, nil
+}
+
+// setPaymentStream saves a payment stream along with its sender and recipient indexes.
+func (k Keeper) setPaymentStream(ctx context.Context, sender, recipient sdk.AccAddress, stream types.PaymentStream) error {
+	if err := k.PaymentStreams.Set(ctx, stream.Id, stream); err != nil {
+		return err
+	}
+
+	if err := k.PaymentStreamsBySender.Set(ctx, collections.Join(sender, stream.Id)); err != nil {
+		return err
+	}
+
+	return k.PaymentStreamsByRecipient.Set(ctx, collections.Join(recipient, stream.Id))
+}
+
+// removePaymentStream removes a payment stream along with its sender and recipient indexes.
+func (k Keeper) removePaymentStream(ctx context.Context, stream types.PaymentStream) error {
+	sender, err := k.authKeeper.AddressCodec().StringToBytes(stream.Sender)
+	if err != nil {
+		return err
+	}
+
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(stream.Recipient)
+	if err != nil {
+		return err
+	}
+
+	if err := k.PaymentStreams.Remove(ctx, stream.Id); err != nil {
+		return err
+	}
+
+	if err := k.PaymentStreamsBySender.Remove(ctx, collections.Join(sdk.AccAddress(sender), stream.Id)); err != nil {
+		return err
+	}
+
+	return k.PaymentStreamsByRecipient.Remove(ctx, collections.Join(sdk.AccAddress(recipient), stream.Id))
+}
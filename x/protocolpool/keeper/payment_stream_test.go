@@ -0,0 +1,226 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var streamSenderAddr = sdk.AccAddress([]byte("sender______________"))
+
+func (suite *KeeperTestSuite) TestCreatePaymentStream() {
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("foo", 100))
+	rate := sdk.NewCoins(sdk.NewInt64Coin("foo", 10))
+	senderStrAddr, err := suite.authKeeper.AddressCodec().BytesToString(streamSenderAddr)
+	suite.Require().NoError(err)
+	recipientStrAddr, err := suite.authKeeper.AddressCodec().BytesToString(recipientAddr)
+	suite.Require().NoError(err)
+
+	testCases := map[string]struct {
+		input     *types.MsgCreatePaymentStream
+		expErrMsg string
+	}{
+		"invalid sender address": {
+			input:     types.NewMsgCreatePaymentStream("invalid", recipientStrAddr, deposit, rate, ""),
+			expErrMsg: "invalid sender address",
+		},
+		"zero deposit": {
+			input:     types.NewMsgCreatePaymentStream(senderStrAddr, recipientStrAddr, sdk.NewCoins(), rate, ""),
+			expErrMsg: "deposit cannot be zero",
+		},
+		"zero rate": {
+			input:     types.NewMsgCreatePaymentStream(senderStrAddr, recipientStrAddr, deposit, sdk.NewCoins(), ""),
+			expErrMsg: "invalid payment stream rate",
+		},
+		"rate denom not in deposit": {
+			input:     types.NewMsgCreatePaymentStream(senderStrAddr, recipientStrAddr, deposit, sdk.NewCoins(sdk.NewInt64Coin("bar", 10)), ""),
+			expErrMsg: "must be a subset of the deposit denoms",
+		},
+		"sender is recipient": {
+			input:     types.NewMsgCreatePaymentStream(senderStrAddr, senderStrAddr, deposit, rate, ""),
+			expErrMsg: "sender and recipient cannot be the same",
+		},
+		"epoch not started": {
+			input:     types.NewMsgCreatePaymentStream(senderStrAddr, recipientStrAddr, deposit, rate, "week"),
+			expErrMsg: "epoch week has not started",
+		},
+		"valid case": {
+			input: types.NewMsgCreatePaymentStream(senderStrAddr, recipientStrAddr, deposit, rate, ""),
+		},
+	}
+
+	for name, tc := range testCases {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), streamSenderAddr, types.PaymentStreamAccount, deposit).Return(nil).AnyTimes()
+
+			res, err := suite.msgServer.CreatePaymentStream(suite.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(0), res.StreamId)
+
+			stream, err := suite.poolKeeper.GetPaymentStream(suite.ctx, res.StreamId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(senderStrAddr, stream.Sender)
+			suite.Require().Equal(recipientStrAddr, stream.Recipient)
+			suite.Require().Equal(deposit, stream.Deposit)
+			suite.Require().Equal(rate, stream.Rate)
+
+			has, err := suite.poolKeeper.PaymentStreamsBySender.Has(suite.ctx, collections.Join(streamSenderAddr, res.StreamId))
+			suite.Require().NoError(err)
+			suite.Require().True(has)
+			has, err = suite.poolKeeper.PaymentStreamsByRecipient.Has(suite.ctx, collections.Join(recipientAddr, res.StreamId))
+			suite.Require().NoError(err)
+			suite.Require().True(has)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestClaimPaymentStream() {
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("foo", 100), sdk.NewInt64Coin("bar", 10))
+	rate := sdk.NewCoins(sdk.NewInt64Coin("foo", 30), sdk.NewInt64Coin("bar", 1))
+	senderStrAddr, err := suite.authKeeper.AddressCodec().BytesToString(streamSenderAddr)
+	suite.Require().NoError(err)
+	recipientStrAddr, err := suite.authKeeper.AddressCodec().BytesToString(recipientAddr)
+	suite.Require().NoError(err)
+
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Height: 10})
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(ctx, streamSenderAddr, types.PaymentStreamAccount, deposit).Return(nil)
+	res, err := suite.msgServer.CreatePaymentStream(ctx, types.NewMsgCreatePaymentStream(senderStrAddr, recipientStrAddr, deposit, rate, ""))
+	suite.Require().NoError(err)
+
+	// only the recipient can claim
+	_, err = suite.msgServer.ClaimPaymentStream(ctx, types.NewMsgClaimPaymentStream(senderStrAddr, res.StreamId))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// nothing is released in the block the stream is created
+	claimRes, err := suite.msgServer.ClaimPaymentStream(ctx, types.NewMsgClaimPaymentStream(recipientStrAddr, res.StreamId))
+	suite.Require().NoError(err)
+	suite.Require().True(claimRes.Amount.IsZero())
+
+	// the rate is released each block
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 12})
+	released := sdk.NewCoins(sdk.NewInt64Coin("foo", 60), sdk.NewInt64Coin("bar", 2))
+	queryRes, err := suite.queryServer.PaymentStream(ctx, &types.QueryPaymentStreamRequest{StreamId: res.StreamId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(released, queryRes.Claimable)
+
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.PaymentStreamAccount, recipientAddr, released).Return(nil)
+	claimRes, err = suite.msgServer.ClaimPaymentStream(ctx, types.NewMsgClaimPaymentStream(recipientStrAddr, res.StreamId))
+	suite.Require().NoError(err)
+	suite.Require().Equal(released, claimRes.Amount)
+
+	stream, err := suite.poolKeeper.GetPaymentStream(ctx, res.StreamId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 40), sdk.NewInt64Coin("bar", 8)), stream.Deposit)
+	suite.Require().Equal(released, stream.Claimed)
+	suite.Require().Equal(int64(12), stream.LastSettled)
+
+	// the released funds are capped to the deposit, and the stream is removed once depleted
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 30})
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.PaymentStreamAccount, recipientAddr, stream.Deposit).Return(nil)
+	claimRes, err = suite.msgServer.ClaimPaymentStream(ctx, types.NewMsgClaimPaymentStream(recipientStrAddr, res.StreamId))
+	suite.Require().NoError(err)
+	suite.Require().Equal(stream.Deposit, claimRes.Amount)
+
+	_, err = suite.poolKeeper.GetPaymentStream(ctx, res.StreamId)
+	suite.Require().ErrorIs(err, types.ErrPaymentStreamNotFound)
+	has, err := suite.poolKeeper.PaymentStreamsByRecipient.Has(ctx, collections.Join(recipientAddr, res.StreamId))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
+
+func (suite *KeeperTestSuite) TestTopUpAndCancelPaymentStream() {
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("foo", 100))
+	rate := sdk.NewCoins(sdk.NewInt64Coin("foo", 40))
+	senderStrAddr, err := suite.authKeeper.AddressCodec().BytesToString(streamSenderAddr)
+	suite.Require().NoError(err)
+	recipientStrAddr, err := suite.authKeeper.AddressCodec().BytesToString(recipientAddr)
+	suite.Require().NoError(err)
+
+	// the payments of epoch streams are released when an epoch starts
+	suite.Require().NoError(suite.poolKeeper.Hooks().BeforeEpochStart(suite.ctx, "week", 1))
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, streamSenderAddr, types.PaymentStreamAccount, deposit).Return(nil)
+	res, err := suite.msgServer.CreatePaymentStream(suite.ctx, types.NewMsgCreatePaymentStream(senderStrAddr, recipientStrAddr, deposit, rate, "week"))
+	suite.Require().NoError(err)
+
+	// only the sender can top up or cancel
+	_, err = suite.msgServer.TopUpPaymentStream(suite.ctx, types.NewMsgTopUpPaymentStream(recipientStrAddr, res.StreamId, deposit))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.CancelPaymentStream(suite.ctx, types.NewMsgCancelPaymentStream(recipientStrAddr, res.StreamId))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.CancelPaymentStream(suite.ctx, types.NewMsgCancelPaymentStream(senderStrAddr, 42))
+	suite.Require().ErrorIs(err, types.ErrPaymentStreamNotFound)
+
+	// the deposit is depleted after 3 epochs, the funds released are paid before topping up
+	suite.Require().NoError(suite.poolKeeper.Hooks().BeforeEpochStart(suite.ctx, "week", 5))
+	topUp := sdk.NewCoins(sdk.NewInt64Coin("foo", 50))
+	gomock.InOrder(
+		suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.PaymentStreamAccount, recipientAddr, deposit).Return(nil),
+		suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, streamSenderAddr, types.PaymentStreamAccount, topUp).Return(nil),
+	)
+	_, err = suite.msgServer.TopUpPaymentStream(suite.ctx, types.NewMsgTopUpPaymentStream(senderStrAddr, res.StreamId, topUp))
+	suite.Require().NoError(err)
+
+	stream, err := suite.poolKeeper.GetPaymentStream(suite.ctx, res.StreamId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(topUp, stream.Deposit)
+	suite.Require().Equal(int64(5), stream.LastSettled)
+
+	// cancelling pays the released funds and refunds the rest of the deposit
+	suite.Require().NoError(suite.poolKeeper.Hooks().BeforeEpochStart(suite.ctx, "week", 6))
+	paid := sdk.NewCoins(sdk.NewInt64Coin("foo", 40))
+	refunded := sdk.NewCoins(sdk.NewInt64Coin("foo", 10))
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.PaymentStreamAccount, recipientAddr, paid).Return(nil)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.PaymentStreamAccount, streamSenderAddr, refunded).Return(nil)
+	cancelRes, err := suite.msgServer.CancelPaymentStream(suite.ctx, types.NewMsgCancelPaymentStream(senderStrAddr, res.StreamId))
+	suite.Require().NoError(err)
+	suite.Require().Equal(paid, cancelRes.Paid)
+	suite.Require().Equal(refunded, cancelRes.Refunded)
+
+	_, err = suite.poolKeeper.GetPaymentStream(suite.ctx, res.StreamId)
+	suite.Require().ErrorIs(err, types.ErrPaymentStreamNotFound)
+}
+
+func (suite *KeeperTestSuite) TestQueryPaymentStreams() {
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("foo", 100))
+	rate := sdk.NewCoins(sdk.NewInt64Coin("foo", 1))
+	senderStrAddr, err := suite.authKeeper.AddressCodec().BytesToString(streamSenderAddr)
+	suite.Require().NoError(err)
+	recipientStrAddr, err := suite.authKeeper.AddressCodec().BytesToString(recipientAddr)
+	suite.Require().NoError(err)
+
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, streamSenderAddr, types.PaymentStreamAccount, deposit).Return(nil).Times(3)
+	for i := 0; i < 3; i++ {
+		_, err := suite.msgServer.CreatePaymentStream(suite.ctx, types.NewMsgCreatePaymentStream(senderStrAddr, recipientStrAddr, deposit, rate, ""))
+		suite.Require().NoError(err)
+	}
+
+	res, err := suite.queryServer.PaymentStreams(suite.ctx, &types.QueryPaymentStreamsRequest{Address: senderStrAddr, Pagination: &query.PageRequest{Limit: 2}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Streams, 2)
+	suite.Require().Equal(uint64(0), res.Streams[0].Id)
+	suite.Require().Equal(uint64(1), res.Streams[1].Id)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	res, err = suite.queryServer.PaymentStreams(suite.ctx, &types.QueryPaymentStreamsRequest{Address: recipientStrAddr, Received: true})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Streams, 3)
+
+	// the sender did not receive any stream
+	res, err = suite.queryServer.PaymentStreams(suite.ctx, &types.QueryPaymentStreamsRequest{Address: senderStrAddr, Received: true})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Streams)
+
+	_, err = suite.queryServer.PaymentStream(suite.ctx, &types.QueryPaymentStreamRequest{StreamId: 3})
+	suite.Require().ErrorContains(err, "no payment stream found for id: 3")
+}
@@ -17,6 +17,10 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgCreateContinuousFund{},
 		&MsgCancelContinuousFund{},
 		&MsgWithdrawContinuousFund{},
+		&MsgCreatePaymentStream{},
+		&MsgTopUpPaymentStream{},
+		&MsgCancelPaymentStream{},
+		&MsgClaimPaymentStream{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -5,4 +5,7 @@ import "cosmossdk.io/errors"
 var (
 	ErrInvalidSigner    = errors.Register(ModuleName, 2, "expected authority account as only signer for community pool spend message")
 	ErrNoRecipientFound = errors.Register(ModuleName, 3, "no recipient found")
+
+	ErrPaymentStreamNotFound = errors.Register(ModuleName, 4, "payment stream not found")
+	ErrInvalidPaymentStream  = errors.Register(ModuleName, 5, "invalid payment stream")
 )
@@ -0,0 +1,15 @@
+package types
+
+// protocolpool module event types
+const (
+	EventTypeCreatePaymentStream = "create_payment_stream"
+	EventTypeTopUpPaymentStream  = "top_up_payment_stream"
+	EventTypeCancelPaymentStream = "cancel_payment_stream"
+	EventTypeClaimPaymentStream  = "claim_payment_stream"
+
+	AttributeKeyStreamID  = "stream_id"
+	AttributeKeySender    = "sender"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyRefunded  = "refunded"
+)
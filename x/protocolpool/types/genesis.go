@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -37,6 +38,32 @@ func ValidateGenesis(gs *GenesisState) error {
 			return err
 		}
 	}
+
+	streamIDs := make(map[uint64]bool, len(gs.PaymentStreams))
+	for _, ps := range gs.PaymentStreams {
+		if err := ps.Validate(); err != nil {
+			return err
+		}
+		if streamIDs[ps.Id] {
+			return fmt.Errorf("duplicate payment stream id %d", ps.Id)
+		}
+		if ps.Id >= gs.NextPaymentStreamId {
+			return fmt.Errorf("payment stream id %d must be lower than the next payment stream id %d", ps.Id, gs.NextPaymentStreamId)
+		}
+		streamIDs[ps.Id] = true
+	}
+
+	epochs := make(map[string]bool, len(gs.StreamEpochs))
+	for _, epoch := range gs.StreamEpochs {
+		if epoch.Identifier == "" {
+			return errors.New("stream epoch identifier cannot be empty")
+		}
+		if epochs[epoch.Identifier] {
+			return fmt.Errorf("duplicate stream epoch %s", epoch.Identifier)
+		}
+		epochs[epoch.Identifier] = true
+	}
+
 	return nil
 }
 
@@ -42,6 +42,12 @@ type GenesisState struct {
 	// funds and budgets. It contains time in order to distribute to non-expired
 	// funds only.
 	Distributions []*Distribution `protobuf:"bytes,4,rep,name=distributions,proto3" json:"distributions,omitempty"`
+	// payment_streams defines the payment streams at genesis.
+	PaymentStreams []*PaymentStream `protobuf:"bytes,5,rep,name=payment_streams,json=paymentStreams,proto3" json:"payment_streams,omitempty"`
+	// next_payment_stream_id is the id of the next payment stream to be created.
+	NextPaymentStreamId uint64 `protobuf:"varint,6,opt,name=next_payment_stream_id,json=nextPaymentStreamId,proto3" json:"next_payment_stream_id,omitempty"`
+	// stream_epochs defines the current epoch numbers of the epochs used by payment streams.
+	StreamEpochs []*StreamEpoch `protobuf:"bytes,7,rep,name=stream_epochs,json=streamEpochs,proto3" json:"stream_epochs,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -98,6 +104,82 @@ func (m *GenesisState) GetDistributions() []*Distribution {
 	return nil
 }
 
+func (m *GenesisState) GetPaymentStreams() []*PaymentStream {
+	if m != nil {
+		return m.PaymentStreams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextPaymentStreamId() uint64 {
+	if m != nil {
+		return m.NextPaymentStreamId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetStreamEpochs() []*StreamEpoch {
+	if m != nil {
+		return m.StreamEpochs
+	}
+	return nil
+}
+
+// StreamEpoch defines the current number of an x/epochs epoch, as tracked by the payment streams.
+type StreamEpoch struct {
+	// identifier is the epoch identifier.
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// number is the number of the current epoch.
+	Number int64 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (m *StreamEpoch) Reset()         { *m = StreamEpoch{} }
+func (m *StreamEpoch) String() string { return proto.CompactTextString(m) }
+func (*StreamEpoch) ProtoMessage()    {}
+func (*StreamEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72560a99455b4146, []int{1}
+}
+func (m *StreamEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamEpoch.Merge(m, src)
+}
+func (m *StreamEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamEpoch proto.InternalMessageInfo
+
+func (m *StreamEpoch) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *StreamEpoch) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
 type Distribution struct {
 	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 	Time   *time.Time            `protobuf:"bytes,6,opt,name=time,proto3,stdtime" json:"time,omitempty"`
@@ -107,7 +189,7 @@ func (m *Distribution) Reset()         { *m = Distribution{} }
 func (m *Distribution) String() string { return proto.CompactTextString(m) }
 func (*Distribution) ProtoMessage()    {}
 func (*Distribution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72560a99455b4146, []int{1}
+	return fileDescriptor_72560a99455b4146, []int{2}
 }
 func (m *Distribution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -145,6 +227,7 @@ func (m *Distribution) GetTime() *time.Time {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.protocolpool.v1.GenesisState")
+	proto.RegisterType((*StreamEpoch)(nil), "cosmos.protocolpool.v1.StreamEpoch")
 	proto.RegisterType((*Distribution)(nil), "cosmos.protocolpool.v1.Distribution")
 }
 
@@ -153,32 +236,39 @@ func init() {
 }
 
 var fileDescriptor_72560a99455b4146 = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcf, 0xca, 0xd3, 0x40,
-	0x14, 0xc5, 0x33, 0x5f, 0x4b, 0xc0, 0x69, 0x55, 0x08, 0x2a, 0x31, 0x8b, 0xa4, 0x96, 0x22, 0x05,
-	0x71, 0x42, 0xab, 0xb8, 0x71, 0x97, 0x8a, 0x52, 0x17, 0x0a, 0xd1, 0x95, 0x9b, 0x92, 0x3f, 0xd3,
-	0x18, 0x4c, 0xe6, 0x86, 0xce, 0x4c, 0xd1, 0x47, 0x70, 0xd7, 0x77, 0xd1, 0x87, 0xe8, 0xb2, 0xb8,
-	0x12, 0x17, 0x55, 0xda, 0x17, 0x91, 0xce, 0xa4, 0x92, 0x80, 0xdd, 0x7c, 0xbb, 0xc9, 0xbd, 0xbf,
-	0x73, 0x72, 0xe0, 0x5c, 0x3c, 0x4a, 0x80, 0x97, 0xc0, 0xfd, 0x6a, 0x05, 0x02, 0x12, 0x28, 0x2a,
-	0x80, 0xc2, 0x5f, 0x4f, 0xfc, 0x8c, 0x32, 0xca, 0x73, 0x4e, 0xd4, 0xdc, 0xba, 0xa7, 0x29, 0xd2,
-	0xa4, 0xc8, 0x7a, 0xe2, 0x0c, 0x2f, 0xa8, 0xc5, 0x97, 0x8a, 0xd6, 0xb4, 0x73, 0x27, 0x83, 0x0c,
-	0xd4, 0xd3, 0x3f, 0xbd, 0xea, 0xe9, 0x7d, 0xad, 0x5c, 0xe8, 0x45, 0xd3, 0xde, 0xf1, 0x32, 0x80,
-	0xac, 0xa0, 0xda, 0x34, 0x96, 0x4b, 0x5f, 0xe4, 0x25, 0xe5, 0x22, 0x2a, 0x2b, 0x0d, 0x0c, 0xbf,
-	0x5d, 0xe1, 0xfe, 0x2b, 0x9d, 0xef, 0x9d, 0x88, 0x04, 0xb5, 0xde, 0xe2, 0xdb, 0x09, 0x30, 0x91,
-	0x33, 0x09, 0x92, 0x2f, 0x96, 0x92, 0xa5, 0x36, 0x1a, 0x74, 0xc6, 0xbd, 0xe9, 0x43, 0xf2, 0xff,
-	0xe0, 0x64, 0xf6, 0x0f, 0x7f, 0x29, 0x59, 0x1a, 0xde, 0x4a, 0x5a, 0xdf, 0xd6, 0x33, 0x6c, 0xc6,
-	0x32, 0xcd, 0xa8, 0xb0, 0xaf, 0x94, 0x8f, 0x7b, 0xc9, 0x27, 0x50, 0x54, 0x58, 0xd3, 0xd6, 0x1b,
-	0xdc, 0x2f, 0x22, 0x2e, 0x16, 0x71, 0x54, 0x44, 0x2c, 0xa1, 0x76, 0x67, 0x80, 0xc6, 0x37, 0x82,
-	0x47, 0xdb, 0xbd, 0x67, 0xfc, 0xda, 0x7b, 0x77, 0xb5, 0x09, 0x4f, 0x3f, 0x91, 0x1c, 0xfc, 0x32,
-	0x12, 0x1f, 0xc9, 0x9c, 0x89, 0x1f, 0xdf, 0x1f, 0xe3, 0xda, 0x7d, 0xce, 0x44, 0xd8, 0x3b, 0x19,
-	0x04, 0x5a, 0x6f, 0xbd, 0xc6, 0x37, 0xd3, 0x9c, 0x8b, 0x55, 0x1e, 0x4b, 0x91, 0x03, 0xe3, 0x76,
-	0x57, 0xc5, 0x19, 0x5d, 0x8a, 0xf3, 0xa2, 0x01, 0x87, 0x6d, 0xe9, 0xf0, 0x2b, 0xc2, 0xfd, 0xe6,
-	0xde, 0x9a, 0x61, 0x33, 0x2a, 0x41, 0x32, 0x71, 0x9d, 0x98, 0xb5, 0xd4, 0x7a, 0x8a, 0xbb, 0xa7,
-	0x7a, 0x6c, 0x73, 0x80, 0xc6, 0xbd, 0xa9, 0x43, 0x74, 0x77, 0xe4, 0xdc, 0x1d, 0x79, 0x7f, 0xee,
-	0x2e, 0xe8, 0x6e, 0x7e, 0x7b, 0x28, 0x54, 0x74, 0xf0, 0x7c, 0x7b, 0x70, 0xd1, 0xee, 0xe0, 0xa2,
-	0x3f, 0x07, 0x17, 0x6d, 0x8e, 0xae, 0xb1, 0x3b, 0xba, 0xc6, 0xcf, 0xa3, 0x6b, 0x7c, 0x78, 0xd0,
-	0xfa, 0xf9, 0xe7, 0xf6, 0x65, 0xa9, 0xb3, 0x8a, 0x4d, 0x35, 0x7b, 0xf2, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0x0c, 0x77, 0xdd, 0xf7, 0xbb, 0x02, 0x00, 0x00,
+	// 511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc7, 0xeb, 0xb5, 0xbf, 0xfe, 0x84, 0xdb, 0x6d, 0x92, 0x81, 0x2a, 0xf4, 0x90, 0x96, 0x32,
+	0x50, 0x25, 0x84, 0xa3, 0x6d, 0x88, 0x0b, 0xb7, 0x8c, 0x01, 0xe5, 0x30, 0x90, 0xc7, 0x89, 0x4b,
+	0x94, 0x3f, 0x6e, 0x66, 0x91, 0xd8, 0x51, 0xec, 0x4c, 0xdb, 0x95, 0x1b, 0xb7, 0xbd, 0x18, 0x5e,
+	0xc4, 0x8e, 0x13, 0x27, 0xc4, 0x61, 0xa0, 0xf6, 0x8d, 0xa0, 0xd8, 0x19, 0x24, 0x12, 0xb9, 0x70,
+	0xf3, 0xf3, 0xf8, 0xf3, 0xfd, 0xe6, 0xab, 0x27, 0x8f, 0xe1, 0x4e, 0x28, 0x64, 0x2a, 0xa4, 0x93,
+	0xe5, 0x42, 0x89, 0x50, 0x24, 0x99, 0x10, 0x89, 0x73, 0xba, 0xeb, 0xc4, 0x94, 0x53, 0xc9, 0x24,
+	0xd6, 0x7d, 0x34, 0x32, 0x14, 0xae, 0x53, 0xf8, 0x74, 0x77, 0x3c, 0x6b, 0x51, 0xab, 0xf3, 0x8c,
+	0x56, 0xf4, 0xf8, 0x4e, 0x2c, 0x62, 0xa1, 0x8f, 0x4e, 0x79, 0xaa, 0xba, 0xf7, 0x8c, 0xd2, 0x33,
+	0x17, 0x75, 0xfb, 0xf1, 0x24, 0x16, 0x22, 0x4e, 0xa8, 0x31, 0x0d, 0x8a, 0xa5, 0xa3, 0x58, 0x4a,
+	0xa5, 0xf2, 0xd3, 0xcc, 0x00, 0xb3, 0x4f, 0x3d, 0x38, 0x7c, 0x65, 0xf2, 0x1d, 0x2b, 0x5f, 0x51,
+	0xf4, 0x16, 0x6e, 0x87, 0x82, 0x2b, 0xc6, 0x0b, 0x51, 0x48, 0x6f, 0x59, 0xf0, 0xc8, 0x02, 0xd3,
+	0xee, 0x7c, 0xb0, 0xf7, 0x08, 0xff, 0x3d, 0x38, 0x3e, 0xf8, 0x8d, 0xbf, 0x2c, 0x78, 0x44, 0xb6,
+	0xc2, 0x46, 0x8d, 0x9e, 0xc1, 0x7e, 0x50, 0x44, 0x31, 0x55, 0xd6, 0x86, 0xf6, 0xb1, 0xdb, 0x7c,
+	0x5c, 0x4d, 0x91, 0x8a, 0x46, 0x47, 0x70, 0x98, 0xf8, 0x52, 0x79, 0x81, 0x9f, 0xf8, 0x3c, 0xa4,
+	0x56, 0x77, 0x0a, 0xe6, 0xb7, 0xdc, 0xc7, 0x97, 0xd7, 0x93, 0xce, 0xf7, 0xeb, 0xc9, 0x5d, 0x63,
+	0x22, 0xa3, 0x8f, 0x98, 0x09, 0x27, 0xf5, 0xd5, 0x09, 0x5e, 0x70, 0xf5, 0xf5, 0xcb, 0x13, 0x58,
+	0xb9, 0x2f, 0xb8, 0x22, 0x83, 0xd2, 0xc0, 0x35, 0x7a, 0xf4, 0x06, 0x6e, 0x46, 0x4c, 0xaa, 0x9c,
+	0x05, 0x85, 0x62, 0x82, 0x4b, 0xab, 0xa7, 0xe3, 0xec, 0xb4, 0xc5, 0x79, 0x51, 0x83, 0x49, 0x53,
+	0x8a, 0x8e, 0xe0, 0x76, 0xe6, 0x9f, 0xa7, 0x94, 0x2b, 0x4f, 0xaa, 0x9c, 0xfa, 0xa9, 0xb4, 0xfe,
+	0xd3, 0x6e, 0x0f, 0xdb, 0xdc, 0xde, 0x19, 0xfc, 0x58, 0xd3, 0x64, 0x2b, 0xab, 0x97, 0x12, 0xed,
+	0xc3, 0x11, 0xa7, 0x67, 0xca, 0x6b, 0x9a, 0x7a, 0x2c, 0xb2, 0xfa, 0x53, 0x30, 0xef, 0x91, 0xdb,
+	0xe5, 0x6d, 0xc3, 0x62, 0x11, 0xa1, 0xd7, 0x70, 0xb3, 0xe2, 0x68, 0x26, 0xc2, 0x13, 0x69, 0xfd,
+	0xaf, 0x23, 0x3c, 0x68, 0x8b, 0x60, 0x84, 0x87, 0x25, 0x4b, 0x86, 0xf2, 0x4f, 0x21, 0x67, 0x87,
+	0x70, 0x50, 0xbb, 0x44, 0x36, 0x84, 0x2c, 0xa2, 0x5c, 0xb1, 0x25, 0xa3, 0xb9, 0x05, 0xca, 0xb9,
+	0x93, 0x5a, 0x07, 0x8d, 0x60, 0x9f, 0x17, 0x69, 0x40, 0x73, 0x6b, 0x63, 0x0a, 0xe6, 0x5d, 0x52,
+	0x55, 0xb3, 0xcf, 0x00, 0x0e, 0xeb, 0x53, 0x43, 0x07, 0xb0, 0xef, 0xa7, 0xa2, 0xe0, 0xea, 0x5f,
+	0x7e, 0x5e, 0x25, 0x45, 0x4f, 0x61, 0xaf, 0x5c, 0x5a, 0x3d, 0x89, 0xc1, 0xde, 0x18, 0x9b, 0x8d,
+	0xc6, 0x37, 0x1b, 0x8d, 0xdf, 0xdf, 0x6c, 0xb4, 0xdb, 0xbb, 0xf8, 0x31, 0x01, 0x44, 0xd3, 0xee,
+	0xf3, 0xcb, 0x95, 0x0d, 0xae, 0x56, 0x36, 0xf8, 0xb9, 0xb2, 0xc1, 0xc5, 0xda, 0xee, 0x5c, 0xad,
+	0xed, 0xce, 0xb7, 0xb5, 0xdd, 0xf9, 0x70, 0xbf, 0xf1, 0xf1, 0xb3, 0xe6, 0x7b, 0xd3, 0x8f, 0x2d,
+	0xe8, 0xeb, 0xde, 0xfe, 0xaf, 0x01, 0x00, 0xf9, 0xd5, 0x85, 0x2c, 0xd1, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -201,6 +291,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StreamEpochs) > 0 {
+		for iNdEx := len(m.StreamEpochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StreamEpochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.NextPaymentStreamId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPaymentStreamId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PaymentStreams) > 0 {
+		for iNdEx := len(m.PaymentStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Distributions) > 0 {
 		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -256,6 +379,41 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StreamEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Distribution) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -336,6 +494,37 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PaymentStreams) > 0 {
+		for _, e := range m.PaymentStreams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextPaymentStreamId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextPaymentStreamId))
+	}
+	if len(m.StreamEpochs) > 0 {
+		for _, e := range m.StreamEpochs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StreamEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovGenesis(uint64(m.Number))
+	}
 	return n
 }
 
@@ -525,6 +714,194 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentStreams = append(m.PaymentStreams, &PaymentStream{})
+			if err := m.PaymentStreams[len(m.PaymentStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPaymentStreamId", wireType)
+			}
+			m.NextPaymentStreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPaymentStreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamEpochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StreamEpochs = append(m.StreamEpochs, &StreamEpoch{})
+			if err := m.StreamEpochs[len(m.StreamEpochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
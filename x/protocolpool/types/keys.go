@@ -12,6 +12,9 @@ const (
 	// ProtocolPoolDistrAccount is an intermediary account that holds the funds to be distributed to the protocolpool accounts.
 	ProtocolPoolDistrAccount = "protocolpool_distr"
 
+	// PaymentStreamAccount is the name constant used for the account escrowing the deposits of the payment streams.
+	PaymentStreamAccount = "payment_stream_acc"
+
 	// StoreKey is the store key string for protocolpool
 	StoreKey = ModuleName
 
@@ -31,4 +34,9 @@ var (
 	RecipientFundDistributionKey = collections.NewPrefix(5)
 	DistributionsKey             = collections.NewPrefix(6)
 	LastBalanceKey               = collections.NewPrefix(7)
+	PaymentStreamsKey            = collections.NewPrefix(8)
+	PaymentStreamsBySenderKey    = collections.NewPrefix(9)
+	PaymentStreamsByRecipientKey = collections.NewPrefix(10)
+	NextPaymentStreamIDKey       = collections.NewPrefix(11)
+	StreamEpochsKey              = collections.NewPrefix(12)
 )
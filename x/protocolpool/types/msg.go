@@ -7,6 +7,10 @@ import (
 var (
 	_ sdk.Msg = (*MsgFundCommunityPool)(nil)
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
+	_ sdk.Msg = (*MsgCreatePaymentStream)(nil)
+	_ sdk.Msg = (*MsgTopUpPaymentStream)(nil)
+	_ sdk.Msg = (*MsgCancelPaymentStream)(nil)
+	_ sdk.Msg = (*MsgClaimPaymentStream)(nil)
 )
 
 // NewMsgFundCommunityPool returns a new MsgFundCommunityPool with a sender and
@@ -27,3 +31,40 @@ func NewMsgCommunityPoolSpend(amount sdk.Coins, authority, recipient string) *Ms
 		Amount:    amount,
 	}
 }
+
+// NewMsgCreatePaymentStream returns a new MsgCreatePaymentStream streaming the deposit of the sender to
+// the recipient at the given rate per block, or per epoch if the epoch identifier is not empty.
+func NewMsgCreatePaymentStream(sender, recipient string, deposit, rate sdk.Coins, epochIdentifier string) *MsgCreatePaymentStream {
+	return &MsgCreatePaymentStream{
+		Sender:          sender,
+		Recipient:       recipient,
+		Deposit:         deposit,
+		Rate:            rate,
+		EpochIdentifier: epochIdentifier,
+	}
+}
+
+// NewMsgTopUpPaymentStream returns a new MsgTopUpPaymentStream.
+func NewMsgTopUpPaymentStream(sender string, streamID uint64, amount sdk.Coins) *MsgTopUpPaymentStream {
+	return &MsgTopUpPaymentStream{
+		Sender:   sender,
+		StreamId: streamID,
+		Amount:   amount,
+	}
+}
+
+// NewMsgCancelPaymentStream returns a new MsgCancelPaymentStream.
+func NewMsgCancelPaymentStream(sender string, streamID uint64) *MsgCancelPaymentStream {
+	return &MsgCancelPaymentStream{
+		Sender:   sender,
+		StreamId: streamID,
+	}
+}
+
+// NewMsgClaimPaymentStream returns a new MsgClaimPaymentStream.
+func NewMsgClaimPaymentStream(recipient string, streamID uint64) *MsgClaimPaymentStream {
+	return &MsgClaimPaymentStream{
+		Recipient: recipient,
+		StreamId:  streamID,
+	}
+}
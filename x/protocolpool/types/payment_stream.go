@@ -0,0 +1,51 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidatePaymentStreamRate validates the rate of a payment stream against its deposit.
+// The rate must be positive and only contain denoms of the deposit.
+func ValidatePaymentStreamRate(rate, deposit sdk.Coins) error {
+	if !rate.IsValid() || rate.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid payment stream rate: %s", rate)
+	}
+
+	if !rate.DenomsSubsetOf(deposit) {
+		return errorsmod.Wrapf(ErrInvalidPaymentStream, "rate denoms %s must be a subset of the deposit denoms %s", rate, deposit)
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the payment stream fields.
+func (ps PaymentStream) Validate() error {
+	if ps.Sender == "" {
+		return errorsmod.Wrapf(ErrInvalidPaymentStream, "payment stream %d: sender cannot be empty", ps.Id)
+	}
+
+	if ps.Recipient == "" {
+		return errorsmod.Wrapf(ErrInvalidPaymentStream, "payment stream %d: recipient cannot be empty", ps.Id)
+	}
+
+	if !ps.Deposit.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "payment stream %d: invalid deposit: %s", ps.Id, ps.Deposit)
+	}
+
+	if !ps.Claimed.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "payment stream %d: invalid claimed amount: %s", ps.Id, ps.Claimed)
+	}
+
+	if !ps.Rate.IsValid() || ps.Rate.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "payment stream %d: invalid rate: %s", ps.Id, ps.Rate)
+	}
+
+	if ps.LastSettled < 0 {
+		return errorsmod.Wrapf(ErrInvalidPaymentStream, "payment stream %d: last settled cannot be negative", ps.Id)
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -253,11 +254,239 @@ func (m *QueryUnclaimedBudgetResponse) GetTranchesLeft() uint64 {
 	return 0
 }
 
+// QueryPaymentStreamRequest is the request type for the Query/PaymentStream
+// RPC method.
+type QueryPaymentStreamRequest struct {
+	// stream_id is the id of the payment stream to query.
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *QueryPaymentStreamRequest) Reset()         { *m = QueryPaymentStreamRequest{} }
+func (m *QueryPaymentStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentStreamRequest) ProtoMessage()    {}
+func (*QueryPaymentStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{4}
+}
+func (m *QueryPaymentStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymentStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentStreamRequest.Merge(m, src)
+}
+func (m *QueryPaymentStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentStreamRequest proto.InternalMessageInfo
+
+func (m *QueryPaymentStreamRequest) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+// QueryPaymentStreamResponse is the response type for the Query/PaymentStream
+// RPC method.
+type QueryPaymentStreamResponse struct {
+	// stream is the payment stream.
+	Stream PaymentStream `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream"`
+	// claimable is the amount released by the payment stream which can be claimed by the recipient.
+	Claimable github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=claimable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimable"`
+}
+
+func (m *QueryPaymentStreamResponse) Reset()         { *m = QueryPaymentStreamResponse{} }
+func (m *QueryPaymentStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentStreamResponse) ProtoMessage()    {}
+func (*QueryPaymentStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{5}
+}
+func (m *QueryPaymentStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymentStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentStreamResponse.Merge(m, src)
+}
+func (m *QueryPaymentStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentStreamResponse proto.InternalMessageInfo
+
+func (m *QueryPaymentStreamResponse) GetStream() PaymentStream {
+	if m != nil {
+		return m.Stream
+	}
+	return PaymentStream{}
+}
+
+func (m *QueryPaymentStreamResponse) GetClaimable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Claimable
+	}
+	return nil
+}
+
+// QueryPaymentStreamsRequest is the request type for the Query/PaymentStreams
+// RPC method.
+type QueryPaymentStreamsRequest struct {
+	// address is the address of the sender or the recipient of the payment streams.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// received selects the payment streams received by the address instead of the ones sent by it.
+	Received bool `protobuf:"varint,2,opt,name=received,proto3" json:"received,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPaymentStreamsRequest) Reset()         { *m = QueryPaymentStreamsRequest{} }
+func (m *QueryPaymentStreamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentStreamsRequest) ProtoMessage()    {}
+func (*QueryPaymentStreamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{6}
+}
+func (m *QueryPaymentStreamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentStreamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentStreamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymentStreamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentStreamsRequest.Merge(m, src)
+}
+func (m *QueryPaymentStreamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentStreamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentStreamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentStreamsRequest proto.InternalMessageInfo
+
+func (m *QueryPaymentStreamsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryPaymentStreamsRequest) GetReceived() bool {
+	if m != nil {
+		return m.Received
+	}
+	return false
+}
+
+func (m *QueryPaymentStreamsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPaymentStreamsResponse is the response type for the Query/PaymentStreams
+// RPC method.
+type QueryPaymentStreamsResponse struct {
+	// streams are the payment streams.
+	Streams []PaymentStream `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPaymentStreamsResponse) Reset()         { *m = QueryPaymentStreamsResponse{} }
+func (m *QueryPaymentStreamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentStreamsResponse) ProtoMessage()    {}
+func (*QueryPaymentStreamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{7}
+}
+func (m *QueryPaymentStreamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentStreamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentStreamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymentStreamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentStreamsResponse.Merge(m, src)
+}
+func (m *QueryPaymentStreamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentStreamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentStreamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymentStreamsResponse proto.InternalMessageInfo
+
+func (m *QueryPaymentStreamsResponse) GetStreams() []PaymentStream {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+func (m *QueryPaymentStreamsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.protocolpool.v1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.protocolpool.v1.QueryCommunityPoolResponse")
 	proto.RegisterType((*QueryUnclaimedBudgetRequest)(nil), "cosmos.protocolpool.v1.QueryUnclaimedBudgetRequest")
 	proto.RegisterType((*QueryUnclaimedBudgetResponse)(nil), "cosmos.protocolpool.v1.QueryUnclaimedBudgetResponse")
+	proto.RegisterType((*QueryPaymentStreamRequest)(nil), "cosmos.protocolpool.v1.QueryPaymentStreamRequest")
+	proto.RegisterType((*QueryPaymentStreamResponse)(nil), "cosmos.protocolpool.v1.QueryPaymentStreamResponse")
+	proto.RegisterType((*QueryPaymentStreamsRequest)(nil), "cosmos.protocolpool.v1.QueryPaymentStreamsRequest")
+	proto.RegisterType((*QueryPaymentStreamsResponse)(nil), "cosmos.protocolpool.v1.QueryPaymentStreamsResponse")
 }
 
 func init() {
@@ -265,46 +494,62 @@ func init() {
 }
 
 var fileDescriptor_51500a0a77d57843 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0xd3, 0xb4, 0x9f, 0xbe, 0x29, 0xa1, 0x68, 0x54, 0xa1, 0xd4, 0xad, 0x9c, 0x12, 0x24,
-	0x14, 0x09, 0x75, 0x46, 0x49, 0x2b, 0x21, 0xc1, 0x86, 0xa6, 0x15, 0x62, 0xc1, 0x82, 0x1a, 0xd8,
-	0xb0, 0xb1, 0xfc, 0x33, 0x71, 0xad, 0xda, 0x73, 0x5d, 0xcf, 0xb8, 0x6a, 0x85, 0xd8, 0xc0, 0x0b,
-	0x54, 0x62, 0xc3, 0x13, 0xb0, 0x60, 0xcd, 0x82, 0x47, 0xe8, 0x0a, 0x55, 0xb0, 0x61, 0x45, 0x51,
-	0xcb, 0x8e, 0x97, 0x40, 0x1e, 0x8f, 0xa1, 0x8d, 0x12, 0x50, 0x57, 0x49, 0xee, 0xb9, 0xe7, 0xdc,
-	0x73, 0x72, 0xef, 0xa0, 0x8e, 0x0f, 0x22, 0x01, 0x41, 0xd3, 0x0c, 0x24, 0xf8, 0x10, 0xa7, 0x00,
-	0x31, 0xdd, 0xeb, 0xd1, 0xdd, 0x9c, 0x65, 0x07, 0x44, 0x55, 0xf1, 0xf5, 0xb2, 0x87, 0x9c, 0xef,
-	0x21, 0x7b, 0x3d, 0x73, 0x3e, 0x84, 0x10, 0x54, 0x91, 0x16, 0xdf, 0x4a, 0xdc, 0x5c, 0x0a, 0x01,
-	0xc2, 0x98, 0x51, 0x37, 0x8d, 0xa8, 0xcb, 0x39, 0x48, 0x57, 0x46, 0xc0, 0x35, 0xdb, 0xb4, 0xf4,
-	0x3c, 0xcf, 0x15, 0x8c, 0xee, 0xf5, 0x3c, 0x26, 0xdd, 0x1e, 0xf5, 0x21, 0xe2, 0x1a, 0x5f, 0x28,
-	0x71, 0xa7, 0x94, 0x3d, 0x3f, 0xd8, 0x6c, 0x6b, 0x61, 0xf5, 0xcb, 0xcb, 0x87, 0x54, 0x46, 0x09,
-	0x13, 0xd2, 0x4d, 0xd2, 0x4a, 0x7b, 0xb4, 0x21, 0xc8, 0x33, 0x35, 0xbc, 0xc4, 0x3b, 0x8b, 0x68,
-	0x61, 0xab, 0x88, 0xb5, 0x01, 0x49, 0x92, 0xf3, 0x48, 0x1e, 0x3c, 0x06, 0x88, 0x6d, 0xb6, 0x9b,
-	0x33, 0x21, 0x3b, 0xaf, 0x0d, 0x64, 0x8e, 0x43, 0x45, 0x0a, 0x5c, 0x30, 0xcc, 0x50, 0xa3, 0x88,
-	0xdd, 0x32, 0x96, 0xa7, 0xba, 0xb3, 0xfd, 0x25, 0xa2, 0x9d, 0x15, 0x31, 0x88, 0x8e, 0x41, 0x36,
-	0x99, 0xbf, 0x01, 0x11, 0x1f, 0xac, 0x1e, 0x7d, 0x6b, 0xd7, 0xde, 0x9f, 0xb4, 0x6f, 0x87, 0x91,
-	0xdc, 0xce, 0x3d, 0xe2, 0x43, 0xa2, 0x93, 0xe8, 0x8f, 0x15, 0x11, 0xec, 0x50, 0x79, 0x90, 0x32,
-	0x51, 0x71, 0x84, 0xad, 0xe4, 0x3b, 0x5b, 0x68, 0x51, 0x99, 0x78, 0xc6, 0xfd, 0xd8, 0x8d, 0x12,
-	0x16, 0x0c, 0xf2, 0x20, 0x64, 0x52, 0x9b, 0xc4, 0x7d, 0xf4, 0x9f, 0x1b, 0x04, 0x19, 0x13, 0xa2,
-	0x65, 0x2c, 0x1b, 0xdd, 0xff, 0x07, 0xad, 0xcf, 0x1f, 0x56, 0xe6, 0xb5, 0x97, 0xf5, 0x12, 0x79,
-	0x22, 0xb3, 0x88, 0x87, 0x76, 0xd5, 0xd8, 0xf9, 0x54, 0x47, 0x4b, 0xe3, 0x35, 0x75, 0xb4, 0xfb,
-	0xe8, 0xaa, 0x06, 0x1c, 0x37, 0x81, 0x9c, 0x4b, 0xa5, 0x3d, 0xdb, 0x5f, 0x18, 0x1b, 0xb2, 0x70,
-	0x6b, 0x37, 0x35, 0x61, 0x5d, 0xf5, 0xe3, 0x4d, 0x74, 0x2d, 0xe7, 0x23, 0x1a, 0xf5, 0x7f, 0x69,
-	0xcc, 0xfd, 0xa6, 0x68, 0x95, 0x87, 0x68, 0x8e, 0xb3, 0x7d, 0xe9, 0xa8, 0xaa, 0x33, 0xcc, 0x20,
-	0x69, 0x4d, 0x29, 0x11, 0x93, 0x94, 0x8b, 0x25, 0xd5, 0x62, 0xc9, 0xd3, 0x6a, 0xf3, 0x83, 0xc6,
-	0xe1, 0x49, 0xdb, 0xb0, 0x9b, 0x05, 0x71, 0xa3, 0xe0, 0x3d, 0xc8, 0x20, 0xc1, 0x77, 0xd0, 0x4c,
-	0xca, 0xb2, 0x08, 0x82, 0x56, 0x43, 0xbb, 0x18, 0x15, 0xd8, 0xd4, 0x97, 0x31, 0x68, 0xbc, 0x2d,
-	0xf8, 0xba, 0x1d, 0xdf, 0x44, 0x4d, 0x99, 0xb9, 0xdc, 0xdf, 0x66, 0xc2, 0x89, 0xd9, 0x50, 0xb6,
-	0xa6, 0x97, 0x8d, 0x6e, 0xc3, 0xbe, 0x52, 0x15, 0x1f, 0xb1, 0xa1, 0xec, 0xff, 0xac, 0xa3, 0x69,
-	0xf5, 0x87, 0xe2, 0x77, 0x06, 0x6a, 0x5e, 0x38, 0x17, 0xdc, 0x23, 0xe3, 0xdf, 0x0a, 0x99, 0x78,
-	0x78, 0x66, 0xff, 0x32, 0x94, 0x72, 0x65, 0x1d, 0xf2, 0xea, 0xcb, 0x8f, 0x37, 0xf5, 0x2e, 0xbe,
-	0x45, 0x27, 0x3c, 0x5f, 0xbf, 0xa2, 0x39, 0x45, 0x05, 0x7f, 0x34, 0xd0, 0xdc, 0xc8, 0xfa, 0xf1,
-	0xea, 0x5f, 0xe7, 0x8e, 0x3f, 0x40, 0x73, 0xed, 0x72, 0x24, 0x6d, 0xf7, 0xae, 0xb2, 0xbb, 0x86,
-	0xfb, 0x93, 0xec, 0xfe, 0xb9, 0x1e, 0x4f, 0x31, 0xe9, 0x0b, 0x7d, 0xbd, 0x2f, 0x07, 0xf7, 0x8e,
-	0x4e, 0x2d, 0xe3, 0xf8, 0xd4, 0x32, 0xbe, 0x9f, 0x5a, 0xc6, 0xe1, 0x99, 0x55, 0x3b, 0x3e, 0xb3,
-	0x6a, 0x5f, 0xcf, 0xac, 0xda, 0xf3, 0x1b, 0xa5, 0x98, 0x08, 0x76, 0x48, 0x04, 0x74, 0xff, 0xa2,
-	0xa8, 0x7a, 0x5d, 0xde, 0x8c, 0xaa, 0xad, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x33, 0x02,
-	0x59, 0xe6, 0x04, 0x00, 0x00,
+	// 871 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0xc7, 0xd7, 0xbb, 0xd9, 0xed, 0xee, 0x94, 0x74, 0xd1, 0xa8, 0x42, 0x59, 0xef, 0x2a, 0xbb,
+	0x18, 0x51, 0x22, 0x50, 0x6d, 0x92, 0x14, 0x81, 0x28, 0x42, 0xd4, 0x1b, 0x0a, 0x48, 0x1c, 0xb6,
+	0x2e, 0x5c, 0xb8, 0x58, 0xfe, 0x31, 0x71, 0xad, 0xc6, 0x33, 0xae, 0x67, 0x1c, 0x35, 0xaa, 0x7a,
+	0x81, 0x7f, 0xa0, 0x12, 0x17, 0xfe, 0x02, 0x0e, 0x48, 0x9c, 0xe8, 0x81, 0x1b, 0xd7, 0x1e, 0x10,
+	0xaa, 0xe0, 0xc2, 0x89, 0xa2, 0x0d, 0x7f, 0x08, 0xf2, 0xcc, 0xf3, 0x26, 0x8e, 0x9c, 0x6e, 0xa3,
+	0x9e, 0x12, 0xbf, 0x79, 0xdf, 0x37, 0x9f, 0xf7, 0x63, 0x1e, 0x32, 0x02, 0xc6, 0x13, 0xc6, 0xad,
+	0x34, 0x63, 0x82, 0x05, 0x6c, 0x94, 0x32, 0x36, 0xb2, 0xc6, 0x5d, 0xeb, 0x5e, 0x4e, 0xb2, 0x89,
+	0x29, 0xad, 0xf8, 0x35, 0xe5, 0x63, 0xce, 0xfb, 0x98, 0xe3, 0xae, 0x7e, 0x39, 0x62, 0x11, 0x93,
+	0x46, 0xab, 0xf8, 0xa7, 0xce, 0xf5, 0x83, 0x88, 0xb1, 0x68, 0x44, 0x2c, 0x2f, 0x8d, 0x2d, 0x8f,
+	0x52, 0x26, 0x3c, 0x11, 0x33, 0x0a, 0x6a, 0xbd, 0x0d, 0xf7, 0xf9, 0x1e, 0x27, 0xd6, 0xb8, 0xeb,
+	0x13, 0xe1, 0x75, 0xad, 0x80, 0xc5, 0x14, 0xce, 0xf7, 0xd4, 0xb9, 0xab, 0xc2, 0xce, 0x5f, 0xac,
+	0xbf, 0x3d, 0x2f, 0x95, 0x7c, 0x67, 0x01, 0x52, 0x2f, 0x8a, 0xa9, 0xbc, 0x07, 0x7c, 0x97, 0xa5,
+	0x25, 0x26, 0x29, 0x29, 0xe3, 0x1d, 0x02, 0xa8, 0xfc, 0xf2, 0xf3, 0xa1, 0x25, 0xe2, 0x84, 0x70,
+	0xe1, 0x25, 0x69, 0xc9, 0xba, 0xe8, 0x10, 0xe6, 0xd9, 0xdc, 0x25, 0xc6, 0x3e, 0xda, 0xbb, 0x55,
+	0x60, 0x1c, 0xb3, 0x24, 0xc9, 0x69, 0x2c, 0x26, 0x27, 0x8c, 0x8d, 0x1c, 0x72, 0x2f, 0x27, 0x5c,
+	0x18, 0xdf, 0x69, 0x48, 0xaf, 0x3b, 0xe5, 0x29, 0xa3, 0x9c, 0x60, 0x82, 0x1a, 0x05, 0x53, 0x4b,
+	0x3b, 0xda, 0xe8, 0x5c, 0xec, 0x1d, 0x98, 0x90, 0x69, 0x91, 0x9b, 0x09, 0x59, 0x99, 0x03, 0x12,
+	0x1c, 0xb3, 0x98, 0xda, 0xfd, 0x27, 0xff, 0x1c, 0xae, 0xfd, 0xf4, 0xec, 0xf0, 0x9d, 0x28, 0x16,
+	0x77, 0x72, 0xdf, 0x0c, 0x58, 0x02, 0x95, 0x81, 0x9f, 0xab, 0x3c, 0xbc, 0x0b, 0xa9, 0x81, 0x86,
+	0x3b, 0x32, 0xbc, 0x71, 0x0b, 0xed, 0x4b, 0x88, 0xaf, 0x69, 0x30, 0xf2, 0xe2, 0x84, 0x84, 0x76,
+	0x1e, 0x46, 0x44, 0x00, 0x24, 0xee, 0xa1, 0x0b, 0x5e, 0x18, 0x66, 0x84, 0xf3, 0x96, 0x76, 0xa4,
+	0x75, 0x76, 0xec, 0xd6, 0x9f, 0x8f, 0xaf, 0x5e, 0x06, 0x96, 0x1b, 0xea, 0xe4, 0xb6, 0xc8, 0x62,
+	0x1a, 0x39, 0xa5, 0xa3, 0xf1, 0xc7, 0x3a, 0x3a, 0xa8, 0x8f, 0x09, 0xa9, 0x7d, 0x82, 0x2e, 0xc1,
+	0x81, 0xeb, 0x25, 0x2c, 0xa7, 0x42, 0xc6, 0xbe, 0xd8, 0xdb, 0xab, 0x4d, 0xb2, 0xa0, 0x75, 0x9a,
+	0x20, 0xb8, 0x21, 0xfd, 0xf1, 0x00, 0xbd, 0x9a, 0xd3, 0x85, 0x18, 0xeb, 0xe7, 0xc5, 0xd8, 0xcd,
+	0x69, 0x35, 0xca, 0xe7, 0x68, 0x97, 0x92, 0xfb, 0xc2, 0x95, 0x56, 0x77, 0x98, 0xb1, 0xa4, 0xb5,
+	0x21, 0x83, 0xe8, 0xa6, 0x6a, 0xac, 0x59, 0x36, 0xd6, 0xfc, 0xaa, 0xec, 0xbc, 0xdd, 0x78, 0xf4,
+	0xec, 0x50, 0x73, 0x9a, 0x85, 0xf0, 0xb8, 0xd0, 0xdd, 0xcc, 0x58, 0x82, 0xdf, 0x47, 0x5b, 0x29,
+	0xc9, 0x62, 0x16, 0xb6, 0x1a, 0x40, 0xb1, 0x18, 0x60, 0x00, 0x93, 0x61, 0x37, 0x7e, 0x28, 0xf4,
+	0xe0, 0x8e, 0xdf, 0x40, 0x4d, 0x91, 0x79, 0x34, 0xb8, 0x43, 0xb8, 0x3b, 0x22, 0x43, 0xd1, 0xda,
+	0x3c, 0xd2, 0x3a, 0x0d, 0xe7, 0x95, 0xd2, 0xf8, 0x25, 0x19, 0x0a, 0xe3, 0x03, 0x18, 0xa3, 0x13,
+	0x6f, 0x92, 0x10, 0x2a, 0x6e, 0x8b, 0x8c, 0x78, 0x49, 0xd9, 0xa1, 0x7d, 0xb4, 0xc3, 0xa5, 0xc1,
+	0x8d, 0x43, 0x59, 0xc7, 0x86, 0xb3, 0xad, 0x0c, 0x5f, 0x84, 0xc6, 0xef, 0xe5, 0x8c, 0x2d, 0x48,
+	0xa1, 0x11, 0xc7, 0x68, 0x4b, 0xb9, 0x42, 0x03, 0xde, 0x34, 0xeb, 0x1f, 0xb2, 0x59, 0x91, 0xdb,
+	0x8d, 0x62, 0xdc, 0x1c, 0x90, 0xe2, 0x18, 0xed, 0xc8, 0x02, 0x7a, 0xfe, 0x88, 0xb4, 0xd6, 0x8f,
+	0x36, 0x9e, 0xdb, 0x04, 0xfb, 0x5d, 0x18, 0xd5, 0xce, 0x0b, 0x8c, 0xaa, 0x9a, 0xd3, 0x59, 0x74,
+	0xe3, 0x71, 0x6d, 0x3a, 0xfc, 0x25, 0x86, 0x15, 0xeb, 0x68, 0x3b, 0x23, 0x01, 0x89, 0xc7, 0x24,
+	0x94, 0x13, 0xb4, 0xed, 0x9c, 0x7d, 0xe3, 0x9b, 0x08, 0xcd, 0xf6, 0x06, 0x8c, 0xc6, 0x95, 0x4a,
+	0x6a, 0x6a, 0x09, 0x96, 0x09, 0x9e, 0x78, 0x11, 0x01, 0x16, 0x67, 0x4e, 0x69, 0xfc, 0xac, 0xa1,
+	0xfd, 0x5a, 0x6c, 0x68, 0xc3, 0xa7, 0xe8, 0x82, 0xaa, 0x25, 0x87, 0xd7, 0xbe, 0x52, 0x1f, 0x4a,
+	0x2d, 0xfe, 0xac, 0x82, 0xab, 0x9e, 0xc3, 0x5b, 0xe7, 0xe2, 0x2a, 0x86, 0x79, 0xde, 0xde, 0x74,
+	0x13, 0x6d, 0x4a, 0x5e, 0xfc, 0xa3, 0x86, 0x9a, 0x95, 0xf5, 0x84, 0xbb, 0xcb, 0xd0, 0x96, 0x2e,
+	0x3a, 0xbd, 0xb7, 0x8a, 0x44, 0xe1, 0x18, 0xe6, 0xb7, 0x7f, 0xfd, 0xf7, 0xfd, 0x7a, 0x07, 0x5f,
+	0xb1, 0x96, 0xec, 0xe9, 0xa0, 0x94, 0xb9, 0x85, 0x05, 0xff, 0xaa, 0xa1, 0xdd, 0x85, 0x75, 0x83,
+	0xfb, 0xcf, 0xbd, 0xb7, 0x7e, 0xe1, 0xe9, 0xd7, 0x56, 0x13, 0x01, 0xee, 0x87, 0x12, 0xf7, 0x1a,
+	0xee, 0x2d, 0xc3, 0x9d, 0x6d, 0x2b, 0x5f, 0x2a, 0xad, 0x07, 0x30, 0x80, 0x0f, 0xf1, 0x2f, 0x1a,
+	0x6a, 0x56, 0xfa, 0x7a, 0x4e, 0x8d, 0xeb, 0xb6, 0x80, 0xde, 0x5b, 0x45, 0x02, 0xd0, 0xd7, 0x25,
+	0xf4, 0x7b, 0xb8, 0xbf, 0x0c, 0x3a, 0x55, 0x32, 0x17, 0x06, 0xcc, 0x7a, 0x70, 0xb6, 0x68, 0x1e,
+	0xe2, 0xdf, 0x34, 0x74, 0xa9, 0x3a, 0xce, 0x78, 0x05, 0x86, 0xf2, 0xc9, 0xea, 0xfd, 0x95, 0x34,
+	0x00, 0x3e, 0x90, 0xe0, 0x1f, 0xe3, 0x8f, 0x5e, 0x14, 0xdc, 0x9f, 0xb8, 0x50, 0xee, 0x59, 0xdd,
+	0xed, 0xeb, 0x4f, 0x4e, 0xdb, 0xda, 0xd3, 0xd3, 0xb6, 0xf6, 0xef, 0x69, 0x5b, 0x7b, 0x34, 0x6d,
+	0xaf, 0x3d, 0x9d, 0xb6, 0xd7, 0xfe, 0x9e, 0xb6, 0xd7, 0xbe, 0x79, 0x5d, 0x85, 0xe5, 0xe1, 0x5d,
+	0x33, 0x66, 0xd6, 0xfd, 0x6a, 0x78, 0xb9, 0x9a, 0xfc, 0x2d, 0x69, 0xeb, 0xff, 0x3f, 0x00, 0x13,
+	0x76, 0xf6, 0x8f, 0x1e, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -323,6 +568,10 @@ type QueryClient interface {
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBudgetRequest, opts ...grpc.CallOption) (*QueryUnclaimedBudgetResponse, error)
+	// PaymentStream queries a payment stream by id, along with the amount claimable by its recipient.
+	PaymentStream(ctx context.Context, in *QueryPaymentStreamRequest, opts ...grpc.CallOption) (*QueryPaymentStreamResponse, error)
+	// PaymentStreams queries the payment streams sent or received by an account.
+	PaymentStreams(ctx context.Context, in *QueryPaymentStreamsRequest, opts ...grpc.CallOption) (*QueryPaymentStreamsResponse, error)
 }
 
 type queryClient struct {
@@ -351,12 +600,34 @@ func (c *queryClient) UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBud
 	return out, nil
 }
 
+func (c *queryClient) PaymentStream(ctx context.Context, in *QueryPaymentStreamRequest, opts ...grpc.CallOption) (*QueryPaymentStreamResponse, error) {
+	out := new(QueryPaymentStreamResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Query/PaymentStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PaymentStreams(ctx context.Context, in *QueryPaymentStreamsRequest, opts ...grpc.CallOption) (*QueryPaymentStreamsResponse, error) {
+	out := new(QueryPaymentStreamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Query/PaymentStreams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(context.Context, *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error)
+	// PaymentStream queries a payment stream by id, along with the amount claimable by its recipient.
+	PaymentStream(context.Context, *QueryPaymentStreamRequest) (*QueryPaymentStreamResponse, error)
+	// PaymentStreams queries the payment streams sent or received by an account.
+	PaymentStreams(context.Context, *QueryPaymentStreamsRequest) (*QueryPaymentStreamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -369,6 +640,12 @@ func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCo
 func (*UnimplementedQueryServer) UnclaimedBudget(ctx context.Context, req *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnclaimedBudget not implemented")
 }
+func (*UnimplementedQueryServer) PaymentStream(ctx context.Context, req *QueryPaymentStreamRequest) (*QueryPaymentStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentStream not implemented")
+}
+func (*UnimplementedQueryServer) PaymentStreams(ctx context.Context, req *QueryPaymentStreamsRequest) (*QueryPaymentStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentStreams not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -410,6 +687,42 @@ func _Query_UnclaimedBudget_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PaymentStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Query/PaymentStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentStream(ctx, req.(*QueryPaymentStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentStreamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Query/PaymentStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentStreams(ctx, req.(*QueryPaymentStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.protocolpool.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -422,6 +735,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnclaimedBudget",
 			Handler:    _Query_UnclaimedBudget_Handler,
 		},
+		{
+			MethodName: "PaymentStream",
+			Handler:    _Query_PaymentStream_Handler,
+		},
+		{
+			MethodName: "PaymentStreams",
+			Handler:    _Query_PaymentStreams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/protocolpool/v1/query.proto",
@@ -589,70 +910,246 @@ func (m *QueryUnclaimedBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPaymentStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryCommunityPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryPaymentStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCommunityPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPaymentStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pool) > 0 {
-		for _, e := range m.Pool {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.StreamId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryUnclaimedBudgetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPaymentStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryUnclaimedBudgetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPaymentStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ClaimedAmount != nil {
-		l = m.ClaimedAmount.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Claimable) > 0 {
+		for iNdEx := len(m.Claimable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.UnclaimedAmount != nil {
-		l = m.UnclaimedAmount.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.NextClaimFrom != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextClaimFrom)
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentStreamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentStreamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Received {
+		i--
+		if m.Received {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentStreamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCommunityPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCommunityPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		for _, e := range m.Pool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUnclaimedBudgetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnclaimedBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClaimedAmount != nil {
+		l = m.ClaimedAmount.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UnclaimedAmount != nil {
+		l = m.UnclaimedAmount.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NextClaimFrom != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextClaimFrom)
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	if m.Period != nil {
@@ -665,6 +1162,74 @@ func (m *QueryUnclaimedBudgetResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPaymentStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovQuery(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *QueryPaymentStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stream.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Claimable) > 0 {
+		for _, e := range m.Claimable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPaymentStreamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Received {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentStreamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1100,6 +1665,450 @@ func (m *QueryUnclaimedBudgetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPaymentStreamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentStreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimable = append(m.Claimable, types.Coin{})
+			if err := m.Claimable[len(m.Claimable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentStreamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentStreamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Received = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentStreamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentStreamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Streams = append(m.Streams, PaymentStream{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,6 +7,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -182,9 +183,123 @@ func (m *ContinuousFund) GetExpiry() *time.Time {
 	return nil
 }
 
+// PaymentStream defines a stream of payments from a sender account to a recipient.
+// The sender escrows the deposit in the payment stream module account, which is then
+// released to the recipient at a fixed rate per block, or per epoch if an epoch identifier is set.
+type PaymentStream struct {
+	// id is the unique identifier of the payment stream.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender is the address of the account which created and funds the payment stream.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient is the address of the account receiving the payments.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// rate is the amount released to the recipient each period.
+	Rate github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=rate,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rate"`
+	// epoch_identifier is the identifier of the x/epochs epoch the payments are released at the start of.
+	// If empty, the payments are released every block.
+	EpochIdentifier string `protobuf:"bytes,5,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// deposit is the amount left in escrow, including the amount released but not yet claimed.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// claimed is the total amount claimed by the recipient.
+	Claimed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=claimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed"`
+	// last_settled is the block height, or the epoch number if an epoch identifier is set, up to which
+	// the payments have been claimed.
+	LastSettled int64 `protobuf:"varint,8,opt,name=last_settled,json=lastSettled,proto3" json:"last_settled,omitempty"`
+}
+
+func (m *PaymentStream) Reset()         { *m = PaymentStream{} }
+func (m *PaymentStream) String() string { return proto.CompactTextString(m) }
+func (*PaymentStream) ProtoMessage()    {}
+func (*PaymentStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{2}
+}
+func (m *PaymentStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymentStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymentStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaymentStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentStream.Merge(m, src)
+}
+func (m *PaymentStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymentStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentStream proto.InternalMessageInfo
+
+func (m *PaymentStream) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PaymentStream) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *PaymentStream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *PaymentStream) GetRate() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rate
+	}
+	return nil
+}
+
+func (m *PaymentStream) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *PaymentStream) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *PaymentStream) GetClaimed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Claimed
+	}
+	return nil
+}
+
+func (m *PaymentStream) GetLastSettled() int64 {
+	if m != nil {
+		return m.LastSettled
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Budget)(nil), "cosmos.protocolpool.v1.Budget")
 	proto.RegisterType((*ContinuousFund)(nil), "cosmos.protocolpool.v1.ContinuousFund")
+	proto.RegisterType((*PaymentStream)(nil), "cosmos.protocolpool.v1.PaymentStream")
 }
 
 func init() {
@@ -192,39 +307,49 @@ func init() {
 }
 
 var fileDescriptor_c1b7d0ea246d7f44 = []byte{
-	// 502 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0x8e, 0x69, 0x88, 0x94, 0x83, 0x94, 0x72, 0xaa, 0x90, 0x1b, 0x24, 0x27, 0x84, 0x25, 0x4b,
-	0xcf, 0x0a, 0x48, 0x80, 0xc4, 0x42, 0x9d, 0xf0, 0x31, 0x74, 0x80, 0xd0, 0x89, 0xc5, 0x3a, 0xdb,
-	0x6f, 0xdc, 0x13, 0xf6, 0x9d, 0x75, 0x77, 0x8e, 0x9a, 0x95, 0x5f, 0xd0, 0x91, 0x1f, 0xd2, 0x1f,
-	0xd1, 0xb1, 0xaa, 0x84, 0x84, 0x18, 0x0a, 0x4a, 0xfe, 0x08, 0x8a, 0xef, 0x12, 0xd2, 0x2e, 0x65,
-	0xb3, 0xde, 0xe7, 0xc3, 0xcf, 0xf3, 0x9e, 0x5e, 0xd4, 0x8b, 0x85, 0xca, 0x85, 0xf2, 0x0b, 0x29,
-	0xb4, 0x88, 0x45, 0x56, 0x08, 0x91, 0xf9, 0xd3, 0x81, 0xaf, 0x67, 0x05, 0x28, 0x52, 0x4d, 0xf1,
-	0x23, 0xc3, 0x21, 0x9b, 0x1c, 0x32, 0x1d, 0xb4, 0x77, 0x53, 0x91, 0x8a, 0x6a, 0xe8, 0x2f, 0xbf,
-	0x0c, 0xde, 0xde, 0x33, 0xec, 0xd0, 0x00, 0x9b, 0xd2, 0xb6, 0x67, 0x7f, 0x16, 0x51, 0x05, 0xfe,
-	0x74, 0x10, 0x81, 0xa6, 0x03, 0x3f, 0x16, 0x8c, 0x5b, 0xbc, 0x93, 0x0a, 0x91, 0x66, 0x60, 0xc2,
-	0x44, 0xe5, 0xc4, 0xd7, 0x2c, 0x07, 0xa5, 0x69, 0x5e, 0xac, 0x0c, 0x6e, 0x12, 0x92, 0x52, 0x52,
-	0xcd, 0x84, 0x35, 0xe8, 0x7d, 0xdb, 0x42, 0x8d, 0xa0, 0x4c, 0x52, 0xd0, 0xf8, 0x2d, 0x7a, 0x28,
-	0x21, 0x66, 0x05, 0x03, 0xae, 0x43, 0x9a, 0x24, 0x12, 0x94, 0x72, 0x9d, 0xae, 0xd3, 0x6f, 0x06,
-	0xee, 0xe5, 0xd9, 0xfe, 0xae, 0x0d, 0x76, 0x60, 0x90, 0xcf, 0x5a, 0x32, 0x9e, 0x8e, 0x77, 0xd6,
-	0x12, 0x3b, 0xc7, 0x6f, 0xd0, 0x76, 0x9c, 0x51, 0x96, 0x43, 0x12, 0xd2, 0x5c, 0x94, 0x5c, 0xbb,
-	0x77, 0xba, 0x4e, 0xff, 0xde, 0xb3, 0x3d, 0x62, 0x0d, 0x96, 0x5d, 0x88, 0xed, 0x42, 0x86, 0x82,
-	0xf1, 0x71, 0xcb, 0x0a, 0x0e, 0x2a, 0x3e, 0xfe, 0x80, 0x1e, 0x64, 0x54, 0xe9, 0x70, 0x6d, 0xa3,
-	0xdd, 0xad, 0xca, 0xa2, 0x4d, 0x4c, 0x1b, 0xb2, 0x6a, 0x43, 0x8e, 0x56, 0x75, 0x83, 0xfa, 0xe9,
-	0xef, 0x8e, 0x33, 0x6e, 0x2d, 0x85, 0x43, 0xeb, 0xa6, 0xf1, 0x53, 0xd4, 0xd2, 0x92, 0xf2, 0xf8,
-	0x18, 0x54, 0x98, 0xc1, 0x44, 0xbb, 0xf5, 0xae, 0xd3, 0xaf, 0x8f, 0xef, 0xaf, 0x86, 0x87, 0x30,
-	0xd1, 0xf8, 0x3d, 0xc2, 0x51, 0xb5, 0x81, 0xb0, 0x00, 0x19, 0x5a, 0xc8, 0xbd, 0x7b, 0x5b, 0xe8,
-	0x1d, 0x23, 0xfa, 0x08, 0xf2, 0xc8, 0x48, 0xf0, 0x4b, 0xd4, 0x28, 0x40, 0x32, 0x91, 0xb8, 0x0d,
-	0x2b, 0xbe, 0x19, 0x77, 0x64, 0x97, 0x1f, 0xd4, 0xbf, 0x2f, 0xd3, 0x5a, 0x7a, 0xef, 0x87, 0x83,
-	0xb6, 0x87, 0x82, 0x6b, 0xc6, 0x4b, 0x51, 0xaa, 0x77, 0x25, 0x4f, 0xf0, 0x0b, 0xd4, 0x5c, 0x6f,
-	0xf6, 0xd6, 0x47, 0xf8, 0x47, 0xc5, 0x9f, 0x10, 0x2a, 0x40, 0xc6, 0xc0, 0x35, 0x4d, 0xa1, 0xda,
-	0x7c, 0x33, 0x18, 0x9c, 0x5f, 0x75, 0x6a, 0xbf, 0xae, 0x3a, 0x8f, 0x8d, 0x58, 0x25, 0x5f, 0x09,
-	0x13, 0x7e, 0x4e, 0xf5, 0x31, 0x39, 0x84, 0x94, 0xc6, 0xb3, 0x11, 0xc4, 0x97, 0x67, 0xfb, 0xc8,
-	0x7a, 0x8f, 0x20, 0x1e, 0x6f, 0x98, 0xe0, 0x57, 0xa8, 0x01, 0x27, 0x05, 0x93, 0xb3, 0xff, 0x7e,
-	0x05, 0xcb, 0x0f, 0x5e, 0x9f, 0xcf, 0x3d, 0xe7, 0x62, 0xee, 0x39, 0x7f, 0xe6, 0x9e, 0x73, 0xba,
-	0xf0, 0x6a, 0x17, 0x0b, 0xaf, 0xf6, 0x73, 0xe1, 0xd5, 0xbe, 0x3c, 0xb9, 0x16, 0xe5, 0xe4, 0xfa,
-	0x31, 0x55, 0x97, 0x14, 0x35, 0xaa, 0xd9, 0xf3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x86, 0x82,
-	0x01, 0x87, 0x70, 0x03, 0x00, 0x00,
+	// 658 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbf, 0x4f, 0xdb, 0x40,
+	0x14, 0x8e, 0x13, 0x37, 0x34, 0x07, 0x01, 0x7a, 0x42, 0x95, 0x49, 0xa5, 0x24, 0xa4, 0x4b, 0x3a,
+	0x60, 0x93, 0x56, 0x6a, 0x2b, 0x75, 0x29, 0x81, 0xfe, 0x92, 0x18, 0xa8, 0x61, 0xea, 0x62, 0x5d,
+	0x7c, 0x2f, 0xce, 0x09, 0xdb, 0x67, 0xdd, 0x9d, 0x11, 0x59, 0x3b, 0x74, 0x66, 0xec, 0xdf, 0xd0,
+	0x99, 0x3f, 0x82, 0x11, 0x21, 0x55, 0xaa, 0x3a, 0x40, 0x05, 0xff, 0x48, 0x65, 0xfb, 0x92, 0x02,
+	0x43, 0x61, 0x68, 0xa7, 0x44, 0xdf, 0xfb, 0xbe, 0xef, 0xde, 0xfb, 0xee, 0x9e, 0x51, 0xc7, 0xe7,
+	0x32, 0xe2, 0xd2, 0x49, 0x04, 0x57, 0xdc, 0xe7, 0x61, 0xc2, 0x79, 0xe8, 0xec, 0xf7, 0x1c, 0x35,
+	0x4e, 0x40, 0xda, 0x39, 0x8a, 0x1f, 0x16, 0x1c, 0xfb, 0x2a, 0xc7, 0xde, 0xef, 0x35, 0x96, 0x02,
+	0x1e, 0xf0, 0x1c, 0x74, 0xb2, 0x7f, 0x45, 0xbd, 0xb1, 0x5c, 0xb0, 0xbd, 0xa2, 0x70, 0x55, 0xda,
+	0x68, 0xea, 0xc3, 0x06, 0x44, 0x82, 0xb3, 0xdf, 0x1b, 0x80, 0x22, 0x3d, 0xc7, 0xe7, 0x2c, 0xd6,
+	0xf5, 0x56, 0xc0, 0x79, 0x10, 0x42, 0xd1, 0xcc, 0x20, 0x1d, 0x3a, 0x8a, 0x45, 0x20, 0x15, 0x89,
+	0x92, 0x89, 0xc1, 0x4d, 0x02, 0x4d, 0x05, 0x51, 0x8c, 0x6b, 0x83, 0xce, 0xe7, 0x0a, 0xaa, 0xf6,
+	0x53, 0x1a, 0x80, 0xc2, 0x6f, 0xd0, 0x03, 0x01, 0x3e, 0x4b, 0x18, 0xc4, 0xca, 0x23, 0x94, 0x0a,
+	0x90, 0xd2, 0x32, 0xda, 0x46, 0xb7, 0xd6, 0xb7, 0x4e, 0x8f, 0x56, 0x97, 0x74, 0x63, 0xeb, 0x45,
+	0x65, 0x47, 0x09, 0x16, 0x07, 0xee, 0xe2, 0x54, 0xa2, 0x71, 0xfc, 0x1a, 0xcd, 0xfb, 0x21, 0x61,
+	0x11, 0x50, 0x8f, 0x44, 0x3c, 0x8d, 0x95, 0x55, 0x6e, 0x1b, 0xdd, 0xd9, 0xa7, 0xcb, 0xb6, 0x36,
+	0xc8, 0x66, 0xb1, 0xf5, 0x2c, 0xf6, 0x06, 0x67, 0xb1, 0x5b, 0xd7, 0x82, 0xf5, 0x9c, 0x8f, 0xdf,
+	0xa3, 0x85, 0x90, 0x48, 0xe5, 0x4d, 0x6d, 0x94, 0x55, 0xc9, 0x2d, 0x1a, 0x76, 0x31, 0x8d, 0x3d,
+	0x99, 0xc6, 0xde, 0x9d, 0x8c, 0xdb, 0x37, 0x0f, 0xcf, 0x5b, 0x86, 0x5b, 0xcf, 0x84, 0x1b, 0xda,
+	0x4d, 0xe1, 0xc7, 0xa8, 0xae, 0x04, 0x89, 0xfd, 0x11, 0x48, 0x2f, 0x84, 0xa1, 0xb2, 0xcc, 0xb6,
+	0xd1, 0x35, 0xdd, 0xb9, 0x09, 0xb8, 0x05, 0x43, 0x85, 0xdf, 0x21, 0x3c, 0xc8, 0x13, 0xf0, 0x12,
+	0x10, 0x9e, 0x2e, 0x59, 0xf7, 0x6e, 0x6b, 0x7a, 0xb1, 0x10, 0x6d, 0x83, 0xd8, 0x2d, 0x24, 0xf8,
+	0x05, 0xaa, 0x26, 0x20, 0x18, 0xa7, 0x56, 0x55, 0x8b, 0x6f, 0xb6, 0xbb, 0xa9, 0xc3, 0xef, 0x9b,
+	0x5f, 0xb3, 0x6e, 0x35, 0xbd, 0xf3, 0xdd, 0x40, 0xf3, 0x1b, 0x3c, 0x56, 0x2c, 0x4e, 0x79, 0x2a,
+	0xdf, 0xa6, 0x31, 0xc5, 0xcf, 0x51, 0x6d, 0x9a, 0xec, 0xad, 0x97, 0xf0, 0x87, 0x8a, 0x3f, 0x22,
+	0x94, 0x80, 0xf0, 0x21, 0x56, 0x24, 0x80, 0x3c, 0xf9, 0x5a, 0xbf, 0x77, 0x7c, 0xd6, 0x2a, 0xfd,
+	0x3c, 0x6b, 0x3d, 0x2a, 0xc4, 0x92, 0xee, 0xd9, 0x8c, 0x3b, 0x11, 0x51, 0x23, 0x7b, 0x0b, 0x02,
+	0xe2, 0x8f, 0x37, 0xc1, 0x3f, 0x3d, 0x5a, 0x45, 0xda, 0x7b, 0x13, 0x7c, 0xf7, 0x8a, 0x09, 0x7e,
+	0x89, 0xaa, 0x70, 0x90, 0x30, 0x31, 0xbe, 0xf3, 0x2d, 0x68, 0x7e, 0xe7, 0x8b, 0x89, 0xea, 0xdb,
+	0x64, 0x1c, 0x41, 0xac, 0x76, 0x94, 0x00, 0x12, 0xe1, 0x79, 0x54, 0x66, 0x34, 0x9f, 0xc7, 0x74,
+	0xcb, 0x8c, 0xe2, 0x35, 0x54, 0x95, 0x10, 0x53, 0x10, 0x56, 0xf9, 0x96, 0x19, 0x35, 0xef, 0x7a,
+	0x30, 0x95, 0xbb, 0x07, 0xe3, 0x21, 0x53, 0x10, 0x05, 0x96, 0xd9, 0xae, 0xfc, 0xf5, 0x5e, 0xfb,
+	0x6b, 0x59, 0x5a, 0xdf, 0xce, 0x5b, 0xdd, 0x80, 0xa9, 0x51, 0x3a, 0xb0, 0x7d, 0x1e, 0xe9, 0x9d,
+	0xd4, 0x3f, 0xab, 0x92, 0xee, 0xe9, 0x6d, 0xcf, 0x04, 0xd2, 0xcd, 0x8d, 0xf1, 0x13, 0xb4, 0x08,
+	0x09, 0xf7, 0x47, 0x1e, 0xa3, 0x10, 0x2b, 0x36, 0x64, 0x20, 0xf2, 0x47, 0x54, 0x73, 0x17, 0x72,
+	0xfc, 0xc3, 0x14, 0xc6, 0x80, 0x66, 0x28, 0x24, 0x5c, 0x32, 0x65, 0x55, 0xff, 0x7d, 0x3b, 0x13,
+	0xef, 0xec, 0x18, 0xbd, 0x42, 0xd6, 0xcc, 0x7f, 0x38, 0x46, 0x7b, 0xe3, 0x15, 0x34, 0x97, 0xaf,
+	0xab, 0x04, 0xa5, 0x42, 0xa0, 0xd6, 0xfd, 0xb6, 0xd1, 0xad, 0xb8, 0xb3, 0x19, 0xb6, 0x53, 0x40,
+	0xfd, 0x57, 0xc7, 0x17, 0x4d, 0xe3, 0xe4, 0xa2, 0x69, 0xfc, 0xba, 0x68, 0x1a, 0x87, 0x97, 0xcd,
+	0xd2, 0xc9, 0x65, 0xb3, 0xf4, 0xe3, 0xb2, 0x59, 0xfa, 0xb4, 0x72, 0xed, 0x4d, 0x1e, 0x5c, 0xff,
+	0xaa, 0xe6, 0xc7, 0x0d, 0xaa, 0x39, 0xf6, 0xec, 0xf7, 0x00, 0x5b, 0xc3, 0x4d, 0x73, 0x79, 0x05,
+	0x00, 0x00,
 }
 
 func (m *Budget) Marshal() (dAtA []byte, err error) {
@@ -356,6 +481,102 @@ func (m *ContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PaymentStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastSettled != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastSettled))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Claimed) > 0 {
+		for iNdEx := len(m.Claimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Rate) > 0 {
+		for iNdEx := len(m.Rate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -418,6 +639,51 @@ func (m *ContinuousFund) Size() (n int) {
 	return n
 }
 
+func (m *PaymentStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Rate) > 0 {
+		for _, e := range m.Rate {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Claimed) > 0 {
+		for _, e := range m.Claimed {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.LastSettled != 0 {
+		n += 1 + sovTypes(uint64(m.LastSettled))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -821,6 +1087,292 @@ func (m *ContinuousFund) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PaymentStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymentStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymentStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rate = append(m.Rate, types.Coin{})
+			if err := m.Rate[len(m.Rate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimed = append(m.Claimed, types.Coin{})
+			if err := m.Claimed[len(m.Claimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSettled", wireType)
+			}
+			m.LastSettled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSettled |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
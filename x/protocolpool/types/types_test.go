@@ -10,6 +10,7 @@ import (
 
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var hour = time.Hour
@@ -24,6 +25,10 @@ func TestRegisterInterfaces(t *testing.T) {
 	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCreateContinuousFund{}))
 	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCancelContinuousFund{}))
 	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgWithdrawContinuousFund{}))
+	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCreatePaymentStream{}))
+	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgTopUpPaymentStream{}))
+	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgCancelPaymentStream{}))
+	require.NoError(t, interfaceRegistry.EnsureRegistered(&MsgClaimPaymentStream{}))
 }
 
 func TestNewMsgFundCommunityPool(t *testing.T) {
@@ -228,3 +233,41 @@ func TestValidateContinuousFund(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateGenesisPaymentStreams(t *testing.T) {
+	stream := &PaymentStream{
+		Id:        1,
+		Sender:    "cosmos1qypq2q2l8z4wz2z2l8z4wz2z2l8z4wz2z2l8z4",
+		Recipient: "cosmos1qypq2q2l8z4wz2z2l8z4wz2z2l8z4wz2z2l8z5",
+		Rate:      sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		Deposit:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	}
+
+	gs := DefaultGenesisState()
+	gs.PaymentStreams = []*PaymentStream{stream}
+	gs.NextPaymentStreamId = 2
+	gs.StreamEpochs = []*StreamEpoch{{Identifier: "week", Number: 1}}
+	require.NoError(t, ValidateGenesis(gs))
+
+	gs.NextPaymentStreamId = 1
+	require.ErrorContains(t, ValidateGenesis(gs), "must be lower than the next payment stream id")
+
+	gs.NextPaymentStreamId = 2
+	gs.PaymentStreams = []*PaymentStream{stream, stream}
+	require.ErrorContains(t, ValidateGenesis(gs), "duplicate payment stream id 1")
+
+	gs.PaymentStreams = []*PaymentStream{{Id: 1, Sender: stream.Sender, Recipient: stream.Recipient, Deposit: stream.Deposit}}
+	require.ErrorContains(t, ValidateGenesis(gs), "invalid rate")
+
+	gs.PaymentStreams = []*PaymentStream{stream}
+	gs.StreamEpochs = append(gs.StreamEpochs, &StreamEpoch{Identifier: "week", Number: 2})
+	require.ErrorContains(t, ValidateGenesis(gs), "duplicate stream epoch week")
+}
+
+func TestValidatePaymentStreamRate(t *testing.T) {
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("foo", 100), sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, ValidatePaymentStreamRate(sdk.NewCoins(sdk.NewInt64Coin("foo", 1)), deposit))
+	require.NoError(t, ValidatePaymentStreamRate(sdk.NewCoins(sdk.NewInt64Coin("foo", 1), sdk.NewInt64Coin("stake", 1)), deposit))
+	require.ErrorIs(t, ValidatePaymentStreamRate(sdk.NewCoins(), deposit), sdkerrors.ErrInvalidCoins)
+	require.ErrorIs(t, ValidatePaymentStreamRate(sdk.NewCoins(sdk.NewInt64Coin("bar", 1)), deposit), ErrInvalidPaymentStream)
+}